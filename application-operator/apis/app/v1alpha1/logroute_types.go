@@ -0,0 +1,138 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	oamrt "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogRouteKind identifies the Kind for the log route.
+const LogRouteKind string = "LogRoute"
+
+func init() {
+	SchemeBuilder.Register(&LogRoute{}, &LogRouteList{})
+}
+
+// LogRouteList contains a list of log route resources.
+// +kubebuilder:object:root=true
+type LogRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogRoute `json:"items"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LogRoute specifies the log route API. A log route sends the logs of the containers in its namespace to
+// additional destinations. Logs continue to be sent to the default Verrazzano OpenSearch data stream.
+type LogRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of a log route.
+	Spec LogRouteSpec `json:"spec"`
+	// The observed state of a log route.
+	Status LogRouteStatus `json:"status,omitempty"`
+}
+
+// LogRouteSpec specifies the desired state of a log route.
+type LogRouteSpec struct {
+	// The destinations to which the logs of the namespace are sent.
+	Destinations []LogDestination `json:"destinations"`
+}
+
+// LogDestination specifies a single log destination. Exactly one of `opensearch`, `s3`, or `loki` must be specified.
+type LogDestination struct {
+	// The name of the destination. Must be unique within the log route.
+	Name string `json:"name"`
+
+	// An OpenSearch destination.
+	// +optional
+	OpenSearch *OpenSearchLogDestination `json:"opensearch,omitempty"`
+
+	// An S3-compatible object store destination.
+	// +optional
+	S3 *S3LogDestination `json:"s3,omitempty"`
+
+	// A Loki destination.
+	// +optional
+	Loki *LokiLogDestination `json:"loki,omitempty"`
+}
+
+// OpenSearchLogDestination specifies an OpenSearch cluster that receives logs.
+type OpenSearchLogDestination struct {
+	// The URL of the OpenSearch cluster, for example, `https://opensearch.example.com:9200`.
+	URL string `json:"url"`
+
+	// The index that receives the logs. When `logstashFormat` is `true`, this value is used as the
+	// prefix of the daily index names. Defaults to `verrazzano-logroute-<namespace>`.
+	// +optional
+	Index string `json:"index,omitempty"`
+
+	// Specifies whether daily indices are created using the index as a prefix. Defaults to `false`.
+	// +optional
+	LogstashFormat *bool `json:"logstashFormat,omitempty"`
+
+	// The name of a secret in the log route namespace that contains the `username` and `password` keys used to
+	// access the OpenSearch cluster.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// S3LogDestination specifies an S3-compatible object store that receives logs.
+type S3LogDestination struct {
+	// The name of the bucket.
+	Bucket string `json:"bucket"`
+
+	// The region of the bucket.
+	Region string `json:"region"`
+
+	// The endpoint of an S3-compatible object store. Required when the store is not Amazon S3.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// The format of the object keys. Defaults to `/<namespace>/%Y/%m/%d/%H/%M/%S/$UUID.gz`.
+	// +optional
+	KeyFormat string `json:"keyFormat,omitempty"`
+
+	// The name of a secret in the log route namespace that contains the `access_key_id` and `secret_access_key` keys
+	// used to access the object store.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// LokiLogDestination specifies a Loki instance that receives logs.
+type LokiLogDestination struct {
+	// The host name of the Loki instance.
+	Host string `json:"host"`
+
+	// The port of the Loki instance. Defaults to `3100`.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Specifies whether TLS is used to connect to Loki. Defaults to `false`.
+	// +optional
+	TLS bool `json:"tls,omitempty"`
+
+	// Additional stream labels in the `key=value` format.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// The name of a secret in the log route namespace that contains the `username` and `password` keys used for
+	// HTTP basic authentication, and optionally the `tenantID` key.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// LogRouteStatus defines the observed state of a log route.
+type LogRouteStatus struct {
+	// Reconcile status of this log route.
+	oamrt.ConditionedStatus `json:",inline"`
+
+	// The names of the fluent-bit ClusterOutput resources generated for this log route.
+	// +optional
+	Outputs []string `json:"outputs,omitempty"`
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -38,6 +38,10 @@ type MetricsTemplateSpec struct {
 	// Prometheus configuration details.
 	// +optional
 	PrometheusConfig PrometheusConfig `json:"prometheusConfig,omitempty"`
+	// Prometheus Operator monitor configuration. When specified, a ServiceMonitor or PodMonitor is created for each
+	// workload that uses this template, instead of updating the Prometheus configuration specified in `prometheusConfig`.
+	// +optional
+	MonitorConfig *MonitorConfig `json:"monitorConfig,omitempty"`
 	// Selector for target workloads.
 	// +optional
 	WorkloadSelector WorkloadSelector `json:"workloadSelector,omitempty"`
@@ -75,6 +79,29 @@ type PrometheusConfig struct {
 	TargetConfigMap TargetConfigMap `json:"targetConfigMap"`
 }
 
+// MonitorKind identifies the kind of Prometheus Operator monitor created from a metrics template.
+type MonitorKind string
+
+const (
+	// ServiceMonitorKind specifies that a ServiceMonitor is created from the template.
+	ServiceMonitorKind MonitorKind = "ServiceMonitor"
+	// PodMonitorKind specifies that a PodMonitor is created from the template.
+	PodMonitorKind MonitorKind = "PodMonitor"
+)
+
+// MonitorConfig refers to the templated Prometheus Operator monitor configuration.
+type MonitorConfig struct {
+	// The kind of monitor to create, either `ServiceMonitor` or `PodMonitor`. Defaults to `PodMonitor`.
+	// +kubebuilder:validation:Enum=ServiceMonitor;PodMonitor
+	// +optional
+	Kind MonitorKind `json:"kind,omitempty"`
+
+	// Monitor spec template in YAML format. The template is processed with the `workload` and `namespace` inputs and
+	// must produce a ServiceMonitor or PodMonitor spec. When a PodMonitor spec has no selector, the pods of the
+	// workload are selected using the `app.verrazzano.io/workload` label. A ServiceMonitor spec must have a selector.
+	MonitorSpecTemplate string `json:"monitorSpecTemplate"`
+}
+
 // TargetConfigMap contains metadata about the Prometheus ConfigMap.
 type TargetConfigMap struct {
 	// Name of the ConfigMap to be updated with the scrape target configuration.
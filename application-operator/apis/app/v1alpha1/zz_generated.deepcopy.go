@@ -1,7 +1,7 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by controller-gen. DO NOT EDIT.
@@ -12,6 +12,163 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDestination) DeepCopyInto(out *LogDestination) {
+	*out = *in
+	if in.OpenSearch != nil {
+		in, out := &in.OpenSearch, &out.OpenSearch
+		*out = new(OpenSearchLogDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3LogDestination)
+		**out = **in
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiLogDestination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDestination.
+func (in *LogDestination) DeepCopy() *LogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRoute) DeepCopyInto(out *LogRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRoute.
+func (in *LogRoute) DeepCopy() *LogRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRouteList) DeepCopyInto(out *LogRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRouteList.
+func (in *LogRouteList) DeepCopy() *LogRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRouteSpec) DeepCopyInto(out *LogRouteSpec) {
+	*out = *in
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]LogDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRouteSpec.
+func (in *LogRouteSpec) DeepCopy() *LogRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRouteStatus) DeepCopyInto(out *LogRouteStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRouteStatus.
+func (in *LogRouteStatus) DeepCopy() *LogRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiLogDestination) DeepCopyInto(out *LokiLogDestination) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiLogDestination.
+func (in *LokiLogDestination) DeepCopy() *LokiLogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiLogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsBinding) DeepCopyInto(out *MetricsBinding) {
 	*out = *in
@@ -151,6 +308,11 @@ func (in *MetricsTemplateList) DeepCopyObject() runtime.Object {
 func (in *MetricsTemplateSpec) DeepCopyInto(out *MetricsTemplateSpec) {
 	*out = *in
 	out.PrometheusConfig = in.PrometheusConfig
+	if in.MonitorConfig != nil {
+		in, out := &in.MonitorConfig, &out.MonitorConfig
+		*out = new(MonitorConfig)
+		**out = **in
+	}
 	in.WorkloadSelector.DeepCopyInto(&out.WorkloadSelector)
 }
 
@@ -164,6 +326,21 @@ func (in *MetricsTemplateSpec) DeepCopy() *MetricsTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorConfig) DeepCopyInto(out *MonitorConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorConfig.
+func (in *MonitorConfig) DeepCopy() *MonitorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceName) DeepCopyInto(out *NamespaceName) {
 	*out = *in
@@ -179,6 +356,26 @@ func (in *NamespaceName) DeepCopy() *NamespaceName {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchLogDestination) DeepCopyInto(out *OpenSearchLogDestination) {
+	*out = *in
+	if in.LogstashFormat != nil {
+		in, out := &in.LogstashFormat, &out.LogstashFormat
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchLogDestination.
+func (in *OpenSearchLogDestination) DeepCopy() *OpenSearchLogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchLogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusConfig) DeepCopyInto(out *PrometheusConfig) {
 	*out = *in
@@ -195,6 +392,21 @@ func (in *PrometheusConfig) DeepCopy() *PrometheusConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3LogDestination) DeepCopyInto(out *S3LogDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3LogDestination.
+func (in *S3LogDestination) DeepCopy() *S3LogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(S3LogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKey) DeepCopyInto(out *SecretKey) {
 	*out = *in
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -43,6 +43,87 @@ type ClusterLevelStatus struct {
 	Name string `json:"name"`
 	// State of the resource in this cluster.
 	State StateType `json:"state"`
+	// Resource usage of the resource in this cluster. Only reported for Verrazzano projects with resource quotas.
+	// +optional
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+	// Services published by this cluster. Only reported for Verrazzano projects with service discovery.
+	// +optional
+	ServiceExports *ServiceExports `json:"serviceExports,omitempty"`
+}
+
+// ServiceExports describes the services of a Verrazzano project published by a specific cluster.
+type ServiceExports struct {
+	// The address of the Istio ingress gateway through which the other clusters reach the services. Not set when the
+	// Istio ingress gateway has no external address or does not expose the port `15443`.
+	// +optional
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+	// The port of the Istio ingress gateway through which the other clusters reach the services.
+	// +optional
+	GatewayPort int32 `json:"gatewayPort,omitempty"`
+	// The SHA-256 fingerprint of the root CA of the Istio mesh of the cluster. The services are only reached from the
+	// clusters whose Istio mesh has the same root CA.
+	// +optional
+	MeshRootCAFingerprint string `json:"meshRootCAFingerprint,omitempty"`
+	// The published services.
+	// +optional
+	Services []ExportedService `json:"services,omitempty"`
+	// The other clusters of the project whose published services are not reached from this cluster.
+	// +optional
+	SkippedPeers []SkippedPeer `json:"skippedPeers,omitempty"`
+}
+
+// SkippedPeer describes a cluster whose published services are not reached from a specific cluster.
+type SkippedPeer struct {
+	// Name of the cluster.
+	Name string `json:"name"`
+	// Reason the published services are not reached: `GatewayUnreachable` when the Istio ingress gateway of the
+	// cluster has no external address or does not expose the port `15443`, or `RootCAMismatch` when the Istio meshes
+	// of the clusters do not share a root CA.
+	Reason SkippedPeerReason `json:"reason"`
+	// A message with details about the reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SkippedPeerReason identifies why the published services of a cluster are not reached.
+type SkippedPeerReason string
+
+const (
+	// SkippedPeerGatewayUnreachable means the Istio ingress gateway of the cluster can not be reached.
+	SkippedPeerGatewayUnreachable SkippedPeerReason = "GatewayUnreachable"
+	// SkippedPeerRootCAMismatch means the Istio meshes of the clusters do not share a root CA.
+	SkippedPeerRootCAMismatch SkippedPeerReason = "RootCAMismatch"
+)
+
+// ExportedService describes a service published to the other clusters.
+type ExportedService struct {
+	// Name of the service.
+	Name string `json:"name"`
+	// Namespace of the service.
+	Namespace string `json:"namespace"`
+	// Ports of the service.
+	// +optional
+	Ports []ExportedServicePort `json:"ports,omitempty"`
+}
+
+// ExportedServicePort describes a port of a service published to the other clusters.
+type ExportedServicePort struct {
+	// Name of the port.
+	Name string `json:"name"`
+	// Number of the port.
+	Port int32 `json:"port"`
+	// Istio protocol of the port: one of `HTTP`, `HTTP2`, `GRPC`, or `TCP`.
+	Protocol string `json:"protocol"`
+}
+
+// ResourceUsage describes the aggregated resource quota usage in a specific cluster.
+type ResourceUsage struct {
+	// The sum of the hard limits of the resource quotas.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+	// The sum of the resources used in the resource quotas.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
 }
 
 // ConditionType identifies the condition of the multicluster resource which can be checked with `kubectl wait`.
@@ -57,6 +138,9 @@ const (
 
 	// DeployPending means deployment to the specified cluster is in progress.
 	DeployPending ConditionType = "DeployPending"
+
+	// DriftDetected means the resource deployed to the specified cluster was modified outside of the multicluster resource.
+	DriftDetected ConditionType = "DriftDetected"
 )
 
 // StateType identifies the state of a multicluster resource.
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const MultiClusterGitSourceKind = "MultiClusterGitSource"
+const MultiClusterGitSourceResource = "multiclustergitsources"
+
+// MultiClusterGitSourceSpec defines the desired state of a MultiCluster Git Source.
+type MultiClusterGitSourceSpec struct {
+	// The URL of the Git repository.
+	RepoURL string `json:"repoURL"`
+	// The Git revision to deploy: a branch, a tag, or a commit. Defaults to `HEAD`.
+	// +optional
+	TargetRevision string `json:"targetRevision,omitempty"`
+	// The path of the resources in the Git repository.
+	Path string `json:"path"`
+	// The name of the Verrazzano project, in the same namespace, whose placement selects the clusters to which the
+	// resources are deployed.
+	Project string `json:"project"`
+	// The namespace to which the resources are deployed. Must be one of the namespaces of the project. Defaults to
+	// the first namespace of the project.
+	// +optional
+	DestinationNamespace string `json:"destinationNamespace,omitempty"`
+	// The synchronization policy of the resources.
+	// +optional
+	SyncPolicy *GitSyncPolicy `json:"syncPolicy,omitempty"`
+}
+
+// GitSyncPolicy defines how the resources are synchronized from the Git repository.
+type GitSyncPolicy struct {
+	// If true, the resources are synchronized automatically when the Git repository changes. The default value is
+	// `true`.
+	// +optional
+	Automated *bool `json:"automated,omitempty"`
+	// If true, the resources that are removed from the Git repository are deleted from the clusters.
+	// +optional
+	Prune bool `json:"prune,omitempty"`
+	// If true, the changes made to the resources on the clusters are reverted.
+	// +optional
+	SelfHeal bool `json:"selfHeal,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=mcgitsource;mcgitsources
+// +kubebuilder:subresource:status
+
+// MultiClusterGitSource specifies the MultiCluster Git Source API. The resources in a path of a Git repository are
+// deployed with Argo CD to the clusters on which a Verrazzano project is placed.
+type MultiClusterGitSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of a MultiCluster Git Source resource.
+	Spec MultiClusterGitSourceSpec `json:"spec,omitempty"`
+	// The observed state of a MultiCluster Git Source resource.
+	Status MultiClusterResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiClusterGitSourceList contains a list of MultiClusterGitSource resources.
+type MultiClusterGitSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiClusterGitSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MultiClusterGitSource{}, &MultiClusterGitSourceList{})
+}
+
+// GetStatus returns the MultiClusterResourceStatus of this resource.
+func (in *MultiClusterGitSource) GetStatus() MultiClusterResourceStatus {
+	return in.Status
+}
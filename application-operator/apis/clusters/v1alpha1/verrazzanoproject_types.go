@@ -1,9 +1,10 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
 
 import (
+	appv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -35,6 +36,82 @@ type NetworkPolicyTemplate struct {
 	Spec netv1.NetworkPolicySpec `json:"spec,omitempty"`
 }
 
+// ResourceQuotaTemplate contains the metadata and specification of a Kubernetes ResourceQuota.
+// When the namespace is not specified, the ResourceQuota is created in every namespace of the project. A namespace
+// that is not a project namespace is ignored.
+type ResourceQuotaTemplate struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	// The specification of a resource quota.
+	Spec corev1.ResourceQuotaSpec `json:"spec,omitempty"`
+}
+
+// LimitRangeTemplate contains the metadata and specification of a Kubernetes LimitRange.
+// When the namespace is not specified, the LimitRange is created in every namespace of the project. A namespace
+// that is not a project namespace is ignored.
+type LimitRangeTemplate struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	// The specification of a limit range.
+	Spec corev1.LimitRangeSpec `json:"spec,omitempty"`
+}
+
+// PodSecurityLevel identifies a Pod Security Standards level.
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type PodSecurityLevel string
+
+const (
+	// PodSecurityPrivileged is the unrestricted Pod Security Standards level.
+	PodSecurityPrivileged PodSecurityLevel = "privileged"
+	// PodSecurityBaseline is the minimally restrictive Pod Security Standards level.
+	PodSecurityBaseline PodSecurityLevel = "baseline"
+	// PodSecurityRestricted is the heavily restricted Pod Security Standards level.
+	PodSecurityRestricted PodSecurityLevel = "restricted"
+)
+
+// PodSecuritySpec defines the Pod Security Admission levels of the project namespaces.
+// The Pod Security Admission labels of a namespace are not changed for the levels that are not specified.
+type PodSecuritySpec struct {
+	// The level for which policy violations cause pods to be rejected.
+	// +optional
+	Enforce PodSecurityLevel `json:"enforce,omitempty"`
+	// The level for which policy violations are recorded in the audit log.
+	// +optional
+	Audit PodSecurityLevel `json:"audit,omitempty"`
+	// The level for which policy violations are returned as warnings to the user.
+	// +optional
+	Warn PodSecurityLevel `json:"warn,omitempty"`
+}
+
+// MTLSMode identifies the Istio mutual TLS mode of the project namespaces.
+// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+type MTLSMode string
+
+const (
+	// MTLSModeStrict only accepts mutual TLS traffic.
+	MTLSModeStrict MTLSMode = "STRICT"
+	// MTLSModePermissive accepts both mutual TLS and plain text traffic.
+	MTLSModePermissive MTLSMode = "PERMISSIVE"
+)
+
+// IstioSecuritySpec defines the Istio security configuration of the project namespaces.
+type IstioSecuritySpec struct {
+	// The mutual TLS mode of the PeerAuthentication created in each project namespace.
+	// When not specified, no PeerAuthentication is created.
+	// +optional
+	MTLSMode MTLSMode `json:"mtlsMode,omitempty"`
+	// If true, an AuthorizationPolicy is created in each project namespace that only allows requests from the
+	// project namespaces, the Verrazzano system namespaces and the allowed namespaces. All other requests are denied.
+	// Requires the `STRICT` mutual TLS mode, because the source namespace of plain text requests is not known.
+	// +optional
+	DefaultDeny bool `json:"defaultDeny,omitempty"`
+	// Additional namespaces allowed to send requests to the project namespaces when `defaultDeny` is true.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
 // SecuritySpec defines the security configuration for a Verrazzano Project.
 type SecuritySpec struct {
 	// The subjects to bind to the `verrazzano-project-admin` role.
@@ -43,6 +120,26 @@ type SecuritySpec struct {
 	// The subjects to bind to the `verrazzano-project-monitoring` role.
 	// +optional
 	ProjectMonitorSubjects []rbacv1.Subject `json:"projectMonitorSubjects,omitempty"`
+	// The Pod Security Admission levels of the project namespaces.
+	// +optional
+	PodSecurity *PodSecuritySpec `json:"podSecurity,omitempty"`
+	// The Istio security configuration of the project namespaces.
+	// +optional
+	Istio *IstioSecuritySpec `json:"istio,omitempty"`
+}
+
+// ServiceDiscoverySpec defines the services of a Verrazzano project that are published to the other clusters of the
+// project placement. A published service is addressable from the other clusters as `<service>.<namespace>.global`,
+// and the requests are routed with mutual TLS through the Istio ingress gateway of the cluster that runs the service.
+// The Istio ingress gateway service of each cluster must expose the port `15443`, for example with a port
+// `tls` of target port `15443` in `spec.components.istio.ingress.ports` of the Verrazzano resource. The Istio
+// meshes of the clusters must share a root CA, which is set up by creating the `cacerts` Secret in the
+// `istio-system` namespace of each cluster, with intermediate CA certificates signed by a common root CA, before
+// Istio is installed. The clusters that are not reached, and the reason, are reported in the `skippedPeers` status
+// of the project in each cluster.
+type ServiceDiscoverySpec struct {
+	// Selects the services of the project namespaces that are published to the other clusters.
+	ServiceSelector metav1.LabelSelector `json:"serviceSelector"`
 }
 
 // ProjectTemplate contains the list of namespaces to create and the optional security configuration for each namespace.
@@ -54,9 +151,25 @@ type ProjectTemplate struct {
 	// +optional
 	NetworkPolicies []NetworkPolicyTemplate `json:"networkPolicies,omitempty"`
 
+	// Resource quotas applied to namespaces in the project.
+	// +optional
+	ResourceQuotas []ResourceQuotaTemplate `json:"resourceQuotas,omitempty"`
+
+	// Limit ranges applied to namespaces in the project.
+	// +optional
+	LimitRanges []LimitRangeTemplate `json:"limitRanges,omitempty"`
+
 	// The project security configuration.
 	// +optional
 	Security SecuritySpec `json:"security,omitempty"`
+
+	// The log destinations for the project. A LogRoute resource is created in each project namespace.
+	// +optional
+	Logging *appv1alpha1.LogRouteSpec `json:"logging,omitempty"`
+
+	// The services published to the other clusters of the project placement.
+	// +optional
+	ServiceDiscovery *ServiceDiscoverySpec `json:"serviceDiscovery,omitempty"`
 }
 
 // VerrazzanoProjectSpec defines the desired state of a Verrazzano Project.
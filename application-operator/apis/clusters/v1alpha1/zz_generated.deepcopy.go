@@ -1,7 +1,7 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by controller-gen. DO NOT EDIT.
@@ -9,7 +9,9 @@
 package v1alpha1
 
 import (
-	"k8s.io/api/rbac/v1"
+	appv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -48,6 +50,16 @@ func (in *Cluster) DeepCopy() *Cluster {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterLevelStatus) DeepCopyInto(out *ClusterLevelStatus) {
 	*out = *in
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(ResourceUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceExports != nil {
+		in, out := &in.ServiceExports, &out.ServiceExports
+		*out = new(ServiceExports)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLevelStatus.
@@ -164,6 +176,98 @@ func (in *EmbeddedObjectMeta) DeepCopy() *EmbeddedObjectMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportedService) DeepCopyInto(out *ExportedService) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ExportedServicePort, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportedService.
+func (in *ExportedService) DeepCopy() *ExportedService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportedService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportedServicePort) DeepCopyInto(out *ExportedServicePort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportedServicePort.
+func (in *ExportedServicePort) DeepCopy() *ExportedServicePort {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportedServicePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncPolicy) DeepCopyInto(out *GitSyncPolicy) {
+	*out = *in
+	if in.Automated != nil {
+		in, out := &in.Automated, &out.Automated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncPolicy.
+func (in *GitSyncPolicy) DeepCopy() *GitSyncPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioSecuritySpec) DeepCopyInto(out *IstioSecuritySpec) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioSecuritySpec.
+func (in *IstioSecuritySpec) DeepCopy() *IstioSecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioSecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitRangeTemplate) DeepCopyInto(out *LimitRangeTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitRangeTemplate.
+func (in *LimitRangeTemplate) DeepCopy() *LimitRangeTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitRangeTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiClusterApplicationConfiguration) DeepCopyInto(out *MultiClusterApplicationConfiguration) {
 	*out = *in
@@ -397,13 +501,94 @@ func (in *MultiClusterConfigMapSpec) DeepCopy() *MultiClusterConfigMapSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterGitSource) DeepCopyInto(out *MultiClusterGitSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterGitSource.
+func (in *MultiClusterGitSource) DeepCopy() *MultiClusterGitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterGitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiClusterGitSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterGitSourceList) DeepCopyInto(out *MultiClusterGitSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MultiClusterGitSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterGitSourceList.
+func (in *MultiClusterGitSourceList) DeepCopy() *MultiClusterGitSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterGitSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiClusterGitSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterGitSourceSpec) DeepCopyInto(out *MultiClusterGitSourceSpec) {
+	*out = *in
+	if in.SyncPolicy != nil {
+		in, out := &in.SyncPolicy, &out.SyncPolicy
+		*out = new(GitSyncPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterGitSourceSpec.
+func (in *MultiClusterGitSourceSpec) DeepCopy() *MultiClusterGitSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterGitSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiClusterResourceStatus) DeepCopyInto(out *MultiClusterResourceStatus) {
 	*out = *in
 	if in.Clusters != nil {
 		in, out := &in.Clusters, &out.Clusters
 		*out = make([]ClusterLevelStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -552,6 +737,21 @@ func (in *Placement) DeepCopy() *Placement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecuritySpec) DeepCopyInto(out *PodSecuritySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecuritySpec.
+func (in *PodSecuritySpec) DeepCopy() *PodSecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectTemplate) DeepCopyInto(out *ProjectTemplate) {
 	*out = *in
@@ -569,7 +769,31 @@ func (in *ProjectTemplate) DeepCopyInto(out *ProjectTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]ResourceQuotaTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]LimitRangeTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.Security.DeepCopyInto(&out.Security)
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(appv1alpha1.LogRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceDiscovery != nil {
+		in, out := &in.ServiceDiscovery, &out.ServiceDiscovery
+		*out = new(ServiceDiscoverySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplate.
@@ -582,6 +806,52 @@ func (in *ProjectTemplate) DeepCopy() *ProjectTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaTemplate) DeepCopyInto(out *ResourceQuotaTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaTemplate.
+func (in *ResourceQuotaTemplate) DeepCopy() *ResourceQuotaTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsage.
+func (in *ResourceUsage) DeepCopy() *ResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretTemplate) DeepCopyInto(out *SecretTemplate) {
 	*out = *in
@@ -625,14 +895,24 @@ func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
 	*out = *in
 	if in.ProjectAdminSubjects != nil {
 		in, out := &in.ProjectAdminSubjects, &out.ProjectAdminSubjects
-		*out = make([]v1.Subject, len(*in))
+		*out = make([]rbacv1.Subject, len(*in))
 		copy(*out, *in)
 	}
 	if in.ProjectMonitorSubjects != nil {
 		in, out := &in.ProjectMonitorSubjects, &out.ProjectMonitorSubjects
-		*out = make([]v1.Subject, len(*in))
+		*out = make([]rbacv1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecuritySpec)
+		**out = **in
+	}
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(IstioSecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
@@ -645,6 +925,64 @@ func (in *SecuritySpec) DeepCopy() *SecuritySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceDiscoverySpec) DeepCopyInto(out *ServiceDiscoverySpec) {
+	*out = *in
+	in.ServiceSelector.DeepCopyInto(&out.ServiceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceDiscoverySpec.
+func (in *ServiceDiscoverySpec) DeepCopy() *ServiceDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExports) DeepCopyInto(out *ServiceExports) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ExportedService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SkippedPeers != nil {
+		in, out := &in.SkippedPeers, &out.SkippedPeers
+		*out = make([]SkippedPeer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExports.
+func (in *ServiceExports) DeepCopy() *ServiceExports {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExports)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedPeer) DeepCopyInto(out *SkippedPeer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedPeer.
+func (in *SkippedPeer) DeepCopy() *SkippedPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoProject) DeepCopyInto(out *VerrazzanoProject) {
 	*out = *in
@@ -1,4 +1,4 @@
-// Copyright (c) 2020, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -65,6 +65,10 @@ type MetricsTraitSpec struct {
 	// +optional
 	Secret *string `json:"secret,omitempty"`
 
+	// The recording and alerting rules that Prometheus evaluates for the metrics of the related workload.
+	// +optional
+	Rules []MetricsRule `json:"rules,omitempty"`
+
 	// The WorkloadReference of the workload to which this trait applies.
 	// This value is populated by the OAM runtime when an ApplicationConfiguration
 	// resource is processed.  When the ApplicationConfiguration is processed, a trait and
@@ -86,6 +90,47 @@ type PortSpec struct {
 	Port *int `json:"port,omitempty"`
 }
 
+// MetricsRule defines a Prometheus recording or alerting rule. Exactly one of `record` or `alert` must be specified.
+type MetricsRule struct {
+	// The name of the time series produced by a recording rule. Must be a valid metric name.
+	// +optional
+	Record string `json:"record,omitempty"`
+
+	// The name of the alert produced by an alerting rule.
+	// +optional
+	Alert string `json:"alert,omitempty"`
+
+	// The PromQL expression to evaluate.
+	Expr string `json:"expr"`
+
+	// The duration for which the expression must be true before an alert fires, for example, `5m`. Only valid for
+	// alerting rules.
+	// +optional
+	For string `json:"for,omitempty"`
+
+	// The labels to add to or overwrite in the result of the rule.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// The annotations to add to each alert. Only valid for alerting rules.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// MetricsRuleStatus defines the observed state of a recording or alerting rule.
+type MetricsRuleStatus struct {
+	// The name of the recording or alerting rule.
+	Name string `json:"name"`
+
+	// Whether the rule was added to the PrometheusRule of the trait, either `Applied` or `Rejected`. The evaluation
+	// health of an applied rule is reported by Prometheus.
+	State string `json:"state"`
+
+	// The reason the rule was rejected.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // MetricsTraitStatus defines the observed state of a metrics trait and related resources.
 type MetricsTraitStatus struct {
 	// Reconcile status of this metrics trait.
@@ -93,4 +138,8 @@ type MetricsTraitStatus struct {
 
 	// Related resources affected by this metrics trait.
 	Resources []QualifiedResourceRelation `json:"resources,omitempty"`
+
+	// Whether each recording and alerting rule of this metrics trait was applied or rejected.
+	// +optional
+	Rules []MetricsRuleStatus `json:"rules,omitempty"`
 }
@@ -1,7 +1,7 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by controller-gen. DO NOT EDIT.
@@ -427,6 +427,50 @@ func (in *LoggingTraitStatus) DeepCopy() *LoggingTraitStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsRule) DeepCopyInto(out *MetricsRule) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsRule.
+func (in *MetricsRule) DeepCopy() *MetricsRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsRuleStatus) DeepCopyInto(out *MetricsRuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsRuleStatus.
+func (in *MetricsRuleStatus) DeepCopy() *MetricsRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsTrait) DeepCopyInto(out *MetricsTrait) {
 	*out = *in
@@ -521,6 +565,13 @@ func (in *MetricsTraitSpec) DeepCopyInto(out *MetricsTraitSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MetricsRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.WorkloadReference = in.WorkloadReference
 }
 
@@ -543,6 +594,11 @@ func (in *MetricsTraitStatus) DeepCopyInto(out *MetricsTraitStatus) {
 		*out = make([]QualifiedResourceRelation, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]MetricsRuleStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsTraitStatus.
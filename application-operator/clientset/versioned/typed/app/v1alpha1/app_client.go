@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -15,6 +15,7 @@ import (
 
 type AppV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	LogRoutesGetter
 	MetricsBindingsGetter
 	MetricsTemplatesGetter
 }
@@ -24,6 +25,10 @@ type AppV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *AppV1alpha1Client) LogRoutes(namespace string) LogRouteInterface {
+	return newLogRoutes(c, namespace)
+}
+
 func (c *AppV1alpha1Client) MetricsBindings(namespace string) MetricsBindingInterface {
 	return newMetricsBindings(c, namespace)
 }
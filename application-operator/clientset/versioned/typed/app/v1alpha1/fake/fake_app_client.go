@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -15,6 +15,10 @@ type FakeAppV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeAppV1alpha1) LogRoutes(namespace string) v1alpha1.LogRouteInterface {
+	return &FakeLogRoutes{c, namespace}
+}
+
 func (c *FakeAppV1alpha1) MetricsBindings(namespace string) v1alpha1.MetricsBindingInterface {
 	return &FakeMetricsBindings{c, namespace}
 }
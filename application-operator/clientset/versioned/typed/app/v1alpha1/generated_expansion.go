@@ -1,10 +1,12 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1alpha1
 
+type LogRouteExpansion interface{}
+
 type MetricsBindingExpansion interface{}
 
 type MetricsTemplateExpansion interface{}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -18,6 +18,7 @@ type ClustersV1alpha1Interface interface {
 	MultiClusterApplicationConfigurationsGetter
 	MultiClusterComponentsGetter
 	MultiClusterConfigMapsGetter
+	MultiClusterGitSourcesGetter
 	MultiClusterSecretsGetter
 	VerrazzanoProjectsGetter
 }
@@ -39,6 +40,10 @@ func (c *ClustersV1alpha1Client) MultiClusterConfigMaps(namespace string) MultiC
 	return newMultiClusterConfigMaps(c, namespace)
 }
 
+func (c *ClustersV1alpha1Client) MultiClusterGitSources(namespace string) MultiClusterGitSourceInterface {
+	return newMultiClusterGitSources(c, namespace)
+}
+
 func (c *ClustersV1alpha1Client) MultiClusterSecrets(namespace string) MultiClusterSecretInterface {
 	return newMultiClusterSecrets(c, namespace)
 }
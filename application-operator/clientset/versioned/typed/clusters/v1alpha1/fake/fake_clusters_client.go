@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -27,6 +27,10 @@ func (c *FakeClustersV1alpha1) MultiClusterConfigMaps(namespace string) v1alpha1
 	return &FakeMultiClusterConfigMaps{c, namespace}
 }
 
+func (c *FakeClustersV1alpha1) MultiClusterGitSources(namespace string) v1alpha1.MultiClusterGitSourceInterface {
+	return &FakeMultiClusterGitSources{c, namespace}
+}
+
 func (c *FakeClustersV1alpha1) MultiClusterSecrets(namespace string) v1alpha1.MultiClusterSecretInterface {
 	return &FakeMultiClusterSecrets{c, namespace}
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -11,6 +11,8 @@ type MultiClusterComponentExpansion interface{}
 
 type MultiClusterConfigMapExpansion interface{}
 
+type MultiClusterGitSourceExpansion interface{}
+
 type MultiClusterSecretExpansion interface{}
 
 type VerrazzanoProjectExpansion interface{}
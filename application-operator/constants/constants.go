@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package constants
@@ -106,6 +106,14 @@ const StatusReasonSuccess = "success"
 // OCILoggingIDAnnotation Annotation name for a customized OCI log ID for all containers in a namespace
 const OCILoggingIDAnnotation = "verrazzano.io/oci-log-id"
 
+// EnforceDriftAnnotation is the annotation that opts a multicluster resource into reverting the changes made to its
+// resources on the managed clusters. Drift is only reported when the annotation is not set to "true".
+const EnforceDriftAnnotation = "verrazzano.io/enforce-drift"
+
+// DriftRevertedAnnotation is set by the managed cluster agent on a multicluster resource, with the time the drift of its
+// resource was reverted, so that the resource is reconciled again from the multicluster resource template
+const DriftRevertedAnnotation = "verrazzano.io/drift-reverted"
+
 // WorkloadTypeCoherence indicates the workload is Coherence
 const WorkloadTypeCoherence = "coherence"
 
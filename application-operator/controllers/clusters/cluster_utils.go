@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusters
@@ -15,6 +15,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -71,7 +72,9 @@ func StatusNeedsUpdate(curStatus clustersv1alpha1.MultiClusterResourceStatus,
 	foundClusterLevelStatus := false
 	for _, existingClusterStatus := range curStatus.Clusters {
 		if existingClusterStatus.Name == newClusterStatus.Name &&
-			existingClusterStatus.State == newClusterStatus.State {
+			existingClusterStatus.State == newClusterStatus.State &&
+			equality.Semantic.DeepEqual(existingClusterStatus.ResourceUsage, newClusterStatus.ResourceUsage) &&
+			equality.Semantic.DeepEqual(existingClusterStatus.ServiceExports, newClusterStatus.ServiceExports) {
 			foundClusterLevelStatus = true
 		}
 	}
@@ -258,11 +261,19 @@ func fetchClusterSecret(ctx context.Context, rdr client.Reader, clusterSecret *c
 // Condition to be added, and if so, computes the state and calls the callback function to perform
 // the status update
 func UpdateStatus(resource MultiClusterResource, mcStatus *clustersv1alpha1.MultiClusterResourceStatus, placement clustersv1alpha1.Placement, newCondition clustersv1alpha1.Condition, clusterName string, agentChannel chan StatusUpdateMessage, updateFunc func() error) (controllerruntime.Result, error) {
+	return UpdateProjectStatus(resource, mcStatus, placement, newCondition, clusterName, nil, nil, agentChannel, updateFunc)
+}
+
+// UpdateProjectStatus is the same as UpdateStatus, and also reports the resource usage and the exported services of
+// a project in the cluster level status. The status is updated when the resource usage or the exported services change.
+func UpdateProjectStatus(resource MultiClusterResource, mcStatus *clustersv1alpha1.MultiClusterResourceStatus, placement clustersv1alpha1.Placement, newCondition clustersv1alpha1.Condition, clusterName string, usage *clustersv1alpha1.ResourceUsage, exports *clustersv1alpha1.ServiceExports, agentChannel chan StatusUpdateMessage, updateFunc func() error) (controllerruntime.Result, error) {
 
 	clusterLevelStatus := CreateClusterLevelStatus(newCondition, clusterName)
+	clusterLevelStatus.ResourceUsage = usage
+	clusterLevelStatus.ServiceExports = exports
 
 	if StatusNeedsUpdate(*mcStatus, newCondition, clusterLevelStatus) {
-		addOrUpdateCondition(mcStatus, newCondition)
+		AddOrUpdateCondition(mcStatus, newCondition)
 		SetClusterLevelStatus(mcStatus, clusterLevelStatus)
 		mcStatus.State = ComputeEffectiveState(*mcStatus, placement)
 		err := updateFunc()
@@ -284,8 +295,8 @@ func UpdateStatus(resource MultiClusterResource, mcStatus *clustersv1alpha1.Mult
 	return reconcile.Result{}, nil
 }
 
-// addOrUpdateCondition adds or updates the newCondition in the status' list of existing conditions
-func addOrUpdateCondition(status *clustersv1alpha1.MultiClusterResourceStatus, condition clustersv1alpha1.Condition) {
+// AddOrUpdateCondition adds or updates the newCondition in the status' list of existing conditions
+func AddOrUpdateCondition(status *clustersv1alpha1.MultiClusterResourceStatus, condition clustersv1alpha1.Condition) {
 	var matchingCondition *clustersv1alpha1.Condition
 	for i, existingCondition := range status.Conditions {
 		if condition.Type == existingCondition.Type &&
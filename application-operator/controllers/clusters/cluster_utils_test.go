@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusters
@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -217,6 +218,16 @@ func TestStatusNeedsUpdate(t *testing.T) {
 
 	// same condition, new cluster not present in conditions - needs update
 	asserts.True(t, StatusNeedsUpdate(curStatus, existingCond, newClusterStatus))
+
+	// same condition, differing in cluster resource usage - needs update
+	cluster1StatusDiffUsage := curCluster1Status
+	cluster1StatusDiffUsage.ResourceUsage = &clustersv1alpha1.ResourceUsage{Used: v1.ResourceList{v1.ResourcePods: resource.MustParse("2")}}
+	asserts.True(t, StatusNeedsUpdate(curStatus, existingCond, cluster1StatusDiffUsage))
+
+	// same condition, differing in cluster exported services - needs update
+	cluster1StatusDiffExports := curCluster1Status
+	cluster1StatusDiffExports.ServiceExports = &clustersv1alpha1.ServiceExports{GatewayAddress: "1.2.3.4", GatewayPort: 15443}
+	asserts.True(t, StatusNeedsUpdate(curStatus, existingCond, cluster1StatusDiffExports))
 }
 
 // TestCreateClusterLevelStatus tests the CreateClusterLevelStatus function
@@ -0,0 +1,128 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package multiclustergitsource
+
+import (
+	"fmt"
+	"sort"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Argo CD ApplicationSet template parameters, set by the list generator for each target cluster
+const (
+	clusterParam       = "cluster"
+	argoCDClusterParam = "argoCDCluster"
+)
+
+func newApplicationSet() *unstructured.Unstructured {
+	appSet := &unstructured.Unstructured{}
+	appSet.SetGroupVersionKind(applicationSetGVK)
+	return appSet
+}
+
+// getApplicationSetName returns the name of the Argo CD ApplicationSet of an MC Git source. Namespace names cannot
+// contain dots, so the name is unique across namespaces.
+func getApplicationSetName(gitSource clustersv1alpha1.MultiClusterGitSource) types.NamespacedName {
+	return types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: fmt.Sprintf("%s.%s", gitSource.Namespace, gitSource.Name)}
+}
+
+// getGitSourceLabels returns the labels identifying the Argo CD resources generated for an MC Git source
+func getGitSourceLabels(gitSource clustersv1alpha1.MultiClusterGitSource) map[string]string {
+	return map[string]string{
+		gitSourceNamespaceLabel: gitSource.Namespace,
+		gitSourceLabel:          gitSource.Name,
+	}
+}
+
+// mutateApplicationSet sets the spec of the Argo CD ApplicationSet of an MC Git source. The ApplicationSet generates
+// an Argo CD application for each target cluster, with a list generator so that only the clusters of the project
+// placement are targeted. The applications belong to the Argo CD AppProject of the Verrazzano project, which restricts
+// what they can deploy.
+func mutateApplicationSet(gitSource clustersv1alpha1.MultiClusterGitSource, namespace string, targets map[string]string, appSet *unstructured.Unstructured) error {
+	labels := appSet.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key, value := range getGitSourceLabels(gitSource) {
+		labels[key] = value
+	}
+	appSet.SetLabels(labels)
+
+	// Sort the clusters so the generator does not change between reconciles
+	var clusterNames []string
+	for cluster := range targets {
+		clusterNames = append(clusterNames, cluster)
+	}
+	sort.Strings(clusterNames)
+	elements := []interface{}{}
+	for _, cluster := range clusterNames {
+		elements = append(elements, map[string]interface{}{
+			clusterParam:       cluster,
+			argoCDClusterParam: targets[cluster],
+		})
+	}
+
+	revision := gitSource.Spec.TargetRevision
+	if revision == "" {
+		revision = defaultRevision
+	}
+	appSpec := map[string]interface{}{
+		"project": getAppProjectName(gitSource.Namespace, gitSource.Spec.Project).Name,
+		"source": map[string]interface{}{
+			"repoURL":        gitSource.Spec.RepoURL,
+			"targetRevision": revision,
+			"path":           gitSource.Spec.Path,
+		},
+		"destination": map[string]interface{}{
+			"name":      "{{" + argoCDClusterParam + "}}",
+			"namespace": namespace,
+		},
+	}
+	if syncPolicy := newSyncPolicy(gitSource.Spec.SyncPolicy); syncPolicy != nil {
+		appSpec["syncPolicy"] = syncPolicy
+	}
+
+	spec := map[string]interface{}{
+		"generators": []interface{}{
+			map[string]interface{}{
+				"list": map[string]interface{}{
+					"elements": elements,
+				},
+			},
+		},
+		"template": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": getApplicationSetName(gitSource).Name + "-{{" + clusterParam + "}}",
+				"labels": map[string]interface{}{
+					gitSourceNamespaceLabel: gitSource.Namespace,
+					gitSourceLabel:          gitSource.Name,
+					managedClusterLabel:     "{{" + clusterParam + "}}",
+				},
+			},
+			"spec": appSpec,
+		},
+	}
+	return unstructured.SetNestedField(appSet.Object, spec, "spec")
+}
+
+// newSyncPolicy returns the sync policy of the Argo CD applications. The applications are synchronized automatically
+// unless disabled in the MC Git source.
+func newSyncPolicy(policy *clustersv1alpha1.GitSyncPolicy) map[string]interface{} {
+	if policy == nil {
+		return map[string]interface{}{"automated": map[string]interface{}{}}
+	}
+	if policy.Automated != nil && !*policy.Automated {
+		return nil
+	}
+	return map[string]interface{}{
+		"automated": map[string]interface{}{
+			"prune":    policy.Prune,
+			"selfHeal": policy.SelfHeal,
+		},
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package multiclustergitsource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Label of the Argo CD AppProjects with the namespace and the name of the Verrazzano project they were generated for
+const (
+	projectNamespaceLabel = "verrazzano.io/project-namespace"
+	projectNameLabel      = "verrazzano.io/project"
+)
+
+func newAppProject() *unstructured.Unstructured {
+	appProject := &unstructured.Unstructured{}
+	appProject.SetGroupVersionKind(appProjectGVK)
+	return appProject
+}
+
+// getAppProjectName returns the name of the Argo CD AppProject of a Verrazzano project. Namespace names cannot
+// contain dots, so the name is unique across namespaces.
+func getAppProjectName(namespace string, project string) types.NamespacedName {
+	return types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: fmt.Sprintf("%s.%s", namespace, project)}
+}
+
+// reconcileAppProject creates or updates the Argo CD AppProject of a Verrazzano project. The AppProject only allows
+// the repositories of the MC Git sources of the project, only allows deploying to the namespaces of the project on
+// the target clusters, and denies all cluster-scoped resources. The AppProject is deleted when the project has no
+// MC Git sources left.
+func (r *Reconciler) reconcileAppProject(ctx context.Context, project clustersv1alpha1.VerrazzanoProject, targets map[string]string) error {
+	repos, err := r.getProjectRepos(ctx, project.Namespace, project.Name)
+	if err != nil {
+		return err
+	}
+	if len(repos) == 0 {
+		return r.deleteAppProject(ctx, project.Namespace, project.Name)
+	}
+
+	appProjectName := getAppProjectName(project.Namespace, project.Name)
+	appProject := newAppProject()
+	appProject.SetNamespace(appProjectName.Namespace)
+	appProject.SetName(appProjectName.Name)
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, appProject, func() error {
+		return mutateAppProject(project, repos, targets, appProject)
+	})
+	return err
+}
+
+// mutateAppProject sets the spec of the Argo CD AppProject of a Verrazzano project
+func mutateAppProject(project clustersv1alpha1.VerrazzanoProject, repos []string, targets map[string]string, appProject *unstructured.Unstructured) error {
+	labels := appProject.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[projectNamespaceLabel] = project.Namespace
+	labels[projectNameLabel] = project.Name
+	appProject.SetLabels(labels)
+
+	// Sort the clusters so the destinations do not change between reconciles
+	var argoCDClusters []string
+	for _, argoCDCluster := range targets {
+		argoCDClusters = append(argoCDClusters, argoCDCluster)
+	}
+	sort.Strings(argoCDClusters)
+	destinations := []interface{}{}
+	for _, argoCDCluster := range argoCDClusters {
+		for _, ns := range project.Spec.Template.Namespaces {
+			destinations = append(destinations, map[string]interface{}{
+				"name":      argoCDCluster,
+				"namespace": ns.Metadata.Name,
+			})
+		}
+	}
+	sourceRepos := []interface{}{}
+	for _, repo := range repos {
+		sourceRepos = append(sourceRepos, repo)
+	}
+
+	spec := map[string]interface{}{
+		"description":  fmt.Sprintf("Verrazzano project %s/%s", project.Namespace, project.Name),
+		"sourceRepos":  sourceRepos,
+		"destinations": destinations,
+		// An empty whitelist denies all cluster-scoped resources
+		"clusterResourceWhitelist": []interface{}{},
+	}
+	return unstructured.SetNestedField(appProject.Object, spec, "spec")
+}
+
+// getProjectRepos returns the sorted repositories of the MC Git sources of a Verrazzano project that are not being
+// deleted
+func (r *Reconciler) getProjectRepos(ctx context.Context, namespace string, project string) ([]string, error) {
+	gitSources := clustersv1alpha1.MultiClusterGitSourceList{}
+	if err := r.List(ctx, &gitSources, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	unique := map[string]bool{}
+	for _, gitSource := range gitSources.Items {
+		if gitSource.Spec.Project == project && gitSource.DeletionTimestamp.IsZero() {
+			unique[gitSource.Spec.RepoURL] = true
+		}
+	}
+	var repos []string
+	for repo := range unique {
+		repos = append(repos, repo)
+	}
+	sort.Strings(repos)
+	return repos, nil
+}
+
+// deleteAppProject deletes the Argo CD AppProject of a Verrazzano project, if it exists
+func (r *Reconciler) deleteAppProject(ctx context.Context, namespace string, project string) error {
+	appProjectName := getAppProjectName(namespace, project)
+	appProject := newAppProject()
+	appProject.SetNamespace(appProjectName.Namespace)
+	appProject.SetName(appProjectName.Name)
+	if err := r.Delete(ctx, appProject); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
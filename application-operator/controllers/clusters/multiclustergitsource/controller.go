@@ -0,0 +1,420 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package multiclustergitsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlog "github.com/verrazzano/verrazzano/pkg/log"
+	vzlog2 "github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	finalizerName  = "multiclustergitsource.verrazzano.io"
+	controllerName = "multiclustergitsource"
+
+	// Labels of the Argo CD applications with the namespace and the name of the MultiClusterGitSource that generated them
+	gitSourceNamespaceLabel = "verrazzano.io/mc-git-source-namespace"
+	gitSourceLabel          = "verrazzano.io/mc-git-source"
+	// Label of the Argo CD applications with the name of the cluster they are deployed to
+	managedClusterLabel = "verrazzano.io/managed-cluster"
+
+	applicationSetCRDName = "applicationsets.argoproj.io"
+	// Name of the admin cluster in the placement of Verrazzano projects, and in Argo CD
+	localClusterName     = "local"
+	argoCDInClusterName  = "in-cluster"
+	defaultRevision      = "HEAD"
+	requeueMinSeconds    = 50
+	requeueMaxSeconds    = 70
+	argoCDMissingMessage = "Argo CD is not installed on the admin cluster"
+)
+
+var (
+	applicationSetGVK  = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "ApplicationSet"}
+	appProjectGVK      = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"}
+	applicationListGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "ApplicationList"}
+)
+
+// Reconciler reconciles a MultiClusterGitSource object
+type Reconciler struct {
+	client.Client
+	Log    *zap.SugaredLogger
+	Scheme *runtime.Scheme
+}
+
+// SetupWithManager registers our controller with the manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&clustersv1alpha1.MultiClusterGitSource{}).
+		Watches(&source.Kind{Type: &clustersv1alpha1.VerrazzanoProject{}}, handler.EnqueueRequestsFromMapFunc(r.mapProjectToGitSources)).
+		Complete(r)
+}
+
+// Reconcile reconciles a MultiClusterGitSource resource. It generates an Argo CD ApplicationSet that deploys the
+// resources of the Git repository to the clusters on which the Verrazzano project is placed, and rolls up the
+// synchronization status of the generated Argo CD applications into the status of the MultiClusterGitSource
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if ctx == nil {
+		return ctrl.Result{}, errors.New("context cannot be nil")
+	}
+
+	// We do not want any resource to get reconciled if it is in namespace kube-system
+	// This is due to a bug found in OKE, it should not affect functionality of any vz operators
+	// If this is the case then return success
+	if req.Namespace == vzconst.KubeSystem {
+		log := zap.S().With(vzlog.FieldResourceNamespace, req.Namespace, vzlog.FieldResourceName, req.Name, vzlog.FieldController, controllerName)
+		log.Infof("Multi-cluster Git source resource %v should not be reconciled in kube-system namespace, ignoring", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	var gitSource clustersv1alpha1.MultiClusterGitSource
+	err := r.Get(ctx, req.NamespacedName, &gitSource)
+	if err != nil {
+		return clusters.IgnoreNotFoundWithLog(err, zap.S())
+	}
+	log, err := clusters.GetResourceLogger("mcgitsource", req.NamespacedName, &gitSource)
+	if err != nil {
+		zap.S().Errorf("Failed to create controller logger for multi-cluster Git source resource: %v", err)
+		return clusters.NewRequeueWithDelay(), nil
+	}
+	log.Oncef("Reconciling multi-cluster Git source resource %v, generation %v", req.NamespacedName, gitSource.Generation)
+
+	res, err := r.doReconcile(ctx, gitSource, log)
+	// Never return an error since it has already been logged and we don't want the
+	// controller runtime to log again (with stack trace).  Just re-queue if there is an error.
+	if err != nil {
+		log.Errorf("Failed to reconcile multi-cluster Git source %v: %v", req.NamespacedName, err)
+		return clusters.NewRequeueWithDelay(), nil
+	}
+	if clusters.ShouldRequeue(res) {
+		return res, nil
+	}
+
+	log.Oncef("Finished reconciling multi-cluster Git source %v", req.NamespacedName)
+
+	// The synchronization status of the Argo CD applications is polled
+	return clusters.NewRequeueWithRandomDelay(requeueMinSeconds, requeueMaxSeconds), nil
+}
+
+// doReconcile performs the reconciliation operations for the MC Git source
+func (r *Reconciler) doReconcile(ctx context.Context, gitSource clustersv1alpha1.MultiClusterGitSource, log vzlog2.VerrazzanoLogger) (ctrl.Result, error) {
+	appSet := newApplicationSet()
+	appSetName := getApplicationSetName(gitSource)
+
+	installed, err := r.isArgoCDInstalled(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// delete the generated ApplicationSet since the MC Git source is being deleted
+	if !gitSource.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&gitSource, finalizerName) {
+			return ctrl.Result{}, nil
+		}
+		if !installed {
+			controllerutil.RemoveFinalizer(&gitSource, finalizerName)
+			return ctrl.Result{}, r.Update(ctx, &gitSource)
+		}
+		if err := r.reconcileAppProjectOnDelete(ctx, gitSource); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, clusters.DeleteAssociatedResource(ctx, r.Client, &gitSource, finalizerName, appSet, appSetName)
+	}
+
+	if !installed {
+		log.Progressf("Waiting for Argo CD to be installed to deploy multi-cluster Git source %s/%s", gitSource.Namespace, gitSource.Name)
+		cond := newCondition(clustersv1alpha1.DeployPending, argoCDMissingMessage)
+		if err := r.updateStatus(ctx, &gitSource, cond, clustersv1alpha1.Placement{}, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+		return clusters.NewRequeueWithRandomDelay(requeueMinSeconds, requeueMaxSeconds), nil
+	}
+
+	project := clustersv1alpha1.VerrazzanoProject{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: gitSource.Namespace, Name: gitSource.Spec.Project}, &project)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if err := r.deleteAppProject(ctx, gitSource.Namespace, gitSource.Spec.Project); err != nil {
+			return ctrl.Result{}, err
+		}
+		cond := newCondition(clustersv1alpha1.DeployFailed, fmt.Sprintf("Verrazzano project %s not found", gitSource.Spec.Project))
+		if err := r.updateStatus(ctx, &gitSource, cond, clustersv1alpha1.Placement{}, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+		return clusters.NewRequeueWithRandomDelay(requeueMinSeconds, requeueMaxSeconds), nil
+	}
+	namespace, err := destinationNamespace(gitSource, project)
+	if err != nil {
+		cond := newCondition(clustersv1alpha1.DeployFailed, err.Error())
+		if err := r.updateStatus(ctx, &gitSource, cond, project.Spec.Placement, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+		return clusters.NewRequeueWithRandomDelay(requeueMinSeconds, requeueMaxSeconds), nil
+	}
+
+	if _, err := clusters.AddFinalizer(ctx, r.Client, &gitSource, finalizerName); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Only the clusters registered with Argo CD are targeted, the others are reported as pending
+	targets, pending, err := r.getTargetClusters(ctx, project.Spec.Placement)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.reconcileAppProject(ctx, project, targets); err != nil {
+		log.Errorf("Failed to create or update the Argo CD AppProject of Verrazzano project %s/%s: %v", project.Namespace, project.Name, err)
+		return ctrl.Result{}, err
+	}
+	appSet.SetNamespace(appSetName.Namespace)
+	appSet.SetName(appSetName.Name)
+	opResult, err := controllerutil.CreateOrUpdate(ctx, r.Client, appSet, func() error {
+		return mutateApplicationSet(gitSource, namespace, targets, appSet)
+	})
+	cond := clusters.GetConditionFromResult(err, opResult, "ApplicationSet")
+	if err != nil {
+		log.Errorf("Failed to create or update the Argo CD ApplicationSet %v: %v", appSetName, err)
+		if err := r.updateStatus(ctx, &gitSource, cond, project.Spec.Placement, pending); err != nil {
+			return ctrl.Result{}, err
+		}
+		return clusters.NewRequeueWithDelay(), nil
+	}
+
+	clusterStatuses, err := r.getClusterStatuses(ctx, gitSource, targets)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for name, status := range pending {
+		clusterStatuses[name] = status
+	}
+	return ctrl.Result{}, r.updateStatus(ctx, &gitSource, cond, project.Spec.Placement, clusterStatuses)
+}
+
+// reconcileAppProjectOnDelete removes the repository of a deleted MC Git source from the Argo CD AppProject of its
+// Verrazzano project, and deletes the AppProject if the project or its other MC Git sources no longer exist
+func (r *Reconciler) reconcileAppProjectOnDelete(ctx context.Context, gitSource clustersv1alpha1.MultiClusterGitSource) error {
+	project := clustersv1alpha1.VerrazzanoProject{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: gitSource.Namespace, Name: gitSource.Spec.Project}, &project)
+	if apierrors.IsNotFound(err) {
+		return r.deleteAppProject(ctx, gitSource.Namespace, gitSource.Spec.Project)
+	}
+	if err != nil {
+		return err
+	}
+	targets, _, err := r.getTargetClusters(ctx, project.Spec.Placement)
+	if err != nil {
+		return err
+	}
+	return r.reconcileAppProject(ctx, project, targets)
+}
+
+// isArgoCDInstalled returns true if the ApplicationSet CRD of Argo CD exists
+func (r *Reconciler) isArgoCDInstalled(ctx context.Context) (bool, error) {
+	crd := apiextv1.CustomResourceDefinition{}
+	err := r.Get(ctx, types.NamespacedName{Name: applicationSetCRDName}, &crd)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// destinationNamespace returns the namespace to which the resources are deployed, which must be a namespace of the project
+func destinationNamespace(gitSource clustersv1alpha1.MultiClusterGitSource, project clustersv1alpha1.VerrazzanoProject) (string, error) {
+	namespaces := project.Spec.Template.Namespaces
+	if gitSource.Spec.DestinationNamespace == "" {
+		if len(namespaces) == 0 {
+			return "", fmt.Errorf("Verrazzano project %s has no namespaces", project.Name)
+		}
+		return namespaces[0].Metadata.Name, nil
+	}
+	for _, ns := range namespaces {
+		if ns.Metadata.Name == gitSource.Spec.DestinationNamespace {
+			return ns.Metadata.Name, nil
+		}
+	}
+	return "", fmt.Errorf("namespace %s is not a namespace of Verrazzano project %s", gitSource.Spec.DestinationNamespace, project.Name)
+}
+
+// getTargetClusters returns the Argo CD cluster names of the placed clusters that are registered with Argo CD, keyed
+// by the Verrazzano cluster names, and the statuses of the placed clusters that are not registered yet
+func (r *Reconciler) getTargetClusters(ctx context.Context, placement clustersv1alpha1.Placement) (map[string]string, map[string]clustersv1alpha1.ClusterLevelStatus, error) {
+	targets := map[string]string{}
+	pending := map[string]clustersv1alpha1.ClusterLevelStatus{}
+	for _, cluster := range placement.Clusters {
+		if cluster.Name == localClusterName {
+			targets[cluster.Name] = argoCDInClusterName
+			continue
+		}
+		vmc := vmcv1alpha1.VerrazzanoManagedCluster{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: cluster.Name}, &vmc)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if err != nil || vmc.Status.ArgoCDRegistration.Status != vmcv1alpha1.MCRegistrationCompleted {
+			pending[cluster.Name] = newClusterLevelStatus(cluster.Name, clustersv1alpha1.Pending,
+				fmt.Sprintf("Waiting for cluster %s to be registered with Argo CD", cluster.Name))
+			continue
+		}
+		// The VMC controller registers the managed clusters in Argo CD with the name of the VMC
+		targets[cluster.Name] = vmc.Name
+	}
+	return targets, pending, nil
+}
+
+// getClusterStatuses returns the statuses of the target clusters, from the status of the Argo CD applications
+// generated by the ApplicationSet
+func (r *Reconciler) getClusterStatuses(ctx context.Context, gitSource clustersv1alpha1.MultiClusterGitSource, targets map[string]string) (map[string]clustersv1alpha1.ClusterLevelStatus, error) {
+	apps := unstructured.UnstructuredList{}
+	apps.SetGroupVersionKind(applicationListGVK)
+	err := r.List(ctx, &apps, client.InNamespace(vzconst.ArgoCDNamespace), client.MatchingLabels(getGitSourceLabels(gitSource)))
+	if err != nil && !meta.IsNoMatchError(err) {
+		return nil, err
+	}
+	statuses := map[string]clustersv1alpha1.ClusterLevelStatus{}
+	for i := range apps.Items {
+		cluster := apps.Items[i].GetLabels()[managedClusterLabel]
+		if _, ok := targets[cluster]; ok {
+			statuses[cluster] = newApplicationClusterStatus(cluster, &apps.Items[i])
+		}
+	}
+	for cluster := range targets {
+		if _, ok := statuses[cluster]; !ok {
+			statuses[cluster] = newClusterLevelStatus(cluster, clustersv1alpha1.Pending, "Waiting for the Argo CD application to be generated")
+		}
+	}
+	return statuses, nil
+}
+
+// newApplicationClusterStatus returns the status of a cluster from the status of its Argo CD application
+func newApplicationClusterStatus(cluster string, app *unstructured.Unstructured) clustersv1alpha1.ClusterLevelStatus {
+	syncStatus, _, _ := unstructured.NestedString(app.Object, "status", "sync", "status")
+	revision, _, _ := unstructured.NestedString(app.Object, "status", "sync", "revision")
+	healthStatus, _, _ := unstructured.NestedString(app.Object, "status", "health", "status")
+	phase, _, _ := unstructured.NestedString(app.Object, "status", "operationState", "phase")
+	opMessage, _, _ := unstructured.NestedString(app.Object, "status", "operationState", "message")
+
+	msg := fmt.Sprintf("Argo CD application %s: sync status %s, health status %s", app.GetName(), valueOrUnknown(syncStatus), valueOrUnknown(healthStatus))
+	if revision != "" {
+		msg = fmt.Sprintf("%s, revision %s", msg, revision)
+	}
+	switch {
+	case phase == "Failed" || phase == "Error":
+		return newClusterLevelStatus(cluster, clustersv1alpha1.Failed, fmt.Sprintf("%s: %s", msg, opMessage))
+	case healthStatus == "Degraded":
+		return newClusterLevelStatus(cluster, clustersv1alpha1.Failed, msg)
+	case syncStatus == "Synced" && healthStatus == "Healthy":
+		return newClusterLevelStatus(cluster, clustersv1alpha1.Succeeded, msg)
+	default:
+		return newClusterLevelStatus(cluster, clustersv1alpha1.Pending, msg)
+	}
+}
+
+// updateStatus updates the status of the MC Git source with the condition and the cluster level statuses, if they changed
+func (r *Reconciler) updateStatus(ctx context.Context, gitSource *clustersv1alpha1.MultiClusterGitSource, cond clustersv1alpha1.Condition, placement clustersv1alpha1.Placement, clusterStatuses map[string]clustersv1alpha1.ClusterLevelStatus) error {
+	status := gitSource.Status.DeepCopy()
+	clusters.AddOrUpdateCondition(status, cond)
+
+	// Remove the statuses of the clusters that are no longer placed
+	var placed []clustersv1alpha1.ClusterLevelStatus
+	for _, clusterStatus := range status.Clusters {
+		if _, ok := clusterStatuses[clusterStatus.Name]; ok {
+			placed = append(placed, clusterStatus)
+		}
+	}
+	status.Clusters = placed
+	for _, newStatus := range clusterStatuses {
+		if !clusterStatusChanged(status.Clusters, newStatus) {
+			continue
+		}
+		clusters.SetClusterLevelStatus(status, newStatus)
+	}
+	if cond.Type == clustersv1alpha1.DeployComplete {
+		status.State = clusters.ComputeEffectiveState(*status, placement)
+	} else if cond.Type == clustersv1alpha1.DeployFailed {
+		status.State = clustersv1alpha1.Failed
+	} else {
+		status.State = clustersv1alpha1.Pending
+	}
+	if equality.Semantic.DeepEqual(*status, gitSource.Status) {
+		return nil
+	}
+	gitSource.Status = *status
+	return r.Status().Update(ctx, gitSource)
+}
+
+// mapProjectToGitSources returns the MC Git sources of a Verrazzano project, so they are reconciled when its placement
+// changes
+func (r *Reconciler) mapProjectToGitSources(obj client.Object) []reconcile.Request {
+	gitSources := clustersv1alpha1.MultiClusterGitSourceList{}
+	if err := r.List(context.TODO(), &gitSources, client.InNamespace(obj.GetNamespace())); err != nil {
+		zap.S().Errorf("Failed to list the multi-cluster Git sources of Verrazzano project %s: %v", obj.GetName(), err)
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, gitSource := range gitSources.Items {
+		if gitSource.Spec.Project == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gitSource.Namespace, Name: gitSource.Name}})
+		}
+	}
+	return requests
+}
+
+func clusterStatusChanged(existing []clustersv1alpha1.ClusterLevelStatus, newStatus clustersv1alpha1.ClusterLevelStatus) bool {
+	for _, status := range existing {
+		if status.Name == newStatus.Name {
+			return status.State != newStatus.State || status.Message != newStatus.Message
+		}
+	}
+	return true
+}
+
+func newCondition(condType clustersv1alpha1.ConditionType, msg string) clustersv1alpha1.Condition {
+	return clustersv1alpha1.Condition{
+		Type:               condType,
+		Status:             corev1.ConditionTrue,
+		Message:            msg,
+		LastTransitionTime: time.Now().Format(time.RFC3339),
+	}
+}
+
+func newClusterLevelStatus(cluster string, state clustersv1alpha1.StateType, msg string) clustersv1alpha1.ClusterLevelStatus {
+	return clustersv1alpha1.ClusterLevelStatus{
+		Name:           cluster,
+		State:          state,
+		Message:        msg,
+		LastUpdateTime: time.Now().Format(time.RFC3339),
+	}
+}
+
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "Unknown"
+	}
+	return value
+}
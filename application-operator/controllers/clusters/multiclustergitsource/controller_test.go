@@ -0,0 +1,305 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package multiclustergitsource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	asserts "github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"go.uber.org/zap"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace     = constants.VerrazzanoMultiClusterNamespace
+	testGitSourceName = "hello-gitops"
+	testProjectName   = "hello-project"
+	testAppNamespace  = "hello"
+	testRepoURL       = "https://github.com/example/gitops.git"
+)
+
+var (
+	testAppSetName     = types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: testNamespace + "." + testGitSourceName}
+	testAppProjectName = types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: testNamespace + "." + testProjectName}
+)
+
+// TestReconcileCreatesApplicationSet tests reconciling a MultiClusterGitSource
+// GIVEN a MultiClusterGitSource of a project placed on the admin cluster and two managed clusters
+// WHEN only one of the managed clusters is registered with Argo CD
+// THEN an ApplicationSet targeting the admin cluster and the registered managed cluster is created, and the other
+// managed cluster is reported as pending
+func TestReconcileCreatesApplicationSet(t *testing.T) {
+	assert := asserts.New(t)
+	cli := newFakeClient(newApplicationSetCRD(), newGitSource(), newProject("local", "managed1", "managed2"),
+		newVMC("managed1", vmcv1alpha1.MCRegistrationCompleted), newVMC("managed2", vmcv1alpha1.RegistrationPendingRancher))
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+
+	appSet := newApplicationSet()
+	assert.NoError(cli.Get(context.TODO(), testAppSetName, appSet))
+	elements, _, _ := unstructured.NestedSlice(appSet.Object, "spec", "generators")
+	assert.Len(elements, 1)
+	list := elements[0].(map[string]interface{})["list"].(map[string]interface{})["elements"].([]interface{})
+	assert.Equal([]interface{}{
+		map[string]interface{}{clusterParam: "local", argoCDClusterParam: argoCDInClusterName},
+		map[string]interface{}{clusterParam: "managed1", argoCDClusterParam: "managed1"},
+	}, list)
+	repoURL, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "spec", "source", "repoURL")
+	assert.Equal(testRepoURL, repoURL)
+	namespace, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "spec", "destination", "namespace")
+	assert.Equal(testAppNamespace, namespace)
+	_, automated, _ := unstructured.NestedMap(appSet.Object, "spec", "template", "spec", "syncPolicy", "automated")
+	assert.True(automated)
+	appName, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "metadata", "name")
+	assert.Equal(testNamespace+"."+testGitSourceName+"-{{"+clusterParam+"}}", appName)
+	appProjectName, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "spec", "project")
+	assert.Equal(testAppProjectName.Name, appProjectName)
+
+	// The AppProject restricts the applications to the repository and the namespaces of the project
+	appProject := newAppProject()
+	assert.NoError(cli.Get(context.TODO(), testAppProjectName, appProject))
+	sourceRepos, _, _ := unstructured.NestedStringSlice(appProject.Object, "spec", "sourceRepos")
+	assert.Equal([]string{testRepoURL}, sourceRepos)
+	destinations, _, _ := unstructured.NestedSlice(appProject.Object, "spec", "destinations")
+	assert.Equal([]interface{}{
+		map[string]interface{}{"name": argoCDInClusterName, "namespace": testAppNamespace},
+		map[string]interface{}{"name": "managed1", "namespace": testAppNamespace},
+	}, destinations)
+	whitelist, found, _ := unstructured.NestedSlice(appProject.Object, "spec", "clusterResourceWhitelist")
+	assert.True(found)
+	assert.Empty(whitelist)
+
+	gitSource := clustersv1alpha1.MultiClusterGitSource{}
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, &gitSource))
+	assert.Contains(gitSource.Finalizers, finalizerName)
+	assert.Equal(clustersv1alpha1.Pending, gitSource.Status.State)
+	assert.Len(gitSource.Status.Clusters, 3)
+	for _, status := range gitSource.Status.Clusters {
+		assert.Equal(clustersv1alpha1.Pending, status.State)
+		if status.Name == "managed2" {
+			assert.Contains(status.Message, "registered with Argo CD")
+		}
+	}
+}
+
+// TestReconcileRollsUpApplicationStatus tests the status of a MultiClusterGitSource
+// GIVEN the Argo CD applications generated for a MultiClusterGitSource
+// WHEN the MultiClusterGitSource is reconciled
+// THEN the synchronization status of the applications is rolled up into the status of the MultiClusterGitSource
+func TestReconcileRollsUpApplicationStatus(t *testing.T) {
+	assert := asserts.New(t)
+	cli := newFakeClient(newApplicationSetCRD(), newGitSource(), newProject("managed1", "managed2"),
+		newVMC("managed1", vmcv1alpha1.MCRegistrationCompleted), newVMC("managed2", vmcv1alpha1.MCRegistrationCompleted),
+		newApplication("managed1", "Synced", "Healthy", ""), newApplication("managed2", "Synced", "Healthy", ""))
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	gitSource := clustersv1alpha1.MultiClusterGitSource{}
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, &gitSource))
+	assert.Equal(clustersv1alpha1.Succeeded, gitSource.Status.State)
+	assert.Len(gitSource.Status.Clusters, 2)
+
+	// A failed synchronization on one cluster fails the MultiClusterGitSource
+	assert.NoError(cli.Delete(context.TODO(), newApplication("managed2", "", "", "")))
+	assert.NoError(cli.Create(context.TODO(), newApplication("managed2", "OutOfSync", "Healthy", "Failed")))
+	_, err = newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, &gitSource))
+	assert.Equal(clustersv1alpha1.Failed, gitSource.Status.State)
+	for _, status := range gitSource.Status.Clusters {
+		if status.Name == "managed2" {
+			assert.Equal(clustersv1alpha1.Failed, status.State)
+			assert.Contains(status.Message, "sync status OutOfSync")
+		}
+	}
+}
+
+// TestReconcileArgoCDNotInstalled tests reconciling a MultiClusterGitSource when Argo CD is not installed
+// GIVEN a MultiClusterGitSource
+// WHEN the ApplicationSet CRD does not exist
+// THEN the MultiClusterGitSource is pending
+func TestReconcileArgoCDNotInstalled(t *testing.T) {
+	assert := asserts.New(t)
+	cli := newFakeClient(newGitSource(), newProject("managed1"))
+
+	result, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.True(result.Requeue)
+	gitSource := clustersv1alpha1.MultiClusterGitSource{}
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, &gitSource))
+	assert.Equal(clustersv1alpha1.Pending, gitSource.Status.State)
+	assert.Equal(argoCDMissingMessage, gitSource.Status.Conditions[0].Message)
+}
+
+// TestReconcileInvalidNamespace tests reconciling a MultiClusterGitSource with an invalid destination namespace
+// GIVEN a MultiClusterGitSource
+// WHEN its destination namespace is not a namespace of the project
+// THEN the MultiClusterGitSource fails and no ApplicationSet is created
+func TestReconcileInvalidNamespace(t *testing.T) {
+	assert := asserts.New(t)
+	gitSource := newGitSource()
+	gitSource.Spec.DestinationNamespace = "other"
+	cli := newFakeClient(newApplicationSetCRD(), gitSource, newProject("managed1"))
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, gitSource))
+	assert.Equal(clustersv1alpha1.Failed, gitSource.Status.State)
+	assert.Contains(gitSource.Status.Conditions[0].Message, "not a namespace of Verrazzano project")
+	appSet := newApplicationSet()
+	assert.Error(cli.Get(context.TODO(), testAppSetName, appSet))
+}
+
+// TestReconcileDelete tests deleting a MultiClusterGitSource
+// GIVEN a MultiClusterGitSource being deleted
+// WHEN the MultiClusterGitSource is reconciled
+// THEN the ApplicationSet is deleted and the finalizer is removed
+func TestReconcileDelete(t *testing.T) {
+	assert := asserts.New(t)
+	gitSource := newGitSource()
+	gitSource.Finalizers = []string{finalizerName}
+	gitSource.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	appSet := newApplicationSet()
+	appSet.SetNamespace(testAppSetName.Namespace)
+	appSet.SetName(testAppSetName.Name)
+	appProject := newAppProject()
+	appProject.SetNamespace(testAppProjectName.Namespace)
+	appProject.SetName(testAppProjectName.Name)
+	cli := newFakeClient(newApplicationSetCRD(), gitSource, newProject("managed1"), appSet, appProject)
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.Error(cli.Get(context.TODO(), testAppSetName, newApplicationSet()))
+	assert.Error(cli.Get(context.TODO(), testAppProjectName, newAppProject()))
+	assert.Error(cli.Get(context.TODO(), newRequest().NamespacedName, gitSource))
+}
+
+// TestReconcileSameNameInNamespaces tests MultiClusterGitSources with the same name in different namespaces
+// GIVEN two MultiClusterGitSources with the same name in different namespaces
+// WHEN both are reconciled
+// THEN each one has its own ApplicationSet and AppProject, and only reports the status of its own applications
+func TestReconcileSameNameInNamespaces(t *testing.T) {
+	assert := asserts.New(t)
+	otherNamespace := "other-ns"
+	otherGitSource := newGitSource()
+	otherGitSource.Namespace = otherNamespace
+	otherGitSource.Spec.RepoURL = "https://github.com/example/other.git"
+	otherProject := newProject("managed1")
+	otherProject.Namespace = otherNamespace
+	otherApp := newApplication("managed1", "OutOfSync", "Degraded", "")
+	otherApp.SetName(otherNamespace + "." + testGitSourceName + "-managed1")
+	otherApp.SetLabels(map[string]string{gitSourceNamespaceLabel: otherNamespace, gitSourceLabel: testGitSourceName, managedClusterLabel: "managed1"})
+	cli := newFakeClient(newApplicationSetCRD(), newGitSource(), newProject("managed1"), otherGitSource, otherProject,
+		newVMC("managed1", vmcv1alpha1.MCRegistrationCompleted), newApplication("managed1", "Synced", "Healthy", ""), otherApp)
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	_, err = newReconciler(cli).Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: otherNamespace, Name: testGitSourceName}})
+	assert.NoError(err)
+
+	appSet := newApplicationSet()
+	assert.NoError(cli.Get(context.TODO(), testAppSetName, appSet))
+	repoURL, _, _ := unstructured.NestedString(appSet.Object, "spec", "template", "spec", "source", "repoURL")
+	assert.Equal(testRepoURL, repoURL)
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: otherNamespace + "." + testGitSourceName}, appSet))
+	repoURL, _, _ = unstructured.NestedString(appSet.Object, "spec", "template", "spec", "source", "repoURL")
+	assert.Equal(otherGitSource.Spec.RepoURL, repoURL)
+
+	appProject := newAppProject()
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.ArgoCDNamespace, Name: otherNamespace + "." + testProjectName}, appProject))
+	sourceRepos, _, _ := unstructured.NestedStringSlice(appProject.Object, "spec", "sourceRepos")
+	assert.Equal([]string{otherGitSource.Spec.RepoURL}, sourceRepos)
+
+	gitSource := clustersv1alpha1.MultiClusterGitSource{}
+	assert.NoError(cli.Get(context.TODO(), newRequest().NamespacedName, &gitSource))
+	assert.Equal(clustersv1alpha1.Succeeded, gitSource.Status.State)
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: otherNamespace, Name: testGitSourceName}, &gitSource))
+	assert.Equal(clustersv1alpha1.Failed, gitSource.Status.State)
+}
+
+func newReconciler(cli client.Client) *Reconciler {
+	return &Reconciler{Client: cli, Log: zap.S(), Scheme: cli.Scheme()}
+}
+
+func newRequest() ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testGitSourceName}}
+}
+
+func newFakeClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	_ = vmcv1alpha1.AddToScheme(scheme)
+	_ = apiextv1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newApplicationSetCRD() *apiextv1.CustomResourceDefinition {
+	return &apiextv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: applicationSetCRDName}}
+}
+
+func newGitSource() *clustersv1alpha1.MultiClusterGitSource {
+	return &clustersv1alpha1.MultiClusterGitSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testGitSourceName},
+		Spec: clustersv1alpha1.MultiClusterGitSourceSpec{
+			RepoURL: testRepoURL,
+			Path:    "apps/hello",
+			Project: testProjectName,
+		},
+	}
+}
+
+func newProject(clusterNames ...string) *clustersv1alpha1.VerrazzanoProject {
+	project := &clustersv1alpha1.VerrazzanoProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testProjectName},
+		Spec: clustersv1alpha1.VerrazzanoProjectSpec{
+			Template: clustersv1alpha1.ProjectTemplate{
+				Namespaces: []clustersv1alpha1.NamespaceTemplate{{Metadata: metav1.ObjectMeta{Name: testAppNamespace}}},
+			},
+		},
+	}
+	for _, name := range clusterNames {
+		project.Spec.Placement.Clusters = append(project.Spec.Placement.Clusters, clustersv1alpha1.Cluster{Name: name})
+	}
+	return project
+}
+
+func newVMC(name string, status vmcv1alpha1.ArgoCDRegistrationStatus) *vmcv1alpha1.VerrazzanoManagedCluster {
+	return &vmcv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: name},
+		Status: vmcv1alpha1.VerrazzanoManagedClusterStatus{
+			ArgoCDRegistration: vmcv1alpha1.ArgoCDRegistration{Status: status},
+		},
+	}
+}
+
+// newApplication returns the Argo CD application generated for a cluster, with the given status
+func newApplication(cluster string, syncStatus string, healthStatus string, phase string) *unstructured.Unstructured {
+	app := &unstructured.Unstructured{}
+	app.SetAPIVersion("argoproj.io/v1alpha1")
+	app.SetKind("Application")
+	app.SetNamespace(vzconst.ArgoCDNamespace)
+	app.SetName(testAppSetName.Name + "-" + cluster)
+	app.SetLabels(map[string]string{gitSourceNamespaceLabel: testNamespace, gitSourceLabel: testGitSourceName, managedClusterLabel: cluster})
+	_ = unstructured.SetNestedField(app.Object, syncStatus, "status", "sync", "status")
+	_ = unstructured.SetNestedField(app.Object, healthStatus, "status", "health", "status")
+	if phase != "" {
+		_ = unstructured.SetNestedField(app.Object, phase, "status", "operationState", "phase")
+		_ = unstructured.SetNestedField(app.Object, "sync failed", "status", "operationState", "message")
+	}
+	return app
+}
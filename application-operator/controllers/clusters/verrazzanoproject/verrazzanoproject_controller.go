@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package verrazzanoproject
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	appv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
 	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
@@ -20,13 +21,16 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
@@ -39,6 +43,7 @@ const (
 	finalizerName               = "project.verrazzano.io"
 	managedClusterRole          = "verrazzano-managed-cluster"
 	controllerName              = "verrazzanoproject"
+	projectLabel                = "verrazzano.io/project"
 )
 
 // Reconciler reconciles a VerrazzanoProject object
@@ -53,6 +58,8 @@ type Reconciler struct {
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&clustersv1alpha1.VerrazzanoProject{}).
+		Watches(&source.Kind{Type: &corev1.ResourceQuota{}}, handler.EnqueueRequestsFromMapFunc(mapResourceQuotaToProject)).
+		Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(r.mapServiceToProjects)).
 		Complete(r)
 }
 
@@ -115,6 +122,24 @@ func (r *Reconciler) doReconcile(ctx context.Context, vp clustersv1alpha1.Verraz
 			if err := r.deleteRoleBindings(ctx, &vp, log); err != nil {
 				return reconcile.Result{}, err
 			}
+			if err := r.deleteLogRoutes(ctx, &vp, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := r.deleteIstioSecurity(ctx, &vp, nil, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := r.deleteOpenSearchSecurity(ctx, &vp, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := r.deleteServiceDiscovery(ctx, &vp, nil, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := r.deleteResourceQuotas(ctx, &vp, nil); err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := r.deleteLimitRanges(ctx, &vp, nil); err != nil {
+				return reconcile.Result{}, err
+			}
 			// Remove the finalizer and update the Verrazzano resource if the deletion has finished.
 			vp.ObjectMeta.Finalizers = vzstring.RemoveStringFromSlice(vp.ObjectMeta.Finalizers, finalizerName)
 			err := r.Update(ctx, &vp)
@@ -175,7 +200,37 @@ func (r *Reconciler) syncAll(ctx context.Context, vp clustersv1alpha1.Verrazzano
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// Sync the log routes
+	err = r.syncLogRoutes(ctx, &vp, log)
+	if err != nil {
+		return err
+	}
+
+	// Sync the Istio security policies
+	err = r.syncIstioSecurity(ctx, &vp, log)
+	if err != nil {
+		return err
+	}
+
+	// Sync the OpenSearch tenant, roles and role bindings
+	err = r.syncOpenSearchSecurity(ctx, &vp, log)
+	if err != nil {
+		return err
+	}
+
+	// Sync the Istio resources of the services published to the other clusters
+	err = r.syncServiceDiscovery(ctx, &vp, log)
+	if err != nil {
+		return err
+	}
+
+	// Sync the resource quotas and limit ranges
+	err = r.syncResourceQuotas(ctx, &vp, log)
+	if err != nil {
+		return err
+	}
+	return r.syncLimitRanges(ctx, &vp, log)
 }
 
 func (r *Reconciler) createOrUpdateNamespaces(ctx context.Context, vp clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
@@ -196,7 +251,7 @@ func (r *Reconciler) createOrUpdateNamespaces(ctx context.Context, vp clustersv1
 			}
 
 			opResult, err := controllerutil.CreateOrUpdate(ctx, r.Client, &namespace, func() error {
-				r.mutateNamespace(nsTemplate, istioInjection, &namespace)
+				r.mutateNamespace(nsTemplate, istioInjection, vp.Spec.Template.Security.PodSecurity, &namespace)
 				return nil
 			})
 			if err != nil {
@@ -215,7 +270,7 @@ func (r *Reconciler) createOrUpdateNamespaces(ctx context.Context, vp clustersv1
 	return nil
 }
 
-func (r *Reconciler) mutateNamespace(nsTemplate clustersv1alpha1.NamespaceTemplate, istioInjection string, namespace *corev1.Namespace) {
+func (r *Reconciler) mutateNamespace(nsTemplate clustersv1alpha1.NamespaceTemplate, istioInjection string, podSecurity *clustersv1alpha1.PodSecuritySpec, namespace *corev1.Namespace) {
 	namespace.Annotations = nsTemplate.Metadata.Annotations
 	namespace.Spec = nsTemplate.Spec
 
@@ -228,6 +283,13 @@ func (r *Reconciler) mutateNamespace(nsTemplate clustersv1alpha1.NamespaceTempla
 	namespace.Labels[vzconst.VerrazzanoManagedLabelKey] = constants.LabelVerrazzanoManagedDefault
 	namespace.Labels[constants.LabelIstioInjection] = istioInjection
 
+	// Apply the project Pod Security Admission levels
+	if podSecurity != nil {
+		setPodSecurityLabel(namespace.Labels, podSecurityEnforceLabel, podSecurity.Enforce)
+		setPodSecurityLabel(namespace.Labels, podSecurityAuditLabel, podSecurity.Audit)
+		setPodSecurityLabel(namespace.Labels, podSecurityWarnLabel, podSecurity.Warn)
+	}
+
 	// Apply user specified labels, which may override standard Verrazzano labels
 	for label, value := range nsTemplate.Metadata.Labels {
 		namespace.Labels[label] = value
@@ -239,16 +301,7 @@ func (r *Reconciler) mutateNamespace(nsTemplate clustersv1alpha1.NamespaceTempla
 func (r *Reconciler) createOrUpdateRoleBindings(ctx context.Context, namespace string, vp clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
 	log.Oncef("Create or update role bindings for namespace %s", namespace)
 
-	// get the default binding subjects
-	adminSubjects, monitorSubjects := r.getDefaultRoleBindingSubjects(vp)
-
-	// override defaults if specified in the project
-	if len(vp.Spec.Template.Security.ProjectAdminSubjects) > 0 {
-		adminSubjects = vp.Spec.Template.Security.ProjectAdminSubjects
-	}
-	if len(vp.Spec.Template.Security.ProjectMonitorSubjects) > 0 {
-		monitorSubjects = vp.Spec.Template.Security.ProjectMonitorSubjects
-	}
+	adminSubjects, monitorSubjects := r.getProjectSubjects(vp)
 
 	// create two role bindings, one for the project admin role and one for the k8s admin role
 	if len(adminSubjects) > 0 {
@@ -310,9 +363,17 @@ func (r *Reconciler) createOrUpdateRoleBinding(ctx context.Context, roleBinding
 func (r *Reconciler) updateStatus(ctx context.Context, vp *clustersv1alpha1.VerrazzanoProject, opResult controllerutil.OperationResult, err error) (ctrl.Result, error) {
 	clusterName := clusters.GetClusterName(ctx, r.Client)
 	newCondition := clusters.GetConditionFromResult(err, opResult, "VerrazzanoProject")
+	usage, usageErr := r.getResourceUsage(ctx, vp)
+	if usageErr != nil {
+		return ctrl.Result{}, usageErr
+	}
+	exports, exportsErr := r.getServiceExports(ctx, vp)
+	if exportsErr != nil {
+		return ctrl.Result{}, exportsErr
+	}
 	updateFunc := func() error { return r.Status().Update(ctx, vp) }
-	return clusters.UpdateStatus(vp, &vp.Status, vp.Spec.Placement, newCondition, clusterName,
-		r.AgentChannel, updateFunc)
+	return clusters.UpdateProjectStatus(vp, &vp.Status, vp.Spec.Placement, newCondition, clusterName,
+		usage, exports, r.AgentChannel, updateFunc)
 }
 
 // newRoleBinding returns a populated RoleBinding struct
@@ -356,6 +417,19 @@ func generateRoleBindingManagedClusterRef(name string) string {
 	return fmt.Sprintf("verrazzano-cluster-%s", name)
 }
 
+// getProjectSubjects returns the project admin and monitor subjects, the default subjects are returned unless
+// the subjects are specified in the project
+func (r *Reconciler) getProjectSubjects(vp clustersv1alpha1.VerrazzanoProject) ([]rbacv1.Subject, []rbacv1.Subject) {
+	adminSubjects, monitorSubjects := r.getDefaultRoleBindingSubjects(vp)
+	if len(vp.Spec.Template.Security.ProjectAdminSubjects) > 0 {
+		adminSubjects = vp.Spec.Template.Security.ProjectAdminSubjects
+	}
+	if len(vp.Spec.Template.Security.ProjectMonitorSubjects) > 0 {
+		monitorSubjects = vp.Spec.Template.Security.ProjectMonitorSubjects
+	}
+	return adminSubjects, monitorSubjects
+}
+
 // getDefaultRoleBindingSubjects returns the default binding subjects for project admin/monitor roles
 func (r *Reconciler) getDefaultRoleBindingSubjects(vp clustersv1alpha1.VerrazzanoProject) ([]rbacv1.Subject, []rbacv1.Subject) {
 	adminSubjects := []rbacv1.Subject{{
@@ -480,3 +554,57 @@ func (r *Reconciler) deleteNetworkPolicies(ctx context.Context, project *cluster
 	}
 	return nil
 }
+
+// syncLogRoutes creates or updates a LogRoute in each project namespace when the project specifies log destinations,
+// and deletes the project LogRoutes that are no longer wanted
+func (r *Reconciler) syncLogRoutes(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	desiredNamespaces := make(map[string]bool)
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace && project.Spec.Template.Logging != nil {
+		for _, ns := range project.Spec.Template.Namespaces {
+			desiredNamespaces[ns.Metadata.Name] = true
+			route := appv1alpha1.LogRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ns.Metadata.Name,
+					Name:      project.Name,
+				},
+			}
+			_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &route, func() error {
+				if route.Labels == nil {
+					route.Labels = map[string]string{}
+				}
+				route.Labels[projectLabel] = project.Name
+				route.Labels[vzconst.VerrazzanoManagedLabelKey] = constants.LabelVerrazzanoManagedDefault
+				project.Spec.Template.Logging.DeepCopyInto(&route.Spec)
+				return nil
+			})
+			if err != nil {
+				log.Errorf("Failed to create or update LogRoute %s in namespace %s: %v", route.Name, route.Namespace, err)
+				return err
+			}
+		}
+	}
+	return r.deleteLogRoutes(ctx, project, desiredNamespaces)
+}
+
+// deleteLogRoutes deletes the project LogRoutes in the project namespaces that are not in the desired namespace set
+func (r *Reconciler) deleteLogRoutes(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, desiredNamespaces map[string]bool) error {
+	for _, ns := range getProjectNamespaces(project) {
+		if desiredNamespaces[ns] {
+			continue
+		}
+		routes := appv1alpha1.LogRouteList{}
+		if err := r.List(ctx, &routes, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			// The LogRoute CRD is not installed when the application operator predates log routes
+			if meta.IsNoMatchError(err) {
+				return nil
+			}
+			return err
+		}
+		for i := range routes.Items {
+			if err := r.Delete(ctx, &routes.Items[i]); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
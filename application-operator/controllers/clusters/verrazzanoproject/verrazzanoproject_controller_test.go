@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package verrazzanoproject
@@ -15,23 +15,30 @@ import (
 
 	"github.com/golang/mock/gomock"
 	asserts "github.com/stretchr/testify/assert"
+	appv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
 	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
 	clusterstest "github.com/verrazzano/verrazzano/application-operator/controllers/clusters/test"
 	"github.com/verrazzano/verrazzano/application-operator/mocks"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	vmcclient "github.com/verrazzano/verrazzano/platform-operator/clientset/versioned/scheme"
 	"go.uber.org/zap"
+	clinet "istio.io/client-go/pkg/apis/networking/v1beta1"
+	clisecurity "istio.io/client-go/pkg/apis/security/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const finalizer = "project.verrazzano.io"
@@ -347,6 +354,10 @@ func TestReconcileVerrazzanoProject(t *testing.T) {
 						return nil
 					})
 
+				// expect call to list the project log routes
+				mockLogRouteListExpectations(mockClient)
+				mockProjectResourceListExpectations(mockClient)
+
 				// status update should be to "succeeded" in both existing and new namespace
 				doExpectStatusUpdateSucceeded(mockClient, mockStatusWriter, assert)
 
@@ -469,6 +480,10 @@ func TestNetworkPolicies(t *testing.T) {
 			return nil
 		})
 
+	// Expect call to list the project log routes
+	mockLogRouteListExpectations(mockClient)
+	mockProjectResourceListExpectations(mockClient)
+
 	// the status update should be to success status/conditions on the VerrazzanoProject
 	// status update should be to "succeeded" in both existing and new namespace
 	doExpectStatusUpdateSucceeded(mockClient, mockStatusWriter, assert)
@@ -602,6 +617,10 @@ func TestDeleteVerrazzanoProjectFinalizer(t *testing.T) {
 	// Expect call to delete rolebinding in the namespace
 	mockClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
+	// Expect call to list the project log routes
+	mockLogRouteListExpectations(mockClient)
+	mockProjectResourceListExpectations(mockClient)
+
 	// the status update should be to success status/conditions on the VerrazzanoProject
 	mockClient.EXPECT().
 		Update(gomock.Any(), gomock.AssignableToTypeOf(&clustersv1alpha1.VerrazzanoProject{}), gomock.Any()).
@@ -773,6 +792,46 @@ func mockUpdatedRoleBindingExpectations(assert *asserts.Assertions, mockClient *
 		})
 }
 
+// mockLogRouteListExpectations expects a call to list the log routes of a project that returns no resources
+func mockLogRouteListExpectations(mockClient *mocks.MockClient) {
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&appv1alpha1.LogRouteList{}), gomock.Any()).
+		Return(nil)
+}
+
+// mockProjectResourceListExpectations expects calls to list the resource quotas, limit ranges, Istio security
+// policies, Istio service discovery resources and OpenSearch security objects of a project that return no resources.
+// The OpenSearch operator CRDs are not installed.
+func mockProjectResourceListExpectations(mockClient *mocks.MockClient) {
+	mockClient.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&unstructured.Unstructured{}), gomock.Any()).
+		Return(&meta.NoKindMatchError{GroupKind: opensearchTenantGVK.GroupKind()}).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&unstructured.UnstructuredList{}), gomock.Any()).
+		Return(&meta.NoKindMatchError{GroupKind: opensearchRoleBindingGVK.GroupKind()}).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&clisecurity.PeerAuthenticationList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&clisecurity.AuthorizationPolicyList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&clinet.ServiceEntryList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&clinet.DestinationRuleList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&clinet.GatewayList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ResourceQuotaList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+	mockClient.EXPECT().
+		List(gomock.Any(), gomock.AssignableToTypeOf(&corev1.LimitRangeList{}), gomock.Any()).
+		Return(nil).AnyTimes()
+}
+
 // doExpectStatusUpdateSucceeded expects a call to update status of
 // VerrazzanoProject to success
 func doExpectStatusUpdateSucceeded(cli *mocks.MockClient, mockStatusWriter *mocks.MockStatusWriter, assert *asserts.Assertions) {
@@ -808,3 +867,33 @@ func TestReconcileKubeSystem(t *testing.T) {
 	assert.Nil(err)
 	assert.True(result.IsZero())
 }
+
+// TestSyncLogRoutes tests the creation and deletion of the project log routes
+// GIVEN a project with log destinations
+// WHEN syncLogRoutes is called
+// THEN a LogRoute is created in every project namespace
+// WHEN the log destinations are removed and syncLogRoutes is called again
+// THEN the project LogRoutes are deleted
+// AND a LogRoute with the project label outside the project namespaces is left alone
+func TestSyncLogRoutes(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	project.Spec.Template.Logging = &appv1alpha1.LogRouteSpec{}
+	other := &appv1alpha1.LogRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: project.Name, Labels: map[string]string{projectLabel: project.Name}}}
+	scheme := runtime.NewScheme()
+	_ = appv1alpha1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(other).Build()
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncLogRoutes(context.TODO(), project, vzlog.DefaultLogger()))
+	routes := appv1alpha1.LogRouteList{}
+	assert.NoError(c.List(context.TODO(), &routes))
+	assert.Len(routes.Items, 3)
+
+	project.Spec.Template.Logging = nil
+	assert.NoError(r.syncLogRoutes(context.TODO(), project, vzlog.DefaultLogger()))
+	assert.NoError(c.List(context.TODO(), &routes))
+	assert.Len(routes.Items, 1)
+	assert.Equal("other", routes.Items[0].Namespace)
+}
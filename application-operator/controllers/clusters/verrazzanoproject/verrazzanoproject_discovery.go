@@ -0,0 +1,472 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	"github.com/verrazzano/verrazzano/pkg/certs"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlog2 "github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	istionet "istio.io/api/networking/v1beta1"
+	clinet "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// globalDomain is the domain of the names of the services published to the other clusters
+	globalDomain = "global"
+	// crossClusterPort is the port of the Istio ingress gateway that forwards the mutual TLS requests of the other
+	// clusters, based on the SNI of the requests
+	crossClusterPort    = 15443
+	istioIngressGateway = "istio-ingressgateway"
+	// istioRootCertConfigMap is the ConfigMap with the root CA of the Istio mesh of the cluster
+	istioRootCertConfigMap = "istio-ca-root-cert"
+	istioRootCertKey       = "root-cert.pem"
+)
+
+// globalService is a published service, as addressed from a cluster
+type globalService struct {
+	host      string
+	ports     []clustersv1alpha1.ExportedServicePort
+	endpoints []*istionet.WorkloadEntry
+}
+
+// getServiceExports returns the services of the project in this cluster that are published to the other clusters,
+// along with the address of the ingress gateway through which they are reached, the fingerprint of the root CA of
+// the Istio mesh, and the other clusters whose services are not reached from this cluster. Returns nil if the project
+// does not publish services.
+func (r *Reconciler) getServiceExports(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject) (*clustersv1alpha1.ServiceExports, error) {
+	discovery := project.Spec.Template.ServiceDiscovery
+	if project.Namespace != constants.VerrazzanoMultiClusterNamespace || discovery == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&discovery.ServiceSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	exports := clustersv1alpha1.ServiceExports{}
+	for _, ns := range project.Spec.Template.Namespaces {
+		services := corev1.ServiceList{}
+		if err := r.List(ctx, &services, client.InNamespace(ns.Metadata.Name), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+		for _, service := range services.Items {
+			exported := clustersv1alpha1.ExportedService{Name: service.Name, Namespace: service.Namespace}
+			for _, port := range service.Spec.Ports {
+				exported.Ports = append(exported.Ports, newExportedServicePort(port))
+			}
+			exports.Services = append(exports.Services, exported)
+		}
+	}
+	sort.Slice(exports.Services, func(i, j int) bool {
+		if exports.Services[i].Namespace != exports.Services[j].Namespace {
+			return exports.Services[i].Namespace < exports.Services[j].Namespace
+		}
+		return exports.Services[i].Name < exports.Services[j].Name
+	})
+
+	exports.GatewayAddress, exports.GatewayPort, err = r.getGatewayAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exports.MeshRootCAFingerprint, err = r.getMeshRootCAFingerprint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, exports.SkippedPeers = getTrustedPeers(project, clusters.GetClusterName(ctx, r.Client), &exports)
+	return &exports, nil
+}
+
+// getMeshRootCAFingerprint returns the fingerprint of the root CA of the Istio mesh of this cluster, or an empty
+// string if Istio has not published the root CA yet
+func (r *Reconciler) getMeshRootCAFingerprint(ctx context.Context) (string, error) {
+	rootCert := corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: vzconst.IstioSystemNamespace, Name: istioRootCertConfigMap}, &rootCert)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return certs.GetCABundleFingerprint([]byte(rootCert.Data[istioRootCertKey])), nil
+}
+
+// getGatewayAddress returns the external address and port of the ingress gateway through which the other clusters
+// reach the published services. Returns an empty address if the ingress gateway does not expose the cross-cluster port.
+func (r *Reconciler) getGatewayAddress(ctx context.Context) (string, int32, error) {
+	gateway := corev1.Service{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: vzconst.IstioSystemNamespace, Name: istioIngressGateway}, &gateway)
+	if apierrors.IsNotFound(err) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	var port int32
+	for _, servicePort := range gateway.Spec.Ports {
+		if servicePort.TargetPort.IntValue() == crossClusterPort || servicePort.Port == crossClusterPort {
+			port = servicePort.Port
+			break
+		}
+	}
+	if port == 0 {
+		return "", 0, nil
+	}
+	if len(gateway.Spec.ExternalIPs) > 0 {
+		return gateway.Spec.ExternalIPs[0], port, nil
+	}
+	for _, ingress := range gateway.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			return ingress.IP, port, nil
+		}
+		if ingress.Hostname != "" {
+			return ingress.Hostname, port, nil
+		}
+	}
+	return "", 0, nil
+}
+
+// newExportedServicePort returns the published port of a service port. The Istio protocol of the port is selected
+// with the application protocol of the port, or with the prefix of its name.
+func newExportedServicePort(port corev1.ServicePort) clustersv1alpha1.ExportedServicePort {
+	protocol := port.Name
+	if port.AppProtocol != nil {
+		protocol = *port.AppProtocol
+	}
+	switch strings.ToLower(strings.SplitN(protocol, "-", 2)[0]) {
+	case "http":
+		protocol = "HTTP"
+	case "http2", "h2c":
+		protocol = "HTTP2"
+	case "grpc":
+		protocol = "GRPC"
+	default:
+		protocol = "TCP"
+	}
+	name := port.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-%d", strings.ToLower(protocol), port.Port)
+	}
+	return clustersv1alpha1.ExportedServicePort{Name: name, Port: port.Port, Protocol: protocol}
+}
+
+// syncServiceDiscovery creates or updates the Istio resources that make the published services of the project
+// addressable as <service>.<namespace>.global. The services of this cluster are reached directly, and the services of
+// the other clusters are reached through the ingress gateway of their cluster. The mutual TLS connections between the
+// clusters require a common root CA, so only the clusters whose mesh shares the root CA of this cluster are peered,
+// and the cross-cluster gateways are only created when there is at least one such cluster. The project resources that
+// are no longer needed are deleted.
+func (r *Reconciler) syncServiceDiscovery(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	desiredServices := make(map[types.NamespacedName]bool)
+	desiredGateways := make(map[types.NamespacedName]bool)
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace && project.Spec.Template.ServiceDiscovery != nil {
+		exports, err := r.getServiceExports(ctx, project)
+		if err != nil {
+			return err
+		}
+		peers, skipped := getTrustedPeers(project, clusters.GetClusterName(ctx, r.Client), exports)
+		for _, peer := range skipped {
+			log.Progressf("Not reaching the services of project %s published by cluster %s: %s", project.Name, peer.Name, peer.Message)
+		}
+		services := getGlobalServices(project, exports, peers)
+		for name, service := range services {
+			desiredServices[name] = true
+			if err := r.createOrUpdateServiceEntry(ctx, project, name, service, log); err != nil {
+				return err
+			}
+			if err := r.createOrUpdateDestinationRule(ctx, project, name, service.host, log); err != nil {
+				return err
+			}
+		}
+		for _, service := range exports.Services {
+			name := types.NamespacedName{Namespace: service.Namespace, Name: project.Name + "-" + globalDomain}
+			if len(peers) == 0 || desiredGateways[name] {
+				continue
+			}
+			desiredGateways[name] = true
+			if err := r.createOrUpdateCrossClusterGateway(ctx, project, name, log); err != nil {
+				return err
+			}
+		}
+	}
+	return r.deleteServiceDiscovery(ctx, project, desiredServices, desiredGateways)
+}
+
+// getTrustedPeers returns the statuses of the other clusters of the project that publish services through a reachable
+// ingress gateway and whose Istio mesh shares the root CA of this cluster, sorted by cluster name, along with the other
+// clusters that publish services but are skipped, and the reason
+func getTrustedPeers(project *clustersv1alpha1.VerrazzanoProject, clusterName string, exports *clustersv1alpha1.ServiceExports) ([]clustersv1alpha1.ClusterLevelStatus, []clustersv1alpha1.SkippedPeer) {
+	var peers []clustersv1alpha1.ClusterLevelStatus
+	var skipped []clustersv1alpha1.SkippedPeer
+	for _, status := range project.Status.Clusters {
+		peerExports := status.ServiceExports
+		if status.Name == clusterName || peerExports == nil {
+			continue
+		}
+		switch {
+		case peerExports.GatewayAddress == "" || peerExports.GatewayPort == 0:
+			skipped = append(skipped, clustersv1alpha1.SkippedPeer{
+				Name:    status.Name,
+				Reason:  clustersv1alpha1.SkippedPeerGatewayUnreachable,
+				Message: fmt.Sprintf("the Istio ingress gateway of the cluster has no external address or does not expose the port %d", crossClusterPort),
+			})
+		case exports.MeshRootCAFingerprint == "":
+			skipped = append(skipped, clustersv1alpha1.SkippedPeer{
+				Name:    status.Name,
+				Reason:  clustersv1alpha1.SkippedPeerRootCAMismatch,
+				Message: "the root CA of the Istio mesh of this cluster has not been published",
+			})
+		case peerExports.MeshRootCAFingerprint != exports.MeshRootCAFingerprint:
+			skipped = append(skipped, clustersv1alpha1.SkippedPeer{
+				Name:    status.Name,
+				Reason:  clustersv1alpha1.SkippedPeerRootCAMismatch,
+				Message: "the Istio mesh of the cluster does not share the root CA of this cluster",
+			})
+		default:
+			peers = append(peers, status)
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].Name < peers[j].Name })
+	sort.Slice(skipped, func(i, j int) bool { return skipped[i].Name < skipped[j].Name })
+	return peers, skipped
+}
+
+// getGlobalServices returns the published services of the project addressed from this cluster, by name of their
+// ServiceEntry. The services of the peer clusters are only addressed in the project namespaces, and a service
+// published by this cluster is always reached in this cluster.
+func getGlobalServices(project *clustersv1alpha1.VerrazzanoProject, exports *clustersv1alpha1.ServiceExports, peerStatuses []clustersv1alpha1.ClusterLevelStatus) map[types.NamespacedName]*globalService {
+	services := make(map[types.NamespacedName]*globalService)
+	for _, service := range exports.Services {
+		services[globalServiceName(service)] = &globalService{
+			host:      globalHost(service),
+			ports:     service.Ports,
+			endpoints: []*istionet.WorkloadEntry{{Address: fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, service.Namespace)}},
+		}
+	}
+
+	projectNamespaces := make(map[string]bool)
+	for _, ns := range project.Spec.Template.Namespaces {
+		projectNamespaces[ns.Metadata.Name] = true
+	}
+	peers := make(map[types.NamespacedName]*globalService)
+	for _, status := range peerStatuses {
+		peerExports := status.ServiceExports
+		for _, service := range peerExports.Services {
+			name := globalServiceName(service)
+			if !projectNamespaces[service.Namespace] || services[name] != nil {
+				continue
+			}
+			peer, ok := peers[name]
+			if !ok {
+				peer = &globalService{host: globalHost(service), ports: service.Ports}
+				peers[name] = peer
+			}
+			endpoint := &istionet.WorkloadEntry{Address: peerExports.GatewayAddress, Ports: map[string]uint32{}}
+			for _, port := range peer.ports {
+				endpoint.Ports[port.Name] = uint32(peerExports.GatewayPort)
+			}
+			peer.endpoints = append(peer.endpoints, endpoint)
+		}
+	}
+	for name, peer := range peers {
+		services[name] = peer
+	}
+	return services
+}
+
+// globalServiceName returns the name of the ServiceEntry and DestinationRule of a published service
+func globalServiceName(service clustersv1alpha1.ExportedService) types.NamespacedName {
+	return types.NamespacedName{Namespace: service.Namespace, Name: service.Name + "-" + globalDomain}
+}
+
+// globalHost returns the host name of a published service
+func globalHost(service clustersv1alpha1.ExportedService) string {
+	return fmt.Sprintf("%s.%s.%s", service.Name, service.Namespace, globalDomain)
+}
+
+// globalAddress returns the virtual IP address of a published service in the 240.240.0.0/16 range, which is resolved
+// by the Istio DNS proxy. The address is derived from the host name so that it is the same in all the clusters.
+func globalAddress(host string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(host))
+	sum := hash.Sum32()
+	return fmt.Sprintf("240.240.%d.%d", (sum>>8)&0xff, sum&0xff)
+}
+
+// createOrUpdateServiceEntry creates or updates the ServiceEntry of a published service
+func (r *Reconciler) createOrUpdateServiceEntry(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, name types.NamespacedName, service *globalService, log vzlog2.VerrazzanoLogger) error {
+	serviceEntry := clinet.ServiceEntry{}
+	serviceEntry.Namespace = name.Namespace
+	serviceEntry.Name = name.Name
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &serviceEntry, func() error {
+		serviceEntry.Labels = getProjectResourceLabels(project, serviceEntry.Labels)
+		serviceEntry.Spec = istionet.ServiceEntry{
+			Hosts:      []string{service.host},
+			Addresses:  []string{globalAddress(service.host)},
+			Location:   istionet.ServiceEntry_MESH_INTERNAL,
+			Resolution: istionet.ServiceEntry_DNS,
+			Endpoints:  service.endpoints,
+		}
+		for _, port := range service.ports {
+			serviceEntry.Spec.Ports = append(serviceEntry.Spec.Ports, &istionet.ServicePort{
+				Number:   uint32(port.Port),
+				Protocol: port.Protocol,
+				Name:     port.Name,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to create or update ServiceEntry %s in namespace %s: %v", serviceEntry.Name, serviceEntry.Namespace, err)
+		return err
+	}
+	return nil
+}
+
+// createOrUpdateDestinationRule creates or updates the DestinationRule of a published service, so that the requests
+// to the service use Istio mutual TLS, which the ingress gateway of the other clusters requires to route them
+func (r *Reconciler) createOrUpdateDestinationRule(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, name types.NamespacedName, host string, log vzlog2.VerrazzanoLogger) error {
+	destinationRule := clinet.DestinationRule{}
+	destinationRule.Namespace = name.Namespace
+	destinationRule.Name = name.Name
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &destinationRule, func() error {
+		destinationRule.Labels = getProjectResourceLabels(project, destinationRule.Labels)
+		destinationRule.Spec = istionet.DestinationRule{
+			Host: host,
+			TrafficPolicy: &istionet.TrafficPolicy{
+				Tls: &istionet.ClientTLSSettings{Mode: istionet.ClientTLSSettings_ISTIO_MUTUAL},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to create or update DestinationRule %s in namespace %s: %v", destinationRule.Name, destinationRule.Namespace, err)
+		return err
+	}
+	return nil
+}
+
+// createOrUpdateCrossClusterGateway creates or updates the Gateway that routes the requests of the other clusters to
+// the published services of a project namespace. The gateway passes the mutual TLS connections through to the
+// services, routing them with their SNI.
+func (r *Reconciler) createOrUpdateCrossClusterGateway(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, name types.NamespacedName, log vzlog2.VerrazzanoLogger) error {
+	gateway := clinet.Gateway{}
+	gateway.Namespace = name.Namespace
+	gateway.Name = name.Name
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &gateway, func() error {
+		gateway.Labels = getProjectResourceLabels(project, gateway.Labels)
+		gateway.Spec = istionet.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*istionet.Server{{
+				Port: &istionet.Port{
+					Number:   crossClusterPort,
+					Protocol: "TLS",
+					Name:     "tls-" + project.Name,
+				},
+				Tls:   &istionet.ServerTLSSettings{Mode: istionet.ServerTLSSettings_AUTO_PASSTHROUGH},
+				Hosts: []string{fmt.Sprintf("*.%s.%s", name.Namespace, globalDomain)},
+			}},
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to create or update Gateway %s in namespace %s: %v", gateway.Name, gateway.Namespace, err)
+		return err
+	}
+	return nil
+}
+
+// deleteServiceDiscovery deletes the project ServiceEntries, DestinationRules and Gateways that are not in the
+// desired sets
+func (r *Reconciler) deleteServiceDiscovery(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, desiredServices map[types.NamespacedName]bool, desiredGateways map[types.NamespacedName]bool) error {
+	serviceEntries := clinet.ServiceEntryList{}
+	if err := r.List(ctx, &serviceEntries, client.MatchingLabels{projectLabel: project.Name}); err != nil {
+		// The Istio CRDs are not installed when Istio is disabled
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	for _, serviceEntry := range serviceEntries.Items {
+		if desiredServices[client.ObjectKeyFromObject(serviceEntry)] {
+			continue
+		}
+		if err := r.Delete(ctx, serviceEntry); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	destinationRules := clinet.DestinationRuleList{}
+	if err := r.List(ctx, &destinationRules, client.MatchingLabels{projectLabel: project.Name}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	for _, destinationRule := range destinationRules.Items {
+		if desiredServices[client.ObjectKeyFromObject(destinationRule)] {
+			continue
+		}
+		if err := r.Delete(ctx, destinationRule); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	gateways := clinet.GatewayList{}
+	if err := r.List(ctx, &gateways, client.MatchingLabels{projectLabel: project.Name}); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	for _, gateway := range gateways.Items {
+		if desiredGateways[client.ObjectKeyFromObject(gateway)] {
+			continue
+		}
+		if err := r.Delete(ctx, gateway); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapServiceToProjects maps a service to the reconcile requests of the projects that publish services in its
+// namespace, so that the published services are updated when the services change
+func (r *Reconciler) mapServiceToProjects(obj client.Object) []reconcile.Request {
+	projects := clustersv1alpha1.VerrazzanoProjectList{}
+	if err := r.List(context.TODO(), &projects, client.InNamespace(constants.VerrazzanoMultiClusterNamespace)); err != nil {
+		r.Log.Errorf("Failed to list Verrazzano projects: %v", err)
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, project := range projects.Items {
+		if project.Spec.Template.ServiceDiscovery == nil {
+			continue
+		}
+		for _, ns := range project.Spec.Template.Namespaces {
+			if ns.Metadata.Name == obj.GetNamespace() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: project.Namespace, Name: project.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
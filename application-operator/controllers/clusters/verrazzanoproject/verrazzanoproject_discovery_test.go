@@ -0,0 +1,226 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"testing"
+
+	asserts "github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	"github.com/verrazzano/verrazzano/pkg/certs"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	istionet "istio.io/api/networking/v1beta1"
+	clinet "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testMeshRootCA = "mesh-root-ca"
+
+var testMeshRootCAFingerprint = certs.GetCABundleFingerprint([]byte(testMeshRootCA))
+
+// TestGetServiceExports tests the services published by a project in this cluster
+// GIVEN a project with service discovery and an ingress gateway exposing the cross-cluster port
+// WHEN getServiceExports is called
+// THEN the selected services of the project namespaces, the address of the ingress gateway and the fingerprint of the
+// mesh root CA are returned
+func TestGetServiceExports(t *testing.T) {
+	assert := asserts.New(t)
+
+	c := newDiscoveryClient(newDiscoveryGateway(), newDiscoveryRootCert(), newDiscoveryService("ns1", "hello", true),
+		newDiscoveryService("ns2", "private", false), newDiscoveryService("other", "outside", true))
+	r := Reconciler{Client: c}
+
+	exports, err := r.getServiceExports(context.TODO(), newDiscoveryProject())
+	assert.NoError(err)
+	assert.Equal("1.2.3.4", exports.GatewayAddress)
+	assert.Equal(int32(15443), exports.GatewayPort)
+	assert.Equal(testMeshRootCAFingerprint, exports.MeshRootCAFingerprint)
+	assert.Equal([]clustersv1alpha1.ExportedService{{
+		Name:      "hello",
+		Namespace: "ns1",
+		Ports: []clustersv1alpha1.ExportedServicePort{
+			{Name: "http-web", Port: 8080, Protocol: "HTTP"},
+			{Name: "tcp-9000", Port: 9000, Protocol: "TCP"},
+		},
+	}}, exports.Services)
+
+	// The services are not published without service discovery
+	project := newDiscoveryProject()
+	project.Spec.Template.ServiceDiscovery = nil
+	exports, err = r.getServiceExports(context.TODO(), project)
+	assert.NoError(err)
+	assert.Nil(exports)
+}
+
+// TestSyncServiceDiscovery tests the creation and deletion of the Istio resources of the published services
+// GIVEN a project with service discovery, a published service in this cluster and services published by other clusters
+// WHEN syncServiceDiscovery is called
+// THEN ServiceEntries and DestinationRules are created for the published services of this cluster and of the clusters
+// that share the mesh root CA, and a Gateway for the local ones, and the other clusters are reported as skipped peers
+// WHEN service discovery is disabled and syncServiceDiscovery is called again
+// THEN the Istio resources are deleted
+func TestSyncServiceDiscovery(t *testing.T) {
+	assert := asserts.New(t)
+
+	registration := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: clusters.MCRegistrationSecretFullName.Namespace, Name: clusters.MCRegistrationSecretFullName.Name},
+		Data:       map[string][]byte{constants.ClusterNameData: []byte("managed1")},
+	}
+	c := newDiscoveryClient(registration, newDiscoveryGateway(), newDiscoveryRootCert(), newDiscoveryService("ns1", "hello", true))
+	r := Reconciler{Client: c}
+
+	peerService := clustersv1alpha1.ExportedService{Name: "world", Namespace: "ns2", Ports: []clustersv1alpha1.ExportedServicePort{{Name: "http", Port: 80, Protocol: "HTTP"}}}
+	project := newDiscoveryProject()
+	project.Status.Clusters = []clustersv1alpha1.ClusterLevelStatus{
+		{Name: "managed3", ServiceExports: &clustersv1alpha1.ServiceExports{GatewayAddress: "gateway.managed3.example.com", GatewayPort: 15443, MeshRootCAFingerprint: testMeshRootCAFingerprint, Services: []clustersv1alpha1.ExportedService{peerService}}},
+		{Name: "managed2", ServiceExports: &clustersv1alpha1.ServiceExports{GatewayAddress: "5.6.7.8", GatewayPort: 443, MeshRootCAFingerprint: testMeshRootCAFingerprint, Services: []clustersv1alpha1.ExportedService{
+			peerService,
+			// Services published by this cluster are reached locally, and services of other namespaces are ignored
+			{Name: "hello", Namespace: "ns1"},
+			{Name: "outside", Namespace: "other"},
+		}}},
+		// Clusters without a gateway address are ignored
+		{Name: "managed4", ServiceExports: &clustersv1alpha1.ServiceExports{Services: []clustersv1alpha1.ExportedService{{Name: "unreachable", Namespace: "ns2"}}}},
+		// Clusters with a different or an unknown mesh root CA are ignored
+		{Name: "managed5", ServiceExports: &clustersv1alpha1.ServiceExports{GatewayAddress: "9.9.9.9", GatewayPort: 15443, MeshRootCAFingerprint: "other", Services: []clustersv1alpha1.ExportedService{peerService}}},
+		{Name: "managed6", ServiceExports: &clustersv1alpha1.ServiceExports{GatewayAddress: "9.9.9.9", GatewayPort: 15443, Services: []clustersv1alpha1.ExportedService{peerService}}},
+		{Name: "managed1", ServiceExports: &clustersv1alpha1.ServiceExports{GatewayAddress: "1.2.3.4", GatewayPort: 15443, MeshRootCAFingerprint: testMeshRootCAFingerprint, Services: []clustersv1alpha1.ExportedService{{Name: "stale", Namespace: "ns2"}}}},
+	}
+
+	assert.NoError(r.syncServiceDiscovery(context.TODO(), project, vzlog.DefaultLogger()))
+
+	serviceEntries := clinet.ServiceEntryList{}
+	assert.NoError(c.List(context.TODO(), &serviceEntries))
+	assert.Len(serviceEntries.Items, 2)
+
+	local := clinet.ServiceEntry{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "ns1", Name: "hello-global"}, &local))
+	assert.Equal(project.Name, local.Labels[projectLabel])
+	assert.Equal([]string{"hello.ns1.global"}, local.Spec.Hosts)
+	assert.Equal([]string{globalAddress("hello.ns1.global")}, local.Spec.Addresses)
+	assert.Equal(istionet.ServiceEntry_MESH_INTERNAL, local.Spec.Location)
+	assert.Len(local.Spec.Endpoints, 1)
+	assert.Equal("hello.ns1.svc.cluster.local", local.Spec.Endpoints[0].Address)
+	assert.Len(local.Spec.Ports, 2)
+
+	peer := clinet.ServiceEntry{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "ns2", Name: "world-global"}, &peer))
+	assert.Equal([]string{"world.ns2.global"}, peer.Spec.Hosts)
+	assert.Len(peer.Spec.Endpoints, 2)
+	assert.Equal("5.6.7.8", peer.Spec.Endpoints[0].Address)
+	assert.Equal(map[string]uint32{"http": 443}, peer.Spec.Endpoints[0].Ports)
+	assert.Equal("gateway.managed3.example.com", peer.Spec.Endpoints[1].Address)
+	assert.Equal(map[string]uint32{"http": 15443}, peer.Spec.Endpoints[1].Ports)
+
+	destinationRule := clinet.DestinationRule{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: "ns2", Name: "world-global"}, &destinationRule))
+	assert.Equal("world.ns2.global", destinationRule.Spec.Host)
+	assert.Equal(istionet.ClientTLSSettings_ISTIO_MUTUAL, destinationRule.Spec.TrafficPolicy.Tls.Mode)
+
+	gateways := clinet.GatewayList{}
+	assert.NoError(c.List(context.TODO(), &gateways))
+	assert.Len(gateways.Items, 1)
+	gateway := gateways.Items[0]
+	assert.Equal(types.NamespacedName{Namespace: "ns1", Name: "myproject-global"}, client.ObjectKeyFromObject(gateway))
+	assert.Equal(istionet.ServerTLSSettings_AUTO_PASSTHROUGH, gateway.Spec.Servers[0].Tls.Mode)
+	assert.Equal([]string{"*.ns1.global"}, gateway.Spec.Servers[0].Hosts)
+
+	exports, err := r.getServiceExports(context.TODO(), project)
+	assert.NoError(err)
+	assert.Len(exports.SkippedPeers, 3)
+	assert.Equal("managed4", exports.SkippedPeers[0].Name)
+	assert.Equal(clustersv1alpha1.SkippedPeerGatewayUnreachable, exports.SkippedPeers[0].Reason)
+	assert.Contains(exports.SkippedPeers[0].Message, "15443")
+	assert.Equal("managed5", exports.SkippedPeers[1].Name)
+	assert.Equal(clustersv1alpha1.SkippedPeerRootCAMismatch, exports.SkippedPeers[1].Reason)
+	assert.Equal("managed6", exports.SkippedPeers[2].Name)
+	assert.Equal(clustersv1alpha1.SkippedPeerRootCAMismatch, exports.SkippedPeers[2].Reason)
+
+	// Without a peer that shares the mesh root CA, only the local services are addressed and no Gateway is created
+	project.Status.Clusters = project.Status.Clusters[4:]
+	assert.NoError(r.syncServiceDiscovery(context.TODO(), project, vzlog.DefaultLogger()))
+
+	assert.NoError(c.List(context.TODO(), &serviceEntries))
+	assert.Len(serviceEntries.Items, 1)
+	assert.Equal("hello-global", serviceEntries.Items[0].Name)
+	assert.NoError(c.List(context.TODO(), &gateways))
+	assert.Empty(gateways.Items)
+
+	project.Spec.Template.ServiceDiscovery = nil
+	assert.NoError(r.syncServiceDiscovery(context.TODO(), project, vzlog.DefaultLogger()))
+
+	assert.NoError(c.List(context.TODO(), &serviceEntries))
+	assert.Empty(serviceEntries.Items)
+	destinationRules := clinet.DestinationRuleList{}
+	assert.NoError(c.List(context.TODO(), &destinationRules))
+	assert.Empty(destinationRules.Items)
+	assert.NoError(c.List(context.TODO(), &gateways))
+	assert.Empty(gateways.Items)
+}
+
+// newDiscoveryProject returns a project that publishes the services labeled with export=true
+func newDiscoveryProject() *clustersv1alpha1.VerrazzanoProject {
+	project := newQuotaProject()
+	project.Spec.Template.ServiceDiscovery = &clustersv1alpha1.ServiceDiscoverySpec{
+		ServiceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"export": "true"}},
+	}
+	return project
+}
+
+// newDiscoveryService returns a service with an HTTP port and an unnamed TCP port
+func newDiscoveryService(namespace string, name string, exported bool) *corev1.Service {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+			{Name: "http-web", Port: 8080},
+			{Port: 9000},
+		}},
+	}
+	if exported {
+		service.Labels = map[string]string{"export": "true"}
+	}
+	return service
+}
+
+// newDiscoveryGateway returns the ingress gateway service exposing the cross-cluster port
+func newDiscoveryGateway() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.IstioSystemNamespace, Name: istioIngressGateway},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Name: "https", Port: 443, TargetPort: intstr.FromInt(8443)},
+				{Name: "tls", Port: 15443, TargetPort: intstr.FromInt(15443)},
+			},
+		},
+		Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}}},
+	}
+}
+
+// newDiscoveryRootCert returns the ConfigMap with the root CA of the Istio mesh
+func newDiscoveryRootCert() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.IstioSystemNamespace, Name: istioRootCertConfigMap},
+		Data:       map[string]string{istioRootCertKey: testMeshRootCA},
+	}
+}
+
+// newDiscoveryClient creates a fake client that knows the Istio networking types
+func newDiscoveryClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	_ = clinet.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
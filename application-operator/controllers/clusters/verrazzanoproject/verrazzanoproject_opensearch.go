@@ -0,0 +1,206 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlog2 "github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	opensearchClusterName = "opensearch"
+	// applicationIndexPattern matches the data streams of the application logs, one per namespace
+	applicationIndexPattern = "verrazzano-application-*"
+	// namespaceField is the log record field holding the namespace of the pod that wrote the log
+	namespaceField              = "kubernetes.namespace_name"
+	opensearchAdminRoleSuffix   = "-admin"
+	opensearchMonitorRoleSuffix = "-monitor"
+	opensearchRolePrefix        = "vz-project-"
+)
+
+var (
+	opensearchRoleGVK        = schema.GroupVersionKind{Group: "opensearch.opster.io", Version: "v1", Kind: "OpensearchRole"}
+	opensearchRoleBindingGVK = schema.GroupVersionKind{Group: "opensearch.opster.io", Version: "v1", Kind: "OpensearchUserRoleBinding"}
+	opensearchTenantGVK      = schema.GroupVersionKind{Group: "opensearch.opster.io", Version: "v1", Kind: "OpensearchTenant"}
+
+	// project admins can manage the saved objects of the project tenant, project monitors can only read them
+	projectAdminTenantActions   = []interface{}{"kibana_all_write"}
+	projectMonitorTenantActions = []interface{}{"kibana_all_read"}
+)
+
+// syncOpenSearchSecurity creates or updates the OpenSearch Dashboards tenant of a project, and the OpenSearch roles and
+// role bindings that give the project admin and monitor subjects access to the tenant and to the logs of the project
+// namespaces. Document level security restricts the roles to the log records of the project namespaces, so that the
+// subjects of a project can't read the logs of other projects. The OpenSearch security objects are reconciled by the
+// OpenSearch operator, so nothing is done when OpenSearch is not installed.
+func (r *Reconciler) syncOpenSearchSecurity(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	desiredNames := make(map[string]bool)
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace && len(project.Spec.Template.Namespaces) > 0 {
+		adminSubjects, monitorSubjects := r.getProjectSubjects(*project)
+		tenant := newOpenSearchObject(opensearchTenantGVK, project.Name)
+		installed, err := r.createOrUpdateOpenSearchObject(ctx, project, tenant, map[string]interface{}{
+			"description": fmt.Sprintf("Tenant of Verrazzano project %s", project.Name),
+		}, log)
+		if err != nil || !installed {
+			return err
+		}
+		desiredNames[tenant.GetName()] = true
+
+		dls, err := getNamespacesQuery(project)
+		if err != nil {
+			return err
+		}
+		roles := []struct {
+			name          string
+			subjects      []rbacv1.Subject
+			tenantActions []interface{}
+		}{
+			{getOpenSearchRoleName(project.Name, opensearchAdminRoleSuffix), adminSubjects, projectAdminTenantActions},
+			{getOpenSearchRoleName(project.Name, opensearchMonitorRoleSuffix), monitorSubjects, projectMonitorTenantActions},
+		}
+		for _, role := range roles {
+			if len(role.subjects) == 0 {
+				continue
+			}
+			roleSpec := newOpenSearchRoleSpec(project.Name, dls, role.tenantActions)
+			if _, err := r.createOrUpdateOpenSearchObject(ctx, project, newOpenSearchObject(opensearchRoleGVK, role.name), roleSpec, log); err != nil {
+				return err
+			}
+			bindingSpec := newOpenSearchRoleBindingSpec(role.name, role.subjects)
+			if _, err := r.createOrUpdateOpenSearchObject(ctx, project, newOpenSearchObject(opensearchRoleBindingGVK, role.name), bindingSpec, log); err != nil {
+				return err
+			}
+			desiredNames[role.name] = true
+		}
+	}
+	return r.deleteOpenSearchSecurity(ctx, project, desiredNames)
+}
+
+// createOrUpdateOpenSearchObject creates or updates an OpenSearch operator object of a project with the given spec,
+// returning false if the OpenSearch operator CRDs are not installed
+func (r *Reconciler) createOrUpdateOpenSearchObject(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, obj *unstructured.Unstructured, spec map[string]interface{}, log vzlog2.VerrazzanoLogger) (bool, error) {
+	spec["opensearchCluster"] = map[string]interface{}{"name": opensearchClusterName}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		obj.SetLabels(getProjectResourceLabels(project, obj.GetLabels()))
+		return unstructured.SetNestedMap(obj.Object, spec, "spec")
+	})
+	if meta.IsNoMatchError(err) {
+		log.Debugf("The OpenSearch operator is not installed, skipping the OpenSearch security of project %s", project.Name)
+		return false, nil
+	}
+	if err != nil {
+		log.Errorf("Failed to create or update %s %s in namespace %s: %v", obj.GetKind(), obj.GetName(), obj.GetNamespace(), err)
+		return false, err
+	}
+	return true, nil
+}
+
+// newOpenSearchRoleSpec returns the spec of an OpenSearch role of a project. The role can read the application logs
+// matching the document level security query and use the project tenant with the given actions.
+func newOpenSearchRoleSpec(projectName string, dls string, tenantActions []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"clusterPermissions": []interface{}{"cluster_composite_ops_ro"},
+		"indexPermissions": []interface{}{
+			map[string]interface{}{
+				"indexPatterns":  []interface{}{applicationIndexPattern},
+				"dls":            dls,
+				"allowedActions": []interface{}{"read", "indices:admin/resolve/index", "indices:admin/mappings/get"},
+			},
+		},
+		"tenantPermissions": []interface{}{
+			map[string]interface{}{
+				"tenantPatterns": []interface{}{projectName},
+				"allowedActions": tenantActions,
+			},
+		},
+	}
+}
+
+// newOpenSearchRoleBindingSpec returns the spec of an OpenSearch role binding that maps the subjects to an OpenSearch
+// role. Groups are mapped as backend roles, since the Verrazzano auth proxy appends the Keycloak groups of a user to
+// the realm roles in the x-proxy-roles header. Service accounts don't access OpenSearch through the auth proxy, so
+// they are not mapped.
+func newOpenSearchRoleBindingSpec(roleName string, subjects []rbacv1.Subject) map[string]interface{} {
+	users := []interface{}{}
+	backendRoles := []interface{}{}
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			users = append(users, subject.Name)
+		case rbacv1.GroupKind:
+			backendRoles = append(backendRoles, subject.Name)
+		}
+	}
+	return map[string]interface{}{
+		"users":        users,
+		"backendRoles": backendRoles,
+		"roles":        []interface{}{roleName},
+	}
+}
+
+// getNamespacesQuery returns the document level security query that matches the log records of the project namespaces
+func getNamespacesQuery(project *clustersv1alpha1.VerrazzanoProject) (string, error) {
+	var namespaces []string
+	for _, ns := range project.Spec.Template.Namespaces {
+		namespaces = append(namespaces, ns.Metadata.Name)
+	}
+	query, err := json.Marshal(map[string]interface{}{
+		"terms": map[string]interface{}{namespaceField: namespaces},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(query), nil
+}
+
+// deleteOpenSearchSecurity deletes the project OpenSearch tenants, roles and role bindings that are not in the desired
+// name set
+func (r *Reconciler) deleteOpenSearchSecurity(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, desiredNames map[string]bool) error {
+	// Delete the role bindings before the roles they refer to
+	for _, gvk := range []schema.GroupVersionKind{opensearchRoleBindingGVK, opensearchRoleGVK, opensearchTenantGVK} {
+		objs := unstructured.UnstructuredList{}
+		objs.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, &objs, client.InNamespace(vzconst.VerrazzanoLoggingNamespace), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			// The OpenSearch operator CRDs are not installed when OpenSearch is disabled
+			if meta.IsNoMatchError(err) {
+				return nil
+			}
+			return err
+		}
+		for i := range objs.Items {
+			if desiredNames[objs.Items[i].GetName()] {
+				continue
+			}
+			if err := r.Delete(ctx, &objs.Items[i]); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newOpenSearchObject(gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(vzconst.VerrazzanoLoggingNamespace)
+	obj.SetName(name)
+	return obj
+}
+
+// getOpenSearchRoleName returns the name of an OpenSearch role of a project
+func getOpenSearchRoleName(projectName string, suffix string) string {
+	return opensearchRolePrefix + projectName + suffix
+}
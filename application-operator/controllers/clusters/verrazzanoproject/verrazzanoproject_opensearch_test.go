@@ -0,0 +1,147 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	asserts "github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const authProxyConfigMapPath = "../../../../platform-operator/helm_config/charts/verrazzano-authproxy/templates/verrazzano-authproxy-configmap.yaml"
+
+// TestSyncOpenSearchSecurity tests the creation and deletion of the project OpenSearch security objects
+// GIVEN a project with admin user and monitor group subjects
+// WHEN syncOpenSearchSecurity is called
+// THEN a tenant, roles restricted to the project namespaces and role bindings of the subjects are created
+// WHEN the project has no namespaces and syncOpenSearchSecurity is called again
+// THEN the OpenSearch security objects of the project are deleted
+func TestSyncOpenSearchSecurity(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	project.Spec.Template.Security.ProjectAdminSubjects = []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "alice"}}
+	project.Spec.Template.Security.ProjectMonitorSubjects = []rbacv1.Subject{
+		{Kind: rbacv1.GroupKind, Name: "operators"},
+		{Kind: rbacv1.ServiceAccountKind, Name: "monitor", Namespace: "ns1"},
+	}
+	c := newQuotaClient()
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncOpenSearchSecurity(context.TODO(), project, vzlog.DefaultLogger()))
+
+	tenant := getOpenSearchObject(t, c, opensearchTenantGVK, project.Name)
+	assert.Equal(project.Name, tenant.GetLabels()[projectLabel])
+	clusterName, _, _ := unstructured.NestedString(tenant.Object, "spec", "opensearchCluster", "name")
+	assert.Equal(opensearchClusterName, clusterName)
+
+	adminRole := getOpenSearchObject(t, c, opensearchRoleGVK, "vz-project-myproject-admin")
+	indexPermissions, _, _ := unstructured.NestedSlice(adminRole.Object, "spec", "indexPermissions")
+	assert.Len(indexPermissions, 1)
+	indexPermission := indexPermissions[0].(map[string]interface{})
+	assert.Equal([]interface{}{applicationIndexPattern}, indexPermission["indexPatterns"])
+	assert.Equal(`{"terms":{"kubernetes.namespace_name":["ns1","ns2"]}}`, indexPermission["dls"])
+	tenantPermissions, _, _ := unstructured.NestedSlice(adminRole.Object, "spec", "tenantPermissions")
+	assert.Equal([]interface{}{project.Name}, tenantPermissions[0].(map[string]interface{})["tenantPatterns"])
+	assert.Equal([]interface{}{"kibana_all_write"}, tenantPermissions[0].(map[string]interface{})["allowedActions"])
+
+	monitorRole := getOpenSearchObject(t, c, opensearchRoleGVK, "vz-project-myproject-monitor")
+	tenantPermissions, _, _ = unstructured.NestedSlice(monitorRole.Object, "spec", "tenantPermissions")
+	assert.Equal([]interface{}{"kibana_all_read"}, tenantPermissions[0].(map[string]interface{})["allowedActions"])
+
+	adminBinding := getOpenSearchObject(t, c, opensearchRoleBindingGVK, "vz-project-myproject-admin")
+	users, _, _ := unstructured.NestedStringSlice(adminBinding.Object, "spec", "users")
+	assert.Equal([]string{"alice"}, users)
+	roles, _, _ := unstructured.NestedStringSlice(adminBinding.Object, "spec", "roles")
+	assert.Equal([]string{"vz-project-myproject-admin"}, roles)
+
+	monitorBinding := getOpenSearchObject(t, c, opensearchRoleBindingGVK, "vz-project-myproject-monitor")
+	users, _, _ = unstructured.NestedStringSlice(monitorBinding.Object, "spec", "users")
+	assert.Empty(users)
+	backendRoles, _, _ := unstructured.NestedStringSlice(monitorBinding.Object, "spec", "backendRoles")
+	assert.Equal([]string{"operators"}, backendRoles)
+
+	project.Spec.Template.Namespaces = nil
+	assert.NoError(r.syncOpenSearchSecurity(context.TODO(), project, vzlog.DefaultLogger()))
+	for _, gvk := range []schema.GroupVersionKind{opensearchTenantGVK, opensearchRoleGVK, opensearchRoleBindingGVK} {
+		objs := unstructured.UnstructuredList{}
+		objs.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		assert.NoError(c.List(context.TODO(), &objs))
+		assert.Empty(objs.Items)
+	}
+}
+
+// TestSyncOpenSearchSecurityDefaultSubjects tests the OpenSearch role bindings of a project without subjects
+// GIVEN a project that doesn't specify the admin and monitor subjects
+// WHEN syncOpenSearchSecurity is called
+// THEN the default project groups are mapped as backend roles
+func TestSyncOpenSearchSecurityDefaultSubjects(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	c := newQuotaClient()
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncOpenSearchSecurity(context.TODO(), project, vzlog.DefaultLogger()))
+
+	adminBinding := getOpenSearchObject(t, c, opensearchRoleBindingGVK, "vz-project-myproject-admin")
+	backendRoles, _, _ := unstructured.NestedStringSlice(adminBinding.Object, "spec", "backendRoles")
+	assert.Equal([]string{"verrazzano-project-myproject-admins"}, backendRoles)
+	monitorBinding := getOpenSearchObject(t, c, opensearchRoleBindingGVK, "vz-project-myproject-monitor")
+	backendRoles, _, _ = unstructured.NestedStringSlice(monitorBinding.Object, "spec", "backendRoles")
+	assert.Equal([]string{"verrazzano-project-myproject-monitors"}, backendRoles)
+}
+
+// TestOpenSearchRoleBindingMatchesProxyRoles tests that the backend roles of a project role binding are sent by the
+// auth proxy
+// GIVEN a user in the default admin group of a project
+// WHEN the auth proxy builds the x-proxy-roles header from the user's ID token
+// THEN the header contains the backend roles of the project admin role binding
+func TestOpenSearchRoleBindingMatchesProxyRoles(t *testing.T) {
+	assert := asserts.New(t)
+
+	// The auth proxy appends the token groups to the realm roles in the roles header
+	authProxyConfig, err := os.ReadFile(authProxyConfigMapPath)
+	assert.NoError(err)
+	getRoles := regexp.MustCompile(`(?s)function me\.getRoles\(idToken\)(.*?)\n    end\n`).FindStringSubmatch(string(authProxyConfig))
+	assert.Len(getRoles, 2)
+	assert.Contains(getRoles[1], "ipairs(id_token.payload.realm_access.roles)")
+	assert.Contains(getRoles[1], "ipairs(id_token.payload.groups)")
+	assert.Contains(getRoles[1], `return table.concat(roles, ",")`)
+
+	project := newQuotaProject()
+	r := Reconciler{}
+	adminSubjects, _ := r.getDefaultRoleBindingSubjects(*project)
+	assert.Len(adminSubjects, 1)
+
+	// The Keycloak groups mapper of the verrazzano-pkce client adds the group names without the path to the ID token
+	header := strings.Join(append([]string{"vz_api_access", "default-roles-verrazzano-system"}, adminSubjects[0].Name), ",")
+	headerRoles := strings.Split(header, ",")
+
+	spec := newOpenSearchRoleBindingSpec("vz-project-myproject-admin", adminSubjects)
+	backendRoles := spec["backendRoles"].([]interface{})
+	assert.NotEmpty(backendRoles)
+	for _, role := range backendRoles {
+		assert.Contains(headerRoles, role)
+	}
+}
+
+// getOpenSearchObject gets an OpenSearch operator object from the logging namespace
+func getOpenSearchObject(t *testing.T, c client.Client, gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	asserts.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.VerrazzanoLoggingNamespace, Name: name}, obj))
+	return obj
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlog2 "github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// syncResourceQuotas creates or updates the ResourceQuotas specified in the project, and deletes the project
+// ResourceQuotas that are no longer wanted
+func (r *Reconciler) syncResourceQuotas(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	desired := make(map[types.NamespacedName]bool)
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace {
+		for i := range project.Spec.Template.ResourceQuotas {
+			quotaTemplate := &project.Spec.Template.ResourceQuotas[i]
+			for _, ns := range getTemplateNamespaces(project, quotaTemplate.Metadata.Namespace) {
+				quota := corev1.ResourceQuota{}
+				quota.Namespace = ns
+				quota.Name = quotaTemplate.Metadata.Name
+				desired[client.ObjectKeyFromObject(&quota)] = true
+				_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &quota, func() error {
+					quota.Labels = getProjectResourceLabels(project, quotaTemplate.Metadata.Labels)
+					quota.Annotations = quotaTemplate.Metadata.Annotations
+					quotaTemplate.Spec.DeepCopyInto(&quota.Spec)
+					return nil
+				})
+				if err != nil {
+					log.Errorf("Failed to create or update ResourceQuota %s in namespace %s: %v", quota.Name, quota.Namespace, err)
+					return err
+				}
+			}
+		}
+	}
+	return r.deleteResourceQuotas(ctx, project, desired)
+}
+
+// deleteResourceQuotas deletes the project ResourceQuotas that are not in the desired set
+func (r *Reconciler) deleteResourceQuotas(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, desired map[types.NamespacedName]bool) error {
+	for _, ns := range getProjectNamespaces(project) {
+		quotas := corev1.ResourceQuotaList{}
+		if err := r.List(ctx, &quotas, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			return err
+		}
+		for i := range quotas.Items {
+			if desired[client.ObjectKeyFromObject(&quotas.Items[i])] {
+				continue
+			}
+			if err := r.Delete(ctx, &quotas.Items[i]); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// syncLimitRanges creates or updates the LimitRanges specified in the project, and deletes the project
+// LimitRanges that are no longer wanted
+func (r *Reconciler) syncLimitRanges(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	desired := make(map[types.NamespacedName]bool)
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace {
+		for i := range project.Spec.Template.LimitRanges {
+			limitTemplate := &project.Spec.Template.LimitRanges[i]
+			for _, ns := range getTemplateNamespaces(project, limitTemplate.Metadata.Namespace) {
+				limitRange := corev1.LimitRange{}
+				limitRange.Namespace = ns
+				limitRange.Name = limitTemplate.Metadata.Name
+				desired[client.ObjectKeyFromObject(&limitRange)] = true
+				_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &limitRange, func() error {
+					limitRange.Labels = getProjectResourceLabels(project, limitTemplate.Metadata.Labels)
+					limitRange.Annotations = limitTemplate.Metadata.Annotations
+					limitTemplate.Spec.DeepCopyInto(&limitRange.Spec)
+					return nil
+				})
+				if err != nil {
+					log.Errorf("Failed to create or update LimitRange %s in namespace %s: %v", limitRange.Name, limitRange.Namespace, err)
+					return err
+				}
+			}
+		}
+	}
+	return r.deleteLimitRanges(ctx, project, desired)
+}
+
+// deleteLimitRanges deletes the project LimitRanges that are not in the desired set
+func (r *Reconciler) deleteLimitRanges(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, desired map[types.NamespacedName]bool) error {
+	for _, ns := range getProjectNamespaces(project) {
+		limitRanges := corev1.LimitRangeList{}
+		if err := r.List(ctx, &limitRanges, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			return err
+		}
+		for i := range limitRanges.Items {
+			if desired[client.ObjectKeyFromObject(&limitRanges.Items[i])] {
+				continue
+			}
+			if err := r.Delete(ctx, &limitRanges.Items[i]); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getResourceUsage returns the sum of the hard limits and used resources of the project ResourceQuotas in this cluster.
+// Only the ResourceQuotas in the project namespaces are counted. Returns nil if the project has no ResourceQuotas.
+func (r *Reconciler) getResourceUsage(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject) (*clustersv1alpha1.ResourceUsage, error) {
+	var usage *clustersv1alpha1.ResourceUsage
+	for _, ns := range getProjectNamespaces(project) {
+		quotas := corev1.ResourceQuotaList{}
+		if err := r.List(ctx, &quotas, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			return nil, err
+		}
+		for _, quota := range quotas.Items {
+			if usage == nil {
+				usage = &clustersv1alpha1.ResourceUsage{Hard: corev1.ResourceList{}, Used: corev1.ResourceList{}}
+			}
+			addResources(usage.Hard, quota.Status.Hard)
+			addResources(usage.Used, quota.Status.Used)
+		}
+	}
+	return usage, nil
+}
+
+// addResources adds the quantities of the resources to the total
+func addResources(total corev1.ResourceList, resources corev1.ResourceList) {
+	for name, quantity := range resources {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+// getTemplateNamespaces returns the namespace of a template, or all the project namespaces if the template
+// does not specify a namespace. A template namespace that is not a project namespace is ignored.
+func getTemplateNamespaces(project *clustersv1alpha1.VerrazzanoProject, namespace string) []string {
+	namespaces := getProjectNamespaces(project)
+	if namespace == "" {
+		return namespaces
+	}
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return []string{namespace}
+		}
+	}
+	return nil
+}
+
+// getProjectNamespaces returns the names of the project namespaces
+func getProjectNamespaces(project *clustersv1alpha1.VerrazzanoProject) []string {
+	var namespaces []string
+	for _, ns := range project.Spec.Template.Namespaces {
+		namespaces = append(namespaces, ns.Metadata.Name)
+	}
+	return namespaces
+}
+
+// getProjectResourceLabels returns the template labels along with the labels that identify the project
+func getProjectResourceLabels(project *clustersv1alpha1.VerrazzanoProject, templateLabels map[string]string) map[string]string {
+	labels := map[string]string{}
+	for k, v := range templateLabels {
+		labels[k] = v
+	}
+	labels[projectLabel] = project.Name
+	labels[vzconst.VerrazzanoManagedLabelKey] = constants.LabelVerrazzanoManagedDefault
+	return labels
+}
+
+// mapResourceQuotaToProject maps a project ResourceQuota to the reconcile request of its project, so that the
+// resource usage of the project is updated when the quota usage changes
+func mapResourceQuotaToProject(obj client.Object) []reconcile.Request {
+	projectName, ok := obj.GetLabels()[projectLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: projectName}}}
+}
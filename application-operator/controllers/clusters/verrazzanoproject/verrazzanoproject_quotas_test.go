@@ -0,0 +1,157 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"testing"
+
+	asserts "github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestSyncResourceQuotas tests the creation of project resource quotas
+// GIVEN a project with a resource quota template without a namespace and a stale project resource quota
+// WHEN syncResourceQuotas is called
+// THEN the resource quota is created in every project namespace
+// AND the stale resource quota is deleted
+// AND a resource quota with the project label outside the project namespaces is left alone
+func TestSyncResourceQuotas(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	project.Spec.Template.ResourceQuotas = []clustersv1alpha1.ResourceQuotaTemplate{{
+		Metadata: metav1.ObjectMeta{Name: "compute", Labels: map[string]string{"tier": "gold"}},
+		Spec:     corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("4")}},
+	}}
+	stale := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "stale", Labels: map[string]string{projectLabel: project.Name}}}
+	other := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "stale", Labels: map[string]string{projectLabel: project.Name}}}
+	c := newQuotaClient(stale, other)
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncResourceQuotas(context.TODO(), project, vzlog.DefaultLogger()))
+
+	for _, ns := range []string{"ns1", "ns2"} {
+		quota := corev1.ResourceQuota{}
+		assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: "compute"}, &quota))
+		assert.Equal(project.Name, quota.Labels[projectLabel])
+		assert.Equal("gold", quota.Labels["tier"])
+		assert.True(resource.MustParse("4").Equal(quota.Spec.Hard[corev1.ResourceLimitsCPU]))
+	}
+	quotas := corev1.ResourceQuotaList{}
+	assert.NoError(c.List(context.TODO(), &quotas))
+	assert.Len(quotas.Items, 3)
+	assert.NoError(c.Get(context.TODO(), client.ObjectKeyFromObject(other), &corev1.ResourceQuota{}))
+}
+
+// TestSyncLimitRanges tests the creation of project limit ranges
+// GIVEN a project with a limit range template for one project namespace and one for another namespace
+// WHEN syncLimitRanges is called
+// THEN the limit range is only created in the project namespace
+func TestSyncLimitRanges(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	project.Spec.Template.LimitRanges = []clustersv1alpha1.LimitRangeTemplate{{
+		Metadata: metav1.ObjectMeta{Namespace: "ns2", Name: "defaults"},
+		Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+			Type:    corev1.LimitTypeContainer,
+			Default: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}}},
+	}, {
+		Metadata: metav1.ObjectMeta{Namespace: "other", Name: "defaults"},
+	}}
+	c := newQuotaClient()
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncLimitRanges(context.TODO(), project, vzlog.DefaultLogger()))
+
+	limitRanges := corev1.LimitRangeList{}
+	assert.NoError(c.List(context.TODO(), &limitRanges))
+	assert.Len(limitRanges.Items, 1)
+	assert.Equal("ns2", limitRanges.Items[0].Namespace)
+	assert.Equal(project.Name, limitRanges.Items[0].Labels[projectLabel])
+	assert.Len(limitRanges.Items[0].Spec.Limits, 1)
+}
+
+// TestGetResourceUsage tests the aggregation of project resource quota usage
+// GIVEN project resource quotas in two namespaces, a resource quota of another project and a resource quota
+// with the project label outside the project namespaces
+// WHEN getResourceUsage is called
+// THEN the hard limits and used resources of the project resource quotas in the project namespaces are summed
+func TestGetResourceUsage(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	newQuota := func(ns string, projectName string, hard string, used string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "compute", Labels: map[string]string{projectLabel: projectName}},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse(hard)},
+				Used: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse(used)},
+			},
+		}
+	}
+	c := newQuotaClient(newQuota("ns1", project.Name, "2", "500m"), newQuota("ns2", project.Name, "2", "1"), newQuota("other", "other", "8", "8"), newQuota("unrelated", project.Name, "8", "8"))
+	r := Reconciler{Client: c}
+
+	usage, err := r.getResourceUsage(context.TODO(), project)
+	assert.NoError(err)
+	assert.NotNil(usage)
+	assert.True(resource.MustParse("4").Equal(usage.Hard[corev1.ResourceLimitsCPU]))
+	assert.True(resource.MustParse("1500m").Equal(usage.Used[corev1.ResourceLimitsCPU]))
+
+	// A project without resource quotas does not report usage
+	project.Name = "no-quotas"
+	usage, err = r.getResourceUsage(context.TODO(), project)
+	assert.NoError(err)
+	assert.Nil(usage)
+}
+
+// TestMapResourceQuotaToProject tests mapping resource quotas to project reconcile requests
+// GIVEN resource quotas with and without the project label
+// WHEN mapResourceQuotaToProject is called
+// THEN a request for the project is returned only for the labeled resource quota
+func TestMapResourceQuotaToProject(t *testing.T) {
+	assert := asserts.New(t)
+
+	labeled := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "q", Labels: map[string]string{projectLabel: "myproject"}}}
+	requests := mapResourceQuotaToProject(labeled)
+	assert.Len(requests, 1)
+	assert.Equal(types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: "myproject"}, requests[0].NamespacedName)
+
+	assert.Empty(mapResourceQuotaToProject(&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "q"}}))
+}
+
+// newQuotaProject creates a project with two namespaces
+func newQuotaProject() *clustersv1alpha1.VerrazzanoProject {
+	return &clustersv1alpha1.VerrazzanoProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: "myproject"},
+		Spec: clustersv1alpha1.VerrazzanoProjectSpec{
+			Template: clustersv1alpha1.ProjectTemplate{
+				Namespaces: []clustersv1alpha1.NamespaceTemplate{
+					{Metadata: metav1.ObjectMeta{Name: "ns1"}},
+					{Metadata: metav1.ObjectMeta{Name: "ns2"}},
+				},
+			},
+		},
+	}
+}
+
+// newQuotaClient creates a fake client with the given objects
+func newQuotaClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlog2 "github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	securityv1beta1 "istio.io/api/security/v1beta1"
+	clisecurity "istio.io/client-go/pkg/apis/security/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	podSecurityAuditLabel   = "pod-security.kubernetes.io/audit"
+	podSecurityWarnLabel    = "pod-security.kubernetes.io/warn"
+)
+
+// systemNamespaces are the namespaces allowed to send requests to the project namespaces when the project
+// denies requests by default, so that the ingress gateway and Prometheus can reach the applications
+var systemNamespaces = []string{
+	vzconst.IstioSystemNamespace,
+	vzconst.VerrazzanoSystemNamespace,
+	vzconst.VerrazzanoMonitoringNamespace,
+}
+
+// setPodSecurityLabel sets a Pod Security Admission label of a namespace if the level is specified
+func setPodSecurityLabel(labels map[string]string, label string, level clustersv1alpha1.PodSecurityLevel) {
+	if level != "" {
+		labels[label] = string(level)
+	}
+}
+
+// syncIstioSecurity creates or updates the PeerAuthentication and the default deny AuthorizationPolicy in each
+// project namespace as specified by the project, and deletes the project policies that are no longer wanted
+func (r *Reconciler) syncIstioSecurity(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, log vzlog2.VerrazzanoLogger) error {
+	peerAuthNamespaces := make(map[string]bool)
+	authzPolicyNamespaces := make(map[string]bool)
+	istioSecurity := project.Spec.Template.Security.Istio
+	if project.Namespace == constants.VerrazzanoMultiClusterNamespace && istioSecurity != nil {
+		for _, ns := range project.Spec.Template.Namespaces {
+			if istioSecurity.MTLSMode != "" {
+				peerAuthNamespaces[ns.Metadata.Name] = true
+				if err := r.createOrUpdatePeerAuthentication(ctx, project, ns.Metadata.Name, log); err != nil {
+					return err
+				}
+			}
+			if istioSecurity.DefaultDeny {
+				authzPolicyNamespaces[ns.Metadata.Name] = true
+				if err := r.createOrUpdateDefaultDenyPolicy(ctx, project, ns.Metadata.Name, log); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return r.deleteIstioSecurity(ctx, project, peerAuthNamespaces, authzPolicyNamespaces)
+}
+
+// createOrUpdatePeerAuthentication creates or updates the namespace wide PeerAuthentication of a project namespace
+func (r *Reconciler) createOrUpdatePeerAuthentication(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, namespace string, log vzlog2.VerrazzanoLogger) error {
+	mode := securityv1beta1.PeerAuthentication_MutualTLS_PERMISSIVE
+	if project.Spec.Template.Security.Istio.MTLSMode == clustersv1alpha1.MTLSModeStrict {
+		mode = securityv1beta1.PeerAuthentication_MutualTLS_STRICT
+	}
+
+	peerAuth := clisecurity.PeerAuthentication{}
+	peerAuth.Namespace = namespace
+	peerAuth.Name = project.Name
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &peerAuth, func() error {
+		peerAuth.Labels = getProjectResourceLabels(project, peerAuth.Labels)
+		peerAuth.Spec = securityv1beta1.PeerAuthentication{
+			Mtls: &securityv1beta1.PeerAuthentication_MutualTLS{Mode: mode},
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to create or update PeerAuthentication %s in namespace %s: %v", peerAuth.Name, peerAuth.Namespace, err)
+		return err
+	}
+	return nil
+}
+
+// createOrUpdateDefaultDenyPolicy creates or updates the AuthorizationPolicy of a project namespace that only allows
+// requests from the project namespaces, the system namespaces and the allowed namespaces. Since Istio denies requests
+// that don't match any ALLOW policy of a workload, requests from other namespaces are denied.
+func (r *Reconciler) createOrUpdateDefaultDenyPolicy(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, namespace string, log vzlog2.VerrazzanoLogger) error {
+	var sourceNamespaces []string
+	for _, ns := range project.Spec.Template.Namespaces {
+		sourceNamespaces = append(sourceNamespaces, ns.Metadata.Name)
+	}
+	sourceNamespaces = append(sourceNamespaces, systemNamespaces...)
+	sourceNamespaces = append(sourceNamespaces, project.Spec.Template.Security.Istio.AllowedNamespaces...)
+
+	authzPolicy := clisecurity.AuthorizationPolicy{}
+	authzPolicy.Namespace = namespace
+	authzPolicy.Name = project.Name
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, &authzPolicy, func() error {
+		authzPolicy.Labels = getProjectResourceLabels(project, authzPolicy.Labels)
+		authzPolicy.Spec = securityv1beta1.AuthorizationPolicy{
+			Action: securityv1beta1.AuthorizationPolicy_ALLOW,
+			Rules: []*securityv1beta1.Rule{{
+				From: []*securityv1beta1.Rule_From{{
+					Source: &securityv1beta1.Source{Namespaces: sourceNamespaces},
+				}},
+			}},
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to create or update AuthorizationPolicy %s in namespace %s: %v", authzPolicy.Name, authzPolicy.Namespace, err)
+		return err
+	}
+	return nil
+}
+
+// deleteIstioSecurity deletes the project PeerAuthentications and AuthorizationPolicies in the project namespaces
+// that are not in the desired namespace sets
+func (r *Reconciler) deleteIstioSecurity(ctx context.Context, project *clustersv1alpha1.VerrazzanoProject, peerAuthNamespaces map[string]bool, authzPolicyNamespaces map[string]bool) error {
+	for _, ns := range getProjectNamespaces(project) {
+		peerAuths := clisecurity.PeerAuthenticationList{}
+		if err := r.List(ctx, &peerAuths, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			// The Istio CRDs are not installed when Istio is disabled
+			if meta.IsNoMatchError(err) {
+				return nil
+			}
+			return err
+		}
+		if !peerAuthNamespaces[ns] {
+			for _, peerAuth := range peerAuths.Items {
+				if err := r.Delete(ctx, peerAuth); client.IgnoreNotFound(err) != nil {
+					return err
+				}
+			}
+		}
+
+		authzPolicies := clisecurity.AuthorizationPolicyList{}
+		if err := r.List(ctx, &authzPolicies, client.InNamespace(ns), client.MatchingLabels{projectLabel: project.Name}); err != nil {
+			if meta.IsNoMatchError(err) {
+				return nil
+			}
+			return err
+		}
+		if !authzPolicyNamespaces[ns] {
+			for _, authzPolicy := range authzPolicies.Items {
+				if err := r.Delete(ctx, authzPolicy); client.IgnoreNotFound(err) != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
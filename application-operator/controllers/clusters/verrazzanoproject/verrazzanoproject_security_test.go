@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package verrazzanoproject
+
+import (
+	"context"
+	"testing"
+
+	asserts "github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	securityv1beta1 "istio.io/api/security/v1beta1"
+	clisecurity "istio.io/client-go/pkg/apis/security/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestSyncIstioSecurity tests the creation and deletion of the project Istio security policies
+// GIVEN a project with the strict mTLS mode and default deny
+// WHEN syncIstioSecurity is called
+// THEN a PeerAuthentication and an AuthorizationPolicy are created in every project namespace
+// WHEN default deny is disabled and syncIstioSecurity is called again
+// THEN the AuthorizationPolicies in the project namespaces are deleted
+// AND an AuthorizationPolicy with the project label outside the project namespaces is left alone
+func TestSyncIstioSecurity(t *testing.T) {
+	assert := asserts.New(t)
+
+	project := newQuotaProject()
+	project.Spec.Template.Security.Istio = &clustersv1alpha1.IstioSecuritySpec{
+		MTLSMode:          clustersv1alpha1.MTLSModeStrict,
+		DefaultDeny:       true,
+		AllowedNamespaces: []string{"shared"},
+	}
+	other := &clisecurity.AuthorizationPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: project.Name, Labels: map[string]string{projectLabel: project.Name}}}
+	c := newSecurityClient(other)
+	r := Reconciler{Client: c}
+
+	assert.NoError(r.syncIstioSecurity(context.TODO(), project, vzlog.DefaultLogger()))
+
+	for _, ns := range []string{"ns1", "ns2"} {
+		peerAuth := clisecurity.PeerAuthentication{}
+		assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: project.Name}, &peerAuth))
+		assert.Equal(project.Name, peerAuth.Labels[projectLabel])
+		assert.Equal(securityv1beta1.PeerAuthentication_MutualTLS_STRICT, peerAuth.Spec.Mtls.Mode)
+
+		authzPolicy := clisecurity.AuthorizationPolicy{}
+		assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: ns, Name: project.Name}, &authzPolicy))
+		assert.Equal(securityv1beta1.AuthorizationPolicy_ALLOW, authzPolicy.Spec.Action)
+		assert.Len(authzPolicy.Spec.Rules, 1)
+		sourceNamespaces := authzPolicy.Spec.Rules[0].From[0].Source.Namespaces
+		assert.Contains(sourceNamespaces, "ns1")
+		assert.Contains(sourceNamespaces, "ns2")
+		assert.Contains(sourceNamespaces, "istio-system")
+		assert.Contains(sourceNamespaces, "shared")
+	}
+
+	project.Spec.Template.Security.Istio.DefaultDeny = false
+	assert.NoError(r.syncIstioSecurity(context.TODO(), project, vzlog.DefaultLogger()))
+
+	authzPolicies := clisecurity.AuthorizationPolicyList{}
+	assert.NoError(c.List(context.TODO(), &authzPolicies))
+	assert.Len(authzPolicies.Items, 1)
+	assert.Equal("other", authzPolicies.Items[0].Namespace)
+	peerAuths := clisecurity.PeerAuthenticationList{}
+	assert.NoError(c.List(context.TODO(), &peerAuths))
+	assert.Len(peerAuths.Items, 2)
+}
+
+// TestMutateNamespacePodSecurity tests setting the Pod Security Admission labels of a project namespace
+// GIVEN a namespace with existing Pod Security Admission labels
+// WHEN mutateNamespace is called with project Pod Security levels
+// THEN the labels of the specified levels are set and the other labels are unchanged
+func TestMutateNamespacePodSecurity(t *testing.T) {
+	assert := asserts.New(t)
+
+	namespace := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "ns1",
+		Labels: map[string]string{podSecurityEnforceLabel: "privileged", podSecurityWarnLabel: "baseline"},
+	}}
+	podSecurity := &clustersv1alpha1.PodSecuritySpec{Enforce: clustersv1alpha1.PodSecurityRestricted, Audit: clustersv1alpha1.PodSecurityBaseline}
+	r := Reconciler{}
+	r.mutateNamespace(clustersv1alpha1.NamespaceTemplate{Metadata: metav1.ObjectMeta{Name: "ns1"}}, "enabled", podSecurity, &namespace)
+
+	assert.Equal("restricted", namespace.Labels[podSecurityEnforceLabel])
+	assert.Equal("baseline", namespace.Labels[podSecurityAuditLabel])
+	assert.Equal("baseline", namespace.Labels[podSecurityWarnLabel])
+}
+
+// newSecurityClient creates a fake client that knows the Istio security types
+func newSecurityClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = clisecurity.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
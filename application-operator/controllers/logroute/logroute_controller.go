@@ -0,0 +1,203 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package logroute
+
+import (
+	"context"
+	"fmt"
+
+	oamrt "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzlogInit "github.com/verrazzano/verrazzano/pkg/log"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	controllerName = "logroute"
+	finalizerName  = "logroute.finalizers.verrazzano.io"
+)
+
+// Reconciler reconciles a LogRoute object
+type Reconciler struct {
+	client.Client
+	Log    *zap.SugaredLogger
+	Scheme *k8sruntime.Scheme
+}
+
+// SetupWithManager creates the controller for the LogRoute, which also watches the credentials secrets of the
+// destinations
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vzapi.LogRoute{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.mapSecretToLogRoutes)).
+		Complete(r)
+}
+
+// mapSecretToLogRoutes maps a secret to the reconcile requests of the log routes of its namespace that use it as the
+// credentials secret of a destination, so that the copied credentials are updated when the secret changes
+func (r *Reconciler) mapSecretToLogRoutes(obj client.Object) []reconcile.Request {
+	routes := vzapi.LogRouteList{}
+	if err := r.List(context.TODO(), &routes, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Errorf("Failed to list the log routes in namespace %s: %v", obj.GetNamespace(), err)
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, route := range routes.Items {
+		for _, dest := range route.Spec.Destinations {
+			if credentialsSecret(dest) == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: route.Namespace, Name: route.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// Reconcile reconciles a LogRoute into the fluent-bit ClusterFilter and ClusterOutput resources that send the logs
+// of the LogRoute namespace to the requested destinations.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// We do not want any resource to get reconciled if it is in namespace kube-system
+	// This is due to a bug found in OKE, it should not affect functionality of any vz operators
+	// If this is the case then return success
+	if req.Namespace == vzconst.KubeSystem {
+		log := zap.S().With(vzlogInit.FieldResourceNamespace, req.Namespace, vzlogInit.FieldResourceName, req.Name, vzlogInit.FieldController, controllerName)
+		log.Infof("Log route resource %v should not be reconciled in kube-system namespace, ignoring", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	route := vzapi.LogRoute{}
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		return clusters.IgnoreNotFoundWithLog(err, zap.S())
+	}
+	log, err := clusters.GetResourceLogger(controllerName, req.NamespacedName, &route)
+	if err != nil {
+		zap.S().Errorf("Failed to create controller logger for log route resource: %v", err)
+		return clusters.NewRequeueWithDelay(), nil
+	}
+	log.Oncef("Reconciling log route resource %v, generation %v", req.NamespacedName, route.Generation)
+
+	res, err := r.doReconcile(ctx, &route, log)
+	if clusters.ShouldRequeue(res) {
+		return res, nil
+	}
+	// Never return an error since it has already been logged and we don't want the
+	// controller runtime to log again (with stack trace).  Just re-queue if there is an error.
+	if err != nil {
+		return clusters.NewRequeueWithDelay(), nil
+	}
+	log.Oncef("Finished reconciling log route %v", req.NamespacedName)
+
+	return ctrl.Result{}, nil
+}
+
+// doReconcile performs the reconciliation operations for the log route
+func (r *Reconciler) doReconcile(ctx context.Context, route *vzapi.LogRoute, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if !route.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, route, log)
+	}
+
+	if !controllerutil.ContainsFinalizer(route, finalizerName) {
+		controllerutil.AddFinalizer(route, finalizerName)
+		if err := r.Update(ctx, route); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	outputs, err := r.reconcileRoute(ctx, route, log)
+	if statusErr := r.updateStatus(ctx, route, outputs, err); statusErr != nil {
+		return ctrl.Result{}, statusErr
+	}
+	return ctrl.Result{}, err
+}
+
+// reconcileRoute validates the log route, then creates or updates the fluent-bit resources and the secrets they
+// reference. Resources that belong to the route but are no longer wanted are deleted.
+func (r *Reconciler) reconcileRoute(ctx context.Context, route *vzapi.LogRoute, log vzlog.VerrazzanoLogger) ([]string, error) {
+	if err := validateLogRoute(route); err != nil {
+		log.Errorf("Invalid log route %s/%s: %v", route.Namespace, route.Name, err)
+		return nil, err
+	}
+
+	if err := r.createOrUpdateSecrets(ctx, route, log); err != nil {
+		return nil, err
+	}
+	if err := r.createOrUpdateClusterFilter(ctx, route); err != nil {
+		return nil, log.ErrorfNewErr("Failed to create or update the fluent-bit ClusterFilter for log route %s/%s: %v", route.Namespace, route.Name, err)
+	}
+
+	var outputs []string
+	for i := range route.Spec.Destinations {
+		name, err := r.createOrUpdateClusterOutput(ctx, route, &route.Spec.Destinations[i])
+		if err != nil {
+			return outputs, log.ErrorfNewErr("Failed to create or update the fluent-bit ClusterOutput for destination %s of log route %s/%s: %v",
+				route.Spec.Destinations[i].Name, route.Namespace, route.Name, err)
+		}
+		outputs = append(outputs, name)
+	}
+
+	if err := r.deleteStaleResources(ctx, route, outputs, log); err != nil {
+		return outputs, err
+	}
+	return outputs, nil
+}
+
+// reconcileDelete deletes the resources generated for the log route and removes the finalizer
+func (r *Reconciler) reconcileDelete(ctx context.Context, route *vzapi.LogRoute, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(route, finalizerName) {
+		return ctrl.Result{}, nil
+	}
+	log.Debugf("Deleting the fluent-bit resources of log route %s/%s", route.Namespace, route.Name)
+	if err := r.deleteStaleResources(ctx, route, nil, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.deleteAllOf(ctx, &fluentbitv1alpha2.ClusterFilter{}, route); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.syncS3Credentials(ctx, log); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(route, finalizerName)
+	if err := r.Update(ctx, route); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// updateStatus records the reconcile outcome and the generated outputs in the log route status
+func (r *Reconciler) updateStatus(ctx context.Context, route *vzapi.LogRoute, outputs []string, err error) error {
+	status := vzapi.LogRouteStatus{Outputs: outputs}
+	if err != nil {
+		status.SetConditions(oamrt.ReconcileError(err))
+	} else {
+		status.SetConditions(oamrt.ReconcileSuccess())
+	}
+	// Keep the existing condition transition time when nothing changed to avoid needless status updates
+	if equality.Semantic.DeepEqual(route.Status.Outputs, status.Outputs) &&
+		route.Status.GetCondition(oamrt.TypeSynced).Equal(status.GetCondition(oamrt.TypeSynced)) {
+		return nil
+	}
+	route.Status = status
+	if err := r.Status().Update(ctx, route); err != nil {
+		return fmt.Errorf("failed to update the status of log route %s/%s: %v", route.Namespace, route.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package logroute
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	oamrt "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	asserts "github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace = "test-ns"
+	testRouteName = "test-route"
+)
+
+// TestReconcileCreatesResources tests reconciling a log route with all destination types
+// GIVEN a log route with OpenSearch, Loki and S3 destinations
+// WHEN the log route is reconciled
+// THEN the ClusterFilter, the ClusterOutputs and the credentials secrets are created and the status is updated
+func TestReconcileCreatesResources(t *testing.T) {
+	assert := asserts.New(t)
+
+	route := newLogRoute(
+		vzapi.LogDestination{Name: "os", OpenSearch: &vzapi.OpenSearchLogDestination{URL: "https://opensearch.example.com:9200", CredentialsSecret: "os-creds"}},
+		vzapi.LogDestination{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki.example.com", CredentialsSecret: "loki-creds"}},
+		vzapi.LogDestination{Name: "archive", S3: &vzapi.S3LogDestination{Bucket: "logs", Region: "us-ashburn-1", CredentialsSecret: "s3-creds"}},
+	)
+	cli := newFakeClient(route,
+		newSecret(testNamespace, "os-creds", map[string]string{usernameKey: "osuser", passwordKey: "ospass"}),
+		newSecret(testNamespace, "loki-creds", map[string]string{usernameKey: "lokiuser", passwordKey: "lokipass", tenantIDKey: "tenant"}),
+		newSecret(testNamespace, "s3-creds", map[string]string{accessKeyIDKey: "key", secretAccessKeyKey: "secret"}),
+	)
+
+	res, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.Equal(ctrl.Result{}, res)
+
+	filter := fluentbitv1alpha2.ClusterFilter{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "vzlogroute-test-ns.test-route"}, &filter))
+	assert.Equal("kube.*", filter.Spec.Match)
+	assert.Len(filter.Spec.FilterItems, 1)
+	assert.Equal([]string{"$kubernetes['namespace_name'] ^test-ns$ vzlogroute.test-ns.test-route true"}, filter.Spec.FilterItems[0].RewriteTag.Rules)
+
+	osOutput := fluentbitv1alpha2.ClusterOutput{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "vzlogroute-test-ns.test-route.os"}, &osOutput))
+	assert.Equal("vzlogroute.test-ns.test-route", osOutput.Spec.Match)
+	assert.NotNil(osOutput.Spec.OpenSearch)
+	assert.Equal("opensearch.example.com", osOutput.Spec.OpenSearch.Host)
+	assert.Equal("verrazzano-logroute-test-ns", osOutput.Spec.OpenSearch.Index)
+	assert.NotNil(osOutput.Spec.OpenSearch.TLS)
+
+	lokiOutput := fluentbitv1alpha2.ClusterOutput{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "vzlogroute-test-ns.test-route.loki"}, &lokiOutput))
+	assert.NotNil(lokiOutput.Spec.Loki)
+	assert.Equal(int32(defaultLokiPort), *lokiOutput.Spec.Loki.Port)
+
+	s3Output := fluentbitv1alpha2.ClusterOutput{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Name: "vzlogroute-test-ns.test-route.archive"}, &s3Output))
+	assert.NotNil(s3Output.Spec.CustomPlugin)
+	assert.Contains(s3Output.Spec.CustomPlugin.Config, "bucket logs")
+
+	lokiSecret := corev1.Secret{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: "vzlogroute-test-ns.test-route.loki"}, &lokiSecret))
+	assert.Equal("tenant", string(lokiSecret.Data[tenantIDKey]))
+
+	s3Secret := corev1.Secret{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: S3CredentialsSecretName}, &s3Secret))
+	assert.True(strings.HasPrefix(string(s3Secret.Data[S3CredentialsKey]), "[vzlogroute-test-ns.test-route.archive]\naws_access_key_id = key\n"))
+
+	updated := vzapi.LogRoute{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testRouteName}, &updated))
+	assert.Contains(updated.Finalizers, finalizerName)
+	assert.Len(updated.Status.Outputs, 3)
+	assert.Equal(corev1.ConditionTrue, updated.Status.GetCondition(oamrt.TypeSynced).Status)
+}
+
+// TestReconcileRemovesStaleOutputs tests reconciling a log route after a destination was removed
+// GIVEN a log route with one destination, and an existing ClusterOutput and S3 profile for a removed S3 destination
+// WHEN the log route is reconciled
+// THEN the ClusterOutput and the S3 profile of the removed destination are deleted
+func TestReconcileRemovesStaleOutputs(t *testing.T) {
+	assert := asserts.New(t)
+
+	route := newLogRoute(vzapi.LogDestination{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki.example.com"}})
+	stale := &fluentbitv1alpha2.ClusterOutput{ObjectMeta: metav1.ObjectMeta{Name: "vzlogroute-test-ns.test-route.old"}}
+	setLabels(&stale.ObjectMeta, route)
+	s3Secret := newSecret(constants.VerrazzanoSystemNamespace, S3CredentialsSecretName,
+		map[string]string{S3CredentialsKey: "[vzlogroute-test-ns.test-route.old]\naws_access_key_id = key\n"})
+	cli := newFakeClient(route, stale, s3Secret)
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+
+	outputs := fluentbitv1alpha2.ClusterOutputList{}
+	assert.NoError(cli.List(context.TODO(), &outputs))
+	assert.Len(outputs.Items, 1)
+	assert.Equal("vzlogroute-test-ns.test-route.loki", outputs.Items[0].Name)
+
+	assert.NoError(cli.Get(context.TODO(), client.ObjectKeyFromObject(s3Secret), s3Secret))
+	assert.Empty(s3Secret.Data[S3CredentialsKey])
+}
+
+// TestMapSecretToLogRoutes tests the mapping of a credentials secret to the log routes that use it
+// GIVEN log routes in the secret namespace and in another namespace
+// WHEN mapSecretToLogRoutes is called
+// THEN only the log routes of the secret namespace with a destination using the secret are returned
+func TestMapSecretToLogRoutes(t *testing.T) {
+	assert := asserts.New(t)
+
+	route := newLogRoute(
+		vzapi.LogDestination{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki.example.com"}},
+		vzapi.LogDestination{Name: "archive", S3: &vzapi.S3LogDestination{Bucket: "logs", Region: "us-ashburn-1", CredentialsSecret: "s3-creds"}},
+	)
+	other := newLogRoute(vzapi.LogDestination{Name: "archive", S3: &vzapi.S3LogDestination{Bucket: "logs", Region: "us-ashburn-1", CredentialsSecret: "s3-creds"}})
+	other.Namespace = "other"
+	unrelated := newLogRoute(vzapi.LogDestination{Name: "os", OpenSearch: &vzapi.OpenSearchLogDestination{URL: "https://opensearch.example.com:9200", CredentialsSecret: "os-creds"}})
+	unrelated.Name = "unrelated"
+	r := newReconciler(newFakeClient(route, other, unrelated))
+
+	requests := r.mapSecretToLogRoutes(newSecret(testNamespace, "s3-creds", nil))
+	assert.Len(requests, 1)
+	assert.Equal(newRequest().NamespacedName, requests[0].NamespacedName)
+	assert.Empty(r.mapSecretToLogRoutes(newSecret(testNamespace, "loki-creds", nil)))
+}
+
+// TestReconcileInvalidRoute tests reconciling an invalid log route
+// GIVEN a log route with a destination that specifies two targets
+// WHEN the log route is reconciled
+// THEN no fluent-bit resources are created and the status reports the error
+func TestReconcileInvalidRoute(t *testing.T) {
+	assert := asserts.New(t)
+
+	route := newLogRoute(vzapi.LogDestination{
+		Name:       "both",
+		OpenSearch: &vzapi.OpenSearchLogDestination{URL: "https://opensearch.example.com:9200"},
+		Loki:       &vzapi.LokiLogDestination{Host: "loki.example.com"},
+	})
+	cli := newFakeClient(route)
+
+	res, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+	assert.True(res.Requeue || res.RequeueAfter > 0)
+
+	outputs := fluentbitv1alpha2.ClusterOutputList{}
+	assert.NoError(cli.List(context.TODO(), &outputs))
+	assert.Empty(outputs.Items)
+
+	updated := vzapi.LogRoute{}
+	assert.NoError(cli.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testRouteName}, &updated))
+	assert.Equal(corev1.ConditionFalse, updated.Status.GetCondition(oamrt.TypeSynced).Status)
+}
+
+// TestReconcileDelete tests reconciling a log route that is being deleted
+// GIVEN a log route with a deletion timestamp and generated resources
+// WHEN the log route is reconciled
+// THEN the generated resources are deleted and the finalizer is removed
+func TestReconcileDelete(t *testing.T) {
+	assert := asserts.New(t)
+
+	route := newLogRoute(vzapi.LogDestination{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki.example.com"}})
+	route.Finalizers = []string{finalizerName}
+	route.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	filter := &fluentbitv1alpha2.ClusterFilter{ObjectMeta: metav1.ObjectMeta{Name: "vzlogroute-test-ns.test-route"}}
+	setLabels(&filter.ObjectMeta, route)
+	output := &fluentbitv1alpha2.ClusterOutput{ObjectMeta: metav1.ObjectMeta{Name: "vzlogroute-test-ns.test-route.loki"}}
+	setLabels(&output.ObjectMeta, route)
+	cli := newFakeClient(route, filter, output)
+
+	_, err := newReconciler(cli).Reconcile(context.TODO(), newRequest())
+	assert.NoError(err)
+
+	filters := fluentbitv1alpha2.ClusterFilterList{}
+	assert.NoError(cli.List(context.TODO(), &filters))
+	assert.Empty(filters.Items)
+	outputs := fluentbitv1alpha2.ClusterOutputList{}
+	assert.NoError(cli.List(context.TODO(), &outputs))
+	assert.Empty(outputs.Items)
+
+	// the log route is gone once the finalizer is removed
+	err = cli.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testRouteName}, &vzapi.LogRoute{})
+	assert.True(errors.IsNotFound(err))
+}
+
+// TestValidateLogRoute tests the validation of log routes
+func TestValidateLogRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		dests   []vzapi.LogDestination
+		wantErr bool
+	}{
+		{"valid", []vzapi.LogDestination{{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki"}}}, false},
+		{"no target", []vzapi.LogDestination{{Name: "none"}}, true},
+		{"invalid name", []vzapi.LogDestination{{Name: "Not_Valid", Loki: &vzapi.LokiLogDestination{Host: "loki"}}}, true},
+		{"duplicate name", []vzapi.LogDestination{
+			{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki"}},
+			{Name: "loki", Loki: &vzapi.LokiLogDestination{Host: "loki2"}}}, true},
+		{"invalid url", []vzapi.LogDestination{{Name: "os", OpenSearch: &vzapi.OpenSearchLogDestination{URL: "opensearch:9200"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLogRoute(newLogRoute(tt.dests...))
+			asserts.Equal(t, tt.wantErr, err != nil, "unexpected validation result: %v", err)
+		})
+	}
+}
+
+// newLogRoute creates a log route with the given destinations
+func newLogRoute(dests ...vzapi.LogDestination) *vzapi.LogRoute {
+	return &vzapi.LogRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testRouteName, UID: "test-uid"},
+		Spec:       vzapi.LogRouteSpec{Destinations: dests},
+	}
+}
+
+// newSecret creates a secret with the given string data
+func newSecret(namespace, name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Data: map[string][]byte{}}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+// newFakeClient creates a fake client that knows the log route and fluent-bit types
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := k8sruntime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	_ = fluentbitv1alpha2.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// newReconciler creates a log route reconciler for testing
+func newReconciler(c client.Client) *Reconciler {
+	return &Reconciler{
+		Client: c,
+		Log:    zap.S().With("test"),
+		Scheme: c.Scheme(),
+	}
+}
+
+// newRequest creates a reconcile request for the test log route
+func newRequest() ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testRouteName}}
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package logroute
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/custom"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
+	"github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/output"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// resourcePrefix is the name prefix of the generated resources. It sorts after the names of the Verrazzano
+	// fluent-bit filters, so the Kubernetes metadata is present when the logs are re-tagged.
+	resourcePrefix = "vzlogroute-"
+	// tagPrefix is the fluent-bit tag prefix of the re-tagged logs. The default Verrazzano outputs exclude it.
+	tagPrefix = "vzlogroute."
+
+	fluentbitEnabledLabel = "fluentbit.fluent.io/enabled"
+	routeNamespaceLabel   = "logroute.verrazzano.io/namespace"
+	routeUIDLabel         = "logroute.verrazzano.io/uid"
+
+	// S3CredentialsSecretName is the secret that contains the AWS shared credentials file used by fluent-bit,
+	// with one profile for each S3 destination. The fluent-bit DaemonSet mounts this secret.
+	S3CredentialsSecretName = "verrazzano-logroute-s3-credentials" //nolint:gosec //#gosec G101
+	S3CredentialsKey        = "credentials"
+
+	usernameKey        = "username"
+	passwordKey        = "password"
+	tenantIDKey        = "tenantID"
+	accessKeyIDKey     = "access_key_id"
+	secretAccessKeyKey = "secret_access_key"
+
+	defaultLokiPort    = 3100
+	defaultS3KeyFormat = "/%s/%%Y/%%m/%%d/%%H/%%M/%%S/$UUID.gz"
+)
+
+// validateLogRoute checks that each destination specifies exactly one target and that the generated resource
+// names are valid
+func validateLogRoute(route *vzapi.LogRoute) error {
+	if len(route.Spec.Destinations) == 0 {
+		return fmt.Errorf("at least one destination must be specified")
+	}
+	names := map[string]bool{}
+	for _, dest := range route.Spec.Destinations {
+		if errs := validation.IsDNS1123Label(dest.Name); len(errs) > 0 {
+			return fmt.Errorf("destination name %q is invalid: %s", dest.Name, strings.Join(errs, ", "))
+		}
+		if names[dest.Name] {
+			return fmt.Errorf("destination name %q is not unique", dest.Name)
+		}
+		names[dest.Name] = true
+		if errs := validation.IsDNS1123Subdomain(destinationResourceName(route, dest.Name)); len(errs) > 0 {
+			return fmt.Errorf("the generated resource name for destination %q is invalid: %s", dest.Name, strings.Join(errs, ", "))
+		}
+
+		count := 0
+		if dest.OpenSearch != nil {
+			count++
+			if err := validateURL(dest.OpenSearch.URL); err != nil {
+				return fmt.Errorf("destination %q has an invalid OpenSearch URL: %v", dest.Name, err)
+			}
+		}
+		if dest.S3 != nil {
+			count++
+			if dest.S3.Bucket == "" || dest.S3.Region == "" || dest.S3.CredentialsSecret == "" {
+				return fmt.Errorf("destination %q must specify the S3 bucket, region and credentials secret", dest.Name)
+			}
+			if dest.S3.Endpoint != "" {
+				if err := validateURL(dest.S3.Endpoint); err != nil {
+					return fmt.Errorf("destination %q has an invalid S3 endpoint: %v", dest.Name, err)
+				}
+			}
+		}
+		if dest.Loki != nil {
+			count++
+			if dest.Loki.Host == "" {
+				return fmt.Errorf("destination %q must specify the Loki host", dest.Name)
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("destination %q must specify exactly one of opensearch, s3 or loki", dest.Name)
+		}
+	}
+	return nil
+}
+
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if parsed.Hostname() == "" {
+		return fmt.Errorf("host must be specified")
+	}
+	return nil
+}
+
+// routeResourceName returns the name of the ClusterFilter of a log route. Namespaces cannot contain dots and
+// destination names are DNS labels, so names generated for different log routes never collide.
+func routeResourceName(route *vzapi.LogRoute) string {
+	return fmt.Sprintf("%s%s.%s", resourcePrefix, route.Namespace, route.Name)
+}
+
+// destinationResourceName returns the name of the ClusterOutput and credentials secret of a destination
+func destinationResourceName(route *vzapi.LogRoute, destination string) string {
+	return fmt.Sprintf("%s.%s", routeResourceName(route), destination)
+}
+
+// routeTag returns the fluent-bit tag used for the logs of a log route
+func routeTag(route *vzapi.LogRoute) string {
+	return fmt.Sprintf("%s%s.%s", tagPrefix, route.Namespace, route.Name)
+}
+
+// routeLabels returns the labels that identify the resources generated for a log route
+func routeLabels(route *vzapi.LogRoute) map[string]string {
+	return map[string]string{
+		routeNamespaceLabel: route.Namespace,
+		routeUIDLabel:       string(route.UID),
+	}
+}
+
+// createOrUpdateClusterFilter creates a rewrite_tag filter that copies the logs of the log route namespace to the
+// log route tag. Only records whose Kubernetes namespace matches the log route namespace are copied, so a log route
+// never receives the logs of another namespace.
+func (r *Reconciler) createOrUpdateClusterFilter(ctx context.Context, route *vzapi.LogRoute) error {
+	clusterFilter := &fluentbitv1alpha2.ClusterFilter{ObjectMeta: metav1.ObjectMeta{Name: routeResourceName(route)}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, clusterFilter, func() error {
+		setLabels(&clusterFilter.ObjectMeta, route)
+		clusterFilter.Spec = fluentbitv1alpha2.FilterSpec{
+			Match: "kube.*",
+			FilterItems: []fluentbitv1alpha2.FilterItem{{
+				RewriteTag: &filter.RewriteTag{
+					Rules:       []string{fmt.Sprintf("$kubernetes['namespace_name'] ^%s$ %s true", regexp.QuoteMeta(route.Namespace), routeTag(route))},
+					EmitterName: strings.ReplaceAll(routeResourceName(route), ".", "_"),
+				},
+			}},
+		}
+		return nil
+	})
+	return err
+}
+
+// createOrUpdateClusterOutput creates or updates the ClusterOutput of a destination and returns its name
+func (r *Reconciler) createOrUpdateClusterOutput(ctx context.Context, route *vzapi.LogRoute, dest *vzapi.LogDestination) (string, error) {
+	name := destinationResourceName(route, dest.Name)
+	clusterOutput := &fluentbitv1alpha2.ClusterOutput{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, clusterOutput, func() error {
+		setLabels(&clusterOutput.ObjectMeta, route)
+		spec, err := buildOutputSpec(route, dest)
+		if err != nil {
+			return err
+		}
+		clusterOutput.Spec = *spec
+		return nil
+	})
+	return name, err
+}
+
+// buildOutputSpec builds the ClusterOutput spec of a destination. Credentials are referenced from the secrets
+// copied into the fluent-bit namespace.
+func buildOutputSpec(route *vzapi.LogRoute, dest *vzapi.LogDestination) (*fluentbitv1alpha2.OutputSpec, error) {
+	secretName := destinationResourceName(route, dest.Name)
+	spec := &fluentbitv1alpha2.OutputSpec{
+		Match:      routeTag(route),
+		Alias:      strings.ReplaceAll(secretName, ".", "_"),
+		RetryLimit: "no_limits",
+	}
+	switch {
+	case dest.OpenSearch != nil:
+		opensearch, err := buildOpenSearchOutput(route, dest.OpenSearch, secretName)
+		if err != nil {
+			return nil, err
+		}
+		spec.OpenSearch = opensearch
+	case dest.Loki != nil:
+		spec.Loki = buildLokiOutput(dest.Loki, secretName)
+	case dest.S3 != nil:
+		spec.CustomPlugin = buildS3Output(route, dest.S3, secretName)
+	}
+	return spec, nil
+}
+
+func buildOpenSearchOutput(route *vzapi.LogRoute, dest *vzapi.OpenSearchLogDestination, secretName string) (*output.OpenSearch, error) {
+	parsed, err := url.Parse(dest.URL)
+	if err != nil {
+		return nil, err
+	}
+	trueValue := true
+	os := &output.OpenSearch{
+		Host:             parsed.Hostname(),
+		Path:             strings.TrimSuffix(parsed.Path, "/"),
+		SuppressTypeName: &trueValue,
+		ReplaceDots:      &trueValue,
+	}
+	port := int32(9200)
+	if parsed.Port() != "" {
+		p, err := strconv.ParseInt(parsed.Port(), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		port = int32(p)
+	}
+	os.Port = &port
+	if parsed.Scheme == "https" {
+		os.TLS = &plugins.TLS{Verify: &trueValue}
+	}
+	index := dest.Index
+	if index == "" {
+		index = fmt.Sprintf("verrazzano-logroute-%s", route.Namespace)
+	}
+	if dest.LogstashFormat != nil && *dest.LogstashFormat {
+		os.LogstashFormat = &trueValue
+		os.LogstashPrefix = index
+	} else {
+		os.Index = index
+	}
+	if dest.CredentialsSecret != "" {
+		os.HTTPUser = secretRef(secretName, usernameKey)
+		os.HTTPPasswd = secretRef(secretName, passwordKey)
+	}
+	return os, nil
+}
+
+func buildLokiOutput(dest *vzapi.LokiLogDestination, secretName string) *output.Loki {
+	port := int32(defaultLokiPort)
+	if dest.Port != nil {
+		port = *dest.Port
+	}
+	loki := &output.Loki{
+		Host:   dest.Host,
+		Port:   &port,
+		Labels: append([]string{"job=fluent-bit"}, dest.Labels...),
+		LabelKeys: []string{
+			"$kubernetes['namespace_name']",
+			"$kubernetes['pod_name']",
+			"$kubernetes['container_name']",
+		},
+		LineFormat: "json",
+	}
+	if dest.TLS {
+		trueValue := true
+		loki.TLS = &plugins.TLS{Verify: &trueValue}
+	}
+	if dest.CredentialsSecret != "" {
+		loki.HTTPUser = secretRef(secretName, usernameKey)
+		loki.HTTPPasswd = secretRef(secretName, passwordKey)
+	}
+	return loki
+}
+
+// buildS3Output builds the configuration of the fluent-bit s3 output. The fluent-operator API does not provide the
+// s3 output, so it is rendered as a custom plugin. The s3 output cannot read credentials from the configuration,
+// it uses the profile of the destination in the shared credentials file.
+func buildS3Output(route *vzapi.LogRoute, dest *vzapi.S3LogDestination, profile string) *custom.CustomPlugin {
+	keyFormat := dest.KeyFormat
+	if keyFormat == "" {
+		keyFormat = fmt.Sprintf(defaultS3KeyFormat, route.Namespace)
+	}
+	lines := []string{
+		"Name s3",
+		"bucket " + dest.Bucket,
+		"region " + dest.Region,
+		"profile " + profile,
+		"s3_key_format " + keyFormat,
+		"compression gzip",
+		"use_put_object On",
+		"total_file_size 50M",
+		"upload_timeout 10m",
+	}
+	if dest.Endpoint != "" {
+		lines = append(lines, "endpoint "+dest.Endpoint)
+	}
+	return &custom.CustomPlugin{Config: strings.Join(lines, "\n")}
+}
+
+func secretRef(name string, key string) *plugins.Secret {
+	return &plugins.Secret{
+		ValueFrom: plugins.ValueSource{
+			SecretKeyRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// credentialsSecret returns the name of the credentials secret of the destination, or an empty string if the
+// destination has no credentials
+func credentialsSecret(dest vzapi.LogDestination) string {
+	switch {
+	case dest.OpenSearch != nil:
+		return dest.OpenSearch.CredentialsSecret
+	case dest.Loki != nil:
+		return dest.Loki.CredentialsSecret
+	case dest.S3 != nil:
+		return dest.S3.CredentialsSecret
+	}
+	return ""
+}
+
+func setLabels(meta *metav1.ObjectMeta, route *vzapi.LogRoute) {
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	for k, v := range routeLabels(route) {
+		meta.Labels[k] = v
+	}
+	meta.Labels[fluentbitEnabledLabel] = "true"
+}
+
+// createOrUpdateSecrets copies the OpenSearch and Loki credentials of the log route into the fluent-bit namespace,
+// because fluent-bit can only read secrets from its own namespace. Credentials are only ever read from the log route
+// namespace. The S3 shared credentials file is always rebuilt from all log routes, so that it follows the S3
+// destinations that were removed from the log route.
+func (r *Reconciler) createOrUpdateSecrets(ctx context.Context, route *vzapi.LogRoute, log vzlog.VerrazzanoLogger) error {
+	for _, dest := range route.Spec.Destinations {
+		var secretName string
+		keys := []string{usernameKey, passwordKey}
+		switch {
+		case dest.OpenSearch != nil:
+			secretName = dest.OpenSearch.CredentialsSecret
+		case dest.Loki != nil:
+			secretName = dest.Loki.CredentialsSecret
+			keys = append(keys, tenantIDKey)
+		}
+		if secretName == "" {
+			continue
+		}
+		source := corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: route.Namespace, Name: secretName}, &source); err != nil {
+			return log.ErrorfNewErr("Failed to get the credentials secret %s/%s of destination %s: %v", route.Namespace, secretName, dest.Name, err)
+		}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoSystemNamespace, Name: destinationResourceName(route, dest.Name)}}
+		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+			setLabels(&secret.ObjectMeta, route)
+			delete(secret.Labels, fluentbitEnabledLabel)
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = map[string][]byte{}
+			for _, key := range keys {
+				if val, ok := source.Data[key]; ok {
+					secret.Data[key] = val
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return log.ErrorfNewErr("Failed to create or update the credentials secret of destination %s: %v", dest.Name, err)
+		}
+	}
+	return r.syncS3Credentials(ctx, log)
+}
+
+// syncS3Credentials rebuilds the shared credentials file with a profile for every S3 destination of every log route
+// that is not being deleted
+func (r *Reconciler) syncS3Credentials(ctx context.Context, log vzlog.VerrazzanoLogger) error {
+	routes := vzapi.LogRouteList{}
+	if err := r.List(ctx, &routes); err != nil {
+		return err
+	}
+	var profiles []string
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if !route.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, dest := range route.Spec.Destinations {
+			if dest.S3 == nil {
+				continue
+			}
+			source := corev1.Secret{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: route.Namespace, Name: dest.S3.CredentialsSecret}, &source); err != nil {
+				if errors.IsNotFound(err) {
+					log.Progressf("Waiting for the S3 credentials secret %s/%s of destination %s to exist", route.Namespace, dest.S3.CredentialsSecret, dest.Name)
+					continue
+				}
+				return err
+			}
+			profiles = append(profiles, fmt.Sprintf("[%s]\naws_access_key_id = %s\naws_secret_access_key = %s\n",
+				destinationResourceName(route, dest.Name), strings.TrimSpace(string(source.Data[accessKeyIDKey])),
+				strings.TrimSpace(string(source.Data[secretAccessKeyKey]))))
+		}
+	}
+	sort.Strings(profiles)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoSystemNamespace, Name: S3CredentialsSecretName}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{S3CredentialsKey: []byte(strings.Join(profiles, "\n"))}
+		return nil
+	})
+	if err != nil {
+		return log.ErrorfNewErr("Failed to create or update the S3 credentials secret %s: %v", S3CredentialsSecretName, err)
+	}
+	return nil
+}
+
+// deleteStaleResources deletes the ClusterOutputs and secrets of the log route that are not in the list of outputs
+func (r *Reconciler) deleteStaleResources(ctx context.Context, route *vzapi.LogRoute, outputs []string, log vzlog.VerrazzanoLogger) error {
+	keep := map[string]bool{}
+	for _, name := range outputs {
+		keep[name] = true
+	}
+
+	clusterOutputs := fluentbitv1alpha2.ClusterOutputList{}
+	if err := r.List(ctx, &clusterOutputs, client.MatchingLabels(routeLabels(route))); err != nil {
+		return err
+	}
+	for i := range clusterOutputs.Items {
+		if keep[clusterOutputs.Items[i].Name] {
+			continue
+		}
+		log.Debugf("Deleting ClusterOutput %s of log route %s/%s", clusterOutputs.Items[i].Name, route.Namespace, route.Name)
+		if err := r.Delete(ctx, &clusterOutputs.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	secrets := corev1.SecretList{}
+	if err := r.List(ctx, &secrets, client.InNamespace(constants.VerrazzanoSystemNamespace), client.MatchingLabels(routeLabels(route))); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		if keep[secrets.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &secrets.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAllOf deletes the cluster-scoped resources of the given type that were generated for the log route
+func (r *Reconciler) deleteAllOf(ctx context.Context, obj client.Object, route *vzapi.LogRoute) error {
+	return client.IgnoreNotFound(r.DeleteAllOf(ctx, obj, client.MatchingLabels(routeLabels(route))))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricsbinding
+
+import (
+	"context"
+	"fmt"
+
+	promoperapi "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	vztemplate "github.com/verrazzano/verrazzano/application-operator/controllers/template"
+	"github.com/verrazzano/verrazzano/application-operator/internal/metrics"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+// handleMonitorMetricsTemplate handles metrics bindings whose metrics template specifies a monitor configuration,
+// by creating or updating a ServiceMonitor or PodMonitor for the workload. Bindings that were previously using the
+// Prometheus configuration are migrated: the scrape job of the binding is removed from the Prometheus ConfigMap or
+// Secret and the binding no longer references them.
+func (r *Reconciler) handleMonitorMetricsTemplate(ctx context.Context, metricsBinding *vzapi.MetricsBinding, template *vzapi.MetricsTemplate, log vzlog.VerrazzanoLogger) error {
+	log.Debugf("Monitor metrics template used by metrics binding %s/%s, creating %s", metricsBinding.Namespace, metricsBinding.Name, getMonitorKind(template))
+
+	var workloadNamespaceUnstructured *unstructured.Unstructured
+	var err error
+	if workloadNamespaceUnstructured, err = r.createWorkloadNamespaceUnstructured(metricsBinding, log); err != nil {
+		return err
+	}
+	var workloadObject *unstructured.Unstructured
+	if workloadObject, err = r.getWorkloadObject(metricsBinding); err != nil {
+		return log.ErrorfNewErr("Failed to get the workload object for metrics binding %s: %v", metricsBinding.GetName(), err)
+	}
+
+	if err = r.createOrUpdateMonitor(ctx, metricsBinding, template, workloadObject, workloadNamespaceUnstructured, log); err != nil {
+		return err
+	}
+	return r.migrateLegacyScrapeConfig(ctx, metricsBinding, log)
+}
+
+// createOrUpdateMonitor renders the monitor spec template and creates or updates the ServiceMonitor or PodMonitor
+// of the metrics binding. A monitor of the other kind, left over from a change of the template kind, is deleted.
+func (r *Reconciler) createOrUpdateMonitor(ctx context.Context, metricsBinding *vzapi.MetricsBinding, template *vzapi.MetricsTemplate,
+	workloadObject *unstructured.Unstructured, workloadNamespaceUnstructured *unstructured.Unstructured, log vzlog.VerrazzanoLogger) error {
+	templateInputs := map[string]interface{}{
+		"workload":  workloadObject.Object,
+		"namespace": workloadNamespaceUnstructured.Object,
+	}
+	templateProcessor := vztemplate.NewProcessor(r.Client, template.Spec.MonitorConfig.MonitorSpecTemplate)
+	specString, err := templateProcessor.Process(templateInputs)
+	if err != nil {
+		return log.ErrorfNewErr("Failed to process metrics template %s: %v", template.GetName(), err)
+	}
+
+	clusterName := clusters.GetClusterName(ctx, r.Client)
+	workloadLabel := workloadObject.GetLabels()[constants.MetricsWorkloadLabel]
+
+	var monitor, obsolete k8sclient.Object
+	var mutate func() error
+	switch getMonitorKind(template) {
+	case vzapi.ServiceMonitorKind:
+		serviceMonitor := &promoperapi.ServiceMonitor{}
+		monitor, obsolete = serviceMonitor, &promoperapi.PodMonitor{}
+		mutate = func() error {
+			spec := promoperapi.ServiceMonitorSpec{}
+			if err := yaml.UnmarshalStrict([]byte(specString), &spec); err != nil {
+				return fmt.Errorf("failed to parse the ServiceMonitor spec of metrics template %s: %v", template.GetName(), err)
+			}
+			if len(spec.Selector.MatchLabels) == 0 && len(spec.Selector.MatchExpressions) == 0 {
+				return fmt.Errorf("the ServiceMonitor spec of metrics template %s must have a selector", template.GetName())
+			}
+			spec.NamespaceSelector = promoperapi.NamespaceSelector{MatchNames: []string{metricsBinding.Namespace}}
+			for i := range spec.Endpoints {
+				spec.Endpoints[i].RelabelConfigs = append([]*promoperapi.RelabelConfig{metrics.ClusterNameRelabelConfig(clusterName)}, spec.Endpoints[i].RelabelConfigs...)
+			}
+			serviceMonitor.Spec = spec
+			return r.setMonitorMetadata(metricsBinding, serviceMonitor)
+		}
+	default:
+		podMonitor := &promoperapi.PodMonitor{}
+		monitor, obsolete = podMonitor, &promoperapi.ServiceMonitor{}
+		mutate = func() error {
+			spec := promoperapi.PodMonitorSpec{}
+			if err := yaml.UnmarshalStrict([]byte(specString), &spec); err != nil {
+				return fmt.Errorf("failed to parse the PodMonitor spec of metrics template %s: %v", template.GetName(), err)
+			}
+			if len(spec.Selector.MatchLabels) == 0 && len(spec.Selector.MatchExpressions) == 0 {
+				if workloadLabel == "" {
+					return fmt.Errorf("failed to find the label %s on the target workload", constants.MetricsWorkloadLabel)
+				}
+				spec.Selector = k8smetav1.LabelSelector{MatchLabels: map[string]string{constants.MetricsWorkloadLabel: workloadLabel}}
+			}
+			spec.NamespaceSelector = promoperapi.NamespaceSelector{MatchNames: []string{metricsBinding.Namespace}}
+			for i := range spec.PodMetricsEndpoints {
+				spec.PodMetricsEndpoints[i].RelabelConfigs = append([]*promoperapi.RelabelConfig{metrics.ClusterNameRelabelConfig(clusterName)}, spec.PodMetricsEndpoints[i].RelabelConfigs...)
+			}
+			podMonitor.Spec = spec
+			return r.setMonitorMetadata(metricsBinding, podMonitor)
+		}
+	}
+
+	monitor.SetNamespace(metricsBinding.Namespace)
+	monitor.SetName(metricsBinding.Name)
+	if _, err = controllerutil.CreateOrUpdate(ctx, r.Client, monitor, mutate); err != nil {
+		return log.ErrorfNewErr("Failed to create or update the %s for the Metrics Binding %s/%s: %v", getMonitorKind(template), metricsBinding.Namespace, metricsBinding.Name, err)
+	}
+
+	obsolete.SetNamespace(metricsBinding.Namespace)
+	obsolete.SetName(metricsBinding.Name)
+	if err = r.Delete(ctx, obsolete); k8sclient.IgnoreNotFound(err) != nil {
+		return log.ErrorfNewErr("Failed to delete the obsolete monitor for the Metrics Binding %s/%s: %v", metricsBinding.Namespace, metricsBinding.Name, err)
+	}
+	return nil
+}
+
+// setMonitorMetadata sets the labels used by Prometheus to select the monitor, and makes the metrics binding the
+// owner of the monitor so that it is deleted with the binding
+func (r *Reconciler) setMonitorMetadata(metricsBinding *vzapi.MetricsBinding, monitor k8sclient.Object) error {
+	labels := monitor.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels["release"] = "prometheus-operator"
+	monitor.SetLabels(labels)
+	return controllerutil.SetControllerReference(metricsBinding, monitor, r.Scheme)
+}
+
+// migrateLegacyScrapeConfig removes the scrape job of the metrics binding from the Prometheus ConfigMap or Secret
+// referenced by the binding, then removes the references from the binding
+func (r *Reconciler) migrateLegacyScrapeConfig(ctx context.Context, metricsBinding *vzapi.MetricsBinding, log vzlog.VerrazzanoLogger) error {
+	configMap := getPromConfigMap(metricsBinding)
+	secret, key := getPromConfigSecret(metricsBinding)
+	if configMap == nil && secret == nil {
+		return nil
+	}
+
+	log.Infof("Migrating Metrics Binding %s/%s from the Prometheus configuration to a Prometheus Operator monitor", metricsBinding.Namespace, metricsBinding.Name)
+	if configMap != nil {
+		err := r.Get(ctx, k8sclient.ObjectKeyFromObject(configMap), configMap)
+		if k8sclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err == nil {
+			if err = r.deleteFromPrometheusConfigMap(ctx, metricsBinding, configMap, log); err != nil {
+				return err
+			}
+		}
+	}
+	if secret != nil {
+		err := r.Get(ctx, k8sclient.ObjectKeyFromObject(secret), secret)
+		if k8sclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err == nil {
+			if err = r.deleteFromPrometheusConfigSecret(ctx, metricsBinding, secret, key, log); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, metricsBinding, func() error {
+		metricsBinding.Spec.PrometheusConfigMap = vzapi.NamespaceName{}
+		metricsBinding.Spec.PrometheusConfigSecret = vzapi.SecretKey{}
+		return nil
+	})
+	if err != nil {
+		return log.ErrorfNewErr("Failed to remove the Prometheus configuration from the Metrics Binding %s/%s: %v", metricsBinding.Namespace, metricsBinding.Name, err)
+	}
+	return nil
+}
+
+// getMonitorKind returns the kind of monitor created for the metrics template
+func getMonitorKind(template *vzapi.MetricsTemplate) vzapi.MonitorKind {
+	if template.Spec.MonitorConfig == nil || template.Spec.MonitorConfig.Kind == "" {
+		return vzapi.PodMonitorKind
+	}
+	return template.Spec.MonitorConfig.Kind
+}
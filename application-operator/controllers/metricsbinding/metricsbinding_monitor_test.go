@@ -0,0 +1,162 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricsbinding
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	promoperapi "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	asserts "github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/app/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const podMonitorSpecTemplate = `podMetricsEndpoints:
+- port: metrics
+  path: /metrics
+`
+
+const serviceMonitorSpecTemplate = `selector:
+  matchLabels:
+    app: {{.workload.metadata.name}}
+endpoints:
+- port: metrics
+`
+
+// TestHandleMonitorMetricsTemplatePodMonitor tests creating a PodMonitor from a metrics template
+// GIVEN a metrics binding that uses a metrics template with a PodMonitor monitor configuration
+// WHEN the metrics binding is reconciled
+// THEN a PodMonitor owned by the binding is created that selects the pods of the workload
+// AND the scrape job of the binding is removed from the Prometheus ConfigMap
+// AND the binding no longer references the Prometheus ConfigMap
+func TestHandleMonitorMetricsTemplatePodMonitor(t *testing.T) {
+	assert := asserts.New(t)
+
+	template := metricsTemplate.DeepCopy()
+	template.Spec.MonitorConfig = &vzapi.MonitorConfig{Kind: vzapi.PodMonitorKind, MonitorSpecTemplate: podMonitorSpecTemplate}
+	testFileCM, err := getConfigMapFromTestFile(false)
+	assert.NoError(err)
+	binding := metricsBinding.DeepCopy()
+	c, r := newMonitorClientAndReconciler(template, binding, testFileCM)
+
+	result, err := r.reconcileBindingCreateOrUpdate(context.TODO(), binding, vzlog.DefaultLogger())
+	assert.NoError(err)
+	assert.True(result.Requeue)
+
+	podMonitor := promoperapi.PodMonitor{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: testMetricsBindingNamespace, Name: testMetricsBindingName}, &podMonitor))
+	assert.Equal("prometheus-operator", podMonitor.Labels["release"])
+	assert.Equal(testDeploymentName, podMonitor.Spec.Selector.MatchLabels[constants.MetricsWorkloadLabel])
+	assert.Equal([]string{testMetricsBindingNamespace}, podMonitor.Spec.NamespaceSelector.MatchNames)
+	assert.Len(podMonitor.OwnerReferences, 1)
+	assert.Equal(testMetricsBindingName, podMonitor.OwnerReferences[0].Name)
+	assert.Len(podMonitor.Spec.PodMetricsEndpoints, 1)
+	assert.Equal("metrics", podMonitor.Spec.PodMetricsEndpoints[0].Port)
+	assert.Len(podMonitor.Spec.PodMetricsEndpoints[0].RelabelConfigs, 1)
+	assert.Equal("verrazzano_cluster", podMonitor.Spec.PodMetricsEndpoints[0].RelabelConfigs[0].TargetLabel)
+
+	newCM := corev1.ConfigMap{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.VerrazzanoSystemNamespace, Name: testConfigMapName}, &newCM))
+	assert.False(strings.Contains(newCM.Data[prometheusConfigKey], createJobName(binding)+"\n"))
+
+	newBinding := vzapi.MetricsBinding{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: testMetricsBindingNamespace, Name: testMetricsBindingName}, &newBinding))
+	assert.Equal(vzapi.NamespaceName{}, newBinding.Spec.PrometheusConfigMap)
+	assert.Equal(vzapi.SecretKey{}, newBinding.Spec.PrometheusConfigSecret)
+}
+
+// TestHandleMonitorMetricsTemplateServiceMonitor tests creating a ServiceMonitor from a metrics template
+// GIVEN a metrics binding with a PodMonitor that uses a metrics template with a ServiceMonitor monitor configuration
+// WHEN the metrics binding is reconciled
+// THEN a ServiceMonitor is created with the rendered selector
+// AND the PodMonitor is deleted
+func TestHandleMonitorMetricsTemplateServiceMonitor(t *testing.T) {
+	assert := asserts.New(t)
+
+	template := metricsTemplate.DeepCopy()
+	template.Spec.MonitorConfig = &vzapi.MonitorConfig{Kind: vzapi.ServiceMonitorKind, MonitorSpecTemplate: serviceMonitorSpecTemplate}
+	binding := metricsBinding.DeepCopy()
+	binding.Spec.PrometheusConfigMap = vzapi.NamespaceName{}
+	podMonitor := &promoperapi.PodMonitor{}
+	podMonitor.SetNamespace(testMetricsBindingNamespace)
+	podMonitor.SetName(testMetricsBindingName)
+	c, r := newMonitorClientAndReconciler(template, binding, podMonitor)
+
+	_, err := r.reconcileBindingCreateOrUpdate(context.TODO(), binding, vzlog.DefaultLogger())
+	assert.NoError(err)
+
+	serviceMonitor := promoperapi.ServiceMonitor{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: testMetricsBindingNamespace, Name: testMetricsBindingName}, &serviceMonitor))
+	assert.Equal(testDeploymentName, serviceMonitor.Spec.Selector.MatchLabels["app"])
+	assert.Equal([]string{testMetricsBindingNamespace}, serviceMonitor.Spec.NamespaceSelector.MatchNames)
+	assert.Len(serviceMonitor.Spec.Endpoints, 1)
+	assert.Len(serviceMonitor.Spec.Endpoints[0].RelabelConfigs, 1)
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: testMetricsBindingNamespace, Name: testMetricsBindingName}, &promoperapi.PodMonitor{})
+	assert.True(errors.IsNotFound(err))
+}
+
+// TestHandleMonitorMetricsTemplateInvalid tests invalid monitor spec templates
+// GIVEN a metrics template with an invalid monitor spec template
+// WHEN the metrics binding is reconciled
+// THEN an error is returned and no monitor is created
+func TestHandleMonitorMetricsTemplateInvalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		monitorKind  vzapi.MonitorKind
+		specTemplate string
+	}{
+		{"ServiceMonitor without a selector", vzapi.ServiceMonitorKind, "endpoints:\n- port: metrics\n"},
+		{"unknown field", vzapi.PodMonitorKind, "notAField: true\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := asserts.New(t)
+
+			template := metricsTemplate.DeepCopy()
+			template.Spec.MonitorConfig = &vzapi.MonitorConfig{Kind: tt.monitorKind, MonitorSpecTemplate: tt.specTemplate}
+			binding := metricsBinding.DeepCopy()
+			c, r := newMonitorClientAndReconciler(template, binding)
+
+			_, err := r.reconcileBindingCreateOrUpdate(context.TODO(), binding, vzlog.DefaultLogger())
+			assert.Error(err)
+
+			serviceMonitors := promoperapi.ServiceMonitorList{}
+			assert.NoError(c.List(context.TODO(), &serviceMonitors))
+			assert.Empty(serviceMonitors.Items)
+			podMonitors := promoperapi.PodMonitorList{}
+			assert.NoError(c.List(context.TODO(), &podMonitors))
+			assert.Empty(podMonitors.Items)
+		})
+	}
+}
+
+// newMonitorClientAndReconciler creates a fake client with a labeled workload and namespace and the given objects,
+// and a reconciler that uses the scheme of the client
+func newMonitorClientAndReconciler(objs ...client.Object) (client.Client, *Reconciler) {
+	scheme := runtime.NewScheme()
+	_ = vzapi.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = promoperapi.AddToScheme(scheme)
+
+	labeledWorkload := plainWorkload.DeepCopy()
+	labeledWorkload.Labels = map[string]string{constants.MetricsWorkloadLabel: testDeploymentName}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(labeledWorkload, plainNs.DeepCopy()).WithObjects(objs...).Build()
+
+	r := newReconciler(c)
+	r.Scheme = scheme
+	return c, &r
+}
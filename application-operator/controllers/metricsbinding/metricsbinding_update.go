@@ -1,4 +1,4 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricsbinding
@@ -66,6 +66,18 @@ func (r *Reconciler) reconcileBindingCreateOrUpdate(ctx context.Context, metrics
 		return k8scontroller.Result{Requeue: true}, err
 	}
 
+	// Handle the case where the workload uses a metrics template that creates a Prometheus Operator monitor
+	template, err := r.getMetricsTemplate(ctx, metricsBinding, log)
+	if err != nil {
+		return k8scontroller.Result{Requeue: true}, err
+	}
+	if template.Spec.MonitorConfig != nil {
+		if err = r.handleMonitorMetricsTemplate(ctx, metricsBinding, template, log); err != nil {
+			return k8scontroller.Result{Requeue: true}, err
+		}
+		return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+	}
+
 	// Handle the case where the workload uses a custom metrics template
 	if err = r.handleCustomMetricsTemplate(ctx, metricsBinding, log); err != nil {
 		return k8scontroller.Result{Requeue: true}, err
@@ -1,4 +1,4 @@
-// Copyright (c) 2020, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricstrait
@@ -295,7 +295,7 @@ func (r *Reconciler) reconcileTraitDelete(ctx context.Context, trait *vzapi.Metr
 			return clusters.NewRequeueWithDelay(), err // the caller always does a requeue if there is an error
 		}
 	}
-	return r.updateTraitStatus(ctx, trait, status, log)
+	return r.updateTraitStatus(ctx, trait, status, trait.Status.Rules, log)
 }
 
 // reconcileTraitCreateOrUpdate reconciles a metrics trait that is being created or updated.
@@ -348,7 +348,8 @@ func (r *Reconciler) reconcileTraitCreateOrUpdate(ctx context.Context, trait *vz
 	status = r.deleteOrUpdateObsoleteResources(ctx, trait, status, log)
 
 	// Update the status of the trait resource using the outcomes of the create or update.
-	traitStatus, err := r.updateTraitStatus(ctx, trait, status, log)
+	// Rules are only evaluated by the Verrazzano Prometheus instance, so they are rejected for custom scrapers.
+	traitStatus, err := r.updateTraitStatus(ctx, trait, status, getRuleStatuses(trait, unsupportedScraperError), log)
 	return traitStatus, true, err
 }
 
@@ -421,6 +422,9 @@ func (r *Reconciler) deleteOrUpdateObsoleteResources(ctx context.Context, trait
 				}
 			case sourceRole:
 				update.RecordOutcomeIfError(r.deleteOrUpdateMetricSourceResource(ctx, trait, rel, log))
+			case rulesRole:
+				result, err := r.deletePrometheusRule(ctx, rel.Namespace, rel.Name, log)
+				update.RecordOutcomeIfError(rel, result, err)
 			default:
 				// Don't record an outcome for unknown role relations.
 				log.Debugf("Skip delete or update of unknown resource role %s", rel.Role)
@@ -589,13 +593,13 @@ func (r *Reconciler) findPrometheusScrapeConfigMapNameFromDeployment(deployment
 	return "", fmt.Errorf("failed to find Prometheus configmap name from deployment %s", vznav.GetNamespacedNameFromObjectMeta(deployment.ObjectMeta))
 }
 
-// updateTraitStatus updates the trait's status conditions and resources if they have changed.
+// updateTraitStatus updates the trait's status conditions, resources and rule states if they have changed.
 // The return value can be used as the result of the Reconcile method.
-func (r *Reconciler) updateTraitStatus(ctx context.Context, trait *vzapi.MetricsTrait, results *reconcileresults.ReconcileResults, log vzlog2.VerrazzanoLogger) (reconcile.Result, error) {
+func (r *Reconciler) updateTraitStatus(ctx context.Context, trait *vzapi.MetricsTrait, results *reconcileresults.ReconcileResults, rules []vzapi.MetricsRuleStatus, log vzlog2.VerrazzanoLogger) (reconcile.Result, error) {
 	name := vznav.GetNamespacedNameFromObjectMeta(trait.ObjectMeta)
 
 	// If the status content has changed persist the updated status.
-	if trait.DeletionTimestamp.IsZero() && updateStatusIfRequired(&trait.Status, results) {
+	if trait.DeletionTimestamp.IsZero() && updateStatusIfRequired(&trait.Status, results, rules) {
 		err := r.Status().Update(ctx, trait)
 		if err != nil {
 			return vzlog.IgnoreConflictWithLog(fmt.Sprintf("Failed to update metrics trait %s status", name.Name), err, zap.S())
@@ -618,10 +622,14 @@ func (r *Reconciler) updateTraitStatus(ctx context.Context, trait *vzapi.Metrics
 	return reconcile.Result{Requeue: true, RequeueAfter: duration}, nil
 }
 
-// updateStatusIfRequired updates the traits status (i.e. resources and conditions) if they have changed.
-// Returns a boolean indicating if status resources or conditions have been updated.
-func updateStatusIfRequired(status *vzapi.MetricsTraitStatus, results *reconcileresults.ReconcileResults) bool {
+// updateStatusIfRequired updates the traits status (i.e. resources, conditions and rules) if they have changed.
+// Returns a boolean indicating if status resources, conditions or rules have been updated.
+func updateStatusIfRequired(status *vzapi.MetricsTraitStatus, results *reconcileresults.ReconcileResults, rules []vzapi.MetricsRuleStatus) bool {
 	updated := false
+	if !equality.Semantic.DeepEqual(status.Rules, rules) {
+		status.Rules = rules
+		updated = true
+	}
 	if !vzapi.QualifiedResourceRelationSlicesEquivalent(status.Resources, results.Relations) {
 		for i, relation := range results.Relations {
 			if !vzapi.QualifiedResourceRelationsContain(status.Resources, &results.Relations[i]) {
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricstrait
@@ -86,7 +86,15 @@ func (r *Reconciler) reconcileOperatorTraitCreateOrUpdate(ctx context.Context, t
 	}
 	status.RecordOutcome(rel, opResult, err)
 
-	return r.updateTraitStatus(ctx, trait, status, log)
+	// Create or update the PrometheusRule that contains the recording and alerting rules of the trait
+	rel, opResult, rules, err := r.updatePrometheusRule(ctx, trait, log)
+	if rel.Name == "" {
+		status.RecordOutcomeIfError(rel, opResult, err)
+	} else {
+		status.RecordOutcome(rel, opResult, err)
+	}
+
+	return r.updateTraitStatus(ctx, trait, status, rules, log)
 }
 
 func (r *Reconciler) reconcileOperatorTraitDelete(ctx context.Context, trait *vzapi.MetricsTrait, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
@@ -0,0 +1,214 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricstrait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/oam-kubernetes-runtime/pkg/oam"
+	promoperapi "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/oam/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// rulesRole is the role of the PrometheusRule in the qualified resource relations of the trait
+	rulesRole = "rules"
+
+	// Rule states reported in the trait status
+	ruleStateApplied  = "Applied"
+	ruleStateRejected = "Rejected"
+
+	// unsupportedScraperError is reported for the rules of a trait that uses a custom scraper
+	unsupportedScraperError = "rules are only evaluated by the Verrazzano Prometheus instance"
+)
+
+// ValidateRules validates the recording and alerting rules of a metrics trait.
+// Returns an error describing the first invalid rule.
+func ValidateRules(rules []vzapi.MetricsRule) error {
+	for i := range rules {
+		if err := validateRule(&rules[i]); err != nil {
+			return fmt.Errorf("invalid metrics trait rule %s: %v", getRuleName(&rules[i], i), err)
+		}
+	}
+	return nil
+}
+
+// validateRule validates a single recording or alerting rule
+func validateRule(rule *vzapi.MetricsRule) error {
+	if (rule.Record == "") == (rule.Alert == "") {
+		return fmt.Errorf("exactly one of record or alert must be specified")
+	}
+	if rule.Record != "" {
+		if !model.IsValidMetricName(model.LabelValue(rule.Record)) {
+			return fmt.Errorf("record %q is not a valid metric name", rule.Record)
+		}
+		if rule.For != "" || len(rule.Annotations) > 0 {
+			return fmt.Errorf("for and annotations can only be specified for alerting rules")
+		}
+	}
+	if rule.For != "" {
+		if _, err := model.ParseDuration(rule.For); err != nil {
+			return fmt.Errorf("for %q is not a valid duration: %v", rule.For, err)
+		}
+	}
+	for name := range rule.Labels {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("label %q is not a valid label name", name)
+		}
+	}
+	return validateExpr(rule.Expr)
+}
+
+// validateExpr checks that a PromQL expression is not empty, that its string literals are terminated and that its
+// parentheses, brackets and braces are balanced. The Prometheus PromQL parser is not a dependency of the application
+// operator, so the full expression is only parsed by Prometheus when the rule is loaded.
+func validateExpr(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("expr must not be empty")
+	}
+	closers := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var open []rune
+	var quote rune
+	escaped := false
+	for _, c := range expr {
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\' && quote != '`':
+				escaped = true
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			open = append(open, c)
+		case ')', ']', '}':
+			if len(open) == 0 || open[len(open)-1] != closers[c] {
+				return fmt.Errorf("expr %q has an unexpected %q", expr, c)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("expr %q has an unterminated string", expr)
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("expr %q has an unclosed %q", expr, open[len(open)-1])
+	}
+	return nil
+}
+
+// getRuleName returns the record or alert name of a rule, or its index when neither is set
+func getRuleName(rule *vzapi.MetricsRule, index int) string {
+	if rule.Record != "" {
+		return rule.Record
+	}
+	if rule.Alert != "" {
+		return rule.Alert
+	}
+	return fmt.Sprintf("rules[%d]", index)
+}
+
+// getRuleStatuses returns whether each trait rule is applied. Invalid rules are rejected.
+// If reason is not empty then all rules are rejected with that reason.
+func getRuleStatuses(trait *vzapi.MetricsTrait, reason string) []vzapi.MetricsRuleStatus {
+	var statuses []vzapi.MetricsRuleStatus
+	for i := range trait.Spec.Rules {
+		status := vzapi.MetricsRuleStatus{Name: getRuleName(&trait.Spec.Rules[i], i), State: ruleStateApplied}
+		if reason != "" {
+			status.State = ruleStateRejected
+			status.Reason = reason
+		} else if err := validateRule(&trait.Spec.Rules[i]); err != nil {
+			status.State = ruleStateRejected
+			status.Reason = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// updatePrometheusRule creates or updates the PrometheusRule that contains the valid rules of the trait.
+// The PrometheusRule is owned by the trait so it is garbage collected when the trait is deleted.
+// If the trait is disabled or has no valid rules the PrometheusRule is deleted and an empty relation is returned.
+// The state of each rule is returned for the trait status.
+func (r *Reconciler) updatePrometheusRule(ctx context.Context, trait *vzapi.MetricsTrait, log vzlog.VerrazzanoLogger) (vzapi.QualifiedResourceRelation, controllerutil.OperationResult, []vzapi.MetricsRuleStatus, error) {
+	var rel vzapi.QualifiedResourceRelation
+	statuses := getRuleStatuses(trait, "")
+
+	name, err := createServiceMonitorName(trait, 0)
+	if err != nil {
+		return rel, controllerutil.OperationResultNone, statuses, log.ErrorfNewErr("Failed to create PrometheusRule name: %v", err)
+	}
+
+	var rules []promoperapi.Rule
+	for i, status := range statuses {
+		if status.State != ruleStateApplied {
+			log.Progressf("Skipping invalid rule %s of metrics trait %s/%s: %s", status.Name, trait.Namespace, trait.Name, status.Reason)
+			continue
+		}
+		rule := trait.Spec.Rules[i]
+		rules = append(rules, promoperapi.Rule{
+			Record:      rule.Record,
+			Alert:       rule.Alert,
+			Expr:        intstr.FromString(rule.Expr),
+			For:         promoperapi.Duration(rule.For),
+			Labels:      rule.Labels,
+			Annotations: rule.Annotations,
+		})
+	}
+
+	if !isEnabled(trait) || len(rules) == 0 {
+		// Only attempt the delete if a PrometheusRule was previously created for the trait
+		for _, existing := range trait.Status.Resources {
+			if existing.Role == rulesRole {
+				result, err := r.deletePrometheusRule(ctx, existing.Namespace, existing.Name, log)
+				return rel, result, statuses, err
+			}
+		}
+		return rel, controllerutil.OperationResultNone, statuses, nil
+	}
+
+	promRule := promoperapi.PrometheusRule{}
+	promRule.SetName(name)
+	promRule.SetNamespace(trait.Namespace)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, &promRule, func() error {
+		promRule.Labels = copyStringMapEntries(promRule.Labels, trait.Labels, oam.LabelAppName, oam.LabelAppComponent)
+		promRule.Labels["release"] = "prometheus-operator"
+		promRule.Spec.Groups = []promoperapi.RuleGroup{{Name: name, Rules: rules}}
+		return controllerutil.SetControllerReference(trait, &promRule, r.Scheme)
+	})
+	if err != nil {
+		return rel, controllerutil.OperationResultNone, statuses, log.ErrorfNewErr("Failed to create or update the PrometheusRule for metrics trait %s/%s: %v", trait.Namespace, trait.Name, err)
+	}
+
+	rel = vzapi.QualifiedResourceRelation{APIVersion: promoperapi.SchemeGroupVersion.String(), Kind: promoperapi.PrometheusRuleKind, Namespace: promRule.Namespace, Name: promRule.Name, Role: rulesRole}
+	return rel, result, statuses, nil
+}
+
+// deletePrometheusRule deletes the PrometheusRule of a trait if it exists
+func (r *Reconciler) deletePrometheusRule(ctx context.Context, namespace string, name string, log vzlog.VerrazzanoLogger) (controllerutil.OperationResult, error) {
+	promRule := promoperapi.PrometheusRule{}
+	promRule.SetName(name)
+	promRule.SetNamespace(namespace)
+	if err := r.Delete(ctx, &promRule); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return controllerutil.OperationResultNone, nil
+		}
+		return controllerutil.OperationResultNone, log.ErrorfNewErr("Failed to delete PrometheusRule %s/%s: %v", namespace, name, err)
+	}
+	log.Debugf("Deleted PrometheusRule %s/%s", namespace, name)
+	return controllerutil.OperationResultUpdated, nil
+}
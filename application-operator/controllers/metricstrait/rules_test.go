@@ -0,0 +1,160 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package metricstrait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/oam-kubernetes-runtime/pkg/oam"
+	promoperapi "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	asserts "github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/application-operator/apis/oam/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// TestValidateRules tests the validation of metrics trait rules
+// GIVEN recording and alerting rules
+// WHEN ValidateRules is called
+// THEN an error is returned for invalid rules
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    vzapi.MetricsRule
+		wantErr bool
+	}{
+		{"valid recording rule", vzapi.MetricsRule{Record: "job:http_requests:rate5m", Expr: `sum by (job) (rate(http_requests_total{code=~"5.."}[5m]))`}, false},
+		{"valid alerting rule", vzapi.MetricsRule{Alert: "HighErrorRate", Expr: "job:http_requests:rate5m > 0.5", For: "10m",
+			Labels: map[string]string{"severity": "page"}, Annotations: map[string]string{"summary": "High error rate"}}, false},
+		{"quoted brackets", vzapi.MetricsRule{Record: "r", Expr: `up{job="a)b\"c"}`}, false},
+		{"record and alert", vzapi.MetricsRule{Record: "r", Alert: "a", Expr: "up"}, true},
+		{"neither record nor alert", vzapi.MetricsRule{Expr: "up"}, true},
+		{"invalid record name", vzapi.MetricsRule{Record: "not-valid", Expr: "up"}, true},
+		{"for on recording rule", vzapi.MetricsRule{Record: "r", Expr: "up", For: "5m"}, true},
+		{"invalid for", vzapi.MetricsRule{Alert: "a", Expr: "up", For: "five minutes"}, true},
+		{"invalid label", vzapi.MetricsRule{Alert: "a", Expr: "up", Labels: map[string]string{"bad-label": "x"}}, true},
+		{"empty expr", vzapi.MetricsRule{Alert: "a", Expr: " "}, true},
+		{"unbalanced expr", vzapi.MetricsRule{Alert: "a", Expr: "sum(rate(up[5m])"}, true},
+		{"mismatched expr", vzapi.MetricsRule{Alert: "a", Expr: "sum(up]"}, true},
+		{"unterminated string", vzapi.MetricsRule{Alert: "a", Expr: `up{job="a}`}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRules([]vzapi.MetricsRule{tt.rule})
+			asserts.Equal(t, tt.wantErr, err != nil, "unexpected validation result: %v", err)
+		})
+	}
+}
+
+// TestUpdatePrometheusRule tests creating the PrometheusRule for a metrics trait
+// GIVEN a metrics trait with a valid and an invalid rule
+// WHEN updatePrometheusRule is called
+// THEN a PrometheusRule owned by the trait is created with the valid rule
+// AND the rule state reports the invalid rule as rejected
+func TestUpdatePrometheusRule(t *testing.T) {
+	assert := asserts.New(t)
+
+	trait := newRulesTrait(
+		vzapi.MetricsRule{Alert: "HighErrorRate", Expr: "rate(errors_total[5m]) > 1", For: "5m"},
+		vzapi.MetricsRule{Record: "bad-name", Expr: "up"},
+	)
+	c := newRulesClient(trait)
+	reconciler := newRulesReconciler(c)
+
+	rel, result, statuses, err := reconciler.updatePrometheusRule(context.TODO(), trait, vzlog.DefaultLogger())
+	assert.NoError(err)
+	assert.Equal(controllerutil.OperationResultCreated, result)
+	assert.Equal(rulesRole, rel.Role)
+	assert.Equal(promoperapi.PrometheusRuleKind, rel.Kind)
+
+	assert.Len(statuses, 2)
+	assert.Equal(vzapi.MetricsRuleStatus{Name: "HighErrorRate", State: ruleStateApplied}, statuses[0])
+	assert.Equal("bad-name", statuses[1].Name)
+	assert.Equal(ruleStateRejected, statuses[1].State)
+	assert.NotEmpty(statuses[1].Reason)
+
+	promRule := promoperapi.PrometheusRule{}
+	assert.NoError(c.Get(context.TODO(), types.NamespacedName{Namespace: rel.Namespace, Name: rel.Name}, &promRule))
+	assert.Equal("test-app", promRule.Labels[oam.LabelAppName])
+	assert.Equal("test-comp", promRule.Labels[oam.LabelAppComponent])
+	assert.Equal("prometheus-operator", promRule.Labels["release"])
+	assert.Len(promRule.OwnerReferences, 1)
+	assert.Equal(trait.Name, promRule.OwnerReferences[0].Name)
+	assert.Len(promRule.Spec.Groups, 1)
+	assert.Len(promRule.Spec.Groups[0].Rules, 1)
+	assert.Equal("HighErrorRate", promRule.Spec.Groups[0].Rules[0].Alert)
+	assert.Equal(promoperapi.Duration("5m"), promRule.Spec.Groups[0].Rules[0].For)
+}
+
+// TestUpdatePrometheusRuleNoRules tests a metrics trait whose rules were removed
+// GIVEN a metrics trait without rules that previously had a PrometheusRule
+// WHEN updatePrometheusRule is called
+// THEN the PrometheusRule is deleted
+func TestUpdatePrometheusRuleNoRules(t *testing.T) {
+	assert := asserts.New(t)
+
+	trait := newRulesTrait()
+	existing := &promoperapi.PrometheusRule{ObjectMeta: k8smeta.ObjectMeta{Namespace: trait.Namespace, Name: "test-rules"}}
+	trait.Status.Resources = []vzapi.QualifiedResourceRelation{{
+		APIVersion: promoperapi.SchemeGroupVersion.String(), Kind: promoperapi.PrometheusRuleKind,
+		Namespace: existing.Namespace, Name: existing.Name, Role: rulesRole,
+	}}
+	c := newRulesClient(trait, existing)
+	reconciler := newRulesReconciler(c)
+
+	rel, result, statuses, err := reconciler.updatePrometheusRule(context.TODO(), trait, vzlog.DefaultLogger())
+	assert.NoError(err)
+	assert.Empty(rel.Name)
+	assert.Equal(controllerutil.OperationResultUpdated, result)
+	assert.Empty(statuses)
+
+	promRules := promoperapi.PrometheusRuleList{}
+	assert.NoError(c.List(context.TODO(), &promRules))
+	assert.Empty(promRules.Items)
+}
+
+// TestGetRuleStatusesUnsupportedScraper tests the rule states of a trait that uses a custom scraper
+// GIVEN a metrics trait with a valid rule
+// WHEN getRuleStatuses is called with a reason
+// THEN the rule is reported as rejected with that reason
+func TestGetRuleStatusesUnsupportedScraper(t *testing.T) {
+	trait := newRulesTrait(vzapi.MetricsRule{Record: "r", Expr: "up"})
+	statuses := getRuleStatuses(trait, unsupportedScraperError)
+	asserts.Equal(t, []vzapi.MetricsRuleStatus{{Name: "r", State: ruleStateRejected, Reason: unsupportedScraperError}}, statuses)
+}
+
+// newRulesTrait creates a metrics trait with the given rules
+func newRulesTrait(rules ...vzapi.MetricsRule) *vzapi.MetricsTrait {
+	return &vzapi.MetricsTrait{
+		TypeMeta: k8smeta.TypeMeta{APIVersion: vzapi.SchemeGroupVersion.Identifier(), Kind: vzapi.MetricsTraitKind},
+		ObjectMeta: k8smeta.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      "test-trait-name",
+			UID:       "test-trait-uid",
+			Labels:    map[string]string{oam.LabelAppName: "test-app", oam.LabelAppComponent: "test-comp"},
+		},
+		Spec: vzapi.MetricsTraitSpec{Rules: rules},
+	}
+}
+
+// newRulesClient creates a fake client that knows the metrics trait and Prometheus Operator types
+func newRulesClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = vzapi.AddToScheme(scheme)
+	_ = promoperapi.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// newRulesReconciler creates a metrics trait reconciler that uses the scheme of the client
+func newRulesReconciler(c client.Client) *Reconciler {
+	reconciler := newMetricsTraitReconciler(c)
+	reconciler.Scheme = c.Scheme()
+	return &reconciler
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gertd/go-pluralize"
+	cluv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	"github.com/verrazzano/verrazzano/application-operator/controllers"
 	"github.com/verrazzano/verrazzano/application-operator/metricsexporter"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
 )
 
 // IstioDefaulterPath specifies the path of Istio defaulter webhook
@@ -37,6 +39,13 @@ const IstioDefaulterPath = "/istio-defaulter"
 // IstioAppLabel label to be used for all pods that are istio enabled
 const IstioAppLabel = "verrazzano.io/istio"
 
+const (
+	// istioProxyConfigAnnotation is the pod annotation that overrides the mesh proxy configuration of the sidecar
+	istioProxyConfigAnnotation = "proxy.istio.io/config"
+	// istioDNSCaptureMetadata enables the DNS proxy of the sidecar, which resolves the hosts of the Istio service entries
+	istioDNSCaptureMetadata = "ISTIO_META_DNS_CAPTURE"
+)
+
 // IstioWebhook type for istio defaulter webhook
 type IstioWebhook struct {
 	client.Client
@@ -73,6 +82,13 @@ func (a *IstioWebhook) Handle(ctx context.Context, req admission.Request) admiss
 		}
 	}
 
+	// Enable the DNS proxy of the sidecar in the namespaces of the projects that publish services across clusters
+	dnsCaptureAdded, err := a.addDNSCaptureAnnotation(pod, req.Namespace)
+	if err != nil {
+		errorCounterMetricObject.Inc(zapLogForMetrics, err)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
 	// Get all owner references for this pod
 	ownerRefList, err := a.flattenOwnerReferences(nil, req.Namespace, pod.OwnerReferences, log)
 	if err != nil {
@@ -92,6 +108,15 @@ func (a *IstioWebhook) Handle(ctx context.Context, req admission.Request) admiss
 	// No ApplicationConfiguration ownerReference resource was found so there is no action required.
 	if appConfigOwnerRef == (metav1.OwnerReference{}) {
 		log.Debugf("Pod is not a child of an ApplicationConfiguration: %s:%s:%s", req.Namespace, pod.Name, pod.GenerateName)
+		if dnsCaptureAdded {
+			marshaledPod, err := json.Marshal(pod)
+			if err != nil {
+				errorCounterMetricObject.Inc(zapLogForMetrics, err)
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			counterMetricObject.Inc(zapLogForMetrics, nil)
+			return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+		}
 		return admission.Allowed("No action required, pod is not a child of an ApplicationConfiguration resource")
 	}
 
@@ -150,6 +175,61 @@ func (a *IstioWebhook) InjectDecoder(d *admission.Decoder) error {
 	return nil
 }
 
+// addDNSCaptureAnnotation enables the DNS proxy of the Istio sidecar of a pod in a namespace of a Verrazzano project
+// that publishes services to the other clusters, so that the published services are resolved by the sidecar as
+// <service>.<namespace>.global. The proxy configuration annotation of the pod is merged. Returns true if the pod was
+// mutated.
+func (a *IstioWebhook) addDNSCaptureAnnotation(pod *corev1.Pod, namespace string) (bool, error) {
+	projects := &cluv1alpha1.VerrazzanoProjectList{}
+	if err := a.Client.List(context.TODO(), projects, client.InNamespace(constants.VerrazzanoMultiClusterNamespace)); err != nil {
+		return false, err
+	}
+	if !isServiceDiscoveryNamespace(projects, namespace) {
+		return false, nil
+	}
+
+	proxyConfig := map[string]interface{}{}
+	if value, ok := pod.Annotations[istioProxyConfigAnnotation]; ok {
+		if err := yaml.Unmarshal([]byte(value), &proxyConfig); err != nil {
+			return false, fmt.Errorf("invalid %s annotation: %v", istioProxyConfigAnnotation, err)
+		}
+	}
+	proxyMetadata, _ := proxyConfig["proxyMetadata"].(map[string]interface{})
+	if proxyMetadata == nil {
+		proxyMetadata = map[string]interface{}{}
+	}
+	if proxyMetadata[istioDNSCaptureMetadata] == "true" {
+		return false, nil
+	}
+	proxyMetadata[istioDNSCaptureMetadata] = "true"
+	proxyConfig["proxyMetadata"] = proxyMetadata
+	value, err := yaml.Marshal(proxyConfig)
+	if err != nil {
+		return false, err
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[istioProxyConfigAnnotation] = string(value)
+	return true, nil
+}
+
+// isServiceDiscoveryNamespace returns true if the namespace belongs to a Verrazzano project that publishes services to
+// the other clusters
+func isServiceDiscoveryNamespace(projects *cluv1alpha1.VerrazzanoProjectList, namespace string) bool {
+	for _, project := range projects.Items {
+		if project.Spec.Template.ServiceDiscovery == nil {
+			continue
+		}
+		for _, ns := range project.Spec.Template.Namespaces {
+			if ns.Metadata.Name == namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // createUpdateAuthorizationPolicy will create/update an Istio authoriztion policy.
 func (a *IstioWebhook) createUpdateAuthorizationPolicy(namespace string, serviceAccountName string, ownerRef metav1.OwnerReference, labels map[string]string, log *zap.SugaredLogger) error {
 	podPrincipal := fmt.Sprintf("cluster.local/ns/%s/sa/%s", namespace, serviceAccountName)
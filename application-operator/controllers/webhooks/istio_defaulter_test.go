@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -86,7 +86,13 @@ func TestHandleIstioDisabled(t *testing.T) {
 //	THEN Handle should return an Allowed response with no action required
 func TestHandleNoOnwerReference(t *testing.T) {
 
+	scheme := runtime.NewScheme()
+	err := cluv1alpha1.AddToScheme(scheme)
+	assert.NoError(t, err, "Unexpected error adding to scheme")
+	client := ctrlfake.NewClientBuilder().WithScheme(scheme).Build()
+
 	defaulter := &IstioWebhook{
+		Client:        client,
 		DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
 		KubeClient:    fake.NewSimpleClientset(),
 		IstioClient:   istiofake.NewSimpleClientset(),
@@ -120,7 +126,13 @@ func TestHandleNoOnwerReference(t *testing.T) {
 // THEN Handle should return an Allowed response with no action required
 func TestHandleNoAppConfigOnwerReference(t *testing.T) {
 
+	scheme := runtime.NewScheme()
+	err := cluv1alpha1.AddToScheme(scheme)
+	assert.NoError(t, err, "Unexpected error adding to scheme")
+	client := ctrlfake.NewClientBuilder().WithScheme(scheme).Build()
+
 	defaulter := &IstioWebhook{
+		Client:        client,
 		DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
 		KubeClient:    fake.NewSimpleClientset(),
 		IstioClient:   istiofake.NewSimpleClientset(),
@@ -132,7 +144,7 @@ func TestHandleNoAppConfigOnwerReference(t *testing.T) {
 		Version:  "v1",
 		Resource: "deployments",
 	}
-	_, err := defaulter.DynamicClient.Resource(resource).Namespace("default").Create(context.TODO(), u, metav1.CreateOptions{})
+	_, err = defaulter.DynamicClient.Resource(resource).Namespace("default").Create(context.TODO(), u, metav1.CreateOptions{})
 	assert.NoError(t, err, "Unexpected error creating deployment")
 
 	u = newUnstructured("apps/v1", "ReplicaSet", "test-replicaSet")
@@ -720,6 +732,78 @@ func TestHandleProject1(t *testing.T) {
 	assert.Contains(t, authPolicy.Spec.GetRules()[0].From[0].Source.Principals, "cluster.local/ns/default/sa/test-appconfig")
 }
 
+// TestHandleServiceDiscoveryProject tests handling an admission.Request
+// GIVEN a IstioWebhook and an admission.Request
+// WHEN Handle is called with an admission.Request containing a pod resource with no parent appconfig owner reference
+//
+//	and a project that publishes services to the other clusters and matches the namespace of pod resource
+//
+// THEN Handle should return an Allowed response that enables the DNS proxy of the sidecar in the proxy configuration
+//
+//	annotation of the pod, keeping the existing proxy configuration
+func TestHandleServiceDiscoveryProject(t *testing.T) {
+
+	scheme := runtime.NewScheme()
+	err := cluv1alpha1.AddToScheme(scheme)
+	assert.NoError(t, err, "Unexpected error adding to scheme")
+	project := &cluv1alpha1.VerrazzanoProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-project",
+			Namespace: constants.VerrazzanoMultiClusterNamespace,
+		},
+		Spec: cluv1alpha1.VerrazzanoProjectSpec{
+			Template: cluv1alpha1.ProjectTemplate{
+				Namespaces: []cluv1alpha1.NamespaceTemplate{
+					{Metadata: metav1.ObjectMeta{
+						Name: "default",
+					}},
+				},
+				ServiceDiscovery: &cluv1alpha1.ServiceDiscoverySpec{},
+			},
+		},
+	}
+	client := ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(project).Build()
+
+	defaulter := &IstioWebhook{
+		Client:        client,
+		DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		KubeClient:    fake.NewSimpleClientset(),
+		IstioClient:   istiofake.NewSimpleClientset(),
+	}
+	decoder := decoder()
+	err = defaulter.InjectDecoder(decoder)
+	assert.NoError(t, err, "Unexpected error injecting decoder")
+
+	// Create a pod that already overrides the proxy configuration
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simple-pod",
+			Namespace: "default",
+			Annotations: map[string]string{
+				istioProxyConfigAnnotation: "holdApplicationUntilProxyStarts: true\n",
+			},
+		},
+	}
+	req := admission.Request{}
+	req.Namespace = "default"
+	marshaledPod, err := json.Marshal(p)
+	assert.NoError(t, err, "Unexpected error marshaling pod")
+	req.Object = runtime.RawExtension{Raw: marshaledPod}
+	res := defaulter.Handle(context.TODO(), req)
+	assert.True(t, res.Allowed)
+	assert.Len(t, res.Patches, 1)
+	assert.Equal(t, "/metadata/annotations/proxy.istio.io~1config", res.Patches[0].Path)
+	assert.Equal(t, "holdApplicationUntilProxyStarts: true\nproxyMetadata:\n  ISTIO_META_DNS_CAPTURE: \"true\"\n", res.Patches[0].Value)
+
+	// A pod in a namespace of a project that does not publish services is not mutated
+	project.Spec.Template.ServiceDiscovery = nil
+	err = client.Update(context.TODO(), project)
+	assert.NoError(t, err, "Unexpected error updating Verrazzano project")
+	res = defaulter.Handle(context.TODO(), req)
+	assert.True(t, res.Allowed)
+	assert.Empty(t, res.Patches)
+}
+
 // TestHandleProject2 tests handling an admission.Request
 // GIVEN a IstioWebhook and an admission.Request
 // WHEN Handle is called twice with an admission.Request containing a pod resource with a parent appconfig owner reference
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -229,20 +229,23 @@ func (a *WorkloadWebhook) updateMetricBinding(ctx context.Context, unst *unstruc
 		// nothing to update
 		return nil
 	}
-	// When the Prometheus target config map was not specified in the metrics template then there is nothing to do.
-	if reflect.DeepEqual(template.Spec.PrometheusConfig.TargetConfigMap, vzapp.TargetConfigMap{}) {
-		log.Infof("Prometheus target config map %s/%s not specified", template.Namespace, template.Name)
-		return nil
-	}
+	// When the metrics template generates a monitor, the metrics binding does not reference the Prometheus configuration
+	if template.Spec.MonitorConfig == nil {
+		// When the Prometheus target config map was not specified in the metrics template then there is nothing to do.
+		if reflect.DeepEqual(template.Spec.PrometheusConfig.TargetConfigMap, vzapp.TargetConfigMap{}) {
+			log.Infof("Prometheus target config map %s/%s not specified", template.Namespace, template.Name)
+			return nil
+		}
 
-	// Only look for the config map if it's not the legacy one. The legacy VMI config map will no longer exist, and be replaced
-	// with the additional scrape configs secret in the MetricsBinding, so don't look for it.
-	if !isLegacyVmiPrometheusConfigMapName(vzapp.NamespaceName{
-		Namespace: template.Spec.PrometheusConfig.TargetConfigMap.Namespace, Name: template.Spec.PrometheusConfig.TargetConfigMap.Name}) {
-		_, err := a.KubeClient.CoreV1().ConfigMaps(template.Spec.PrometheusConfig.TargetConfigMap.Namespace).Get(ctx, template.Spec.PrometheusConfig.TargetConfigMap.Name, metav1.GetOptions{})
-		if err != nil {
-			log.Errorf("Failed getting Prometheus target config map %s/%s: %v", template.Namespace, template.Name, err)
-			return err
+		// Only look for the config map if it's not the legacy one. The legacy VMI config map will no longer exist, and be replaced
+		// with the additional scrape configs secret in the MetricsBinding, so don't look for it.
+		if !isLegacyVmiPrometheusConfigMapName(vzapp.NamespaceName{
+			Namespace: template.Spec.PrometheusConfig.TargetConfigMap.Namespace, Name: template.Spec.PrometheusConfig.TargetConfigMap.Name}) {
+			_, err := a.KubeClient.CoreV1().ConfigMaps(template.Spec.PrometheusConfig.TargetConfigMap.Namespace).Get(ctx, template.Spec.PrometheusConfig.TargetConfigMap.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Errorf("Failed getting Prometheus target config map %s/%s: %v", template.Namespace, template.Name, err)
+				return err
+			}
 		}
 	}
 
@@ -273,11 +276,19 @@ func (a *WorkloadWebhook) updateMetricBinding(ctx context.Context, unst *unstruc
 func (a *WorkloadWebhook) mutateMetricsBinding(metricsBinding *vzapp.MetricsBinding, template *vzapp.MetricsTemplate, unst *unstructured.Unstructured) error {
 	metricsBinding.Spec.MetricsTemplate.Namespace = template.Namespace
 	metricsBinding.Spec.MetricsTemplate.Name = template.Name
-	metricsBinding.Spec.PrometheusConfigMap.Namespace = template.Spec.PrometheusConfig.TargetConfigMap.Namespace
-	metricsBinding.Spec.PrometheusConfigMap.Name = template.Spec.PrometheusConfig.TargetConfigMap.Name
 	metricsBinding.Spec.Workload.Name = unst.GetName()
 	metricsBinding.Spec.Workload.TypeMeta = metav1.TypeMeta{APIVersion: unst.GetAPIVersion(), Kind: unst.GetKind()}
 
+	// A metrics template that generates a monitor does not use the Prometheus configuration. The Prometheus
+	// configuration of an existing binding is left as is, the controller clears it once it has removed the
+	// legacy scrape job.
+	if template.Spec.MonitorConfig != nil {
+		return nil
+	}
+
+	metricsBinding.Spec.PrometheusConfigMap.Namespace = template.Spec.PrometheusConfig.TargetConfigMap.Namespace
+	metricsBinding.Spec.PrometheusConfigMap.Name = template.Spec.PrometheusConfig.TargetConfigMap.Name
+
 	// If the config map specified is the legacy VMI prometheus config map, modify it to use
 	// the additionalScrapeConfigs config map for the Prometheus Operator
 	if isLegacyVmiPrometheusConfigMapName(metricsBinding.Spec.PrometheusConfigMap) {
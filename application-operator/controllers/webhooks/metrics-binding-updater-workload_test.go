@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -902,6 +902,68 @@ func TestHandleNoConfigMap(t *testing.T) {
 	v.validateNoMetricsBinding(t)
 }
 
+// TestHandleMonitorConfig tests the handling of a workload resource which references a metrics template that
+// generates a monitor and that already has a MetricsBinding
+// GIVEN a call to the webhook Handle function
+// WHEN the metrics template has a monitor configuration and no Prometheus target config map
+// THEN the Handle function should succeed, the workload is labeled and the Prometheus configuration of the
+// MetricsBinding is left for the controller to migrate
+func TestHandleMonitorConfig(t *testing.T) {
+
+	v := newGeneratorWorkloadWebhook()
+
+	// Test data
+	v.createNamespace(t, testNS, map[string]string{vzconst.VerrazzanoManagedLabelKey: "true"}, nil)
+	testDeployment := appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       vzconst.DeploymentWorkloadKind,
+			APIVersion: appsv1APIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testDeploymentName,
+			Namespace: testNS,
+			Annotations: map[string]string{
+				MetricsAnnotation: testTemplateWorkloadNamespace,
+			},
+			UID: "11",
+		},
+	}
+	assert.NoError(t, v.Client.Create(context.TODO(), &testDeployment))
+	existingMetricsBinding := vzapp.MetricsBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generateMetricsBindingName(testDeployment.Name, testDeployment.APIVersion, testDeployment.Kind),
+			Namespace: testNS,
+		},
+		Spec: vzapp.MetricsBindingSpec{
+			PrometheusConfigMap: vzapp.NamespaceName{Namespace: testNS, Name: testConfigMapName},
+		},
+	}
+	assert.NoError(t, v.Client.Create(context.TODO(), &existingMetricsBinding))
+	testTemplate := vzapp.MetricsTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      testTemplateWorkloadNamespace,
+		},
+		Spec: vzapp.MetricsTemplateSpec{
+			MonitorConfig: &vzapp.MonitorConfig{Kind: vzapp.PodMonitorKind},
+		},
+	}
+	assert.NoError(t, v.Client.Create(context.TODO(), &testTemplate))
+
+	req := newGeneratorWorkloadRequest(admissionv1.Create, vzconst.DeploymentWorkloadKind, testDeployment)
+	res := v.Handle(context.TODO(), req)
+	assert.True(t, res.Allowed)
+	assert.Len(t, res.Patches, 1)
+	assert.Equal(t, "/metadata/labels", res.Patches[0].Path)
+	assert.Contains(t, res.Patches[0].Value, constants.MetricsWorkloadLabel)
+
+	metricsBinding := vzapp.MetricsBinding{}
+	assert.NoError(t, v.Client.Get(context.TODO(), types.NamespacedName{Namespace: testNS, Name: existingMetricsBinding.Name}, &metricsBinding))
+	assert.Equal(t, testTemplateWorkloadNamespace, metricsBinding.Spec.MetricsTemplate.Name)
+	// The legacy Prometheus configuration is kept for the controller, which removes the legacy scrape job
+	assert.Equal(t, vzapp.NamespaceName{Namespace: testNS, Name: testConfigMapName}, metricsBinding.Spec.PrometheusConfigMap)
+}
+
 // TestHandleNamespaceAnnotation tests the handling of a namespace that specifies a template
 // GIVEN a call to the webhook Handle function
 // WHEN the namespace has a metrics template reference
@@ -1,4 +1,4 @@
-// Copyright (c) 2020, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -51,10 +51,31 @@ func (m *MetricsTraitDefaulter) addDefaultTrait(component *oamv1.ApplicationConf
 	component.Traits = append(component.Traits, componentTrait)
 }
 
-// Default method adds default MetricsTrait to ApplicationConfiguration
+// validateMetricsTraitRules validates the recording and alerting rules of the metrics traits of a component
+func (m *MetricsTraitDefaulter) validateMetricsTraitRules(component *oamv1.ApplicationConfigurationComponent) error {
+	for _, trait := range component.Traits {
+		var metricsTrait v1alpha1.MetricsTrait
+		if err := json.Unmarshal(trait.Trait.Raw, &metricsTrait); err != nil {
+			continue
+		}
+		if metricsTrait.APIVersion != apiVersion || metricsTrait.Kind != v1alpha1.MetricsTraitKind {
+			continue
+		}
+		if err := metricstrait.ValidateRules(metricsTrait.Spec.Rules); err != nil {
+			return fmt.Errorf("component %s: %v", component.ComponentName, err)
+		}
+	}
+	return nil
+}
+
+// Default method validates the rules of existing MetricsTraits and adds a default MetricsTrait to ApplicationConfiguration
 func (m *MetricsTraitDefaulter) Default(appConfig *oamv1.ApplicationConfiguration, dryRun bool, log *zap.SugaredLogger) error {
 	for i := range appConfig.Spec.Components {
 		appConfigComponent := &appConfig.Spec.Components[i]
+		if err := m.validateMetricsTraitRules(appConfigComponent); err != nil {
+			log.Errorf("Invalid metrics trait in application configuration %s/%s: %v", appConfig.GetNamespace(), appConfig.GetName(), err)
+			return err
+		}
 		if m.shouldDefaultTraitBeAdded(appConfig, appConfigComponent, log) {
 			m.addDefaultTrait(appConfigComponent)
 		}
@@ -1,4 +1,4 @@
-// Copyright (c) 2020, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -15,6 +15,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/apis/oam/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/mocks"
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -46,6 +47,42 @@ func TestMetricsTraitDefaulter_Cleanup(t *testing.T) {
 	testMetricsTraitDefaulterCleanup(t, "bobs-conf-no-metrics.yaml", true)
 }
 
+// TestMetricsTraitDefaulter_InvalidRules tests validating the rules of a MetricsTrait in an appconfig
+// GIVEN a AppConfigDefaulter and an appconfig with a MetricsTrait that has an invalid rule
+// WHEN Default is called with the appconfig
+// THEN Default should return an error
+func TestMetricsTraitDefaulter_InvalidRules(t *testing.T) {
+	trait := v1alpha1.MetricsTrait{
+		TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: v1alpha1.MetricsTraitKind},
+		Spec: v1alpha1.MetricsTraitSpec{
+			Rules: []v1alpha1.MetricsRule{{Alert: "HighErrorRate", Expr: "sum(rate(errors_total[5m])"}},
+		},
+	}
+	rawTrait, err := json.Marshal(trait)
+	assert.NoError(t, err)
+	appConfig := &oamv1.ApplicationConfiguration{
+		Spec: oamv1.ApplicationConfigurationSpec{
+			Components: []oamv1.ApplicationConfigurationComponent{{
+				ComponentName: "hello-component",
+				Traits:        []oamv1.ComponentTrait{{Trait: runtime.RawExtension{Raw: rawTrait}}},
+			}},
+		},
+	}
+
+	defaulter := &MetricsTraitDefaulter{}
+	err = defaulter.Default(appConfig, false, zap.S())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "HighErrorRate")
+
+	// Fix the rule and expect no error and no additional default trait
+	trait.Spec.Rules[0].Expr = "sum(rate(errors_total[5m])) > 1"
+	rawTrait, err = json.Marshal(trait)
+	assert.NoError(t, err)
+	appConfig.Spec.Components[0].Traits[0].Trait.Raw = rawTrait
+	assert.NoError(t, defaulter.Default(appConfig, false, zap.S()))
+	assert.Len(t, appConfig.Spec.Components[0].Traits, 1)
+}
+
 func testDefaulter(t *testing.T, componentPath, configPath, workloadPath string, workloadSupported bool, initTraitsSize, expectedTraitsSize int) {
 	mocker := gomock.NewController(t)
 	mock := mocks.NewMockClient(mocker)
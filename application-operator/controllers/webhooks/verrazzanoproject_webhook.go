@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
 	"github.com/verrazzano/verrazzano/application-operator/metricsexporter"
 
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	k8sadmission "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -76,6 +79,14 @@ func validateVerrazzanoProject(c client.Client, vp *v1alpha1.VerrazzanoProject)
 		return err
 	}
 
+	if err := validateResourceLimits(vp); err != nil {
+		return err
+	}
+
+	if err := validateProjectSecurity(vp); err != nil {
+		return err
+	}
+
 	if err := validateNamespaceCanBeUsed(c, vp); err != nil {
 		return err
 	}
@@ -104,6 +115,73 @@ func validateNetworkPolicies(vp *v1alpha1.VerrazzanoProject) error {
 	return nil
 }
 
+// validateResourceLimits validates the resource quotas and limit ranges specified in the project
+func validateResourceLimits(vp *v1alpha1.VerrazzanoProject) error {
+	// Build the set of project namespaces for validation
+	nsSet := make(map[string]bool)
+	for _, ns := range vp.Spec.Template.Namespaces {
+		nsSet[ns.Metadata.Name] = true
+	}
+	validate := func(kind string, metadata metav1.ObjectMeta) error {
+		if metadata.Name == "" {
+			return fmt.Errorf("%s name must be provided", kind)
+		}
+		// The namespace is optional, the resource is created in every project namespace when it is not specified
+		if metadata.Namespace != "" && !nsSet[metadata.Namespace] {
+			return fmt.Errorf("namespace %s used in %s %s does not exist in project", metadata.Namespace, kind, metadata.Name)
+		}
+		return nil
+	}
+	for _, quotaTemplate := range vp.Spec.Template.ResourceQuotas {
+		if err := validate("ResourceQuota", quotaTemplate.Metadata); err != nil {
+			return err
+		}
+	}
+	for _, limitTemplate := range vp.Spec.Template.LimitRanges {
+		if err := validate("LimitRange", limitTemplate.Metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateProjectSecurity validates the Pod Security Admission levels and the Istio security configuration of the project
+func validateProjectSecurity(vp *v1alpha1.VerrazzanoProject) error {
+	if podSecurity := vp.Spec.Template.Security.PodSecurity; podSecurity != nil {
+		for _, level := range []v1alpha1.PodSecurityLevel{podSecurity.Enforce, podSecurity.Audit, podSecurity.Warn} {
+			switch level {
+			case "", v1alpha1.PodSecurityPrivileged, v1alpha1.PodSecurityBaseline, v1alpha1.PodSecurityRestricted:
+			default:
+				return fmt.Errorf("invalid Pod Security level %q, must be one of %s, %s or %s", level,
+					v1alpha1.PodSecurityPrivileged, v1alpha1.PodSecurityBaseline, v1alpha1.PodSecurityRestricted)
+			}
+		}
+	}
+
+	istioSecurity := vp.Spec.Template.Security.Istio
+	if istioSecurity == nil {
+		return nil
+	}
+	switch istioSecurity.MTLSMode {
+	case "", v1alpha1.MTLSModeStrict, v1alpha1.MTLSModePermissive:
+	default:
+		return fmt.Errorf("invalid mTLS mode %q, must be %s or %s", istioSecurity.MTLSMode, v1alpha1.MTLSModeStrict, v1alpha1.MTLSModePermissive)
+	}
+	// The source namespace of a request is only known when mTLS is used, so a default deny policy needs strict mTLS
+	if istioSecurity.DefaultDeny && istioSecurity.MTLSMode != v1alpha1.MTLSModeStrict {
+		return fmt.Errorf("the %s mTLS mode is required when defaultDeny is true", v1alpha1.MTLSModeStrict)
+	}
+	if len(istioSecurity.AllowedNamespaces) > 0 && !istioSecurity.DefaultDeny {
+		return fmt.Errorf("allowedNamespaces can only be specified when defaultDeny is true")
+	}
+	for _, ns := range istioSecurity.AllowedNamespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("allowed namespace %q is not a valid namespace name: %s", ns, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
 func validateNamespaceCanBeUsed(c client.Client, vp *v1alpha1.VerrazzanoProject) error {
 	projectsList := &v1alpha1.VerrazzanoProjectList{}
 	listOptions := &client.ListOptions{Namespace: constants.VerrazzanoMultiClusterNamespace}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package webhooks
@@ -162,6 +162,98 @@ func TestNetworkPolicyMissingNamespace(t *testing.T) {
 	asrt.Containsf(res.Result.Reason, "namespace ns1 used in NetworkPolicy net1 does not exist in project", "Error validating VerrazzanProject with NetworkPolicyTemplate")
 }
 
+// TestResourceLimitsValidation tests the validation of VerrazzanoProject ResourceQuotaTemplate and LimitRangeTemplate
+// GIVEN a call validate VerrazzanoProject on create
+// WHEN the VerrazzanoProject has resource quota and limit range templates
+// THEN the validation should fail if a template has no name or uses a namespace that does not exist in the project
+func TestResourceLimitsValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		quotaMeta      metav1.ObjectMeta
+		limitMeta      metav1.ObjectMeta
+		expectedReason string
+	}{
+		{"all namespaces", metav1.ObjectMeta{Name: "quota"}, metav1.ObjectMeta{Name: "limits"}, ""},
+		{"project namespace", metav1.ObjectMeta{Name: "quota", Namespace: "ns1"}, metav1.ObjectMeta{Name: "limits", Namespace: "ns1"}, ""},
+		{"missing quota name", metav1.ObjectMeta{}, metav1.ObjectMeta{Name: "limits"}, "ResourceQuota name must be provided"},
+		{"limit range namespace not in project", metav1.ObjectMeta{Name: "quota"}, metav1.ObjectMeta{Name: "limits", Namespace: "other"},
+			"namespace other used in LimitRange limits does not exist in project"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asrt := assert.New(t)
+			v := newVerrazzanoProjectValidator()
+
+			// Test data
+			testVP := testNetworkPolicy.DeepCopy()
+			testVP.Spec.Template.Namespaces = []v1alpha12.NamespaceTemplate{{Metadata: metav1.ObjectMeta{Name: "ns1"}}}
+			testVP.Spec.Template.NetworkPolicies = nil
+			testVP.Spec.Template.ResourceQuotas = []v1alpha12.ResourceQuotaTemplate{{Metadata: tt.quotaMeta}}
+			testVP.Spec.Template.LimitRanges = []v1alpha12.LimitRangeTemplate{{Metadata: tt.limitMeta}}
+			testMC := testManagedCluster
+			asrt.NoError(v.client.Create(context.TODO(), &testMC))
+
+			req := newAdmissionRequest(admissionv1.Create, testVP)
+			res := v.Handle(context.TODO(), req)
+			if tt.expectedReason == "" {
+				asrt.True(res.Allowed, "Error validating VerrazzanoProject with resource limits")
+				return
+			}
+			asrt.False(res.Allowed, "Expected project validation to fail for invalid resource limits")
+			asrt.Contains(res.Result.Reason, tt.expectedReason)
+		})
+	}
+}
+
+// TestProjectSecurityValidation tests the validation of the VerrazzanoProject security configuration
+// GIVEN a call validate VerrazzanoProject on create
+// WHEN the VerrazzanoProject has Pod Security levels and an Istio security configuration
+// THEN the validation should fail for invalid levels, invalid mTLS modes and default deny without strict mTLS
+func TestProjectSecurityValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		security       v1alpha12.SecuritySpec
+		expectedReason string
+	}{
+		{"valid", v1alpha12.SecuritySpec{
+			PodSecurity: &v1alpha12.PodSecuritySpec{Enforce: v1alpha12.PodSecurityBaseline, Warn: v1alpha12.PodSecurityRestricted},
+			Istio:       &v1alpha12.IstioSecuritySpec{MTLSMode: v1alpha12.MTLSModeStrict, DefaultDeny: true, AllowedNamespaces: []string{"shared"}},
+		}, ""},
+		{"permissive without default deny", v1alpha12.SecuritySpec{Istio: &v1alpha12.IstioSecuritySpec{MTLSMode: v1alpha12.MTLSModePermissive}}, ""},
+		{"invalid level", v1alpha12.SecuritySpec{PodSecurity: &v1alpha12.PodSecuritySpec{Audit: "strict"}}, `invalid Pod Security level "strict"`},
+		{"invalid mTLS mode", v1alpha12.SecuritySpec{Istio: &v1alpha12.IstioSecuritySpec{MTLSMode: "DISABLE"}}, `invalid mTLS mode "DISABLE"`},
+		{"default deny without strict mTLS", v1alpha12.SecuritySpec{Istio: &v1alpha12.IstioSecuritySpec{MTLSMode: v1alpha12.MTLSModePermissive, DefaultDeny: true}},
+			"the STRICT mTLS mode is required when defaultDeny is true"},
+		{"allowed namespaces without default deny", v1alpha12.SecuritySpec{Istio: &v1alpha12.IstioSecuritySpec{AllowedNamespaces: []string{"shared"}}},
+			"allowedNamespaces can only be specified when defaultDeny is true"},
+		{"invalid allowed namespace", v1alpha12.SecuritySpec{Istio: &v1alpha12.IstioSecuritySpec{MTLSMode: v1alpha12.MTLSModeStrict, DefaultDeny: true, AllowedNamespaces: []string{"Not_Valid"}}},
+			`allowed namespace "Not_Valid" is not a valid namespace name`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asrt := assert.New(t)
+			v := newVerrazzanoProjectValidator()
+
+			// Test data
+			testVP := testNetworkPolicy.DeepCopy()
+			testVP.Spec.Template.Namespaces = []v1alpha12.NamespaceTemplate{{Metadata: metav1.ObjectMeta{Name: "ns1"}}}
+			testVP.Spec.Template.NetworkPolicies = nil
+			testVP.Spec.Template.Security = tt.security
+			testMC := testManagedCluster
+			asrt.NoError(v.client.Create(context.TODO(), &testMC))
+
+			req := newAdmissionRequest(admissionv1.Create, testVP)
+			res := v.Handle(context.TODO(), req)
+			if tt.expectedReason == "" {
+				asrt.True(res.Allowed, "Error validating VerrazzanoProject with security configuration")
+				return
+			}
+			asrt.False(res.Allowed, "Expected project validation to fail for invalid security configuration")
+			asrt.Contains(res.Result.Reason, tt.expectedReason)
+		})
+	}
+}
+
 // TestNamespaceUniquenessForProjects tests that the namespace of a VerrazzanoProject N does not conflict with a preexisting project
 // GIVEN a call validate VerrazzanoProject on create or update
 // WHEN the VerrazzanoProject has a a namespace that conflicts with any pre-existing projects
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metrics
@@ -62,6 +62,19 @@ func PopulateServiceMonitor(info ScrapeInfo, serviceMonitor *promoperapi.Service
 	return nil
 }
 
+// ClusterNameRelabelConfig returns the relabel config that sets the Verrazzano cluster name label on scraped metrics.
+// The default cluster name is used if the cluster name is empty.
+func ClusterNameRelabelConfig(clusterName string) *promoperapi.RelabelConfig {
+	if clusterName == "" {
+		clusterName = constants.DefaultClusterName
+	}
+	return &promoperapi.RelabelConfig{
+		Action:      "replace",
+		Replacement: clusterName,
+		TargetLabel: prometheusClusterNameLabel,
+	}
+}
+
 // createServiceMonitorEndpoint creates an endpoint for a given port increment and info
 // this function effectively creates a scrape config for the workload target through the Service Monitor API
 func createServiceMonitorEndpoint(info ScrapeInfo, portIncrement int) (promoperapi.Endpoint, error) {
@@ -120,17 +133,8 @@ func createServiceMonitorEndpoint(info ScrapeInfo, portIncrement int) (promopera
 		pathLabel = fmt.Sprintf("__meta_kubernetes_pod_annotation_verrazzano_io_metricsPath%s", portString)
 	}
 
-	// Add default cluster name if not populated
-	if info.ClusterName == "" {
-		info.ClusterName = constants.DefaultClusterName
-	}
-
 	// Relabel the cluster name
-	endpoint.RelabelConfigs = append(endpoint.RelabelConfigs, &promoperapi.RelabelConfig{
-		Action:      "replace",
-		Replacement: info.ClusterName,
-		TargetLabel: prometheusClusterNameLabel,
-	})
+	endpoint.RelabelConfigs = append(endpoint.RelabelConfigs, ClusterNameRelabelConfig(info.ClusterName))
 
 	// Relabel to match the expected labels
 	regexString := "true"
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operatorinit
@@ -10,6 +10,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/multiclusterapplicationconfiguration"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/multiclustercomponent"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/multiclusterconfigmap"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/multiclustergitsource"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/multiclustersecret"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters/verrazzanoproject"
 	"github.com/verrazzano/verrazzano/application-operator/mcagent"
@@ -69,6 +70,7 @@ func setupClusterAgentReconciler(mgr manager.Manager, log *zap.SugaredLogger) (c
 		Log:          log.With(vzlog.FieldAgent, "multi-cluster"),
 		Scheme:       mgr.GetScheme(),
 		AgentChannel: agentChannel,
+		Recorder:     mgr.GetEventRecorderFor("verrazzano-cluster-agent"),
 	}).SetupWithManager(mgr); err != nil {
 		log.Errorf("Failed to create managed cluster agent controller: %v", err)
 		return nil, err
@@ -124,5 +126,13 @@ func setupMulticlusterReconcilers(mgr manager.Manager, agentChannel chan cluster
 		log.Errorf("Failed to create %s controller %v", clustersv1alpha1.VerrazzanoProjectKind, err)
 		return err
 	}
+	if err := (&multiclustergitsource.Reconciler{
+		Client: mgr.GetClient(),
+		Log:    log,
+		Scheme: scheme,
+	}).SetupWithManager(mgr); err != nil {
+		log.Errorf("Failed to create %s controller: %v", clustersv1alpha1.MultiClusterGitSourceKind, err)
+		return err
+	}
 	return nil
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operatorinit
@@ -10,6 +10,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/controllers/helidonworkload"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/ingresstrait"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/loggingtrait"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/logroute"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/metricsbinding"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/metricstrait"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/namespace"
@@ -163,5 +164,13 @@ func setupAppReconcilers(mgr manager.Manager, defaultMetricsScraper string, log
 		log.Errorf("Failed to create MetricsBinding controller: %v", err)
 		return err
 	}
+	if err = (&logroute.Reconciler{
+		Client: mgr.GetClient(),
+		Log:    log,
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		log.Errorf("Failed to create LogRoute controller: %v", err)
+		return err
+	}
 	return nil
 }
@@ -1,10 +1,11 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -22,9 +23,11 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/mcconstants"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -49,6 +52,19 @@ type Reconciler struct {
 	Log          *zap.SugaredLogger
 	Scheme       *runtime.Scheme
 	AgentChannel chan clusters.StatusUpdateMessage
+	Recorder     record.EventRecorder
+
+	// Synchronization times of the multicluster resource kinds, kept across agent iterations
+	syncTimes *syncTimes
+
+	// The agent cache, loaded from its config map on the first agent iteration
+	cache *agentCache
+
+	// Drift of the resources of the multicluster resources, kept across agent iterations
+	drift driftState
+
+	// The number of consecutive agent iterations that failed because the admin cluster was unavailable
+	adminFailures int
 }
 
 // SetupWithManager registers our controller with the manager
@@ -103,6 +119,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Process one iteration of the agent thread
 	err := r.doReconcile(ctx, agentSecret)
+	if errors.As(err, &adminUnavailableError{}) {
+		// Back off while the admin cluster is unavailable
+		r.adminFailures++
+		adminConsecutiveFailuresMetric.Set(float64(r.adminFailures))
+		r.Log.Errorf("failed processing multi-cluster resources, attempt %d: %v", r.adminFailures, err)
+		return newAdminBackoffResult(r.adminFailures), nil
+	}
+	r.adminFailures = 0
+	adminConsecutiveFailuresMetric.Set(0)
 	if err != nil {
 		r.Log.Errorf("failed processing multi-cluster resources: %v", err)
 	}
@@ -119,6 +144,21 @@ func (r *Reconciler) doReconcile(ctx context.Context, agentSecret corev1.Secret)
 		return fmt.Errorf("failed to get discovery client for this workload cluster: %v", err)
 	}
 
+	if r.syncTimes == nil {
+		r.syncTimes = newSyncTimes()
+	}
+	if r.drift == nil {
+		r.drift = driftState{}
+	}
+	if r.cache == nil {
+		cache, err := r.loadAgentCache(ctx)
+		if err != nil {
+			return err
+		}
+		cache.restoreSyncTimes(r.syncTimes)
+		r.cache = cache
+	}
+
 	// Initialize the syncer object
 	s := &Syncer{
 		LocalClient:          r.Client,
@@ -128,6 +168,12 @@ func (r *Reconciler) doReconcile(ctx context.Context, agentSecret corev1.Secret)
 		ProjectNamespaces:    []string{},
 		StatusUpdateChannel:  r.AgentChannel,
 		ManagedClusterName:   managedClusterName,
+		AgentCredential:      string(agentSecret.Data[mcconstants.AgentCredentialKey]),
+		syncTimes:            r.syncTimes,
+		statusQueue:          &r.cache.statusQueue,
+		adminResources:       r.cache.syncState.AdminResources,
+		Recorder:             r.Recorder,
+		drift:                r.drift,
 	}
 
 	// Read current agent state from config map
@@ -143,6 +189,9 @@ func (r *Reconciler) doReconcile(ctx context.Context, agentSecret corev1.Secret)
 	if apierrors.IsUnauthorized(err) {
 		return s.syncDeregistration()
 	}
+	if isAdminUnavailable(err) {
+		return r.handleAdminUnavailable(ctx, s, fmt.Errorf("failed to get the client for cluster %q with error %v", managedClusterName, err))
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get the client for cluster %q with error %v", managedClusterName, err)
 	}
@@ -169,10 +218,15 @@ func (r *Reconciler) doReconcile(ctx context.Context, agentSecret corev1.Secret)
 	// Update the status of our VMC on the admin cluster to record the last time we connected
 	// and update other fields of in the VMC status
 	err = s.updateVMCStatus()
+	if isAdminUnavailable(err) {
+		return r.handleAdminUnavailable(ctx, s, err)
+	}
 	if err != nil {
 		// we couldn't update status of the VMC - but we should keep going with the rest of the work
 		r.Log.Errorf("Failed to update VMC status on admin cluster: %v", err)
 	}
+	now := metav1.Now()
+	r.cache.syncState.LastAdminContact = &now
 
 	// Sync multi-cluster objects
 	s.SyncMultiClusterResources()
@@ -191,9 +245,25 @@ func (r *Reconciler) doReconcile(ctx context.Context, agentSecret corev1.Secret)
 		r.Log.Errorf("Failed to synchronize cluster CA certificates: %v", err)
 	}
 
+	if err := r.saveAgentCache(ctx, r.syncTimes); err != nil {
+		r.Log.Errorf("Failed to save the agent cache: %v", err)
+	}
 	return nil
 }
 
+// handleAdminUnavailable keeps the status updates received while the admin cluster is unavailable in the agent cache,
+// so that they are sent once the admin cluster is reachable again
+func (r *Reconciler) handleAdminUnavailable(ctx context.Context, s *Syncer, err error) error {
+	adminUnavailableMetric.Inc()
+	r.Log.Infof("Keeping the %d resources last synced from the admin cluster until it is available", r.cache.syncState.AdminResources.count())
+	s.queueStatusUpdates()
+	s.updateSyncMetrics(nil)
+	if err := r.saveAgentCache(ctx, r.syncTimes); err != nil {
+		r.Log.Errorf("Failed to save the agent cache: %v", err)
+	}
+	return adminUnavailableError{err: err}
+}
+
 // updateMCAgentStateConfigMap updates the managed cluster name and cattle agent hash in the
 // agent state config map if those have changed from what was there before
 func (r *Reconciler) updateMCAgentStateConfigMap(ctx context.Context, managedClusterName string, cattleAgentHashValue string) error {
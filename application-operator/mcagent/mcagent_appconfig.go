@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -33,8 +33,10 @@ func (s *Syncer) syncMCApplicationConfigurationObjects(namespace string) error {
 		return err
 	}
 
+	synced := []adminResource{}
 	for _, mcAppConfig := range allAdminMCAppConfigs.Items {
 		if s.isThisCluster(mcAppConfig.Spec.Placement) {
+			synced = append(synced, newAdminResource(&mcAppConfig))
 			// Synchronize the components referenced by the application
 			err := s.syncComponentList(mcAppConfig)
 			if err != nil {
@@ -46,14 +48,18 @@ func (s *Syncer) syncMCApplicationConfigurationObjects(namespace string) error {
 			// handling the application components.  For compatibility with v1.0.0 it is valid
 			// for none of the OAM Components to be found because they may all be wrapped in
 			// an MultiClusterComponent resource.
-			_, err = s.createOrUpdateMCAppConfig(mcAppConfig)
+			opResult, err := s.createOrUpdateMCAppConfig(mcAppConfig)
 			if err != nil {
 				s.Log.Errorw(fmt.Sprintf("Failed syncing object: %c", err),
 					"MultiClusterApplicationConfiguration",
 					types.NamespacedName{Namespace: mcAppConfig.Namespace, Name: mcAppConfig.Name})
+			} else if opResult == controllerutil.OperationResultNone {
+				// The multicluster resource is unchanged, check that its resource still matches the template
+				s.checkMCAppConfigDrift(mcAppConfig)
 			}
 		}
 	}
+	s.adminResources.record(kindMCApplicationConfig, namespace, synced)
 
 	// Delete orphaned MultiClusterApplicationConfiguration resources.
 	// Get the list of MultiClusterApplicationConfiguration resources on the
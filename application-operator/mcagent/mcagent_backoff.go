@@ -0,0 +1,70 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"errors"
+	"net"
+
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// The maximum requeue delay while the admin cluster is unavailable
+	adminBackoffMaxSeconds = 900
+	// The requeue delay is randomized by this percentage, so that the agents of the managed clusters
+	// do not all retry at the same time when the admin cluster comes back
+	adminBackoffJitterPercent = 20
+)
+
+// adminUnavailableError is returned by an agent iteration that could not reach the admin cluster
+type adminUnavailableError struct {
+	err error
+}
+
+func (e adminUnavailableError) Error() string {
+	return "the admin cluster is unavailable: " + e.err.Error()
+}
+
+func (e adminUnavailableError) Unwrap() error {
+	return e.err
+}
+
+// isAdminUnavailable returns true if the error is caused by the admin cluster being overloaded or rate limiting the
+// agent, or by a connection to it that was refused, reset or timed out, in which case the request should be retried
+// later. Other errors, like an admin API server address that does not resolve, an invalid registration secret or an
+// untrusted certificate, are not fixed by waiting and are not reported as unavailable.
+func isAdminUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.As(err, &adminUnavailableError{}) {
+		return true
+	}
+	if apierrors.IsServiceUnavailable(err) || apierrors.IsTooManyRequests(err) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return utilnet.IsConnectionRefused(err) || utilnet.IsConnectionReset(err)
+}
+
+// newAdminBackoffResult returns the requeue result after consecutive failures to reach the admin cluster.
+// The delay doubles with each failure, starting from the regular agent cadence, up to adminBackoffMaxSeconds.
+func newAdminBackoffResult(failures int) reconcile.Result {
+	delay := (requeueDelayMinSeconds + requeueDelayMaxSeconds) / 2
+	for i := 0; i < failures && delay < adminBackoffMaxSeconds; i++ {
+		delay *= 2
+	}
+	if delay > adminBackoffMaxSeconds {
+		delay = adminBackoffMaxSeconds
+	}
+	jitter := delay * adminBackoffJitterPercent / 100
+	return clusters.NewRequeueWithRandomDelay(delay-jitter, delay+jitter)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	asserts "github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TestIsAdminUnavailable tests the classification of admin cluster errors
+// GIVEN errors returned by the admin cluster
+// WHEN isAdminUnavailable is called
+// THEN only refused, reset or timed out connections and an overloaded admin cluster are reported as unavailable
+func TestIsAdminUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"no error", nil, false},
+		{"not found", errors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "secret"), false},
+		{"conflict", errors.NewConflict(schema.GroupResource{Resource: "secrets"}, "secret", fmt.Errorf("conflict")), false},
+		{"service unavailable", errors.NewServiceUnavailable("unavailable"), true},
+		{"too many requests", errors.NewTooManyRequests("slow down", 10), true},
+		{"server timeout", errors.NewServerTimeout(schema.GroupResource{Resource: "secrets"}, "get", 10), true},
+		{"connection refused", &url.Error{Op: "Get", URL: "https://admin:6443", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}, true},
+		{"connection reset", &url.Error{Op: "Get", URL: "https://admin:6443", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true},
+		{"unknown host", &url.Error{Op: "Get", URL: "https://admin:6443", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "admin", IsNotFound: true}}}, false},
+		{"network unreachable", &url.Error{Op: "Get", URL: "https://admin:6443", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ENETUNREACH)}}, false},
+		{"timeout", &url.Error{Op: "Get", URL: "https://admin:6443", Err: testTimeoutError{}}, true},
+		{"untrusted certificate", &url.Error{Op: "Get", URL: "https://admin:6443", Err: x509.UnknownAuthorityError{}}, false},
+		{"invalid registration secret", fmt.Errorf("failed to create the admin client: %w", fmt.Errorf("invalid kubeconfig")), false},
+		{"agent iteration", adminUnavailableError{err: fmt.Errorf("failed to get the client")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asserts.Equal(t, tt.want, isAdminUnavailable(tt.err))
+		})
+	}
+}
+
+// testTimeoutError is a network error that timed out
+type testTimeoutError struct{}
+
+func (testTimeoutError) Error() string   { return "i/o timeout" }
+func (testTimeoutError) Timeout() bool   { return true }
+func (testTimeoutError) Temporary() bool { return true }
+
+// TestNewAdminBackoffResult tests the requeue delay while the admin cluster is unavailable
+// GIVEN consecutive failures to reach the admin cluster
+// WHEN the requeue result is computed
+// THEN the delay grows exponentially with jitter, up to the maximum delay
+func TestNewAdminBackoffResult(t *testing.T) {
+	base := (requeueDelayMinSeconds + requeueDelayMaxSeconds) / 2
+	tests := []struct {
+		failures int
+		delay    int
+	}{
+		{1, base * 2},
+		{2, base * 4},
+		{3, base * 8},
+		{10, adminBackoffMaxSeconds},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d failures", tt.failures), func(t *testing.T) {
+			result := newAdminBackoffResult(tt.failures)
+			jitter := tt.delay * adminBackoffJitterPercent / 100
+			asserts.True(t, result.Requeue)
+			asserts.GreaterOrEqual(t, result.RequeueAfter, time.Duration(tt.delay-jitter)*time.Second)
+			asserts.LessOrEqual(t, result.RequeueAfter, time.Duration(tt.delay+jitter)*time.Second)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Name of the config map that caches the synchronization state of the agent
+var mcAgentCacheConfigMapName = types.NamespacedName{Name: "mc-agent-cache", Namespace: constants.VerrazzanoMultiClusterNamespace}
+
+const (
+	syncStateCacheKey     = "sync-state"
+	statusUpdatesCacheKey = "status-updates"
+	// The minimum interval between writes of the cache when only the synchronization times have changed
+	cachePersistInterval = 5 * time.Minute
+)
+
+// syncState is the synchronization state of the agent, including the admin resources last synced to this cluster.
+// Orphaned local copies are only deleted after the admin resources have been listed, so the local copies stay in place
+// and keep being reconciled while the admin cluster is unavailable.
+type syncState struct {
+	// The last time the agent reached the admin cluster
+	LastAdminContact *v1.Time `json:"lastAdminContact,omitempty"`
+	// The last successful synchronization time of each multicluster resource kind
+	LastSync map[string]v1.Time `json:"lastSync,omitempty"`
+	// The admin resources of each multicluster resource kind placed on this cluster at the last synchronization
+	AdminResources adminResources `json:"adminResources,omitempty"`
+}
+
+// adminResources are the admin resources placed on this cluster, by multicluster resource kind
+type adminResources map[string][]adminResource
+
+// adminResource is an admin resource placed on this cluster, and the version of it that was last synced
+type adminResource struct {
+	Namespace       string `json:"namespace"`
+	Name            string `json:"name"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// newAdminResource returns the synced state of an admin resource
+func newAdminResource(obj v1.Object) adminResource {
+	return adminResource{Namespace: obj.GetNamespace(), Name: obj.GetName(), ResourceVersion: obj.GetResourceVersion()}
+}
+
+// record replaces the admin resources of a kind in a namespace with the ones listed by the last synchronization
+func (a adminResources) record(kind string, namespace string, resources []adminResource) {
+	if a == nil {
+		return
+	}
+	recorded := []adminResource{}
+	for _, resource := range a[kind] {
+		if resource.Namespace != namespace {
+			recorded = append(recorded, resource)
+		}
+	}
+	recorded = append(recorded, resources...)
+	sort.Slice(recorded, func(i, j int) bool {
+		if recorded[i].Namespace != recorded[j].Namespace {
+			return recorded[i].Namespace < recorded[j].Namespace
+		}
+		return recorded[i].Name < recorded[j].Name
+	})
+	if len(recorded) == 0 {
+		delete(a, kind)
+		return
+	}
+	a[kind] = recorded
+}
+
+// count returns the number of admin resources of all kinds
+func (a adminResources) count() int {
+	count := 0
+	for _, resources := range a {
+		count += len(resources)
+	}
+	return count
+}
+
+// agentCache keeps the synchronization state and the status updates that have not been sent
+// to the admin cluster, in a config map on the managed cluster, so that they survive agent restarts during admin
+// cluster outages
+type agentCache struct {
+	syncState   syncState
+	statusQueue statusUpdateQueue
+
+	// The admin resources and status updates last written to the config map, and the time they were written
+	persistedResources string
+	persistedUpdates   string
+	persistTime        time.Time
+}
+
+// loadAgentCache reads the agent cache from the config map
+func (r *Reconciler) loadAgentCache(ctx context.Context) (*agentCache, error) {
+	cache := &agentCache{syncState: syncState{AdminResources: adminResources{}}}
+	cm := corev1.ConfigMap{}
+	err := r.Get(ctx, mcAgentCacheConfigMapName, &cm)
+	if apierrors.IsNotFound(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the agent cache config map %v: %v", mcAgentCacheConfigMapName, err)
+	}
+	if data, ok := cm.Data[syncStateCacheKey]; ok {
+		if err := json.Unmarshal([]byte(data), &cache.syncState); err != nil {
+			r.Log.Errorf("Ignoring the invalid sync state in the agent cache: %v", err)
+		}
+	}
+	if data, ok := cm.Data[statusUpdatesCacheKey]; ok {
+		if err := json.Unmarshal([]byte(data), &cache.statusQueue.updates); err != nil {
+			r.Log.Errorf("Ignoring the invalid status updates in the agent cache: %v", err)
+		}
+		cache.persistedUpdates = data
+	}
+	if cache.syncState.AdminResources == nil {
+		cache.syncState.AdminResources = adminResources{}
+	}
+	if data, err := json.Marshal(cache.syncState.AdminResources); err == nil {
+		cache.persistedResources = string(data)
+	}
+	r.Log.Infof("Loaded the agent cache with %d synced admin resources and %d pending status updates", cache.syncState.AdminResources.count(), cache.statusQueue.len())
+	return cache, nil
+}
+
+// restoreSyncTimes restores the synchronization times from the agent cache, so the sync lag is reported from the last
+// synchronization before the agent restarted
+func (c *agentCache) restoreSyncTimes(times *syncTimes) {
+	for kind, lastSync := range c.syncState.LastSync {
+		times.lastSync[kind] = lastSync
+	}
+}
+
+// saveAgentCache writes the agent cache to the config map. To limit writes, the cache is only written when the synced
+// admin resources or the pending status updates have changed, or when cachePersistInterval has elapsed since the last write.
+func (r *Reconciler) saveAgentCache(ctx context.Context, times *syncTimes) error {
+	cache := r.cache
+	if times != nil && len(times.lastSync) > 0 {
+		cache.syncState.LastSync = map[string]v1.Time{}
+		for kind, lastSync := range times.lastSync {
+			cache.syncState.LastSync[kind] = lastSync
+		}
+	}
+	updates := "[]"
+	if cache.statusQueue.len() > 0 {
+		data, err := json.Marshal(cache.statusQueue.updates)
+		if err != nil {
+			return err
+		}
+		updates = string(data)
+	}
+	resources, err := json.Marshal(cache.syncState.AdminResources)
+	if err != nil {
+		return err
+	}
+	if string(resources) == cache.persistedResources && updates == cache.persistedUpdates && time.Since(cache.persistTime) < cachePersistInterval {
+		return nil
+	}
+	state, err := json.Marshal(cache.syncState)
+	if err != nil {
+		return err
+	}
+
+	cm := corev1.ConfigMap{}
+	cm.Name = mcAgentCacheConfigMapName.Name
+	cm.Namespace = mcAgentCacheConfigMapName.Namespace
+	data := map[string]string{
+		syncStateCacheKey:     string(state),
+		statusUpdatesCacheKey: updates,
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, &cm, func() error {
+		cm.Data = data
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update the agent cache config map %v: %v", mcAgentCacheConfigMapName, err)
+	}
+	cache.persistedResources = string(resources)
+	cache.persistedUpdates = updates
+	cache.persistTime = time.Now()
+	return nil
+}
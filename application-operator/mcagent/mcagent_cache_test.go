@@ -0,0 +1,93 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	asserts "github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestAgentCache tests saving and loading the agent cache
+// GIVEN an agent cache with pending status updates, synchronization times and synced admin resources
+// WHEN the cache is saved and loaded by a new agent
+// THEN the pending status updates, synchronization times and synced admin resources are restored
+func TestAgentCache(t *testing.T) {
+	assert := asserts.New(t)
+	cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+	r := &Reconciler{Client: cli, Log: zap.S()}
+
+	// A new agent starts with an empty cache
+	cache, err := r.loadAgentCache(context.TODO())
+	assert.NoError(err)
+	assert.Equal(0, cache.statusQueue.len())
+	r.cache = cache
+
+	lastSync := v1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	times := newSyncTimes()
+	times.lastSync[kindMCSecret] = lastSync
+	r.cache.statusQueue.push(queuedStatusUpdate{Kind: kindMCSecret, Namespace: "ns", Name: "secret"})
+	assert.NoError(r.saveAgentCache(context.TODO(), times))
+	cm := corev1.ConfigMap{}
+	assert.NoError(cli.Get(context.TODO(), mcAgentCacheConfigMapName, &cm))
+	assert.Contains(cm.Data[statusUpdatesCacheKey], "secret")
+
+	// The cache is written again when the synced admin resources change
+	r.cache.syncState.AdminResources.record(kindMCConfigMap, "ns", []adminResource{{Namespace: "ns", Name: "config", ResourceVersion: "5"}})
+	assert.NoError(r.saveAgentCache(context.TODO(), times))
+	assert.NoError(cli.Get(context.TODO(), mcAgentCacheConfigMapName, &cm))
+	assert.Contains(cm.Data[syncStateCacheKey], "config")
+
+	// Another agent restores the cache
+	r2 := &Reconciler{Client: cli, Log: zap.S()}
+	cache, err = r2.loadAgentCache(context.TODO())
+	assert.NoError(err)
+	assert.Equal(1, cache.statusQueue.len())
+	update, _ := cache.statusQueue.peek()
+	assert.Equal(kindMCSecret, update.Kind)
+	restored := newSyncTimes()
+	cache.restoreSyncTimes(restored)
+	assert.True(lastSync.Equal(&v1.Time{Time: restored.lastSync[kindMCSecret].Time}))
+	assert.Equal([]adminResource{{Namespace: "ns", Name: "config", ResourceVersion: "5"}}, cache.syncState.AdminResources[kindMCConfigMap])
+
+	// The cache is written again once the status updates have been sent
+	r2.cache = cache
+	r2.cache.statusQueue.pop()
+	assert.NoError(r2.saveAgentCache(context.TODO(), restored))
+	assert.NoError(cli.Get(context.TODO(), mcAgentCacheConfigMapName, &cm))
+	assert.Equal("[]", cm.Data[statusUpdatesCacheKey])
+}
+
+// TestRecordAdminResources tests recording the synced admin resources
+// GIVEN admin resources synced from several namespaces
+// WHEN the admin resources of a namespace are synced again
+// THEN only the admin resources of that namespace are replaced
+func TestRecordAdminResources(t *testing.T) {
+	assert := asserts.New(t)
+	resources := adminResources{}
+	resources.record(kindMCSecret, "ns1", []adminResource{{Namespace: "ns1", Name: "b"}, {Namespace: "ns1", Name: "a"}})
+	resources.record(kindMCSecret, "ns2", []adminResource{{Namespace: "ns2", Name: "c"}})
+	assert.Equal([]adminResource{{Namespace: "ns1", Name: "a"}, {Namespace: "ns1", Name: "b"}, {Namespace: "ns2", Name: "c"}}, resources[kindMCSecret])
+	assert.Equal(3, resources.count())
+
+	// A resource that is no longer placed on this cluster is removed
+	resources.record(kindMCSecret, "ns1", []adminResource{{Namespace: "ns1", Name: "a", ResourceVersion: "2"}})
+	assert.Equal([]adminResource{{Namespace: "ns1", Name: "a", ResourceVersion: "2"}, {Namespace: "ns2", Name: "c"}}, resources[kindMCSecret])
+
+	// A kind without admin resources is removed
+	resources.record(kindMCSecret, "ns1", []adminResource{})
+	resources.record(kindMCSecret, "ns2", []adminResource{})
+	assert.NotContains(resources, kindMCSecret)
+
+	// Nothing is recorded by a syncer without an agent cache
+	var none adminResources
+	none.record(kindMCSecret, "ns1", []adminResource{{Namespace: "ns1", Name: "a"}})
+	assert.Equal(0, none.count())
+}
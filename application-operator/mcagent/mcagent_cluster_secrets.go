@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -26,6 +26,11 @@ func (s *Syncer) syncClusterCAs() (controllerutil.OperationResult, error) {
 	if err != nil {
 		s.Log.Errorf("Error syncing Admin Cluster CA: %v", err)
 	}
+	// The agent secret is updated on the admin cluster when the agent credentials are rotated
+	_, err = s.syncAgentSecretFromAdminCluster()
+	if err != nil {
+		s.Log.Errorf("Error syncing the agent secret: %v", err)
+	}
 	err = s.syncLocalClusterCA()
 	if err != nil {
 		s.Log.Errorf("Error syncing Local Cluster CA: %v", err)
@@ -34,7 +39,7 @@ func (s *Syncer) syncClusterCAs() (controllerutil.OperationResult, error) {
 }
 
 // syncAgentSecretFromAdminCluster - synchronize the agent secret from admin cluster including
-// kubeconfig, cluster name -- update local agent secret if any of those change
+// kubeconfig, cluster name and agent credential -- update local agent secret if any of those change
 func (s *Syncer) syncAgentSecretFromAdminCluster() (controllerutil.OperationResult, error) {
 	opResult := controllerutil.OperationResultNone
 
@@ -53,9 +58,15 @@ func (s *Syncer) syncAgentSecretFromAdminCluster() (controllerutil.OperationResu
 	agentSecret.Name = constants.MCAgentSecret
 	agentSecret.Namespace = constants.VerrazzanoSystemNamespace
 	return controllerutil.CreateOrUpdate(s.Context, s.LocalClient, &agentSecret, func() error {
+		if agentSecret.Data == nil {
+			agentSecret.Data = map[string][]byte{}
+		}
 		// Set info from admin agent secret
 		agentSecret.Data[mcconstants.KubeconfigKey] = adminAgentSecret.Data[mcconstants.KubeconfigKey]
 		agentSecret.Data[mcconstants.ManagedClusterNameKey] = adminAgentSecret.Data[mcconstants.ManagedClusterNameKey]
+		if credential, ok := adminAgentSecret.Data[mcconstants.AgentCredentialKey]; ok {
+			agentSecret.Data[mcconstants.AgentCredentialKey] = credential
+		}
 		return nil
 	})
 }
@@ -132,6 +143,19 @@ func (s *Syncer) syncRegistrationFromAdminCluster() (controllerutil.OperationRes
 	return opResult, nil
 }
 
+// getAdminCABundleFingerprint returns the fingerprint of the admin CA bundle in the local cluster registration secret
+func (s *Syncer) getAdminCABundleFingerprint() (string, error) {
+	registrationSecret := corev1.Secret{}
+	err := s.LocalClient.Get(s.Context, client.ObjectKey{
+		Namespace: constants.VerrazzanoSystemNamespace,
+		Name:      constants.MCRegistrationSecret,
+	}, &registrationSecret)
+	if err != nil {
+		return "", err
+	}
+	return certs.GetCABundleFingerprint(registrationSecret.Data[mcconstants.AdminCaBundleKey]), nil
+}
+
 func registrationInfoEqual(regSecret1 corev1.Secret, regSecret2 corev1.Secret) bool {
 	return byteSlicesEqualTrimmedWhitespace(regSecret1.Data[mcconstants.ESURLKey],
 		regSecret2.Data[mcconstants.ESURLKey]) &&
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -619,6 +619,16 @@ func TestSyncAgentSecretFromAdminCluster(t *testing.T) {
 			controllerutil.OperationResultUpdated,
 			nil,
 		},
+		{
+			"admin agent secret agent credential rotated",
+			createSecretWithOverrides(adminAgentSecretPath, map[string]string{
+				mcconstants.AgentCredentialKey: "verrazzano-cluster-managed1-token-1700000000",
+			}, "", getAgentSecretName(testClusterName)),
+			testUnchangedLocalAgentSecret,
+			nil,
+			controllerutil.OperationResultUpdated,
+			nil,
+		},
 		{
 			"admin agent secret some unused field added",
 			createSecretWithOverrides(adminAgentSecretPath, map[string]string{
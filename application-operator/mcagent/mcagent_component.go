@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -30,16 +30,22 @@ func (s *Syncer) syncMCComponentObjects(namespace string) error {
 	}
 
 	// Write each of the records that are targeted to this cluster
+	synced := []adminResource{}
 	for _, mcComponent := range allAdminMCComponents.Items {
 		if s.isThisCluster(mcComponent.Spec.Placement) {
-			_, err := s.createOrUpdateMCComponent(mcComponent)
+			synced = append(synced, newAdminResource(&mcComponent))
+			opResult, err := s.createOrUpdateMCComponent(mcComponent)
 			if err != nil {
 				s.Log.Errorw(fmt.Sprintf("Failed syncing object: %v", err),
 					"MultiClusterComponent",
 					types.NamespacedName{Namespace: mcComponent.Namespace, Name: mcComponent.Name})
+			} else if opResult == controllerutil.OperationResultNone {
+				// The multicluster resource is unchanged, check that its resource still matches the template
+				s.checkMCComponentDrift(mcComponent)
 			}
 		}
 	}
+	s.adminResources.record(kindMCComponent, namespace, synced)
 
 	// Delete orphaned MultiClusterComponent resources.
 	// Get the list of MultiClusterComponent resources on the
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -232,6 +232,12 @@ func TestDeleteMCComponent(t *testing.T) {
 			return nil
 		})
 
+	// Managed Cluster - expect call to get the component of the MultiClusterComponent to check for drift
+	//                   Return the resource does not exist
+	mcMock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: testMCComponentNamespace, Name: testMCComponentName}, gomock.AssignableToTypeOf(&v1alpha2.Component{}), gomock.Any()).
+		Return(errors.NewNotFound(schema.GroupResource{Group: "core.oam.dev", Resource: "components"}, testMCComponentName))
+
 	// Managed Cluster - expect call to list MultiClusterComponent objects - return list including an orphaned object
 	mcMock.EXPECT().
 		List(gomock.Any(), &clustersv1alpha1.MultiClusterComponentList{}, gomock.Not(gomock.Nil())).
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -30,16 +30,22 @@ func (s *Syncer) syncMCConfigMapObjects(namespace string) error {
 	}
 
 	// Write each of the records that are targeted to this cluster
+	synced := []adminResource{}
 	for _, mcConfigMap := range allAdminMCConfigMaps.Items {
 		if s.isThisCluster(mcConfigMap.Spec.Placement) {
-			_, err := s.createOrUpdateMCConfigMap(mcConfigMap)
+			synced = append(synced, newAdminResource(&mcConfigMap))
+			opResult, err := s.createOrUpdateMCConfigMap(mcConfigMap)
 			if err != nil {
 				s.Log.Errorw(fmt.Sprintf("Failed syncing object: %v", err),
 					"MultiClusterConfigMap",
 					types.NamespacedName{Namespace: mcConfigMap.Namespace, Name: mcConfigMap.Name})
+			} else if opResult == controllerutil.OperationResultNone {
+				// The multicluster resource is unchanged, check that its resource still matches the template
+				s.checkMCConfigMapDrift(mcConfigMap)
 			}
 		}
 	}
+	s.adminResources.record(kindMCConfigMap, namespace, synced)
 
 	// Delete orphaned MultiClusterConfigMap resources.
 	// Get the list of MultiClusterConfigMap resources on the
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -17,6 +17,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/mocks"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,7 +35,7 @@ var mcConfigMapTestUpdatedLabels = map[string]string{"label1": "test1updated",
 // TestCreateMCConfigMap tests the synchronization method for the following use case.
 // GIVEN a request to sync MultiClusterConfigMap objects
 // WHEN the a new object exists
-// THEN ensure that the MultiClusterConfigMap is created.
+// THEN ensure that the MultiClusterConfigMap is created and recorded as synced.
 func TestCreateMCConfigMap(t *testing.T) {
 	assert := asserts.New(t)
 	log := zap.S().With("test")
@@ -96,6 +97,7 @@ func TestCreateMCConfigMap(t *testing.T) {
 		Log:                log,
 		ManagedClusterName: testClusterName,
 		Context:            context.TODO(),
+		adminResources:     adminResources{},
 	}
 	err = s.syncMCConfigMapObjects(testMCConfigMapNamespace)
 
@@ -103,6 +105,7 @@ func TestCreateMCConfigMap(t *testing.T) {
 	adminMocker.Finish()
 	mcMocker.Finish()
 	assert.NoError(err)
+	assert.Equal([]adminResource{newAdminResource(&testMCConfigMap)}, s.adminResources[kindMCConfigMap])
 }
 
 // TestUpdateMCConfigMap tests the synchronization method for the following use case.
@@ -229,6 +232,12 @@ func TestDeleteMCConfigMap(t *testing.T) {
 			return nil
 		})
 
+	// Managed Cluster - expect call to get the config map of the MultiClusterConfigMap to check for drift
+	//                   Return the resource does not exist
+	mcMock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: testMCConfigMapNamespace, Name: testMCConfigMapName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{}), gomock.Any()).
+		Return(errors.NewNotFound(schema.GroupResource{Group: "", Resource: "configmaps"}, testMCConfigMapName))
+
 	// Managed Cluster - expect call to list MultiClusterConfigMap objects - return list including an orphaned object
 	mcMock.EXPECT().
 		List(gomock.Any(), &clustersv1alpha1.MultiClusterConfigMapList{}, gomock.Not(gomock.Nil())).
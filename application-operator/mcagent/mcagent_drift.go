@@ -0,0 +1,337 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	oamv1alpha2 "github.com/crossplane/oam-kubernetes-runtime/apis/core/v1alpha2"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	driftDetectedReason = "DriftDetected"
+	driftRevertedReason = "DriftReverted"
+	driftResolvedReason = "DriftResolved"
+)
+
+// driftState keeps the drifted fields of the resources of the multicluster resources across agent iterations,
+// keyed by kind, namespace and name, so that drift is only reported when it is detected, changes or is resolved
+type driftState map[string]string
+
+func driftKey(kind string, name types.NamespacedName) string {
+	return fmt.Sprintf("%s/%s/%s", kind, name.Namespace, name.Name)
+}
+
+// checkMCSecretDrift compares the secret of a MultiClusterSecret with the secret template
+func (s *Syncer) checkMCSecretDrift(mcSecret clustersv1alpha1.MultiClusterSecret) {
+	secret := corev1.Secret{}
+	if !s.getDriftedObject(kindMCSecret, &mcSecret, &secret) {
+		return
+	}
+	template := mcSecret.Spec.Template
+	var fields []string
+	if template.Type != "" && template.Type != secret.Type {
+		fields = append(fields, "type")
+	}
+	// The string data of the template is merged into the data of the secret by the API server
+	data := map[string][]byte{}
+	for key, value := range template.Data {
+		data[key] = value
+	}
+	for key, value := range template.StringData {
+		data[key] = []byte(value)
+	}
+	if !bytesMapsEqual(data, secret.Data) {
+		fields = append(fields, "data")
+	}
+	fields = append(fields, metadataDrift(template.Metadata, secret.Labels, secret.Annotations)...)
+	s.handleDrift(kindMCSecret, &mcSecret, fields)
+}
+
+// checkMCConfigMapDrift compares the config map of a MultiClusterConfigMap with the config map template
+func (s *Syncer) checkMCConfigMapDrift(mcConfigMap clustersv1alpha1.MultiClusterConfigMap) {
+	configMap := corev1.ConfigMap{}
+	if !s.getDriftedObject(kindMCConfigMap, &mcConfigMap, &configMap) {
+		return
+	}
+	template := mcConfigMap.Spec.Template
+	var fields []string
+	if !stringMapsEqual(template.Data, configMap.Data) {
+		fields = append(fields, "data")
+	}
+	if !bytesMapsEqual(template.BinaryData, configMap.BinaryData) {
+		fields = append(fields, "binaryData")
+	}
+	fields = append(fields, metadataDrift(template.Metadata, configMap.Labels, configMap.Annotations)...)
+	s.handleDrift(kindMCConfigMap, &mcConfigMap, fields)
+}
+
+// checkMCComponentDrift compares the OAM component of a MultiClusterComponent with the component template
+func (s *Syncer) checkMCComponentDrift(mcComponent clustersv1alpha1.MultiClusterComponent) {
+	component := oamv1alpha2.Component{}
+	if !s.getDriftedObject(kindMCComponent, &mcComponent, &component) {
+		return
+	}
+	template := mcComponent.Spec.Template
+	var fields []string
+	if s.specDrift(template.Spec, component.Spec) {
+		fields = append(fields, "spec")
+	}
+	fields = append(fields, metadataDrift(template.Metadata, component.Labels, component.Annotations)...)
+	s.handleDrift(kindMCComponent, &mcComponent, fields)
+}
+
+// checkMCAppConfigDrift compares the OAM application configuration of a MultiClusterApplicationConfiguration with
+// the application configuration template
+func (s *Syncer) checkMCAppConfigDrift(mcAppConfig clustersv1alpha1.MultiClusterApplicationConfiguration) {
+	appConfig := oamv1alpha2.ApplicationConfiguration{}
+	if !s.getDriftedObject(kindMCApplicationConfig, &mcAppConfig, &appConfig) {
+		return
+	}
+	template := mcAppConfig.Spec.Template
+	var fields []string
+	if s.specDrift(template.Spec, appConfig.Spec) {
+		fields = append(fields, "spec")
+	}
+	fields = append(fields, metadataDrift(template.Metadata, appConfig.Labels, appConfig.Annotations)...)
+	s.handleDrift(kindMCApplicationConfig, &mcAppConfig, fields)
+}
+
+// getDriftedObject gets the resource of a multicluster resource on the local cluster. It returns false if the
+// resource cannot be compared, for example because it has not been created yet by the multicluster resource controller.
+func (s *Syncer) getDriftedObject(kind string, mcObject client.Object, object client.Object) bool {
+	name := types.NamespacedName{Namespace: mcObject.GetNamespace(), Name: mcObject.GetName()}
+	err := s.LocalClient.Get(s.Context, name, object)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			s.Log.Errorf("Failed to get the resource of %s %v to check for drift: %v", kind, name, err)
+		}
+		return false
+	}
+	return true
+}
+
+// specDrift returns true if the spec of a resource no longer contains the spec of the template. Fields and list
+// items added to the spec, for example by defaulting webhooks, are not considered drift.
+func (s *Syncer) specDrift(desired interface{}, live interface{}) bool {
+	desiredValue, err := toUnstructuredValue(desired)
+	if err != nil {
+		s.Log.Errorf("Failed to convert the template spec to check for drift: %v", err)
+		return false
+	}
+	liveValue, err := toUnstructuredValue(live)
+	if err != nil {
+		s.Log.Errorf("Failed to convert the resource spec to check for drift: %v", err)
+		return false
+	}
+	return !containsDesired(desiredValue, liveValue)
+}
+
+// handleDrift reports the drift of the resource of a multicluster resource, and reverts it if the multicluster
+// resource on the admin cluster opted into enforcement. The drift is reported as an event on the local multicluster
+// resource and as a cluster level status of the multicluster resource on the admin cluster.
+func (s *Syncer) handleDrift(kind string, mcObject client.Object, fields []string) {
+	name := types.NamespacedName{Namespace: mcObject.GetNamespace(), Name: mcObject.GetName()}
+	key := driftKey(kind, name)
+	sort.Strings(fields)
+	drifted := strings.Join(fields, ",")
+	previous, found := s.drift[key]
+	if len(fields) == 0 {
+		if found {
+			msg := fmt.Sprintf("The resource matches the %s template", kind)
+			s.Log.Infof("Drift of %s %v resolved", kind, name)
+			s.recordEvent(mcObject, corev1.EventTypeNormal, driftResolvedReason, msg)
+			s.queueDriftStatusUpdate(kind, name, corev1.ConditionFalse, clustersv1alpha1.Succeeded, msg)
+			delete(s.drift, key)
+		}
+		return
+	}
+	if found && previous == drifted {
+		return
+	}
+	if s.drift != nil {
+		s.drift[key] = drifted
+	}
+
+	msg := fmt.Sprintf("The resource was modified outside of the %s, drifted fields: %s", kind, drifted)
+	s.Log.Infof("Drift detected for %s %v, drifted fields: %s", kind, name, drifted)
+	s.recordEvent(mcObject, corev1.EventTypeWarning, driftDetectedReason, msg)
+	if mcObject.GetAnnotations()[constants.EnforceDriftAnnotation] != "true" {
+		s.queueDriftStatusUpdate(kind, name, corev1.ConditionTrue, clustersv1alpha1.Failed, msg)
+		return
+	}
+
+	// Trigger the multicluster resource controller to restore the resource from the template
+	if err := s.revertDrift(mcObject); err != nil {
+		s.Log.Errorf("Failed to revert the drift of %s %v: %v", kind, name, err)
+		s.queueDriftStatusUpdate(kind, name, corev1.ConditionTrue, clustersv1alpha1.Failed, msg)
+		return
+	}
+	msg = fmt.Sprintf("Reverted the changes made outside of the %s, drifted fields: %s", kind, drifted)
+	s.recordEvent(mcObject, corev1.EventTypeNormal, driftRevertedReason, msg)
+	s.queueDriftStatusUpdate(kind, name, corev1.ConditionTrue, clustersv1alpha1.Succeeded, msg)
+	// The resource is checked again on the next iteration, once it has been restored
+	delete(s.drift, key)
+}
+
+// getLocalMCObject gets the local copy of a multicluster resource of the admin cluster
+func (s *Syncer) getLocalMCObject(mcObject client.Object) (client.Object, error) {
+	local, ok := mcObject.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", mcObject)
+	}
+	err := s.LocalClient.Get(s.Context, types.NamespacedName{Namespace: mcObject.GetNamespace(), Name: mcObject.GetName()}, local)
+	return local, err
+}
+
+// revertDrift annotates the local multicluster resource, so that its controller reconciles it and restores its resource
+func (s *Syncer) revertDrift(mcObject client.Object) error {
+	local, err := s.getLocalMCObject(mcObject)
+	if err != nil {
+		return err
+	}
+	annotations := local.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[constants.DriftRevertedAnnotation] = time.Now().Format(time.RFC3339)
+	local.SetAnnotations(annotations)
+	return s.LocalClient.Update(s.Context, local)
+}
+
+// recordEvent records an event on the local copy of a multicluster resource
+func (s *Syncer) recordEvent(mcObject client.Object, eventType string, reason string, msg string) {
+	if s.Recorder == nil {
+		return
+	}
+	local, err := s.getLocalMCObject(mcObject)
+	if err != nil {
+		s.Log.Errorf("Failed to get the multicluster resource %s/%s to record event %s: %v", mcObject.GetNamespace(), mcObject.GetName(), reason, err)
+		return
+	}
+	s.Recorder.Event(local, eventType, reason, msg)
+}
+
+// queueDriftStatusUpdate queues the status update of a multicluster resource on the admin cluster for its drift
+func (s *Syncer) queueDriftStatusUpdate(kind string, name types.NamespacedName, status corev1.ConditionStatus, state clustersv1alpha1.StateType, msg string) {
+	now := time.Now().Format(time.RFC3339)
+	s.queueStatusUpdate(queuedStatusUpdate{
+		Kind:      kind,
+		Namespace: name.Namespace,
+		Name:      name.Name,
+		Condition: clustersv1alpha1.Condition{
+			Type:               clustersv1alpha1.DriftDetected,
+			Status:             status,
+			Message:            msg,
+			LastTransitionTime: now,
+		},
+		ClusterStatus: clustersv1alpha1.ClusterLevelStatus{
+			Name:           s.ManagedClusterName,
+			State:          state,
+			Message:        msg,
+			LastUpdateTime: now,
+		},
+	})
+}
+
+// metadataDrift returns the metadata fields of a resource that no longer contain the labels or annotations of the
+// template. Labels and annotations added to the resource are not considered drift.
+func metadataDrift(template clustersv1alpha1.EmbeddedObjectMeta, labels map[string]string, annotations map[string]string) []string {
+	var fields []string
+	if !containsStrings(template.Labels, labels) {
+		fields = append(fields, "metadata.labels")
+	}
+	if !containsStrings(template.Annotations, annotations) {
+		fields = append(fields, "metadata.annotations")
+	}
+	return fields
+}
+
+func containsStrings(desired map[string]string, live map[string]string) bool {
+	for key, value := range desired {
+		if liveValue, ok := live[key]; !ok || liveValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// stringMapsEqual compares two maps, a nil map being equal to an empty map
+func stringMapsEqual(a map[string]string, b map[string]string) bool {
+	return len(a) == len(b) && containsStrings(a, b)
+}
+
+// bytesMapsEqual compares two maps, a nil map being equal to an empty map
+func bytesMapsEqual(a map[string][]byte, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if bValue, ok := b[key]; !ok || !bytes.Equal(value, bValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// toUnstructuredValue converts an object to its JSON representation as maps, lists and values
+func toUnstructuredValue(object interface{}) (interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+// containsDesired returns true if the live value contains the desired value: every field of a desired map must be
+// contained in the live map, and every item of a desired list must be contained in an item of the live list
+func containsDesired(desired interface{}, live interface{}) bool {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			return len(desiredValue) == 0 && live == nil
+		}
+		for key, value := range desiredValue {
+			if !containsDesired(value, liveValue[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok {
+			return len(desiredValue) == 0 && live == nil
+		}
+		for _, item := range desiredValue {
+			found := false
+			for _, liveItem := range liveValue {
+				if containsDesired(item, liveItem) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(desired, live)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"context"
+	"testing"
+
+	asserts "github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	driftTestNamespace = "drift-ns"
+	driftTestName      = "drift-resource"
+	driftTestCluster   = "managed1"
+)
+
+// TestMCSecretDrift tests the drift detection of the secret of a MultiClusterSecret
+// GIVEN a MultiClusterSecret synchronized to the managed cluster
+// WHEN its secret is modified on the managed cluster and then restored
+// THEN the drift is reported once when detected and once when resolved
+func TestMCSecretDrift(t *testing.T) {
+	assert := asserts.New(t)
+	mcSecret := newDriftTestMCSecret(nil)
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: driftTestNamespace, Name: driftTestName, Labels: map[string]string{"app": "test", "extra": "label"}},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"username": []byte("edited"), "password": []byte("secret")},
+	}
+	s, localClient, recorder := newDriftTestSyncer(mcSecret.DeepCopy(), mcSecret.DeepCopy(), &secret)
+
+	// The drift is reported when detected
+	assert.NoError(s.syncMCSecretObjects(driftTestNamespace))
+	assert.Equal(1, s.statusQueue.len())
+	update, _ := s.statusQueue.peek()
+	assert.Equal(kindMCSecret, update.Kind)
+	assert.Equal(clustersv1alpha1.DriftDetected, update.Condition.Type)
+	assert.Equal(corev1.ConditionTrue, update.Condition.Status)
+	assert.Equal(clustersv1alpha1.Failed, update.ClusterStatus.State)
+	assert.Equal(driftTestCluster, update.ClusterStatus.Name)
+	assert.Contains(update.ClusterStatus.Message, "drifted fields: data")
+	assert.Contains(<-recorder.Events, driftDetectedReason)
+
+	// The same drift is not reported again
+	assert.NoError(s.syncMCSecretObjects(driftTestNamespace))
+	assert.Equal(1, s.statusQueue.len())
+
+	// The resolution of the drift is reported
+	secret.Data["username"] = []byte("verrazzano")
+	assert.NoError(localClient.Update(context.TODO(), &secret))
+	assert.NoError(s.syncMCSecretObjects(driftTestNamespace))
+	assert.Equal(2, s.statusQueue.len())
+	assert.Equal(corev1.ConditionFalse, s.statusQueue.updates[1].Condition.Status)
+	assert.Equal(clustersv1alpha1.Succeeded, s.statusQueue.updates[1].ClusterStatus.State)
+	assert.Contains(<-recorder.Events, driftResolvedReason)
+	assert.Empty(s.drift)
+}
+
+// TestMCSecretDriftEnforced tests reverting the drift of the secret of a MultiClusterSecret
+// GIVEN a MultiClusterSecret that opted into drift enforcement
+// WHEN its secret is modified on the managed cluster
+// THEN the local MultiClusterSecret is annotated so that its controller restores the secret
+func TestMCSecretDriftEnforced(t *testing.T) {
+	assert := asserts.New(t)
+	mcSecret := newDriftTestMCSecret(map[string]string{constants.EnforceDriftAnnotation: "true"})
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: driftTestNamespace, Name: driftTestName},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"username": []byte("verrazzano"), "password": []byte("secret")},
+	}
+	s, localClient, recorder := newDriftTestSyncer(mcSecret.DeepCopy(), mcSecret.DeepCopy(), &secret)
+
+	assert.NoError(s.syncMCSecretObjects(driftTestNamespace))
+	local := clustersv1alpha1.MultiClusterSecret{}
+	assert.NoError(localClient.Get(context.TODO(), types.NamespacedName{Namespace: driftTestNamespace, Name: driftTestName}, &local))
+	assert.NotEmpty(local.Annotations[constants.DriftRevertedAnnotation])
+	assert.Equal(1, s.statusQueue.len())
+	update, _ := s.statusQueue.peek()
+	assert.Equal(clustersv1alpha1.Succeeded, update.ClusterStatus.State)
+	assert.Contains(update.ClusterStatus.Message, "drifted fields: metadata.labels")
+	assert.Contains(<-recorder.Events, driftDetectedReason)
+	assert.Contains(<-recorder.Events, driftRevertedReason)
+	assert.Empty(s.drift)
+}
+
+// TestMCConfigMapDrift tests the drift detection of the config map of a MultiClusterConfigMap
+// GIVEN a MultiClusterConfigMap synchronized to the managed cluster
+// WHEN its config map matches the template or has been modified
+// THEN drift is only reported for the modified config map
+func TestMCConfigMapDrift(t *testing.T) {
+	assert := asserts.New(t)
+	mcConfigMap := clustersv1alpha1.MultiClusterConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: driftTestNamespace, Name: driftTestName},
+		Spec: clustersv1alpha1.MultiClusterConfigMapSpec{
+			Template:  clustersv1alpha1.ConfigMapTemplate{Data: map[string]string{"key": "value"}},
+			Placement: clustersv1alpha1.Placement{Clusters: []clustersv1alpha1.Cluster{{Name: driftTestCluster}}},
+		},
+	}
+	configMap := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: driftTestNamespace, Name: driftTestName},
+		Data:       map[string]string{"key": "value"},
+	}
+	s, localClient, _ := newDriftTestSyncer(mcConfigMap.DeepCopy(), mcConfigMap.DeepCopy(), &configMap)
+
+	assert.NoError(s.syncMCConfigMapObjects(driftTestNamespace))
+	assert.Equal(0, s.statusQueue.len())
+
+	configMap.BinaryData = map[string][]byte{"binary": []byte("data")}
+	assert.NoError(localClient.Update(context.TODO(), &configMap))
+	assert.NoError(s.syncMCConfigMapObjects(driftTestNamespace))
+	assert.Equal(1, s.statusQueue.len())
+	update, _ := s.statusQueue.peek()
+	assert.Equal(kindMCConfigMap, update.Kind)
+	assert.Contains(update.ClusterStatus.Message, "drifted fields: binaryData")
+}
+
+// TestContainsDesired tests the comparison of the spec of a resource with the spec of its template
+// GIVEN the JSON representation of a template spec and of a resource spec
+// WHEN containsDesired is called
+// THEN only changed or removed values are reported as drift, added fields and list items are not
+func TestContainsDesired(t *testing.T) {
+	desired := map[string]interface{}{
+		"components": []interface{}{
+			map[string]interface{}{"componentName": "hello", "traits": []interface{}{map[string]interface{}{"kind": "IngressTrait"}}},
+		},
+	}
+	tests := []struct {
+		name string
+		live interface{}
+		want bool
+	}{
+		{"equal", desired, true},
+		{"added trait", map[string]interface{}{
+			"components": []interface{}{
+				map[string]interface{}{"componentName": "hello", "traits": []interface{}{map[string]interface{}{"kind": "IngressTrait"}, map[string]interface{}{"kind": "MetricsTrait"}}},
+			},
+		}, true},
+		{"changed value", map[string]interface{}{
+			"components": []interface{}{
+				map[string]interface{}{"componentName": "hello", "traits": []interface{}{map[string]interface{}{"kind": "LoggingTrait"}}},
+			},
+		}, false},
+		{"removed list", map[string]interface{}{
+			"components": []interface{}{map[string]interface{}{"componentName": "hello"}},
+		}, false},
+		{"no spec", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asserts.Equal(t, tt.want, containsDesired(desired, tt.live))
+		})
+	}
+}
+
+// newDriftTestMCSecret returns a MultiClusterSecret placed on the test managed cluster
+func newDriftTestMCSecret(annotations map[string]string) *clustersv1alpha1.MultiClusterSecret {
+	return &clustersv1alpha1.MultiClusterSecret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: driftTestNamespace, Name: driftTestName, Annotations: annotations},
+		Spec: clustersv1alpha1.MultiClusterSecretSpec{
+			Template: clustersv1alpha1.SecretTemplate{
+				Metadata: clustersv1alpha1.EmbeddedObjectMeta{Labels: map[string]string{"app": "test"}},
+				Type:     corev1.SecretTypeOpaque,
+				Data:     map[string][]byte{"username": []byte("verrazzano")},
+				StringData: map[string]string{
+					"password": "secret",
+				},
+			},
+			Placement: clustersv1alpha1.Placement{Clusters: []clustersv1alpha1.Cluster{{Name: driftTestCluster}}},
+		},
+	}
+}
+
+// newDriftTestSyncer returns a syncer with the multicluster resource on the admin cluster, and its local copy and
+// resource on the managed cluster
+func newDriftTestSyncer(adminMCObject client.Object, localMCObject client.Object, object client.Object) (*Syncer, client.Client, *record.FakeRecorder) {
+	adminClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(adminMCObject).Build()
+	localClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(localMCObject, object).Build()
+	recorder := record.NewFakeRecorder(10)
+	return &Syncer{
+		AdminClient:        adminClient,
+		LocalClient:        localClient,
+		Log:                zap.S().With("test"),
+		ManagedClusterName: driftTestCluster,
+		Context:            context.TODO(),
+		Recorder:           recorder,
+		statusQueue:        &statusUpdateQueue{},
+		drift:              driftState{},
+	}, localClient, recorder
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Weights of each health check in the health score, adding up to 100
+	componentsScoreWeight = 40
+	nodesScoreWeight      = 30
+	certsScoreWeight      = 15
+	syncScoreWeight       = 15
+
+	// certExpiryWarning is the expiry horizon under which certificates degrade the cluster health
+	certExpiryWarning = 30 * 24 * time.Hour
+	// maxSyncLag is the lag over which a multicluster resource kind degrades the cluster health
+	maxSyncLag = 5 * time.Minute
+
+	kindVerrazzanoProject     = "VerrazzanoProject"
+	kindSecret                = "Secret"
+	kindMCSecret              = "MultiClusterSecret"
+	kindMCConfigMap           = "MultiClusterConfigMap"
+	kindMCComponent           = "MultiClusterComponent"
+	kindMCApplicationConfig   = "MultiClusterApplicationConfiguration"
+	tlsCertificateSecretField = "tls.crt"
+)
+
+// syncedKinds are the kinds of resources synchronized from the admin cluster, in reporting order
+var syncedKinds = []string{kindVerrazzanoProject, kindSecret, kindMCSecret, kindMCConfigMap, kindMCComponent, kindMCApplicationConfig}
+
+// certificateNamespaces are the namespaces whose TLS certificates are included in the certificate expiry horizon
+var certificateNamespaces = []string{
+	constants.VerrazzanoSystemNamespace,
+	constants.VerrazzanoMultiClusterNamespace,
+	vzconst.VerrazzanoMonitoringNamespace,
+	vzconst.KeycloakNamespace,
+	vzconst.CertManagerNamespace,
+	constants.IstioSystemNamespace,
+}
+
+// syncTimes tracks the synchronization times of the multicluster resource kinds across agent iterations
+type syncTimes struct {
+	// The time the agent started tracking synchronization
+	startTime time.Time
+	// The last successful synchronization time of each kind
+	lastSync map[string]v1.Time
+}
+
+func newSyncTimes() *syncTimes {
+	return &syncTimes{
+		startTime: time.Now(),
+		lastSync:  map[string]v1.Time{},
+	}
+}
+
+// getHealthSummary returns the health summary of this managed cluster
+func (s *Syncer) getHealthSummary() (*v1alpha1.HealthSummary, error) {
+	now := v1.Now()
+	health := &v1alpha1.HealthSummary{
+		LastUpdateTime: &now,
+	}
+	components, err := s.getComponentHealth()
+	if err != nil {
+		return nil, err
+	}
+	health.Components = components
+	nodes, err := s.getNodeHealth()
+	if err != nil {
+		return nil, err
+	}
+	health.Nodes = nodes
+	certificateExpiry, err := s.getCertificateExpiry()
+	if err != nil {
+		return nil, err
+	}
+	health.CertificateExpiry = certificateExpiry
+	health.Sync = s.getSyncStatus(now.Time)
+	health.Score = healthScore(health, now.Time)
+	if health.Score == 100 {
+		health.Status = v1alpha1.HealthHealthy
+	} else {
+		health.Status = v1alpha1.HealthDegraded
+	}
+	return health, nil
+}
+
+// getComponentHealth returns the availability of the enabled Verrazzano components on this managed cluster
+func (s *Syncer) getComponentHealth() (v1alpha1.ComponentHealth, error) {
+	health := v1alpha1.ComponentHealth{}
+	vzList := &v1beta1.VerrazzanoList{}
+	if err := s.LocalClient.List(s.Context, vzList, &client.ListOptions{}); err != nil {
+		return health, fmt.Errorf("error listing Verrazzanos: %v", err)
+	}
+	if len(vzList.Items) == 0 {
+		return health, nil
+	}
+	for name, component := range vzList.Items[0].Status.Components {
+		if component == nil || component.State == v1beta1.CompStateDisabled {
+			continue
+		}
+		health.Total++
+		if component.Available != nil && *component.Available == v1beta1.ComponentAvailable {
+			health.Available++
+		} else {
+			health.Unavailable = append(health.Unavailable, name)
+		}
+	}
+	sort.Strings(health.Unavailable)
+	return health, nil
+}
+
+// getNodeHealth returns the readiness of the nodes of this managed cluster
+func (s *Syncer) getNodeHealth() (v1alpha1.NodeHealth, error) {
+	health := v1alpha1.NodeHealth{}
+	nodeList := &corev1.NodeList{}
+	if err := s.LocalClient.List(s.Context, nodeList); err != nil {
+		return health, fmt.Errorf("error listing nodes: %v", err)
+	}
+	for _, node := range nodeList.Items {
+		health.Total++
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				health.Ready++
+			}
+		}
+	}
+	return health, nil
+}
+
+// getCertificateExpiry returns the earliest expiry time of the TLS certificates in the Verrazzano system namespaces,
+// or nil if there are no certificates
+func (s *Syncer) getCertificateExpiry() (*v1.Time, error) {
+	var earliest *v1.Time
+	for _, namespace := range certificateNamespaces {
+		secretList := &corev1.SecretList{}
+		if err := s.LocalClient.List(s.Context, secretList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("error listing secrets in namespace %s: %v", namespace, err)
+		}
+		for i := range secretList.Items {
+			secret := &secretList.Items[i]
+			if secret.Type != corev1.SecretTypeTLS {
+				continue
+			}
+			notAfter, ok := getCertificateNotAfter(secret.Data[tlsCertificateSecretField])
+			if !ok {
+				s.Log.Debugf("Skipping secret %s/%s that does not contain a valid certificate", secret.Namespace, secret.Name)
+				continue
+			}
+			if earliest == nil || notAfter.Before(earliest.Time) {
+				expiry := v1.NewTime(notAfter)
+				earliest = &expiry
+			}
+		}
+	}
+	return earliest, nil
+}
+
+// getCertificateNotAfter returns the expiry time of the first certificate of a PEM encoded certificate chain
+func getCertificateNotAfter(data []byte) (time.Time, bool) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return cert.NotAfter, true
+}
+
+// getSyncStatus returns the synchronization state of each multicluster resource kind. The lag of a kind that has not
+// been synchronized is measured from the time the agent started tracking synchronization.
+func (s *Syncer) getSyncStatus(now time.Time) []v1alpha1.ResourceSyncStatus {
+	if s.syncTimes == nil {
+		return nil
+	}
+	var statuses []v1alpha1.ResourceSyncStatus
+	for _, kind := range syncedKinds {
+		status := v1alpha1.ResourceSyncStatus{
+			Kind:       kind,
+			LagSeconds: int64(now.Sub(s.syncTimes.startTime).Seconds()),
+		}
+		if lastSyncTime, ok := s.syncTimes.lastSync[kind]; ok {
+			status.LastSyncTime = lastSyncTime.DeepCopy()
+			status.LagSeconds = int64(now.Sub(lastSyncTime.Time).Seconds())
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// recordSyncTimes records the synchronization time of the multicluster resource kinds that did not fail
+func (s *Syncer) recordSyncTimes(failed map[string]bool) {
+	if s.syncTimes == nil {
+		return
+	}
+	now := v1.Now()
+	for _, kind := range syncedKinds {
+		if !failed[kind] {
+			s.syncTimes.lastSync[kind] = now
+		}
+	}
+}
+
+// healthScore returns the weighted health score of a managed cluster, from 0 to 100
+func healthScore(health *v1alpha1.HealthSummary, now time.Time) int {
+	score := componentsScoreWeight * ratio(health.Components.Available, health.Components.Total)
+	score += nodesScoreWeight * ratio(health.Nodes.Ready, health.Nodes.Total)
+	if health.CertificateExpiry == nil || health.CertificateExpiry.Time.Sub(now) > certExpiryWarning {
+		score += certsScoreWeight
+	}
+	synced := 0
+	for _, status := range health.Sync {
+		if time.Duration(status.LagSeconds)*time.Second <= maxSyncLag {
+			synced++
+		}
+	}
+	score += syncScoreWeight * ratio(synced, len(health.Sync))
+	return int(score)
+}
+
+// ratio returns the ratio of a count to a total, where an empty total is a ratio of 1
+func ratio(count, total int) float64 {
+	if total == 0 {
+		return 1
+	}
+	return float64(count) / float64(total)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/application-operator/constants"
+	"github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestGetHealthSummary tests the health summary reported by the agent
+// GIVEN a managed cluster with Verrazzano components, nodes and TLS certificates
+// WHEN getHealthSummary is called
+// THEN the health summary reflects the component availability, node readiness, certificate expiry and sync lag
+func TestGetHealthSummary(t *testing.T) {
+	available := v1beta1.ComponentAvailability(v1beta1.ComponentAvailable)
+	unavailable := v1beta1.ComponentAvailability(v1beta1.ComponentUnavailable)
+	vz := &v1beta1.Verrazzano{
+		ObjectMeta: v1.ObjectMeta{Name: "verrazzano", Namespace: "default"},
+		Status: v1beta1.VerrazzanoStatus{
+			Components: v1beta1.ComponentStatusMap{
+				"keycloak":   {Available: &available, State: v1beta1.CompStateReady},
+				"rancher":    {Available: &unavailable, State: v1beta1.CompStateReconciling},
+				"opensearch": {State: v1beta1.CompStateDisabled},
+			},
+		},
+	}
+	expiry := time.Now().Add(10 * 24 * time.Hour)
+	var tests = []struct {
+		name           string
+		objects        []client.Object
+		lastSync       map[string]v1.Time
+		status         v1alpha1.HealthStatus
+		score          int
+		components     v1alpha1.ComponentHealth
+		nodes          v1alpha1.NodeHealth
+		hasCertificate bool
+	}{
+		{
+			"healthy cluster",
+			[]client.Object{testNode("node1", corev1.ConditionTrue)},
+			testLastSync(time.Now()),
+			v1alpha1.HealthHealthy,
+			100,
+			v1alpha1.ComponentHealth{},
+			v1alpha1.NodeHealth{Ready: 1, Total: 1},
+			false,
+		},
+		{
+			"degraded cluster",
+			[]client.Object{
+				vz,
+				testNode("node1", corev1.ConditionTrue),
+				testNode("node2", corev1.ConditionFalse),
+				testTLSSecret(t, constants.VerrazzanoSystemNamespace, expiry),
+			},
+			testLastSync(time.Now().Add(-time.Hour)),
+			v1alpha1.HealthDegraded,
+			// Half of the components and nodes are available, the certificate is expiring and the sync is stale
+			20 + 15,
+			v1alpha1.ComponentHealth{Available: 1, Total: 2, Unavailable: []string{"rancher"}},
+			v1alpha1.NodeHealth{Ready: 1, Total: 2},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = k8scheme.AddToScheme(scheme)
+			_ = v1beta1.AddToScheme(scheme)
+			s := &Syncer{
+				LocalClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build(),
+				Log:         zap.S().With(tt.name),
+				Context:     context.TODO(),
+				syncTimes: &syncTimes{
+					startTime: time.Now().Add(-time.Hour),
+					lastSync:  tt.lastSync,
+				},
+			}
+			health, err := s.getHealthSummary()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, health.Status)
+			assert.Equal(t, tt.score, health.Score)
+			assert.Equal(t, tt.components, health.Components)
+			assert.Equal(t, tt.nodes, health.Nodes)
+			assert.Len(t, health.Sync, len(syncedKinds))
+			assert.NotNil(t, health.LastUpdateTime)
+			if tt.hasCertificate {
+				assert.NotNil(t, health.CertificateExpiry)
+				assert.Equal(t, expiry.Unix(), health.CertificateExpiry.Unix())
+			} else {
+				assert.Nil(t, health.CertificateExpiry)
+			}
+		})
+	}
+}
+
+// TestRecordSyncTimes tests the recording of the sync times of multicluster resource kinds
+// GIVEN a sync of multicluster resources where some kinds failed
+// WHEN recordSyncTimes is called
+// THEN only the kinds that did not fail have a sync time, and the other kinds report a lag from the agent start time
+func TestRecordSyncTimes(t *testing.T) {
+	s := &Syncer{
+		syncTimes: &syncTimes{
+			startTime: time.Now().Add(-time.Hour),
+			lastSync:  map[string]v1.Time{},
+		},
+	}
+	s.recordSyncTimes(map[string]bool{kindMCSecret: true})
+	statuses := s.getSyncStatus(time.Now())
+	assert.Len(t, statuses, len(syncedKinds))
+	for _, status := range statuses {
+		if status.Kind == kindMCSecret {
+			assert.Nil(t, status.LastSyncTime)
+			assert.GreaterOrEqual(t, status.LagSeconds, int64(3600))
+		} else {
+			assert.NotNil(t, status.LastSyncTime)
+			assert.Less(t, status.LagSeconds, int64(60))
+		}
+	}
+}
+
+func testLastSync(lastSyncTime time.Time) map[string]v1.Time {
+	lastSync := map[string]v1.Time{}
+	for _, kind := range syncedKinds {
+		lastSync[kind] = v1.NewTime(lastSyncTime)
+	}
+	return lastSync
+}
+
+func testNode(name string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: ready},
+			},
+		},
+	}
+}
+
+func testTLSSecret(t *testing.T, namespace string, notAfter time.Time) *corev1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "test-tls", Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			tlsCertificateSecretField: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		},
+	}
+}
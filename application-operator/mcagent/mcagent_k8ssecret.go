@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -34,6 +34,7 @@ func (s *Syncer) syncSecretObjects(namespace string) error {
 	}
 
 	// Write each of the secrets that are targeted for the local cluster
+	synced := []adminResource{}
 	for _, mcAppConfig := range allAdminMCAppConfigs.Items {
 		if s.isThisCluster(mcAppConfig.Spec.Placement) {
 			for _, adminSecret := range mcAppConfig.Spec.Secrets {
@@ -43,6 +44,7 @@ func (s *Syncer) syncSecretObjects(namespace string) error {
 				if err != nil {
 					return err
 				}
+				synced = append(synced, newAdminResource(&secret))
 				_, err = s.createOrUpdateSecret(secret, mcAppConfig.Name)
 				if err != nil {
 					s.Log.Errorw(fmt.Sprintf("Failed syncing object: %v", err),
@@ -52,6 +54,7 @@ func (s *Syncer) syncSecretObjects(namespace string) error {
 			}
 		}
 	}
+	s.adminResources.record(kindSecret, namespace, synced)
 
 	// Cleanup orphaned or no longer placed Secret resources.
 	// Get the list of Secret resources on the local cluster and compare to the list received from the admin cluster.
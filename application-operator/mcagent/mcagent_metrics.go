@@ -1,16 +1,60 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
 
 import (
+	"time"
+
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-const prometheusClusterNameLabel = "verrazzano_cluster"
+const (
+	prometheusClusterNameLabel = "verrazzano_cluster"
+	kindLabel                  = "kind"
+)
+
+var (
+	syncLagMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_mcagent_sync_lag_seconds",
+		Help: "The time since a multicluster resource kind was last synchronized from the admin cluster",
+	}, []string{kindLabel})
+	syncFailuresMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vz_mcagent_sync_failures_total",
+		Help: "The number of failed synchronizations of a multicluster resource kind from the admin cluster",
+	}, []string{kindLabel})
+	adminUnavailableMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "vz_mcagent_admin_unavailable_total",
+		Help: "The number of agent iterations that failed because the admin cluster was unavailable",
+	})
+	adminConsecutiveFailuresMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "vz_mcagent_admin_consecutive_failures",
+		Help: "The number of consecutive agent iterations that failed because the admin cluster was unavailable",
+	})
+	statusUpdateQueueLengthMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "vz_mcagent_status_update_queue_length",
+		Help: "The number of status updates waiting to be sent to the admin cluster",
+	})
+	statusUpdatesDroppedMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "vz_mcagent_status_updates_dropped_total",
+		Help: "The number of status updates dropped because the status update queue was full",
+	})
+)
+
+// updateSyncMetrics counts the failed synchronizations and sets the sync lag of the multicluster resource kinds
+func (s *Syncer) updateSyncMetrics(failed map[string]bool) {
+	for kind := range failed {
+		syncFailuresMetric.WithLabelValues(kind).Inc()
+	}
+	for _, status := range s.getSyncStatus(time.Now()) {
+		syncLagMetric.WithLabelValues(status.Kind).Set(float64(status.LagSeconds))
+	}
+}
 
 func (s *Syncer) updatePrometheusMonitorsClusterName() error {
 	err := s.updateServiceMonitorsClusterName()
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -10,6 +10,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -27,9 +28,11 @@ func (s *Syncer) syncVerrazzanoProjects() error {
 	}
 
 	// Write each of the records in verrazzano-mc namespace
+	synced := []adminResource{}
 	for _, vp := range allAdminProjects.Items {
 		if vp.Namespace == constants.VerrazzanoMultiClusterNamespace {
 			if s.isThisCluster(vp.Spec.Placement) {
+				synced = append(synced, newAdminResource(&vp))
 				_, err := s.createOrUpdateVerrazzanoProject(vp)
 				if err != nil {
 					s.Log.Errorw(fmt.Sprintf("Failed syncing object: %v", err),
@@ -51,6 +54,7 @@ func (s *Syncer) syncVerrazzanoProjects() error {
 			}
 		}
 	}
+	s.adminResources.record(kindVerrazzanoProject, constants.VerrazzanoMultiClusterNamespace, synced)
 
 	// Delete orphaned VerrazzanoProject resources.
 	// Get the list of VerrazzanoProject resources on the
@@ -89,10 +93,38 @@ func (s *Syncer) createOrUpdateVerrazzanoProject(vp clustersv1alpha1.VerrazzanoP
 	vpNew.Name = vp.Name
 
 	// Create or update on the local cluster
-	return controllerutil.CreateOrUpdate(s.Context, s.LocalClient, &vpNew, func() error {
+	opResult, err := controllerutil.CreateOrUpdate(s.Context, s.LocalClient, &vpNew, func() error {
 		mutateVerrazzanoProject(vp, &vpNew)
 		return nil
 	})
+	if err != nil {
+		return opResult, err
+	}
+	return opResult, s.syncPeerServiceExports(vp, &vpNew)
+}
+
+// syncPeerServiceExports copies the cluster level statuses of the other clusters that publish services of a project
+// from the admin cluster to the status of the local project, so that the local project controller can route the
+// requests to the services of the other clusters
+func (s *Syncer) syncPeerServiceExports(vp clustersv1alpha1.VerrazzanoProject, vpLocal *clustersv1alpha1.VerrazzanoProject) error {
+	var clusterStatuses []clustersv1alpha1.ClusterLevelStatus
+	for _, status := range vpLocal.Status.Clusters {
+		if status.Name == s.ManagedClusterName {
+			clusterStatuses = append(clusterStatuses, status)
+		}
+	}
+	if vp.Spec.Template.ServiceDiscovery != nil {
+		for _, status := range vp.Status.Clusters {
+			if status.Name != s.ManagedClusterName && status.ServiceExports != nil {
+				clusterStatuses = append(clusterStatuses, status)
+			}
+		}
+	}
+	if len(clusterStatuses) == len(vpLocal.Status.Clusters) && (len(clusterStatuses) == 0 || equality.Semantic.DeepEqual(clusterStatuses, vpLocal.Status.Clusters)) {
+		return nil
+	}
+	vpLocal.Status.Clusters = clusterStatuses
+	return s.LocalClient.Status().Update(s.Context, vpLocal)
 }
 
 func (s *Syncer) updateVerrazzanoProjectStatus(name types.NamespacedName, newCond clustersv1alpha1.Condition, newClusterStatus clustersv1alpha1.ClusterLevelStatus) error {
@@ -101,7 +133,10 @@ func (s *Syncer) updateVerrazzanoProjectStatus(name types.NamespacedName, newCon
 	if err != nil {
 		return err
 	}
-	fetched.Status.Conditions = append(fetched.Status.Conditions, newCond)
+	// Status updates are also sent when only the resource usage of the project changed, don't repeat the condition
+	if !containsCondition(fetched.Status.Conditions, newCond) {
+		fetched.Status.Conditions = append(fetched.Status.Conditions, newCond)
+	}
 	clusters.SetClusterLevelStatus(&fetched.Status, newClusterStatus)
 	return s.AdminClient.Status().Update(s.Context, &fetched)
 }
@@ -131,3 +166,13 @@ func projectListContains(projectList *clustersv1alpha1.VerrazzanoProjectList, na
 	}
 	return false
 }
+
+// containsCondition returns true if a condition with the same type, status and message is in the list of conditions
+func containsCondition(conditions []clustersv1alpha1.Condition, condition clustersv1alpha1.Condition) bool {
+	for _, existing := range conditions {
+		if existing.Type == condition.Type && existing.Status == condition.Status && existing.Message == condition.Message {
+			return true
+		}
+	}
+	return false
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -19,10 +19,12 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var testLabels = map[string]string{"label1": "test1", "label2": "test2"}
@@ -597,3 +599,81 @@ func getTestVerrazzanoProject(vpNamespace string, vpName string, nsNames []clust
 	proj.Spec.Placement.Clusters = clusters
 	return proj, err
 }
+
+// TestUpdateVerrazzanoProjectStatusResourceUsage tests updating the status of a VerrazzanoProject on the admin cluster
+// GIVEN a VerrazzanoProject with a condition on the admin cluster
+// WHEN a status update with the same condition and a new resource usage is received
+// THEN the resource usage of the cluster is updated and the condition is not repeated
+func TestUpdateVerrazzanoProjectStatusResourceUsage(t *testing.T) {
+	assert := asserts.New(t)
+
+	condition := clustersv1alpha1.Condition{Type: clustersv1alpha1.DeployComplete, Status: corev1.ConditionTrue, Message: "VerrazzanoProject created"}
+	vp := clustersv1alpha1.VerrazzanoProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: "myproject"},
+		Status: clustersv1alpha1.MultiClusterResourceStatus{
+			Conditions: []clustersv1alpha1.Condition{condition},
+			Clusters:   []clustersv1alpha1.ClusterLevelStatus{{Name: "managed1", State: clustersv1alpha1.Succeeded}},
+		},
+	}
+	adminClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(&vp).Build()
+	s := &Syncer{AdminClient: adminClient, Context: context.TODO()}
+
+	usage := &clustersv1alpha1.ResourceUsage{Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("3")}}
+	clusterStatus := clustersv1alpha1.ClusterLevelStatus{Name: "managed1", State: clustersv1alpha1.Succeeded, ResourceUsage: usage}
+	assert.NoError(s.updateVerrazzanoProjectStatus(types.NamespacedName{Namespace: vp.Namespace, Name: vp.Name}, condition, clusterStatus))
+
+	updated := clustersv1alpha1.VerrazzanoProject{}
+	assert.NoError(adminClient.Get(context.TODO(), types.NamespacedName{Namespace: vp.Namespace, Name: vp.Name}, &updated))
+	assert.Len(updated.Status.Conditions, 1)
+	assert.Len(updated.Status.Clusters, 1)
+	assert.NotNil(updated.Status.Clusters[0].ResourceUsage)
+	assert.True(resource.MustParse("3").Equal(updated.Status.Clusters[0].ResourceUsage.Used[corev1.ResourcePods]))
+}
+
+// TestSyncPeerServiceExports tests copying the services published by the other clusters to the local VerrazzanoProject
+// GIVEN a VerrazzanoProject with service discovery, whose status on the admin cluster has the services of other clusters
+// WHEN the VerrazzanoProject is synchronized to the managed cluster
+// THEN the cluster level statuses of the other clusters that publish services are added to the local status
+// WHEN service discovery is disabled and the VerrazzanoProject is synchronized again
+// THEN only the cluster level status of the managed cluster remains
+func TestSyncPeerServiceExports(t *testing.T) {
+	assert := asserts.New(t)
+
+	exports := &clustersv1alpha1.ServiceExports{GatewayAddress: "1.2.3.4", GatewayPort: 15443, Services: []clustersv1alpha1.ExportedService{{Name: "hello", Namespace: "ns1"}}}
+	adminVP := clustersv1alpha1.VerrazzanoProject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: "myproject"},
+		Spec: clustersv1alpha1.VerrazzanoProjectSpec{
+			Template: clustersv1alpha1.ProjectTemplate{
+				Namespaces:       []clustersv1alpha1.NamespaceTemplate{{Metadata: metav1.ObjectMeta{Name: "ns1"}}},
+				ServiceDiscovery: &clustersv1alpha1.ServiceDiscoverySpec{},
+			},
+		},
+		Status: clustersv1alpha1.MultiClusterResourceStatus{
+			Clusters: []clustersv1alpha1.ClusterLevelStatus{
+				{Name: "managed1", State: clustersv1alpha1.Pending},
+				{Name: "managed2", State: clustersv1alpha1.Succeeded, ServiceExports: exports},
+				{Name: "managed3", State: clustersv1alpha1.Succeeded},
+			},
+		},
+	}
+	localVP := adminVP.DeepCopy()
+	localVP.Status.Clusters = []clustersv1alpha1.ClusterLevelStatus{{Name: "managed1", State: clustersv1alpha1.Succeeded}}
+	localClient := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(localVP).Build()
+	s := &Syncer{LocalClient: localClient, ManagedClusterName: "managed1", Context: context.TODO(), Log: zap.S()}
+
+	_, err := s.createOrUpdateVerrazzanoProject(adminVP)
+	assert.NoError(err)
+	updated := clustersv1alpha1.VerrazzanoProject{}
+	assert.NoError(localClient.Get(context.TODO(), types.NamespacedName{Namespace: adminVP.Namespace, Name: adminVP.Name}, &updated))
+	assert.Len(updated.Status.Clusters, 2)
+	assert.Equal(clustersv1alpha1.ClusterLevelStatus{Name: "managed1", State: clustersv1alpha1.Succeeded}, updated.Status.Clusters[0])
+	assert.Equal("managed2", updated.Status.Clusters[1].Name)
+	assert.Equal(exports, updated.Status.Clusters[1].ServiceExports)
+
+	adminVP.Spec.Template.ServiceDiscovery = nil
+	_, err = s.createOrUpdateVerrazzanoProject(adminVP)
+	assert.NoError(err)
+	assert.NoError(localClient.Get(context.TODO(), types.NamespacedName{Namespace: adminVP.Namespace, Name: adminVP.Name}, &updated))
+	assert.Len(updated.Status.Clusters, 1)
+	assert.Equal("managed1", updated.Status.Clusters[0].Name)
+}
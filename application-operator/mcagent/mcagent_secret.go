@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2022, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -28,16 +28,22 @@ func (s *Syncer) syncMCSecretObjects(namespace string) error {
 	}
 
 	// Write each of the records that are targeted to this cluster
+	synced := []adminResource{}
 	for _, mcSecret := range allAdminMCSecrets.Items {
 		if s.isThisCluster(mcSecret.Spec.Placement) {
-			_, err := s.createOrUpdateMCSecret(mcSecret)
+			synced = append(synced, newAdminResource(&mcSecret))
+			opResult, err := s.createOrUpdateMCSecret(mcSecret)
 			if err != nil {
 				s.Log.Errorw(fmt.Sprintf("Failed syncing object: %v", err),
 					"MultiClusterSecret",
 					types.NamespacedName{Namespace: mcSecret.Namespace, Name: mcSecret.Name})
+			} else if opResult == controllerutil.OperationResultNone {
+				// The multicluster resource is unchanged, check that its resource still matches the template
+				s.checkMCSecretDrift(mcSecret)
 			}
 		}
 	}
+	s.adminResources.record(kindMCSecret, namespace, synced)
 
 	// Delete orphaned or no longer placed MultiClusterSecret resources.
 	// Get the list of MultiClusterSecret resources on the
@@ -0,0 +1,113 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"fmt"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+)
+
+// The maximum number of status updates waiting to be sent to the admin cluster
+const statusUpdateQueueLimit = 1000
+
+// queuedStatusUpdate is a status update of a multicluster resource on the admin cluster, in a form that can be
+// persisted in the agent cache
+type queuedStatusUpdate struct {
+	Kind          string                              `json:"kind"`
+	Namespace     string                              `json:"namespace"`
+	Name          string                              `json:"name"`
+	Condition     clustersv1alpha1.Condition          `json:"condition"`
+	ClusterStatus clustersv1alpha1.ClusterLevelStatus `json:"clusterStatus"`
+}
+
+// statusUpdateQueue is a bounded queue of the status updates waiting to be sent to the admin cluster.
+// When the queue is full, the oldest update is dropped.
+type statusUpdateQueue struct {
+	updates []queuedStatusUpdate
+}
+
+// push adds an update to the end of the queue and returns true if the oldest update was dropped to make room for it
+func (q *statusUpdateQueue) push(update queuedStatusUpdate) bool {
+	dropped := false
+	if len(q.updates) >= statusUpdateQueueLimit {
+		q.updates = q.updates[1:]
+		dropped = true
+	}
+	q.updates = append(q.updates, update)
+	return dropped
+}
+
+// peek returns the oldest update in the queue
+func (q *statusUpdateQueue) peek() (queuedStatusUpdate, bool) {
+	if len(q.updates) == 0 {
+		return queuedStatusUpdate{}, false
+	}
+	return q.updates[0], true
+}
+
+// pop removes the oldest update from the queue
+func (q *statusUpdateQueue) pop() {
+	if len(q.updates) > 0 {
+		q.updates = q.updates[1:]
+	}
+}
+
+func (q *statusUpdateQueue) len() int {
+	return len(q.updates)
+}
+
+// newQueuedStatusUpdate converts a status update message received from the local controllers to a queued status update
+func newQueuedStatusUpdate(msg clusters.StatusUpdateMessage) (queuedStatusUpdate, error) {
+	update := queuedStatusUpdate{
+		Condition:     msg.NewCondition,
+		ClusterStatus: msg.NewClusterStatus,
+	}
+	switch msg.Resource.(type) {
+	case *clustersv1alpha1.MultiClusterApplicationConfiguration:
+		update.Kind = kindMCApplicationConfig
+	case *clustersv1alpha1.MultiClusterComponent:
+		update.Kind = kindMCComponent
+	case *clustersv1alpha1.MultiClusterConfigMap:
+		update.Kind = kindMCConfigMap
+	case *clustersv1alpha1.MultiClusterSecret:
+		update.Kind = kindMCSecret
+	case *clustersv1alpha1.VerrazzanoProject:
+		update.Kind = kindVerrazzanoProject
+	default:
+		return update, fmt.Errorf("received status update message for unknown resource type %T", msg.Resource)
+	}
+	update.Namespace = msg.Resource.GetNamespace()
+	update.Name = msg.Resource.GetName()
+	return update, nil
+}
+
+// queueStatusUpdates moves the status update messages received from the local controllers to the status update queue
+func (s *Syncer) queueStatusUpdates() {
+	if s.statusQueue == nil {
+		s.statusQueue = &statusUpdateQueue{}
+	}
+	length := len(s.StatusUpdateChannel)
+	for i := 0; i < length; i++ {
+		update, err := newQueuedStatusUpdate(<-s.StatusUpdateChannel)
+		if err != nil {
+			s.Log.Errorf("Failed to queue status update: %v", err)
+			continue
+		}
+		s.queueStatusUpdate(update)
+	}
+	statusUpdateQueueLengthMetric.Set(float64(s.statusQueue.len()))
+}
+
+// queueStatusUpdate adds a status update to the status update queue
+func (s *Syncer) queueStatusUpdate(update queuedStatusUpdate) {
+	if s.statusQueue == nil {
+		s.statusQueue = &statusUpdateQueue{}
+	}
+	if s.statusQueue.push(update) {
+		s.Log.Errorf("The status update queue is full, dropped the oldest status update")
+		statusUpdatesDroppedMetric.Inc()
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcagent
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	asserts "github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
+	"github.com/verrazzano/verrazzano/application-operator/mocks"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TestStatusUpdateQueueBounded tests the status update queue limit
+// GIVEN a full status update queue
+// WHEN a status update is added
+// THEN the oldest status update is dropped
+func TestStatusUpdateQueueBounded(t *testing.T) {
+	assert := asserts.New(t)
+	q := &statusUpdateQueue{}
+	for i := 0; i < statusUpdateQueueLimit; i++ {
+		assert.False(q.push(queuedStatusUpdate{Kind: kindMCSecret, Name: fmt.Sprintf("secret%d", i)}))
+	}
+	assert.True(q.push(queuedStatusUpdate{Kind: kindMCSecret, Name: "newest"}))
+	assert.Equal(statusUpdateQueueLimit, q.len())
+	oldest, ok := q.peek()
+	assert.True(ok)
+	assert.Equal("secret1", oldest.Name)
+	assert.Equal("newest", q.updates[q.len()-1].Name)
+}
+
+// TestNewQueuedStatusUpdate tests the conversion of status update messages to queued status updates
+// GIVEN status update messages for multicluster resources
+// WHEN they are converted to queued status updates
+// THEN the kind, name and namespace of the resource are kept
+func TestNewQueuedStatusUpdate(t *testing.T) {
+	assert := asserts.New(t)
+	for _, msg := range makeStatusUpdateMessages() {
+		update, err := newQueuedStatusUpdate(msg)
+		assert.NoError(err)
+		assert.Equal(msg.Resource.GetName(), update.Name)
+		assert.Equal(msg.Resource.GetNamespace(), update.Namespace)
+		assert.Equal(msg.NewCondition, update.Condition)
+		assert.Equal(msg.NewClusterStatus, update.ClusterStatus)
+	}
+	update, err := newQueuedStatusUpdate(clusters.StatusUpdateMessage{Resource: &v1alpha1.VerrazzanoProject{}})
+	assert.NoError(err)
+	assert.Equal(kindVerrazzanoProject, update.Kind)
+}
+
+// TestProcessStatusUpdatesAdminUnavailable tests the processStatusUpdates method when the admin cluster is unavailable
+// GIVEN status update messages on the status update channel
+// WHEN processStatusUpdates is called and the admin cluster is unavailable
+// THEN the status updates are kept in the queue
+func TestProcessStatusUpdatesAdminUnavailable(t *testing.T) {
+	assert := asserts.New(t)
+	adminMocker := gomock.NewController(t)
+	adminMock := mocks.NewMockClient(adminMocker)
+
+	statusUpdates := makeStatusUpdateMessages()
+	statusUpdatesChan := make(chan clusters.StatusUpdateMessage, 5)
+	for _, update := range statusUpdates {
+		statusUpdatesChan <- update
+	}
+
+	// The first status update fails, the second one is not attempted
+	adminMock.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.NewServiceUnavailable("the admin cluster is down"))
+
+	s := &Syncer{
+		Context:             context.TODO(),
+		AdminClient:         adminMock,
+		ManagedClusterName:  "mycluster1",
+		StatusUpdateChannel: statusUpdatesChan,
+		Log:                 zap.S().With("statusUpdateUnitTest"),
+	}
+	s.processStatusUpdates()
+	assert.Equal(0, len(statusUpdatesChan))
+	assert.Equal(len(statusUpdates), s.statusQueue.len())
+	assert.Equal(float64(len(statusUpdates)), testutil.ToFloat64(statusUpdateQueueLengthMetric))
+	adminMocker.Finish()
+
+	// When the admin cluster is back, the status updates are sent and removed from the queue, even if they fail
+	// for another reason
+	adminMocker = gomock.NewController(t)
+	adminMock = mocks.NewMockClient(adminMocker)
+	adminMock.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.NewNotFound(schema.GroupResource{Group: "clusters.verrazzano.io", Resource: "multiclustersecrets"}, "somesecret")).
+		Times(len(statusUpdates))
+	s.AdminClient = adminMock
+	s.processStatusUpdates()
+	assert.Equal(0, s.statusQueue.len())
+	adminMocker.Finish()
+}
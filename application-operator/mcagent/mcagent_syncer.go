@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -6,8 +6,6 @@ package mcagent
 import (
 	"context"
 	"fmt"
-	"reflect"
-	"strings"
 	"time"
 
 	clustersv1alpha1 "github.com/verrazzano/verrazzano/application-operator/apis/clusters/v1alpha1"
@@ -26,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -38,9 +37,27 @@ type Syncer struct {
 	ManagedClusterName   string
 	Context              context.Context
 
+	// Name of the admin cluster token used by the agent, reported to the admin cluster to confirm credential rotation
+	AgentCredential string
+
 	// List of namespaces to watch for multi-cluster objects.
 	ProjectNamespaces   []string
 	StatusUpdateChannel chan clusters.StatusUpdateMessage
+
+	// Synchronization times of the multicluster resource kinds, used to report the sync lag
+	syncTimes *syncTimes
+
+	// Status updates waiting to be sent to the admin cluster
+	statusQueue *statusUpdateQueue
+
+	// Admin resources placed on this cluster at the last synchronization, kept in the agent cache
+	adminResources adminResources
+
+	// Records the drift events of the multicluster resources
+	Recorder record.EventRecorder
+
+	// Drift of the resources of the multicluster resources, kept across agent iterations
+	drift driftState
 }
 
 type adminStatusUpdateFuncType = func(name types.NamespacedName, newCond clustersv1alpha1.Condition, newClusterStatus clustersv1alpha1.ClusterLevelStatus) error
@@ -79,23 +96,28 @@ func (s *Syncer) isThisCluster(placement clustersv1alpha1.Placement) bool {
 	return false
 }
 
-// processStatusUpdates monitors the StatusUpdateChannel for any
-// received messages and processes a batch of them
+// processStatusUpdates queues the messages received on the StatusUpdateChannel and sends a batch of the
+// queued status updates to the admin cluster. Status updates that fail because the admin cluster is
+// unavailable are kept in the queue and retried on a later iteration.
 func (s *Syncer) processStatusUpdates() {
+	s.queueStatusUpdates()
 	for i := 0; i < constants.StatusUpdateBatchSize; i++ {
-		// Use a select with default so as to not block on the channel if there are no updates
-		select {
-		case msg := <-s.StatusUpdateChannel:
-			err := s.performAdminStatusUpdate(msg)
-			if err != nil {
-				s.Log.Errorf("Failed to update status on admin cluster for %s/%s from cluster %s after %d retries: %v",
-					msg.Resource.GetNamespace(), msg.Resource.GetName(),
-					msg.NewClusterStatus.Name, retryCount, err)
-			}
-		default:
+		update, ok := s.statusQueue.peek()
+		if !ok {
+			break
+		}
+		err := s.performAdminStatusUpdate(update)
+		if isAdminUnavailable(err) {
+			s.Log.Infof("The admin cluster is unavailable, %d status updates will be retried: %v", s.statusQueue.len(), err)
 			break
 		}
+		s.statusQueue.pop()
+		if err != nil {
+			s.Log.Errorf("Failed to update status on admin cluster for %s/%s from cluster %s after %d retries: %v",
+				update.Namespace, update.Name, update.ClusterStatus.Name, retryCount, err)
+		}
 	}
+	statusUpdateQueueLengthMetric.Set(float64(s.statusQueue.len()))
 }
 
 // getVerrazzanoManagedNamespaces - return the list of namespaces that have the Verrazzano managed label set to true
@@ -122,24 +144,24 @@ func (s *Syncer) getManagedNamespaces() ([]string, error) {
 	return nsList, nil
 }
 
-func (s *Syncer) performAdminStatusUpdate(msg clusters.StatusUpdateMessage) error {
-	fullResourceName := types.NamespacedName{Name: msg.Resource.GetName(), Namespace: msg.Resource.GetNamespace()}
-	typeName := reflect.TypeOf(msg.Resource).String()
+func (s *Syncer) performAdminStatusUpdate(update queuedStatusUpdate) error {
+	fullResourceName := types.NamespacedName{Name: update.Name, Namespace: update.Namespace}
 	var statusUpdateFunc adminStatusUpdateFuncType
-	if strings.Contains(typeName, reflect.TypeOf(clustersv1alpha1.MultiClusterApplicationConfiguration{}).String()) {
+	switch update.Kind {
+	case kindMCApplicationConfig:
 		statusUpdateFunc = s.updateMultiClusterAppConfigStatus
-	} else if strings.Contains(typeName, reflect.TypeOf(clustersv1alpha1.MultiClusterComponent{}).String()) {
+	case kindMCComponent:
 		statusUpdateFunc = s.updateMultiClusterComponentStatus
-	} else if strings.Contains(typeName, reflect.TypeOf(clustersv1alpha1.MultiClusterConfigMap{}).String()) {
+	case kindMCConfigMap:
 		statusUpdateFunc = s.updateMultiClusterConfigMapStatus
-	} else if strings.Contains(typeName, reflect.TypeOf(clustersv1alpha1.MultiClusterSecret{}).String()) {
+	case kindMCSecret:
 		statusUpdateFunc = s.updateMultiClusterSecretStatus
-	} else if strings.Contains(typeName, reflect.TypeOf(clustersv1alpha1.VerrazzanoProject{}).String()) {
+	case kindVerrazzanoProject:
 		statusUpdateFunc = s.updateVerrazzanoProjectStatus
-	} else {
-		return fmt.Errorf("received status update message for unknown resource type %s", typeName)
+	default:
+		return fmt.Errorf("received status update message for unknown resource kind %s", update.Kind)
 	}
-	return s.adminStatusUpdateWithRetry(statusUpdateFunc, fullResourceName, msg.NewCondition, msg.NewClusterStatus)
+	return s.adminStatusUpdateWithRetry(statusUpdateFunc, fullResourceName, update.Condition, update.ClusterStatus)
 }
 
 func (s *Syncer) adminStatusUpdateWithRetry(statusUpdateFunc adminStatusUpdateFuncType,
@@ -171,6 +193,9 @@ func (s *Syncer) updateVMCStatus() error {
 
 	curTime := v1.Now()
 	vmc.Status.LastAgentConnectTime = &curTime
+	if len(s.AgentCredential) > 0 {
+		vmc.Status.AgentCredential = s.AgentCredential
+	}
 	apiURL, err := s.getAPIServerURL()
 	if err != nil {
 		return fmt.Errorf("Failed to get api server url for vmc %s with error %v", vmcName, err)
@@ -210,6 +235,24 @@ func (s *Syncer) updateVMCStatus() error {
 	}
 	vmc.Status.Verrazzano.Version = vzVersion
 
+	// Update the health summary of this managed cluster on the VMC. A failure to get the health summary must not
+	// prevent the rest of the status from being updated, since the last connect time is the heartbeat of the agent.
+	health, err := s.getHealthSummary()
+	if err != nil {
+		s.Log.Errorf("Failed to get health information to update VMC %s: %v", vmcName, err)
+	} else {
+		vmc.Status.Health = health
+	}
+
+	// Report the admin CA bundle held by this managed cluster, so that the admin cluster knows when this managed
+	// cluster trusts a new CA
+	fingerprint, err := s.getAdminCABundleFingerprint()
+	if err != nil {
+		s.Log.Errorf("Failed to get the admin CA bundle to update VMC %s: %v", vmcName, err)
+	} else {
+		vmc.Status.AdminCABundleFingerprint = fingerprint
+	}
+
 	// update status of VMC
 	return s.AdminClient.Status().Update(s.Context, &vmc)
 }
@@ -255,9 +298,12 @@ func (s *Syncer) SyncMultiClusterResources() {
 		}
 		s.Log.Errorf("Failed retrieving CRD %s: %v", mcAppConfCRDName, err)
 	}
+	// Kinds that failed to sync in any namespace
+	failed := map[string]bool{}
 	err := s.syncVerrazzanoProjects()
 	if err != nil {
 		s.Log.Errorf("Failed syncing VerrazzanoProject objects: %v", err)
+		failed[kindVerrazzanoProject] = true
 	}
 
 	// Synchronize objects one namespace at a time
@@ -265,27 +311,34 @@ func (s *Syncer) SyncMultiClusterResources() {
 		err = s.syncSecretObjects(namespace)
 		if err != nil {
 			s.Log.Errorf("Failed to sync Secret objects: %v", err)
+			failed[kindSecret] = true
 		}
 		err = s.syncMCSecretObjects(namespace)
 		if err != nil {
 			s.Log.Errorf("Failed to sync MultiClusterSecret objects: %v", err)
+			failed[kindMCSecret] = true
 		}
 		err = s.syncMCConfigMapObjects(namespace)
 		if err != nil {
 			s.Log.Errorf("Failed to sync MultiClusterConfigMap objects: %v", err)
+			failed[kindMCConfigMap] = true
 		}
 		err = s.syncMCComponentObjects(namespace)
 		if err != nil {
 			s.Log.Errorf("Failed to sync MultiClusterComponent objects: %v", err)
+			failed[kindMCComponent] = true
 		}
 		err = s.syncMCApplicationConfigurationObjects(namespace)
 		if err != nil {
 			s.Log.Errorf("Failed to sync MultiClusterApplicationConfiguration objects: %v", err)
+			failed[kindMCApplicationConfig] = true
 		}
 
 		s.processStatusUpdates()
 
 	}
+	s.recordSyncTimes(failed)
+	s.updateSyncMetrics(failed)
 }
 
 // getAPIServerURL returns the API Server URL for Verrazzano instance.
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mcagent
@@ -16,6 +16,7 @@ import (
 	"github.com/verrazzano/verrazzano/application-operator/controllers/clusters"
 	"github.com/verrazzano/verrazzano/application-operator/mocks"
 	clustersapi "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/certs"
 	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/pkg/mcconstants"
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
@@ -46,6 +47,7 @@ var validSecret = corev1.Secret{
 const testManagedPrometheusHost = "prometheus"
 const testManagedThanosQueryStoreAPIHost = "thanos-query-store.example.com"
 const testVZVersion = "dummy-verrazzano-version"
+const testAdminCABundle = "admin-ca-bundle"
 
 var testK8sVersion = &version.Info{
 	GitVersion: "v1.26.3",
@@ -318,6 +320,8 @@ func expectAdminVMCStatusUpdateSuccess(adminMock *mocks.MockClient, vmcName type
 			assert.Equal(testManagedThanosQueryStoreAPIHost, vmc.Status.ThanosQueryStore)
 			assert.Equal(testK8sVersion.String(), vmc.Status.Kubernetes.Version)
 			assert.Equal(testVZVersion, vmc.Status.Verrazzano.Version)
+			assert.NotNil(vmc.Status.Health)
+			assert.Equal(certs.GetCABundleFingerprint([]byte(testAdminCABundle)), vmc.Status.AdminCABundleFingerprint)
 			return nil
 		})
 }
@@ -356,6 +360,13 @@ func expectCASyncSuccess(localMock, adminMock *mocks.MockClient, assert *asserts
 			secret.Namespace = localRegistrationSecret.Namespace
 			return nil
 		})
+	// Admin Cluster - expect call to get the agent secret. Return not found, the local agent secret
+	// is then left as is.
+	adminMock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: getAgentSecretName(testClusterName)}, gomock.Not(gomock.Nil()), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name types.NamespacedName, secret *corev1.Secret, opts ...client.GetOption) error {
+			return errors.NewNotFound(schema.GroupResource{Group: "", Resource: "Secret"}, name.Name)
+		})
 	localMock.EXPECT().
 		Get(gomock.Any(), localIngressTLSSecret, gomock.Not(gomock.Nil()), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, name types.NamespacedName, secret *corev1.Secret, opts ...client.GetOption) error {
@@ -413,6 +424,8 @@ func TestSyncer_updateVMCStatus(t *testing.T) {
 	expectGetPrometheusHostCalled(localClientMock)
 	expectGetThanosQueryHostCalled(localClientMock)
 	expectGetWorkloadVZVersionCalled(localClientMock)
+	expectGetHealthSummaryCalled(localClientMock)
+	expectGetAdminCABundleCalled(localClientMock)
 	// Mock the success of status updates and assert that updateVMCStatus returns nil error
 	expectAdminVMCStatusUpdateSuccess(adminMock, vmcName, adminStatusMock, assert)
 	assert.Nil(s.updateVMCStatus())
@@ -424,6 +437,57 @@ func TestSyncer_updateVMCStatus(t *testing.T) {
 	adminMocker.Finish()
 }
 
+// TestSyncer_updateVMCStatusHealthFailure tests updateVMCStatus when the health summary cannot be computed
+// GIVEN updateVMCStatus is called
+// WHEN listing the resources of the health summary fails
+// THEN the status of the VMC is still updated with the last connect time, without the health summary
+func TestSyncer_updateVMCStatusHealthFailure(t *testing.T) {
+	assert := asserts.New(t)
+	log := zap.S().With("test")
+
+	adminMocker := gomock.NewController(t)
+	adminMock := mocks.NewMockClient(adminMocker)
+	adminStatusMock := mocks.NewMockStatusWriter(adminMocker)
+	localClientMock := mocks.NewMockClient(adminMocker)
+
+	fakeDiscoveryClient, err := fakeDiscoveryClientFunc()
+	assert.Nil(err)
+
+	s := &Syncer{
+		AdminClient:          adminMock,
+		Log:                  log,
+		ManagedClusterName:   "my-test-cluster",
+		LocalClient:          localClientMock,
+		LocalDiscoveryClient: fakeDiscoveryClient,
+	}
+	vmcName := types.NamespacedName{Name: s.ManagedClusterName, Namespace: constants.VerrazzanoMultiClusterNamespace}
+
+	expectGetAPIServerURLCalled(localClientMock)
+	expectGetPrometheusHostCalled(localClientMock)
+	expectGetThanosQueryHostCalled(localClientMock)
+	expectGetWorkloadVZVersionCalled(localClientMock)
+	// Fail listing the Verrazzanos for the component health
+	localClientMock.EXPECT().
+		List(gomock.Any(), &v1beta1.VerrazzanoList{}, gomock.Any()).
+		Return(errors.NewServiceUnavailable("unavailable"))
+	expectGetAdminCABundleCalled(localClientMock)
+
+	expectGetVMC(adminMock, vmcName, "")
+	adminMock.EXPECT().Status().Return(adminStatusMock)
+	adminStatusMock.EXPECT().
+		Update(gomock.Any(), gomock.AssignableToTypeOf(&clustersapi.VerrazzanoManagedCluster{}), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, vmc *clustersapi.VerrazzanoManagedCluster, opts ...client.UpdateOption) error {
+			assert.NotNil(vmc.Status.LastAgentConnectTime)
+			assert.Equal(testVZVersion, vmc.Status.Verrazzano.Version)
+			assert.Nil(vmc.Status.Health)
+			assert.Equal(certs.GetCABundleFingerprint([]byte(testAdminCABundle)), vmc.Status.AdminCABundleFingerprint)
+			return nil
+		})
+	assert.Nil(s.updateVMCStatus())
+
+	adminMocker.Finish()
+}
+
 func expectGetWorkloadVZVersionCalled(mock *mocks.MockClient) {
 	// Expect a call to list the Verrazzanos.
 	mock.EXPECT().
@@ -440,6 +504,30 @@ func expectGetWorkloadVZVersionCalled(mock *mocks.MockClient) {
 		})
 }
 
+func expectGetHealthSummaryCalled(mock *mocks.MockClient) {
+	// Expect calls to list the Verrazzanos, nodes and the secrets in each certificate namespace
+	mock.EXPECT().
+		List(gomock.Any(), &v1beta1.VerrazzanoList{}, gomock.Any()).
+		Return(nil)
+	mock.EXPECT().
+		List(gomock.Any(), &corev1.NodeList{}, gomock.Any()).
+		Return(nil)
+	mock.EXPECT().
+		List(gomock.Any(), &corev1.SecretList{}, gomock.Any()).
+		Return(nil).
+		Times(len(certificateNamespaces))
+}
+
+func expectGetAdminCABundleCalled(mock *mocks.MockClient) {
+	// Expect a call to get the local registration secret holding the admin CA bundle
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: constants.VerrazzanoSystemNamespace, Name: constants.MCRegistrationSecret}, gomock.Not(gomock.Nil()), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name types.NamespacedName, secret *corev1.Secret, opts ...client.GetOption) error {
+			secret.Data = map[string][]byte{mcconstants.AdminCaBundleKey: []byte(testAdminCABundle)}
+			return nil
+		})
+}
+
 func expectGetAPIServerURLCalled(mock *mocks.MockClient) {
 	// Expect a call to get the console ingress and return the ingress.
 	mock.EXPECT().
@@ -612,6 +700,8 @@ func expectAllCallsNoApps(adminMock *mocks.MockClient, mcMock *mocks.MockClient,
 	expectGetPrometheusHostCalled(mcMock)
 	expectGetThanosQueryHostCalled(mcMock)
 	expectGetWorkloadVZVersionCalled(mcMock)
+	expectGetHealthSummaryCalled(mcMock)
+	expectGetAdminCABundleCalled(mcMock)
 	expectAdminVMCStatusUpdateSuccess(adminMock, vmcName, adminStatusMock, assert)
 
 	// Managed Cluster - expect call to get MC app config CRD - return exists
@@ -633,6 +723,22 @@ func expectAllCallsNoApps(adminMock *mocks.MockClient, mcMock *mocks.MockClient,
 	clusterCASecret := "clusterCASecret"
 	expectGetVMC(adminMock, vmcName, clusterCASecret)
 	expectGetManifestSecretNotFound(adminMock, clusterName)
+
+	expectAgentCacheCreated(mcMock)
+}
+
+func expectAgentCacheCreated(mock *mocks.MockClient) {
+	// expect a get when the cache is loaded and another one when it is saved, where the configmap is not found
+	mock.EXPECT().
+		Get(gomock.Any(), mcAgentCacheConfigMapName, gomock.Not(gomock.Nil()), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name types.NamespacedName, cm *corev1.ConfigMap, opts ...client.GetOption) error {
+			return errors.NewNotFound(schema.GroupResource{Group: "", Resource: "ConfigMap"}, name.Name)
+		}).Times(2)
+
+	// expect a create of the config map
+	mock.EXPECT().
+		Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.ConfigMap{})).
+		Return(nil)
 }
 
 func expectGetMCAppConfigCRD(mock *mocks.MockClient) {
@@ -1,16 +1,19 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
 
 import (
 	"context"
+	"fmt"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
 	ocnemeta "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/ocne"
 	vzerror "github.com/verrazzano/verrazzano/cluster-operator/internal/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"net/url"
+	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
@@ -101,6 +104,34 @@ func addOCINetworkErrors(ctx *validationContext, ociClient oci.Client, network *
 	}
 }
 
+// addNodePoolUpdateErrors validates updated node pools. Node pools may be added or removed, but only the replicas of
+// an existing node pool may be changed.
+func addNodePoolUpdateErrors(ctx *validationContext, oldPools, newPools []NamedOCINode, field string) {
+	existing := map[string]NamedOCINode{}
+	for _, np := range oldPools {
+		existing[np.Name] = np
+	}
+	for i, np := range newPools {
+		oldPool, ok := existing[np.Name]
+		if !ok {
+			addOCINodeErrors(ctx, np.OCINode, fmt.Sprintf("%s[%d]", field, i))
+			continue
+		}
+		oldPool.Replicas = np.Replicas
+		if !reflect.DeepEqual(oldPool, np) {
+			ctx.Errors.Addf("%s[%d] only replicas may be updated", field, i)
+		}
+	}
+}
+
+// validateDeletionProtection rejects the deletion of a quick create that has deletion protection enabled.
+func validateDeletionProtection(o metav1.Object) error {
+	if o.GetAnnotations()[DeletionProtectionAnnotation] == "true" {
+		return fmt.Errorf("deletion protection is enabled for %s/%s, remove the %s annotation to delete it", o.GetNamespace(), o.GetName(), DeletionProtectionAnnotation)
+	}
+	return nil
+}
+
 func addOCNEErrors(ctx *validationContext, ocne OCNE, field string) {
 	if _, err := ocnemeta.GetVersionDefaults(ctx.Ctx, ctx.Cli, ocne.Version); err != nil {
 		ctx.Errors.Addf("%s.version [%s] is not a known OCNE version", field, ocne.Version)
@@ -0,0 +1,65 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DockerQuickCreate specifies the API for quick-create Cluster API Docker (CAPD) clusters, used for local development.
+type DockerQuickCreate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of a DockerQuickCreate resource.
+	Spec DockerQuickCreateSpec `json:"spec,omitempty"`
+	// The observed state of a DockerQuickCreate resource.
+	Status DockerQuickCreateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DockerQuickCreateList contains a list of DockerQuickCreate resources.
+type DockerQuickCreateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DockerQuickCreate `json:"items"`
+}
+
+type (
+	DockerQuickCreateSpec struct {
+		// Kubernetes settings.
+		Kubernetes `json:"kubernetes"`
+		// +optional
+
+		// Docker cluster settings.
+		Docker Docker `json:"docker,omitempty"`
+	}
+	Docker struct {
+		// +optional
+		// +kubebuilder:validation:Minimum:=1
+
+		// Number of control plane nodes.
+		// The default is `1`.
+		ControlPlaneReplicas *int `json:"controlPlaneReplicas,omitempty"`
+		// +optional
+		// +kubebuilder:validation:Minimum:=0
+
+		// Number of worker nodes.
+		// The default is `1`.
+		WorkerReplicas *int `json:"workerReplicas,omitempty"`
+		// +optional
+
+		// Node container image.
+		// The default is the kindest/node image of the Kubernetes version.
+		NodeImage string `json:"nodeImage,omitempty"`
+	}
+	DockerQuickCreateStatus struct {
+		QuickCreateStatus `json:",inline"`
+	}
+)
@@ -0,0 +1,56 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"k8s.io/apimachinery/pkg/runtime"
+	"reflect"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var _ webhook.Validator = &DockerQuickCreate{}
+
+// SetupWebhookWithManager is used to let the controller manager know about the webhook
+func (d *DockerQuickCreate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(d).
+		Complete()
+}
+
+// ValidateCreate validates the DockerQuickCreate input.
+func (d *DockerQuickCreate) ValidateCreate() error {
+	return d.validateControlPlaneReplicas()
+}
+
+// ValidateUpdate rejects changes to the quick create spec other than the number of control plane and worker nodes.
+func (d *DockerQuickCreate) ValidateUpdate(old runtime.Object) error {
+	oldCluster, ok := old.(*DockerQuickCreate)
+	if !ok {
+		return errors.New("update resource must be of kind DockerQuickCreate")
+	}
+	// Reset the fields that may be updated, the rest of the spec must not change
+	spec := d.Spec.DeepCopy()
+	spec.Docker.ControlPlaneReplicas = oldCluster.Spec.Docker.ControlPlaneReplicas
+	spec.Docker.WorkerReplicas = oldCluster.Spec.Docker.WorkerReplicas
+	if !reflect.DeepEqual(*spec, oldCluster.Spec) {
+		return errors.New("only spec.docker.controlPlaneReplicas and spec.docker.workerReplicas may be updated")
+	}
+	return d.validateControlPlaneReplicas()
+}
+
+// ValidateDelete rejects the deletion of a cluster with deletion protection enabled.
+func (d *DockerQuickCreate) ValidateDelete() error {
+	return validateDeletionProtection(d)
+}
+
+func (d *DockerQuickCreate) validateControlPlaneReplicas() error {
+	// An even number of control plane nodes cannot tolerate more etcd member failures than one fewer node
+	if d.Spec.Docker.ControlPlaneReplicas != nil && *d.Spec.Docker.ControlPlaneReplicas%2 == 0 {
+		return fmt.Errorf("spec.docker.controlPlaneReplicas must be an odd number, got %d", *d.Spec.Docker.ControlPlaneReplicas)
+	}
+	return nil
+}
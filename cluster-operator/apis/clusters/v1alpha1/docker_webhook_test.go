@@ -0,0 +1,88 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1alpha1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateCreateDocker(t *testing.T) {
+	one := 1
+	two := 2
+	var tests = []struct {
+		name                 string
+		controlPlaneReplicas *int
+		hasError             bool
+	}{
+		{
+			"no error for default control plane replicas",
+			nil,
+			false,
+		},
+		{
+			"no error for odd control plane replicas",
+			&one,
+			false,
+		},
+		{
+			"error for even control plane replicas",
+			&two,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DockerQuickCreate{
+				Spec: DockerQuickCreateSpec{
+					Docker: Docker{
+						ControlPlaneReplicas: tt.controlPlaneReplicas,
+					},
+				},
+			}
+			err := d.ValidateCreate()
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateDocker(t *testing.T) {
+	one := 1
+	three := 3
+	four := 4
+	old := &DockerQuickCreate{
+		Spec: DockerQuickCreateSpec{
+			Kubernetes: Kubernetes{Version: "v1.26.2"},
+		},
+	}
+	assert.NoError(t, old.DeepCopy().ValidateUpdate(old))
+
+	// The number of control plane and worker nodes may be updated
+	scaled := old.DeepCopy()
+	scaled.Spec.Docker.WorkerReplicas = &one
+	scaled.Spec.Docker.ControlPlaneReplicas = &three
+	assert.NoError(t, scaled.ValidateUpdate(old))
+
+	// The control plane must keep an odd number of nodes
+	even := old.DeepCopy()
+	even.Spec.Docker.ControlPlaneReplicas = &four
+	assert.Error(t, even.ValidateUpdate(old))
+
+	// The rest of the spec may not be updated
+	upgraded := old.DeepCopy()
+	upgraded.Spec.Kubernetes.Version = "v1.27.1"
+	assert.Error(t, upgraded.ValidateUpdate(old))
+	assert.Error(t, scaled.ValidateUpdate(&OKEQuickCreate{}))
+}
+
+func TestValidateDeleteDocker(t *testing.T) {
+	d := &DockerQuickCreate{}
+	assert.NoError(t, d.ValidateDelete())
+	d.Annotations = map[string]string{DeletionProtectionAnnotation: "true"}
+	assert.Error(t, d.ValidateDelete())
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Package v1alpha1 contains API Schema definitions for the clusters.verrazzano.io v1alpha1 API group
@@ -12,7 +12,7 @@ import (
 )
 
 func init() {
-	SchemeBuilder.Register(&VerrazzanoManagedCluster{}, &VerrazzanoManagedClusterList{}, &OCNEOCIQuickCreate{}, &OCNEOCIQuickCreateList{}, &OKEQuickCreate{}, &OKEQuickCreateList{})
+	SchemeBuilder.Register(&VerrazzanoManagedCluster{}, &VerrazzanoManagedClusterList{}, &OCNEOCIQuickCreate{}, &OCNEOCIQuickCreateList{}, &OKEQuickCreate{}, &OKEQuickCreateList{}, &DockerQuickCreate{}, &DockerQuickCreateList{})
 }
 
 var (
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -6,6 +6,8 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	ocnemeta "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/ocne"
+	vzerror "github.com/verrazzano/verrazzano/cluster-operator/internal/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -56,18 +58,41 @@ func (o *OCNEOCIQuickCreate) ValidateCreate() error {
 	return nil
 }
 
-// ValidateUpdate rejects any changes to the quick create spec.
+// ValidateUpdate only permits OCNE upgrades, control plane scaling and worker node pool changes.
 func (o *OCNEOCIQuickCreate) ValidateUpdate(old runtime.Object) error {
 	oldCluster, ok := old.(*OCNEOCIQuickCreate)
 	if !ok {
 		return errors.New("update resource must be of kind OCNEOCIQuickCreate")
 	}
-	if !reflect.DeepEqual(o.Spec, oldCluster.Spec) {
-		return errors.New("spec updates are not permitted")
+	// Reset the fields that may be updated, the rest of the spec must not change
+	spec := o.Spec.DeepCopy()
+	spec.OCNE.Version = oldCluster.Spec.OCNE.Version
+	spec.OCI.ControlPlane.Replicas = oldCluster.Spec.OCI.ControlPlane.Replicas
+	spec.OCI.Workers = oldCluster.Spec.OCI.Workers
+	if !reflect.DeepEqual(*spec, oldCluster.Spec) {
+		return errors.New("only spec.ocne.version, spec.oci.controlPlane.replicas and spec.oci.workers may be updated")
+	}
+	ctx := &validationContext{
+		Errors: vzerror.NewAggregator("\n"),
+	}
+	// Upgrades are validated against the OCNE version mapping, which requires a client
+	if o.Spec.OCNE.Version != oldCluster.Spec.OCNE.Version {
+		var err error
+		if ctx, err = NewValidationContext(); err != nil {
+			return fmt.Errorf("failed to create validation context: %w", err)
+		}
+		if err := ocnemeta.ValidateUpgrade(ctx.Ctx, ctx.Cli, oldCluster.Spec.OCNE.Version, o.Spec.OCNE.Version); err != nil {
+			ctx.Errors.Addf("spec.ocne.version is invalid: %v", err)
+		}
+	}
+	addNodePoolUpdateErrors(ctx, oldCluster.Spec.OCI.Workers, o.Spec.OCI.Workers, "spec.oci.workers")
+	if ctx.Errors.HasError() {
+		return ctx.Errors
 	}
 	return nil
 }
 
+// ValidateDelete rejects the deletion of a cluster with deletion protection enabled.
 func (o *OCNEOCIQuickCreate) ValidateDelete() error {
-	return nil
+	return validateDeletionProtection(o)
 }
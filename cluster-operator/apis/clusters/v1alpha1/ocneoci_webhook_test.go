@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -88,10 +88,55 @@ func TestValidateUpdateOCNEOCI(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"no error when upgrading OCNE",
+			func(o *OCNEOCIQuickCreate) {
+				o.Spec.OCNE.Version = "1.6"
+			},
+			false,
+		},
+		{
+			"error when upgrading OCNE more than one minor Kubernetes version",
+			func(o *OCNEOCIQuickCreate) {
+				o.Spec.OCNE.Version = "1.5"
+			},
+			true,
+		},
+		{
+			"no error when scaling nodes",
+			func(o *OCNEOCIQuickCreate) {
+				three := 3
+				o.Spec.OCI.ControlPlane.Replicas = &three
+				o.Spec.OCI.Workers[0].Replicas = &three
+			},
+			false,
+		},
+		{
+			"no error when adding a worker node pool",
+			func(o *OCNEOCIQuickCreate) {
+				o.Spec.OCI.Workers = nil
+			},
+			false,
+		},
+		{
+			"error when updating the shape of a worker node pool",
+			func(o *OCNEOCIQuickCreate) {
+				shape := "VM.Standard.E5.Flex"
+				o.Spec.OCI.Workers[0].Shape = &shape
+			},
+			true,
+		},
 	}
 
+	cm, err := testOCNEConfigMap()
+	assert.NoError(t, err)
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(cm).Build()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			defer func() { NewValidationContext = newValidationContext }()
+			NewValidationContext = func() (*validationContext, error) {
+				return testValidationContext(cli), nil
+			}
 			o1 := o.DeepCopy()
 			o2 := o.DeepCopy()
 			tt.modifier(o2)
@@ -104,3 +149,10 @@ func TestValidateUpdateOCNEOCI(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeleteOCNEOCI(t *testing.T) {
+	o := &OCNEOCIQuickCreate{}
+	assert.NoError(t, o.ValidateDelete())
+	o.Annotations = map[string]string{DeletionProtectionAnnotation: "true"}
+	assert.Error(t, o.ValidateDelete())
+}
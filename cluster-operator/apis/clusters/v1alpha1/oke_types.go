@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -68,7 +68,7 @@ type (
 		Replicas *int   `json:"replicas"`
 	}
 	OKEQuickCreateStatus struct {
-		Phase QuickCreatePhase `json:"phase"`
+		QuickCreateStatus `json:",inline"`
 	}
 )
 
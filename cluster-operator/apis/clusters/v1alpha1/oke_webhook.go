@@ -1,28 +1,70 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/preview"
+	ocnemeta "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/ocne"
+	vzerror "github.com/verrazzano/verrazzano/cluster-operator/internal/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // OCNEOCIQuickCreate should be both a validating and defaulting webhook
 var _ webhook.Validator = &OKEQuickCreate{}
+var _ admission.CustomValidator = &okeQuickCreateValidator{}
+
+// okeQuickCreateValidator validates OKEQuickCreate resources, and previews the compartment capacity for dry-run creates
+type okeQuickCreateValidator struct{}
 
 // SetupWebhookWithManager is used to let the controller manager know about the webhook
 func (o *OKEQuickCreate) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(o).
+		WithValidator(&okeQuickCreateValidator{}).
 		Complete()
 }
 
+func (v *okeQuickCreateValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	o, ok := obj.(*OKEQuickCreate)
+	if !ok {
+		return errors.New("create resource must be of kind OKEQuickCreate")
+	}
+	req, err := admission.RequestFromContext(ctx)
+	dryRun := err == nil && req.DryRun != nil && *req.DryRun
+	return o.validateCreate(dryRun)
+}
+
+func (v *okeQuickCreateValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) error {
+	o, ok := newObj.(*OKEQuickCreate)
+	if !ok {
+		return errors.New("update resource must be of kind OKEQuickCreate")
+	}
+	return o.ValidateUpdate(oldObj)
+}
+
+func (v *okeQuickCreateValidator) ValidateDelete(_ context.Context, obj runtime.Object) error {
+	o, ok := obj.(*OKEQuickCreate)
+	if !ok {
+		return errors.New("delete resource must be of kind OKEQuickCreate")
+	}
+	return o.ValidateDelete()
+}
+
 func (o *OKEQuickCreate) ValidateCreate() error {
+	return o.validateCreate(false)
+}
+
+// validateCreate validates the OKEQuickCreate input. The capacity of the compartment is checked when previewing.
+func (o *OKEQuickCreate) validateCreate(preview bool) error {
 	ctx, err := NewValidationContext()
 	if err != nil {
 		return fmt.Errorf("failed to create validation context: %w", err)
@@ -48,12 +90,63 @@ func (o *OKEQuickCreate) ValidateCreate() error {
 	for i, np := range o.Spec.OKE.NodePools {
 		addOCINodeErrors(ctx, np.OCINode, fmt.Sprintf("spec.oke.nodePools[%d]", i))
 	}
+	if preview && !ctx.Errors.HasError() {
+		addCapacityErrors(ctx, ociClient, o.PreviewRequest())
+	}
 	if ctx.Errors.HasError() {
 		return ctx.Errors
 	}
 	return nil
 }
 
+// PreviewRequest returns the OCI resources of the cluster, for capacity and cost previews
+func (o *OKEQuickCreate) PreviewRequest() preview.Request {
+	req := preview.Request{
+		Compartment: o.Spec.OKE.Compartment,
+	}
+	for _, np := range o.Spec.OKE.NodePools {
+		nodePool := preview.NodePool{
+			Name:          np.Name,
+			OCPUs:         np.OCPUs,
+			MemoryGbs:     np.MemoryGbs,
+			BootVolumeGbs: np.BootVolumeGbs,
+		}
+		if np.Shape != nil {
+			nodePool.Shape = *np.Shape
+		}
+		if np.Replicas != nil {
+			nodePool.Replicas = *np.Replicas
+		}
+		req.NodePools = append(req.NodePools, nodePool)
+	}
+	for _, vnp := range o.Spec.OKE.VirtualNodePools {
+		virtualNodePool := preview.VirtualNodePool{
+			Name: vnp.Name,
+		}
+		if vnp.Replicas != nil {
+			virtualNodePool.Replicas = *vnp.Replicas
+		}
+		req.VirtualNodePools = append(req.VirtualNodePools, virtualNodePool)
+	}
+	return req
+}
+
+func addCapacityErrors(ctx *validationContext, ociClient oci.Client, req preview.Request) {
+	prices, err := preview.DefaultPriceTable()
+	if err != nil {
+		ctx.Errors.Addf("failed to load price table: %v", err)
+		return
+	}
+	report, err := preview.Preview(ctx.Ctx, ociClient, req, prices)
+	if err != nil {
+		ctx.Errors.Addf("failed to check capacity: %v", err)
+		return
+	}
+	for _, e := range report.Errors() {
+		ctx.Errors.Addf("spec.oke.nodePools: %s", e)
+	}
+}
+
 func addCNITypeErrors(ctx *validationContext, cniType CNIType, field string) {
 	switch cniType {
 	case FlannelOverlay, VCNNative:
@@ -63,16 +156,36 @@ func addCNITypeErrors(ctx *validationContext, cniType CNIType, field string) {
 	}
 }
 
+// ValidateUpdate only permits Kubernetes upgrades and node pool changes.
 func (o *OKEQuickCreate) ValidateUpdate(old runtime.Object) error {
 	oldCluster, ok := old.(*OKEQuickCreate)
 	if !ok {
 		return errors.New("update resource must be of kind OKEQuickCreate")
 	}
-	if !reflect.DeepEqual(o.Spec, oldCluster.Spec) {
-		return errors.New("spec updates are not permitted")
+	// Reset the fields that may be updated, the rest of the spec must not change
+	spec := o.Spec.DeepCopy()
+	spec.Kubernetes.Version = oldCluster.Spec.Kubernetes.Version
+	spec.OKE.NodePools = oldCluster.Spec.OKE.NodePools
+	spec.OKE.VirtualNodePools = oldCluster.Spec.OKE.VirtualNodePools
+	if !reflect.DeepEqual(*spec, oldCluster.Spec) {
+		return errors.New("only spec.kubernetes.version, spec.oke.nodePools and spec.oke.virtualNodePools may be updated")
+	}
+	ctx := &validationContext{
+		Errors: vzerror.NewAggregator("\n"),
+	}
+	if o.Spec.Kubernetes.Version != oldCluster.Spec.Kubernetes.Version {
+		if err := ocnemeta.ValidateKubernetesUpgrade(oldCluster.Spec.Kubernetes.Version, o.Spec.Kubernetes.Version); err != nil {
+			ctx.Errors.Addf("spec.kubernetes.version is invalid: %v", err)
+		}
+	}
+	addNodePoolUpdateErrors(ctx, oldCluster.Spec.OKE.NodePools, o.Spec.OKE.NodePools, "spec.oke.nodePools")
+	if ctx.Errors.HasError() {
+		return ctx.Errors
 	}
 	return nil
 }
+
+// ValidateDelete rejects the deletion of a cluster with deletion protection enabled.
 func (o *OKEQuickCreate) ValidateDelete() error {
-	return nil
+	return validateDeletionProtection(o)
 }
@@ -1,15 +1,19 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
 
 import (
+	"context"
 	_ "embed"
 	"github.com/oracle/oci-go-sdk/v53/core"
 	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
 	ocifake "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/fake"
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	"sigs.k8s.io/yaml"
 	"testing"
 )
@@ -64,9 +68,75 @@ func TestValidateCreateOKE(t *testing.T) {
 	}
 }
 
+func TestValidateCreateOKEDryRun(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	dryRun := true
+	ctx := admission.NewContextWithRequest(context.TODO(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			DryRun: &dryRun,
+		},
+	})
+	var tests = []struct {
+		name     string
+		ctx      context.Context
+		limit    int64
+		hasError bool
+	}{
+		{
+			"no error when the compartment has capacity",
+			ctx,
+			2,
+			false,
+		},
+		{
+			"error when the compartment does not have capacity",
+			ctx,
+			1,
+			true,
+		},
+		{
+			"capacity is not checked when not a dry-run",
+			context.TODO(),
+			1,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ociClient := &ocifake.ClientImpl{
+				VCN: &core.Vcn{
+					Id: &testID,
+				},
+				AvailabilityDomains: []oci.AvailabilityDomain{
+					{Name: "AD-1"},
+				},
+				Shapes: map[string][]oci.Shape{
+					"AD-1": {{Name: "VM.Standard.E4.Flex", OCPUs: 1, MemoryGbs: 16}},
+				},
+				ComputeLimits: map[string]int64{
+					"standard-e4-core-count":   tt.limit,
+					"standard-e4-memory-count": 100,
+				},
+			}
+			defer func() { NewValidationContext = newValidationContext }()
+			NewValidationContext = func() (*validationContext, error) {
+				return testValidationContextWithOCIClient(cli, ociClient), nil
+			}
+			o := &OKEQuickCreate{}
+			assert.NoError(t, yaml.Unmarshal(testValidOKECR, o))
+			err := (&okeQuickCreateValidator{}).ValidateCreate(tt.ctx, o)
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateUpdateOK(t *testing.T) {
 	o := &OKEQuickCreate{}
-	err := yaml.Unmarshal(testValidOCIOCNECR, o)
+	err := yaml.Unmarshal(testValidOKECR, o)
 	assert.NoError(t, err)
 	var tests = []struct {
 		name     string
@@ -85,6 +155,27 @@ func TestValidateUpdateOK(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"no error when upgrading Kubernetes",
+			func(o *OKEQuickCreate) {
+				o.Spec.Kubernetes.Version = "v1.25.7"
+			},
+			false,
+		},
+		{
+			"error when downgrading Kubernetes",
+			func(o *OKEQuickCreate) {
+				o.Spec.Kubernetes.Version = "v1.27.2"
+			},
+			true,
+		},
+		{
+			"no error when removing a node pool",
+			func(o *OKEQuickCreate) {
+				o.Spec.OKE.NodePools = append(o.Spec.OKE.NodePools, NamedOCINode{Name: "removed"})
+			},
+			false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,3 +192,12 @@ func TestValidateUpdateOK(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeleteOKE(t *testing.T) {
+	o := &OKEQuickCreate{}
+	assert.NoError(t, o.ValidateDelete())
+	o.Annotations = map[string]string{DeletionProtectionAnnotation: "false"}
+	assert.NoError(t, o.ValidateDelete())
+	o.Annotations[DeletionProtectionAnnotation] = "true"
+	assert.Error(t, o.ValidateDelete())
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -161,6 +161,38 @@ type (
 		Namespace string `json:"namespace"`
 	}
 	QuickCreatePhase string
+	NodePoolStatus   struct {
+		// Name of the node pool.
+		Name string `json:"name"`
+		// Desired number of nodes.
+		Replicas int64 `json:"replicas"`
+		// Number of ready nodes.
+		ReadyReplicas int64 `json:"readyReplicas"`
+		// Kubernetes version of the node pool.
+		Version string `json:"version,omitempty"`
+		// Phase of the Cluster API resource of the node pool.
+		Phase string `json:"phase,omitempty"`
+	}
+	QuickCreateStatus struct {
+		Phase QuickCreatePhase `json:"phase"`
+		// +optional
+
+		// The generation of the spec that was last applied to the cluster.
+		ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+		// +optional
+
+		// Kubernetes version reported by the cluster control plane.
+		KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+		// +optional
+
+		// Status of the cluster node pools.
+		NodePools []NodePoolStatus `json:"nodePools,omitempty"`
+	}
+)
+
+const (
+	// DeletionProtectionAnnotation protects a quick create cluster from deletion when set to "true".
+	DeletionProtectionAnnotation = "clusters.verrazzano.io/deletion-protection"
 )
 
 // Subnet Roles
@@ -176,7 +208,8 @@ const (
 
 	// QuickCreatePhaseProvisioning means the Quick Create is in progress.
 	QuickCreatePhaseProvisioning QuickCreatePhase = "Provisioning"
-	// QuickCreatePhaseComplete means the Quick Create has finished. Quick Create CR cleanup is started once this phase is reached.
+	// QuickCreatePhaseComplete means the Quick Create has finished. OKE and OCNE OCI clusters are kept in sync with
+	// spec updates once this phase is reached, other Quick Create CRs are cleaned up.
 	QuickCreatePhaseComplete QuickCreatePhase = "Complete"
 )
 
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -10,6 +10,10 @@ import (
 
 const VerrazzanoManagedClusterKind = "VerrazzanoManagedCluster"
 
+// RotateCredentialsAnnotation requests a rotation of the managed cluster agent credentials. Each new value of the
+// annotation triggers one rotation.
+const RotateCredentialsAnnotation = "clusters.verrazzano.io/rotate-credentials"
+
 // The VerrazzanoManagedCluster custom resource contains information about a
 // kubernetes cluster where Verrazzano managed applications are deployed.
 
@@ -35,6 +39,18 @@ type VerrazzanoManagedClusterSpec struct {
 	// Verrazzano Kubernetes operator.
 	// +optional
 	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The rotation policy of the credentials used by the managed cluster agent to access the admin cluster.
+	// +optional
+	CredentialRotation *CredentialRotationPolicy `json:"credentialRotation,omitempty"`
+}
+
+// CredentialRotationPolicy defines when the credentials of the managed cluster agent are rotated.
+type CredentialRotationPolicy struct {
+	// The maximum age of the agent token. The token is rotated when it is older than this age. If not specified,
+	// the token is only rotated on request, using the `clusters.verrazzano.io/rotate-credentials` annotation.
+	// +optional
+	MaxTokenAge *metav1.Duration `json:"maxTokenAge,omitempty"`
 }
 
 // ConditionType identifies the condition of the Verrazzano Managed Cluster which can be checked with `kubectl wait`.
@@ -137,6 +153,123 @@ type VerrazzanoInformation struct {
 	Version string `json:"version,omitempty"`
 }
 
+// CredentialRotationResult identifies the result of an agent credential rotation.
+type CredentialRotationResult string
+
+const (
+	CredentialRotationSucceeded CredentialRotationResult = "Succeeded"
+	CredentialRotationFailed    CredentialRotationResult = "Failed"
+)
+
+// CredentialRotationStatus defines the state of the managed cluster agent credentials.
+type CredentialRotationStatus struct {
+	// The name of the service account token Secret used by the managed cluster agent.
+	// +optional
+	CurrentToken string `json:"currentToken,omitempty"`
+	// The creation time of the current token.
+	// +optional
+	TokenCreationTime *metav1.Time `json:"tokenCreationTime,omitempty"`
+	// The name of the new token Secret, while a rotation is waiting for the agent to use it.
+	// +optional
+	PendingToken string `json:"pendingToken,omitempty"`
+	// The reason of the rotation in progress.
+	// +optional
+	PendingReason string `json:"pendingReason,omitempty"`
+	// The start time of the rotation in progress.
+	// +optional
+	RotationStartTime *metav1.Time `json:"rotationStartTime,omitempty"`
+	// The value of the last `clusters.verrazzano.io/rotate-credentials` annotation that was handled.
+	// +optional
+	LastRequest string `json:"lastRequest,omitempty"`
+	// The most recent rotations, oldest first.
+	// +optional
+	History []CredentialRotationRecord `json:"history,omitempty"`
+}
+
+// CredentialRotationRecord defines a completed agent credential rotation.
+type CredentialRotationRecord struct {
+	// The reason of the rotation.
+	Reason string `json:"reason"`
+	// The result of the rotation.
+	Result CredentialRotationResult `json:"result"`
+	// A message with details about the result of the rotation.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// The name of the token Secret that was replaced.
+	// +optional
+	OldToken string `json:"oldToken,omitempty"`
+	// The name of the new token Secret.
+	NewToken string `json:"newToken"`
+	// The start time of the rotation.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// The completion time of the rotation.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// HealthStatus identifies the overall health of a managed cluster.
+type HealthStatus string
+
+const (
+	HealthHealthy  HealthStatus = "Healthy"
+	HealthDegraded HealthStatus = "Degraded"
+	HealthUnknown  HealthStatus = "Unknown"
+)
+
+// HealthSummary defines the health of a managed cluster, as reported by the managed cluster agent.
+type HealthSummary struct {
+	// The overall health of this managed cluster.
+	Status HealthStatus `json:"status,omitempty"`
+	// The health score of this managed cluster, from 0 to 100. A score of 100 means all health checks passed.
+	Score int `json:"score"`
+	// The availability of the Verrazzano components on this managed cluster.
+	// +optional
+	Components ComponentHealth `json:"components,omitempty"`
+	// The readiness of the nodes of this managed cluster.
+	// +optional
+	Nodes NodeHealth `json:"nodes,omitempty"`
+	// The earliest expiry time of the TLS certificates on this managed cluster.
+	// +optional
+	CertificateExpiry *metav1.Time `json:"certificateExpiry,omitempty"`
+	// The synchronization state of each multicluster resource kind.
+	// +optional
+	Sync []ResourceSyncStatus `json:"sync,omitempty"`
+	// The last time the agent reported the health of this managed cluster.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ComponentHealth defines the availability of the Verrazzano components on a managed cluster.
+type ComponentHealth struct {
+	// The number of available components.
+	Available int `json:"available"`
+	// The number of enabled components.
+	Total int `json:"total"`
+	// The names of the enabled components that are not available.
+	// +optional
+	Unavailable []string `json:"unavailable,omitempty"`
+}
+
+// NodeHealth defines the readiness of the nodes of a managed cluster.
+type NodeHealth struct {
+	// The number of ready nodes.
+	Ready int `json:"ready"`
+	// The total number of nodes.
+	Total int `json:"total"`
+}
+
+// ResourceSyncStatus defines the synchronization state of a multicluster resource kind on a managed cluster.
+type ResourceSyncStatus struct {
+	// The kind of the multicluster resource.
+	Kind string `json:"kind"`
+	// The last time all resources of this kind were synchronized from the admin cluster.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// The number of seconds since the last synchronization of this kind, when the health was reported.
+	LagSeconds int64 `json:"lagSeconds"`
+}
+
 // ClusterReference identifies the underlying ClusterAPI cluster for a managed cluster.
 type ClusterReference struct {
 	// The API version of the referenced ClusterAPI cluster object.
@@ -178,6 +311,20 @@ type VerrazzanoManagedClusterStatus struct {
 	Imported *bool `json:"imported,omitempty"`
 	// The provider of this managed cluster.
 	Provider string `json:"provider,omitempty"`
+	// The health of this managed cluster, as reported by the managed cluster agent.
+	// +optional
+	Health *HealthSummary `json:"health,omitempty"`
+	// The name of the token Secret of the credentials last used by the agent to connect to the admin cluster, as
+	// reported by the managed cluster agent.
+	// +optional
+	AgentCredential string `json:"agentCredential,omitempty"`
+	// The state of the managed cluster agent credentials.
+	// +optional
+	CredentialRotation *CredentialRotationStatus `json:"credentialRotation,omitempty"`
+	// The SHA-256 fingerprint of the admin cluster CA bundle held by this managed cluster, as reported by the managed
+	// cluster agent.
+	// +optional
+	AdminCABundleFingerprint string `json:"adminCABundleFingerprint,omitempty"`
 }
 
 // +genclient
@@ -1,7 +1,7 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by controller-gen. DO NOT EDIT.
@@ -9,6 +9,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +113,26 @@ func (in *CommonOCI) DeepCopy() *CommonOCI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentHealth) DeepCopyInto(out *ComponentHealth) {
+	*out = *in
+	if in.Unavailable != nil {
+		in, out := &in.Unavailable, &out.Unavailable
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentHealth.
+func (in *ComponentHealth) DeepCopy() *ComponentHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -131,6 +152,228 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationPolicy) DeepCopyInto(out *CredentialRotationPolicy) {
+	*out = *in
+	if in.MaxTokenAge != nil {
+		in, out := &in.MaxTokenAge, &out.MaxTokenAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationPolicy.
+func (in *CredentialRotationPolicy) DeepCopy() *CredentialRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationRecord) DeepCopyInto(out *CredentialRotationRecord) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationRecord.
+func (in *CredentialRotationRecord) DeepCopy() *CredentialRotationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationStatus) DeepCopyInto(out *CredentialRotationStatus) {
+	*out = *in
+	if in.TokenCreationTime != nil {
+		in, out := &in.TokenCreationTime, &out.TokenCreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RotationStartTime != nil {
+		in, out := &in.RotationStartTime, &out.RotationStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]CredentialRotationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationStatus.
+func (in *CredentialRotationStatus) DeepCopy() *CredentialRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Docker) DeepCopyInto(out *Docker) {
+	*out = *in
+	if in.ControlPlaneReplicas != nil {
+		in, out := &in.ControlPlaneReplicas, &out.ControlPlaneReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.WorkerReplicas != nil {
+		in, out := &in.WorkerReplicas, &out.WorkerReplicas
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Docker.
+func (in *Docker) DeepCopy() *Docker {
+	if in == nil {
+		return nil
+	}
+	out := new(Docker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerQuickCreate) DeepCopyInto(out *DockerQuickCreate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerQuickCreate.
+func (in *DockerQuickCreate) DeepCopy() *DockerQuickCreate {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerQuickCreate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DockerQuickCreate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerQuickCreateList) DeepCopyInto(out *DockerQuickCreateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DockerQuickCreate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerQuickCreateList.
+func (in *DockerQuickCreateList) DeepCopy() *DockerQuickCreateList {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerQuickCreateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DockerQuickCreateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerQuickCreateSpec) DeepCopyInto(out *DockerQuickCreateSpec) {
+	*out = *in
+	out.Kubernetes = in.Kubernetes
+	in.Docker.DeepCopyInto(&out.Docker)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerQuickCreateSpec.
+func (in *DockerQuickCreateSpec) DeepCopy() *DockerQuickCreateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerQuickCreateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerQuickCreateStatus) DeepCopyInto(out *DockerQuickCreateStatus) {
+	*out = *in
+	in.QuickCreateStatus.DeepCopyInto(&out.QuickCreateStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerQuickCreateStatus.
+func (in *DockerQuickCreateStatus) DeepCopy() *DockerQuickCreateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerQuickCreateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthSummary) DeepCopyInto(out *HealthSummary) {
+	*out = *in
+	in.Components.DeepCopyInto(&out.Components)
+	out.Nodes = in.Nodes
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = make([]ResourceSyncStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthSummary.
+func (in *HealthSummary) DeepCopy() *HealthSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -229,6 +472,36 @@ func (in *Network) DeepCopy() *Network {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealth) DeepCopyInto(out *NodeHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealth.
+func (in *NodeHealth) DeepCopy() *NodeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolStatus) DeepCopyInto(out *NodePoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatus.
+func (in *NodePoolStatus) DeepCopy() *NodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCI) DeepCopyInto(out *OCI) {
 	*out = *in
@@ -354,7 +627,7 @@ func (in *OCNEOCIQuickCreate) DeepCopyInto(out *OCNEOCIQuickCreate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCNEOCIQuickCreate.
@@ -410,6 +683,7 @@ func (in *OCNEOCIQuickCreateList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCNEOCIQuickCreateStatus) DeepCopyInto(out *OCNEOCIQuickCreateStatus) {
 	*out = *in
+	in.QuickCreateStatus.DeepCopyInto(&out.QuickCreateStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCNEOCIQuickCreateStatus.
@@ -483,7 +757,7 @@ func (in *OKEQuickCreate) DeepCopyInto(out *OKEQuickCreate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OKEQuickCreate.
@@ -557,6 +831,7 @@ func (in *OKEQuickCreateSpec) DeepCopy() *OKEQuickCreateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OKEQuickCreateStatus) DeepCopyInto(out *OKEQuickCreateStatus) {
 	*out = *in
+	in.QuickCreateStatus.DeepCopyInto(&out.QuickCreateStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OKEQuickCreateStatus.
@@ -600,6 +875,26 @@ func (in *Proxy) DeepCopy() *Proxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuickCreateStatus) DeepCopyInto(out *QuickCreateStatus) {
+	*out = *in
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]NodePoolStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuickCreateStatus.
+func (in *QuickCreateStatus) DeepCopy() *QuickCreateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuickCreateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RancherRegistration) DeepCopyInto(out *RancherRegistration) {
 	*out = *in
@@ -615,6 +910,25 @@ func (in *RancherRegistration) DeepCopy() *RancherRegistration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSyncStatus) DeepCopyInto(out *ResourceSyncStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSyncStatus.
+func (in *ResourceSyncStatus) DeepCopy() *ResourceSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -650,7 +964,7 @@ func (in *VerrazzanoManagedCluster) DeepCopyInto(out *VerrazzanoManagedCluster)
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -707,6 +1021,11 @@ func (in *VerrazzanoManagedClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoManagedClusterSpec) DeepCopyInto(out *VerrazzanoManagedClusterSpec) {
 	*out = *in
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(CredentialRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoManagedClusterSpec.
@@ -747,6 +1066,16 @@ func (in *VerrazzanoManagedClusterStatus) DeepCopyInto(out *VerrazzanoManagedClu
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(HealthSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(CredentialRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoManagedClusterStatus.
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -15,6 +15,7 @@ import (
 
 type ClustersV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	DockerQuickCreatesGetter
 	OCNEOCIQuickCreatesGetter
 	OKEQuickCreatesGetter
 	VerrazzanoManagedClustersGetter
@@ -25,6 +26,10 @@ type ClustersV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ClustersV1alpha1Client) DockerQuickCreates(namespace string) DockerQuickCreateInterface {
+	return newDockerQuickCreates(c, namespace)
+}
+
 func (c *ClustersV1alpha1Client) OCNEOCIQuickCreates(namespace string) OCNEOCIQuickCreateInterface {
 	return newOCNEOCIQuickCreates(c, namespace)
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by client-gen. DO NOT EDIT.
@@ -15,6 +15,10 @@ type FakeClustersV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeClustersV1alpha1) DockerQuickCreates(namespace string) v1alpha1.DockerQuickCreateInterface {
+	return &FakeDockerQuickCreates{c, namespace}
+}
+
 func (c *FakeClustersV1alpha1) OCNEOCIQuickCreates(namespace string) v1alpha1.OCNEOCIQuickCreateInterface {
 	return &FakeOCNEOCIQuickCreates{c, namespace}
 }
@@ -0,0 +1,163 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package controller
+
+// Reusable code for Quick Create day-2 operations
+
+import (
+	"context"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"reflect"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
+)
+
+const (
+	// NodePoolLabel is the name of the quick create node pool of a Cluster API resource
+	NodePoolLabel = "verrazzano.io/node-pool"
+)
+
+var (
+	GVKMachinePool = schema.GroupVersionKind{
+		Group:   "cluster.x-k8s.io",
+		Version: "v1beta1",
+		Kind:    "MachinePool",
+	}
+	GVKMachineDeployment = schema.GroupVersionKind{
+		Group:   "cluster.x-k8s.io",
+		Version: "v1beta1",
+		Kind:    "MachineDeployment",
+	}
+)
+
+// DeleteCluster deletes the CAPI Cluster created by a quick create. Cluster API deletes the cluster resources.
+func (b *Base) DeleteCluster(ctx context.Context, o clipkg.Object) error {
+	cluster := &v1beta1.Cluster{}
+	cluster.Namespace = o.GetNamespace()
+	cluster.Name = o.GetName()
+	return clipkg.IgnoreNotFound(b.Delete(ctx, cluster))
+}
+
+// UpdateNodePoolStatus updates the node pool status and the control plane Kubernetes version of a quick create whose
+// spec has been applied to the cluster. The quick create is requeued while the node pools are being scaled or upgraded.
+func (b *Base) UpdateNodePoolStatus(ctx context.Context, o clipkg.Object, status *vmcv1alpha1.QuickCreateStatus, nodePoolGVK, controlPlaneGVK schema.GroupVersionKind, controlPlaneName string) (ctrl.Result, error) {
+	nodePools, err := GetNodePoolStatuses(ctx, b.Client, nodePoolGVK, o.GetNamespace(), o.GetName())
+	if err != nil {
+		return RequeueDelay(), err
+	}
+	version, err := GetControlPlaneVersion(ctx, b.Client, controlPlaneGVK, types.NamespacedName{
+		Namespace: o.GetNamespace(),
+		Name:      controlPlaneName,
+	})
+	if err != nil {
+		return RequeueDelay(), err
+	}
+	newStatus := *status
+	newStatus.ObservedGeneration = o.GetGeneration()
+	newStatus.NodePools = nodePools
+	newStatus.KubernetesVersion = version
+	if !reflect.DeepEqual(newStatus, *status) {
+		*status = newStatus
+		if res, err := b.UpdateStatus(ctx, o); err != nil {
+			return res, err
+		}
+	}
+	if isUpdating(status) {
+		return RequeueDelay(), nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// GetNodePoolStatuses returns the status of the node pools of a cluster, read from the Cluster API node pool resources
+func GetNodePoolStatuses(ctx context.Context, cli clipkg.Client, gvk schema.GroupVersionKind, namespace, clusterName string) ([]vmcv1alpha1.NodePoolStatus, error) {
+	nodePools, err := listNodePools(ctx, cli, gvk, namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []vmcv1alpha1.NodePoolStatus
+	for _, np := range nodePools {
+		replicas, _, _ := unstructured.NestedInt64(np.Object, "spec", "replicas")
+		readyReplicas, _, _ := unstructured.NestedInt64(np.Object, "status", "readyReplicas")
+		version, _, _ := unstructured.NestedString(np.Object, "spec", "template", "spec", "version")
+		phase, _, _ := unstructured.NestedString(np.Object, "status", "phase")
+		statuses = append(statuses, vmcv1alpha1.NodePoolStatus{
+			Name:          np.GetName(),
+			Replicas:      replicas,
+			ReadyReplicas: readyReplicas,
+			Version:       version,
+			Phase:         phase,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses, nil
+}
+
+// DeleteRemovedNodePools deletes the Cluster API node pool resources of a cluster that are not in the desired set of names
+func DeleteRemovedNodePools(ctx context.Context, cli clipkg.Client, gvk schema.GroupVersionKind, namespace, clusterName string, desired map[string]bool) error {
+	nodePools, err := listNodePools(ctx, cli, gvk, namespace, clusterName)
+	if err != nil {
+		return err
+	}
+	for i := range nodePools {
+		if desired[nodePools[i].GetName()] {
+			continue
+		}
+		if err := cli.Delete(ctx, &nodePools[i]); clipkg.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetControlPlaneVersion returns the Kubernetes version reported by the control plane of a cluster, or an empty string
+// if the control plane does not exist
+func GetControlPlaneVersion(ctx context.Context, cli clipkg.Client, gvk schema.GroupVersionKind, nsn types.NamespacedName) (string, error) {
+	controlPlane := &unstructured.Unstructured{}
+	controlPlane.SetGroupVersionKind(gvk)
+	if err := cli.Get(ctx, nsn, controlPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "status", "version")
+	return version, nil
+}
+
+// listNodePools lists the Cluster API node pool resources of a cluster that were created for quick create node pools
+func listNodePools(ctx context.Context, cli clipkg.Client, gvk schema.GroupVersionKind, namespace, clusterName string) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := cli.List(ctx, list, clipkg.InNamespace(namespace), clipkg.HasLabels{NodePoolLabel}); err != nil {
+		return nil, err
+	}
+	var nodePools []unstructured.Unstructured
+	for _, np := range list.Items {
+		if name, _, _ := unstructured.NestedString(np.Object, "spec", "clusterName"); name == clusterName {
+			nodePools = append(nodePools, np)
+		}
+	}
+	return nodePools, nil
+}
+
+// isUpdating returns true if any node pool is not ready, or is not at the Kubernetes version of the control plane
+func isUpdating(status *vmcv1alpha1.QuickCreateStatus) bool {
+	for _, np := range status.NodePools {
+		if np.ReadyReplicas != np.Replicas {
+			return true
+		}
+		if status.KubernetesVersion != "" && np.Version != status.KubernetesVersion {
+			return true
+		}
+	}
+	return false
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package oci
@@ -8,6 +8,8 @@ import (
 	"github.com/oracle/oci-go-sdk/v53/common"
 	"github.com/oracle/oci-go-sdk/v53/core"
 	"github.com/oracle/oci-go-sdk/v53/identity"
+	"github.com/oracle/oci-go-sdk/v53/limits"
+	"net/http"
 )
 
 const (
@@ -21,12 +23,17 @@ type (
 		GetSubnetByID(ctx context.Context, id, role string) (*Subnet, error)
 		GetVCNByID(ctx context.Context, id string) (*core.Vcn, error)
 		GetAvailabilityAndFaultDomains(ctx context.Context) ([]AvailabilityDomain, error)
+		ListShapes(ctx context.Context, compartmentID, availabilityDomain string) ([]Shape, error)
+		GetComputeLimitAvailability(ctx context.Context, compartmentID, availabilityDomain, limitName string) (*int64, error)
+		GetFaultDomainAvailability(ctx context.Context, compartmentID, availabilityDomain string, faultDomains []string, shape ShapeConfig) (map[string]bool, error)
 	}
 	// ClientImpl OCI Client implementation
 	ClientImpl struct {
 		tenancyID      string
 		vnClient       core.VirtualNetworkClient
 		identityClient identity.IdentityClient
+		computeClient  core.ComputeClient
+		limitsClient   limits.LimitsClient
 	}
 	Subnet struct {
 		ID          string
@@ -43,6 +50,49 @@ type (
 	FaultDomain struct {
 		Name string
 	}
+	Shape struct {
+		Name      string
+		OCPUs     float32
+		MemoryGbs float32
+	}
+	// ShapeConfig is the shape of an instance, with the size of a flex shape
+	ShapeConfig struct {
+		Shape     string
+		OCPUs     *float32
+		MemoryGbs *float32
+	}
+)
+
+const (
+	computeCapacityReportsPath = "/computeCapacityReports"
+	capacityStatusAvailable    = "AVAILABLE"
+)
+
+type (
+	// createComputeCapacityReportRequest is the request of the compute capacity report API, which the OCI SDK
+	// version used by the cluster operator does not support
+	createComputeCapacityReportRequest struct {
+		Details computeCapacityReport `contributesTo:"body"`
+	}
+	createComputeCapacityReportResponse struct {
+		RawResponse *http.Response
+		Report      computeCapacityReport `presentIn:"body"`
+	}
+	computeCapacityReport struct {
+		CompartmentID       *string                     `mandatory:"true" json:"compartmentId"`
+		AvailabilityDomain  *string                     `mandatory:"true" json:"availabilityDomain"`
+		ShapeAvailabilities []capacityShapeAvailability `mandatory:"true" json:"shapeAvailabilities"`
+	}
+	capacityShapeAvailability struct {
+		InstanceShape       *string                      `mandatory:"true" json:"instanceShape"`
+		FaultDomain         *string                      `mandatory:"false" json:"faultDomain,omitempty"`
+		InstanceShapeConfig *capacityInstanceShapeConfig `mandatory:"false" json:"instanceShapeConfig,omitempty"`
+		AvailabilityStatus  *string                      `mandatory:"false" json:"availabilityStatus,omitempty"`
+	}
+	capacityInstanceShapeConfig struct {
+		OCPUs     *float32 `mandatory:"false" json:"ocpus,omitempty"`
+		MemoryGbs *float32 `mandatory:"false" json:"memoryInGBs,omitempty"`
+	}
 )
 
 // NewClient creates a new OCI Client
@@ -59,10 +109,20 @@ func NewClient(creds *Credentials) (Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	compute, err := core.NewComputeClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	l, err := limits.NewLimitsClientWithConfigurationProvider(provider)
+	if err != nil {
+		return nil, err
+	}
 	return &ClientImpl{
 		tenancyID:      creds.Tenancy,
 		vnClient:       net,
 		identityClient: i,
+		computeClient:  compute,
+		limitsClient:   l,
 	}, nil
 }
 
@@ -126,6 +186,105 @@ func (c *ClientImpl) GetAvailabilityAndFaultDomains(ctx context.Context) ([]Avai
 	return availabilityDomains, nil
 }
 
+// ListShapes lists the compute shapes that are available in an availability domain of a compartment.
+func (c *ClientImpl) ListShapes(ctx context.Context, compartmentID, availabilityDomain string) ([]Shape, error) {
+	var shapes []Shape
+	var page *string
+	for {
+		response, err := c.computeClient.ListShapes(ctx, core.ListShapesRequest{
+			CompartmentId:      &compartmentID,
+			AvailabilityDomain: &availabilityDomain,
+			Page:               page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range response.Items {
+			shape := Shape{
+				Name: *s.Shape,
+			}
+			if s.Ocpus != nil {
+				shape.OCPUs = *s.Ocpus
+			}
+			if s.MemoryInGBs != nil {
+				shape.MemoryGbs = *s.MemoryInGBs
+			}
+			shapes = append(shapes, shape)
+		}
+		if response.OpcNextPage == nil {
+			return shapes, nil
+		}
+		page = response.OpcNextPage
+	}
+}
+
+// GetComputeLimitAvailability returns the available count of a compute service limit in an availability domain of a
+// compartment, or nil if the limit does not exist.
+func (c *ClientImpl) GetComputeLimitAvailability(ctx context.Context, compartmentID, availabilityDomain, limitName string) (*int64, error) {
+	service := "compute"
+	response, err := c.limitsClient.GetResourceAvailability(ctx, limits.GetResourceAvailabilityRequest{
+		ServiceName:        &service,
+		LimitName:          &limitName,
+		CompartmentId:      &compartmentID,
+		AvailabilityDomain: &availabilityDomain,
+	})
+	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return response.Available, nil
+}
+
+// GetFaultDomainAvailability returns whether the compute capacity report of an availability domain has capacity for
+// an instance of the shape, by fault domain name.
+func (c *ClientImpl) GetFaultDomainAvailability(ctx context.Context, compartmentID, availabilityDomain string, faultDomains []string, shape ShapeConfig) (map[string]bool, error) {
+	request := createComputeCapacityReportRequest{
+		Details: computeCapacityReport{
+			CompartmentID:      &compartmentID,
+			AvailabilityDomain: &availabilityDomain,
+		},
+	}
+	var shapeConfig *capacityInstanceShapeConfig
+	if shape.OCPUs != nil || shape.MemoryGbs != nil {
+		shapeConfig = &capacityInstanceShapeConfig{
+			OCPUs:     shape.OCPUs,
+			MemoryGbs: shape.MemoryGbs,
+		}
+	}
+	for i := range faultDomains {
+		request.Details.ShapeAvailabilities = append(request.Details.ShapeAvailabilities, capacityShapeAvailability{
+			InstanceShape:       &shape.Shape,
+			FaultDomain:         &faultDomains[i],
+			InstanceShapeConfig: shapeConfig,
+		})
+	}
+	httpRequest, err := common.MakeDefaultHTTPRequestWithTaggedStruct(http.MethodPost, computeCapacityReportsPath, request)
+	if err != nil {
+		return nil, err
+	}
+	httpResponse, err := c.computeClient.Call(ctx, &httpRequest)
+	defer common.CloseBodyIfValid(httpResponse)
+	if err != nil {
+		return nil, err
+	}
+	response := createComputeCapacityReportResponse{RawResponse: httpResponse}
+	if err := common.UnmarshalResponse(httpResponse, &response); err != nil {
+		return nil, err
+	}
+	availability := map[string]bool{}
+	for _, fd := range faultDomains {
+		availability[fd] = false
+	}
+	for _, sa := range response.Report.ShapeAvailabilities {
+		if sa.FaultDomain != nil && sa.AvailabilityStatus != nil {
+			availability[*sa.FaultDomain] = *sa.AvailabilityStatus == capacityStatusAvailable
+		}
+	}
+	return availability, nil
+}
+
 // subnetAccess returns public or private, depending on a subnet's access type
 func subnetAccess(subnet core.Subnet) string {
 	if subnet.ProhibitPublicIpOnVnic != nil && subnet.ProhibitInternetIngress != nil && !*subnet.ProhibitPublicIpOnVnic && !*subnet.ProhibitInternetIngress {
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package fake
@@ -19,6 +19,12 @@ type (
 	ClientImpl struct {
 		VCN                 *core.Vcn
 		AvailabilityDomains []oci.AvailabilityDomain
+		// Shapes by availability domain name
+		Shapes map[string][]oci.Shape
+		// Available compute limits by limit name
+		ComputeLimits map[string]int64
+		// Fault domains without capacity by shape name
+		OutOfCapacity map[string][]string
 	}
 )
 
@@ -47,3 +53,28 @@ func (c *ClientImpl) GetVCNByID(_ context.Context, id string) (*core.Vcn, error)
 func (c *ClientImpl) GetAvailabilityAndFaultDomains(_ context.Context) ([]oci.AvailabilityDomain, error) {
 	return c.AvailabilityDomains, nil
 }
+
+func (c *ClientImpl) ListShapes(_ context.Context, _, availabilityDomain string) ([]oci.Shape, error) {
+	return c.Shapes[availabilityDomain], nil
+}
+
+func (c *ClientImpl) GetComputeLimitAvailability(_ context.Context, _, _, limitName string) (*int64, error) {
+	available, ok := c.ComputeLimits[limitName]
+	if !ok {
+		return nil, nil
+	}
+	return &available, nil
+}
+
+func (c *ClientImpl) GetFaultDomainAvailability(_ context.Context, _, _ string, faultDomains []string, shape oci.ShapeConfig) (map[string]bool, error) {
+	availability := map[string]bool{}
+	for _, fd := range faultDomains {
+		availability[fd] = true
+	}
+	for _, fd := range c.OutOfCapacity[shape.Shape] {
+		if _, ok := availability[fd]; ok {
+			availability[fd] = false
+		}
+	}
+	return availability, nil
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package preview
+
+import (
+	"context"
+	"fmt"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HoursPerMonth is the number of hours used to estimate monthly costs
+	HoursPerMonth = 744
+
+	defaultBootVolumeGbs = 50
+	flexShapeSuffix      = "Flex"
+)
+
+type (
+	// Request describes the OCI resources of a quick create cluster
+	Request struct {
+		Compartment      string
+		NodePools        []NodePool
+		VirtualNodePools []VirtualNodePool
+	}
+	NodePool struct {
+		Name          string
+		Shape         string
+		OCPUs         *int
+		MemoryGbs     *int
+		BootVolumeGbs *int
+		Replicas      int
+	}
+	VirtualNodePool struct {
+		Name     string
+		Replicas int
+	}
+	// Report is the capacity and cost preview of a quick create cluster
+	Report struct {
+		Shapes   []ShapeAvailability
+		Limits   []LimitCheck
+		Estimate Estimate
+	}
+	// ShapeAvailability reports whether the shape of a node pool is offered in an availability domain, and the fault
+	// domains that have capacity for a node of the node pool
+	ShapeAvailability struct {
+		NodePool           string
+		Shape              string
+		AvailabilityDomain string
+		FaultDomains       []string
+		Available          bool
+	}
+	// LimitCheck compares the resources required in an availability domain with the available service limit
+	LimitCheck struct {
+		Limit              string
+		AvailabilityDomain string
+		Required           int64
+		Available          int64
+	}
+	// Estimate is an itemized estimate of the monthly cost of a cluster
+	Estimate struct {
+		Currency string
+		Items    []LineItem
+		// Shapes that have no price in the price table
+		Unpriced []string
+		// Node pools whose shape is not available in any availability domain
+		Unavailable []string
+	}
+	LineItem struct {
+		Description string
+		Quantity    float64
+		Unit        string
+		MonthlyCost float64
+	}
+)
+
+// Preview checks the shape availability and service limits of the compartment for a cluster, and estimates its cost
+func Preview(ctx context.Context, ociClient oci.Client, req Request, prices *PriceTable) (*Report, error) {
+	ads, err := ociClient.GetAvailabilityAndFaultDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	// The shape details of each node pool, from an availability domain that has capacity for the shape
+	nodePoolShapes := map[string]oci.Shape{}
+	for _, ad := range ads {
+		shapes, err := ociClient.ListShapes(ctx, req.Compartment, ad.Name)
+		if err != nil {
+			return nil, err
+		}
+		// Node pools are placed across all availability domains
+		required := map[string]int64{}
+		for _, np := range req.NodePools {
+			shape, available := findShape(shapes, np.Shape)
+			var faultDomains []string
+			if available && len(ad.FaultDomains) > 0 {
+				faultDomains, err = getFaultDomainsWithCapacity(ctx, ociClient, req.Compartment, ad, np)
+				if err != nil {
+					return nil, err
+				}
+				available = len(faultDomains) > 0
+			}
+			report.Shapes = append(report.Shapes, ShapeAvailability{
+				NodePool:           np.Name,
+				Shape:              np.Shape,
+				AvailabilityDomain: ad.Name,
+				FaultDomains:       faultDomains,
+				Available:          available,
+			})
+			if !available {
+				continue
+			}
+			nodePoolShapes[np.Name] = shape
+			nodes := int64((np.Replicas + len(ads) - 1) / len(ads))
+			ocpus, memoryGbs := nodeResources(np, shape)
+			prefix := limitPrefix(np.Shape)
+			required[prefix+"-core-count"] += nodes * int64(ocpus)
+			if isFlex(np.Shape) {
+				required[prefix+"-memory-count"] += nodes * int64(memoryGbs)
+			}
+		}
+		if err := addLimitChecks(ctx, ociClient, req.Compartment, ad.Name, required, report); err != nil {
+			return nil, err
+		}
+	}
+	report.Estimate = estimate(req, nodePoolShapes, prices)
+	return report, nil
+}
+
+// Errors returns the capacity problems of the report
+func (r *Report) Errors() []string {
+	var errs []string
+	for _, s := range r.Shapes {
+		if !s.Available {
+			errs = append(errs, fmt.Sprintf("node pool %s shape %s is not available in %s", s.NodePool, s.Shape, s.AvailabilityDomain))
+		}
+	}
+	for _, l := range r.Limits {
+		if l.Required > l.Available {
+			errs = append(errs, fmt.Sprintf("limit %s in %s has %d available, %d required", l.Limit, l.AvailabilityDomain, l.Available, l.Required))
+		}
+	}
+	return errs
+}
+
+// MonthlyTotal returns the total estimated monthly cost
+func (e *Estimate) MonthlyTotal() float64 {
+	var total float64
+	for _, item := range e.Items {
+		total += item.MonthlyCost
+	}
+	return total
+}
+
+// getFaultDomainsWithCapacity returns the fault domains of an availability domain that have capacity for a node of
+// the node pool
+func getFaultDomainsWithCapacity(ctx context.Context, ociClient oci.Client, compartment string, ad oci.AvailabilityDomain, np NodePool) ([]string, error) {
+	var faultDomains []string
+	for _, fd := range ad.FaultDomains {
+		faultDomains = append(faultDomains, fd.Name)
+	}
+	shapeConfig := oci.ShapeConfig{Shape: np.Shape}
+	if isFlex(np.Shape) {
+		shapeConfig.OCPUs = toFloat32(np.OCPUs)
+		shapeConfig.MemoryGbs = toFloat32(np.MemoryGbs)
+	}
+	availability, err := ociClient.GetFaultDomainAvailability(ctx, compartment, ad.Name, faultDomains, shapeConfig)
+	if err != nil {
+		return nil, err
+	}
+	var withCapacity []string
+	for _, fd := range faultDomains {
+		if availability[fd] {
+			withCapacity = append(withCapacity, fd)
+		}
+	}
+	return withCapacity, nil
+}
+
+func addLimitChecks(ctx context.Context, ociClient oci.Client, compartment, ad string, required map[string]int64, report *Report) error {
+	var limitNames []string
+	for limitName := range required {
+		limitNames = append(limitNames, limitName)
+	}
+	sort.Strings(limitNames)
+	for _, limitName := range limitNames {
+		available, err := ociClient.GetComputeLimitAvailability(ctx, compartment, ad, limitName)
+		if err != nil {
+			return err
+		}
+		// Shapes without a service limit are not checked
+		if available == nil {
+			continue
+		}
+		report.Limits = append(report.Limits, LimitCheck{
+			Limit:              limitName,
+			AvailabilityDomain: ad,
+			Required:           required[limitName],
+			Available:          *available,
+		})
+	}
+	return nil
+}
+
+func estimate(req Request, nodePoolShapes map[string]oci.Shape, prices *PriceTable) Estimate {
+	e := Estimate{
+		Currency: prices.Currency,
+	}
+	e.Items = append(e.Items, LineItem{
+		Description: "Cluster control plane",
+		Quantity:    1,
+		Unit:        "cluster",
+		MonthlyCost: prices.ClusterHour * HoursPerMonth,
+	})
+	for _, np := range req.NodePools {
+		// The size of a node is not known when the shape is not available
+		shape, ok := nodePoolShapes[np.Name]
+		if !ok {
+			e.Unavailable = append(e.Unavailable, np.Name)
+			continue
+		}
+		ocpus, memoryGbs := nodeResources(np, shape)
+		replicas := float64(np.Replicas)
+		price, ok := prices.Shapes[np.Shape]
+		if !ok && !contains(e.Unpriced, np.Shape) {
+			e.Unpriced = append(e.Unpriced, np.Shape)
+		}
+		e.Items = append(e.Items, LineItem{
+			Description: fmt.Sprintf("Node pool %s %s OCPUs", np.Name, np.Shape),
+			Quantity:    replicas * ocpus,
+			Unit:        "OCPU",
+			MonthlyCost: replicas * ocpus * price.OCPUHour * HoursPerMonth,
+		})
+		if price.MemoryGbHour > 0 {
+			e.Items = append(e.Items, LineItem{
+				Description: fmt.Sprintf("Node pool %s %s memory", np.Name, np.Shape),
+				Quantity:    replicas * memoryGbs,
+				Unit:        "GB",
+				MonthlyCost: replicas * memoryGbs * price.MemoryGbHour * HoursPerMonth,
+			})
+		}
+		bootVolumeGbs := float64(defaultBootVolumeGbs)
+		if np.BootVolumeGbs != nil {
+			bootVolumeGbs = float64(*np.BootVolumeGbs)
+		}
+		e.Items = append(e.Items, LineItem{
+			Description: fmt.Sprintf("Node pool %s boot volumes", np.Name),
+			Quantity:    replicas * bootVolumeGbs,
+			Unit:        "GB",
+			MonthlyCost: replicas * bootVolumeGbs * prices.BootVolumeGbMonth,
+		})
+	}
+	for _, vnp := range req.VirtualNodePools {
+		e.Items = append(e.Items, LineItem{
+			Description: fmt.Sprintf("Virtual node pool %s", vnp.Name),
+			Quantity:    float64(vnp.Replicas),
+			Unit:        "node",
+			MonthlyCost: float64(vnp.Replicas) * prices.VirtualNodeHour * HoursPerMonth,
+		})
+	}
+	return e
+}
+
+// nodeResources returns the OCPUs and memory of a node. Flex shapes use the shape defaults when not specified.
+func nodeResources(np NodePool, shape oci.Shape) (float64, float64) {
+	ocpus := float64(shape.OCPUs)
+	memoryGbs := float64(shape.MemoryGbs)
+	if isFlex(np.Shape) {
+		if np.OCPUs != nil {
+			ocpus = float64(*np.OCPUs)
+		}
+		if np.MemoryGbs != nil {
+			memoryGbs = float64(*np.MemoryGbs)
+		}
+	}
+	return ocpus, memoryGbs
+}
+
+func findShape(shapes []oci.Shape, name string) (oci.Shape, bool) {
+	for _, shape := range shapes {
+		if shape.Name == name {
+			return shape, true
+		}
+	}
+	return oci.Shape{}, false
+}
+
+func toFloat32(value *int) *float32 {
+	if value == nil {
+		return nil
+	}
+	f := float32(*value)
+	return &f
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isFlex(shape string) bool {
+	return strings.HasSuffix(shape, flexShapeSuffix)
+}
+
+// limitPrefix returns the compute limit name prefix of a shape, e.g. VM.Standard.E4.Flex is standard-e4 and
+// VM.Standard2.4 is standard2
+func limitPrefix(shape string) string {
+	parts := strings.Split(shape, ".")
+	if len(parts) > 1 {
+		// Drop the VM or BM prefix
+		parts = parts[1:]
+	}
+	if last := parts[len(parts)-1]; len(parts) > 1 && (last == flexShapeSuffix || isNumber(last)) {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.ToLower(strings.Join(parts, "-"))
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package preview
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/fake"
+	"testing"
+)
+
+func testOCIClient(limits map[string]int64, outOfCapacity map[string][]string) *fake.ClientImpl {
+	shapes := []oci.Shape{
+		{Name: "VM.Standard.E4.Flex", OCPUs: 1, MemoryGbs: 16},
+		{Name: "VM.Standard2.4", OCPUs: 4, MemoryGbs: 60},
+	}
+	return &fake.ClientImpl{
+		AvailabilityDomains: []oci.AvailabilityDomain{
+			{
+				Name:         "AD-1",
+				FaultDomains: []oci.FaultDomain{{Name: "FAULT-DOMAIN-1"}, {Name: "FAULT-DOMAIN-2"}},
+			},
+			{
+				Name:         "AD-2",
+				FaultDomains: []oci.FaultDomain{{Name: "FAULT-DOMAIN-1"}},
+			},
+		},
+		Shapes: map[string][]oci.Shape{
+			"AD-1": shapes,
+			"AD-2": shapes[:1],
+		},
+		ComputeLimits: limits,
+		OutOfCapacity: outOfCapacity,
+	}
+}
+
+func TestPreview(t *testing.T) {
+	two := 2
+	eight := 8
+	prices, err := DefaultPriceTable()
+	assert.NoError(t, err)
+	var tests = []struct {
+		name          string
+		nodePools     []NodePool
+		limits        map[string]int64
+		outOfCapacity map[string][]string
+		errs          int
+	}{
+		{
+			"enough capacity",
+			[]NodePool{
+				{Name: "np-1", Shape: "VM.Standard.E4.Flex", OCPUs: &two, MemoryGbs: &eight, Replicas: 3},
+			},
+			map[string]int64{
+				"standard-e4-core-count":   4,
+				"standard-e4-memory-count": 16,
+			},
+			nil,
+			0,
+		},
+		{
+			"shapes without limits are not checked",
+			[]NodePool{
+				{Name: "np-1", Shape: "VM.Standard.E4.Flex", Replicas: 3},
+			},
+			nil,
+			nil,
+			0,
+		},
+		{
+			"not enough OCPUs",
+			[]NodePool{
+				{Name: "np-1", Shape: "VM.Standard.E4.Flex", OCPUs: &two, MemoryGbs: &eight, Replicas: 3},
+			},
+			map[string]int64{
+				"standard-e4-core-count":   3,
+				"standard-e4-memory-count": 16,
+			},
+			nil,
+			2,
+		},
+		{
+			"shape not available in an availability domain",
+			[]NodePool{
+				{Name: "np-1", Shape: "VM.Standard2.4", Replicas: 1},
+			},
+			map[string]int64{
+				"standard2-core-count": 100,
+			},
+			nil,
+			1,
+		},
+		{
+			"no capacity in the fault domains of an availability domain",
+			[]NodePool{
+				{Name: "np-1", Shape: "VM.Standard.E4.Flex", Replicas: 3},
+			},
+			nil,
+			map[string][]string{
+				"VM.Standard.E4.Flex": {"FAULT-DOMAIN-1"},
+			},
+			1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Preview(context.TODO(), testOCIClient(tt.limits, tt.outOfCapacity), Request{
+				Compartment: "compartment",
+				NodePools:   tt.nodePools,
+			}, prices)
+			assert.NoError(t, err)
+			assert.Len(t, report.Errors(), tt.errs)
+			assert.Len(t, report.Shapes, 2*len(tt.nodePools))
+		})
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	two := 2
+	hundred := 100
+	prices := &PriceTable{
+		Currency:          "USD",
+		ClusterHour:       0.1,
+		VirtualNodeHour:   0.01,
+		BootVolumeGbMonth: 0.05,
+		Shapes: map[string]ShapePrice{
+			"VM.Standard.E4.Flex": {
+				OCPUHour:     0.025,
+				MemoryGbHour: 0.0015,
+			},
+		},
+	}
+	report, err := Preview(context.TODO(), testOCIClient(nil, nil), Request{
+		NodePools: []NodePool{
+			{Name: "np-1", Shape: "VM.Standard.E4.Flex", OCPUs: &two, BootVolumeGbs: &hundred, Replicas: 3},
+			{Name: "np-2", Shape: "VM.Standard2.4", Replicas: 1},
+			{Name: "np-3", Shape: "VM.Standard.A1.Flex", Replicas: 1},
+		},
+		VirtualNodePools: []VirtualNodePool{
+			{Name: "vnp-1", Replicas: 2},
+		},
+	}, prices)
+	assert.NoError(t, err)
+	e := report.Estimate
+	assert.Equal(t, "USD", e.Currency)
+	assert.Equal(t, []string{"VM.Standard2.4"}, e.Unpriced)
+	// np-3 has a shape that is not available in any availability domain, so it has no cost
+	assert.Equal(t, []string{"np-3"}, e.Unavailable)
+	// control plane, np-1 OCPUs, memory and boot volumes, np-2 OCPUs and boot volumes, virtual nodes
+	assert.Len(t, e.Items, 7)
+	assert.InDelta(t, 6.0, e.Items[1].Quantity, 0.001)
+	assert.InDelta(t, 48.0, e.Items[2].Quantity, 0.001)
+	assert.InDelta(t, 300.0, e.Items[3].Quantity, 0.001)
+	assert.InDelta(t, 4.0, e.Items[4].Quantity, 0.001)
+	expected := 0.1*HoursPerMonth + 6*0.025*HoursPerMonth + 48*0.0015*HoursPerMonth + 300*0.05 + 50*0.05 + 2*0.01*HoursPerMonth
+	assert.InDelta(t, expected, e.MonthlyTotal(), 0.001)
+}
+
+// TestPreviewFaultDomains tests the fault domain availability of a shape
+// GIVEN a shape without capacity in one fault domain of an availability domain
+// WHEN Preview is called
+// THEN the shape is available in that availability domain, with the other fault domain
+func TestPreviewFaultDomains(t *testing.T) {
+	prices, err := DefaultPriceTable()
+	assert.NoError(t, err)
+	report, err := Preview(context.TODO(), testOCIClient(nil, map[string][]string{"VM.Standard.E4.Flex": {"FAULT-DOMAIN-2"}}), Request{
+		NodePools: []NodePool{
+			{Name: "np-1", Shape: "VM.Standard.E4.Flex", Replicas: 2},
+		},
+	}, prices)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Errors())
+	assert.Len(t, report.Shapes, 2)
+	assert.Equal(t, []string{"FAULT-DOMAIN-1"}, report.Shapes[0].FaultDomains)
+	assert.Equal(t, []string{"FAULT-DOMAIN-1"}, report.Shapes[1].FaultDomains)
+}
+
+func TestLimitPrefix(t *testing.T) {
+	assert.Equal(t, "standard-e4", limitPrefix("VM.Standard.E4.Flex"))
+	assert.Equal(t, "standard2", limitPrefix("VM.Standard2.4"))
+	assert.Equal(t, "standard3", limitPrefix("VM.Standard3.Flex"))
+	assert.Equal(t, "standard-e3", limitPrefix("BM.Standard.E3.128"))
+}
+
+func TestLoadPriceTable(t *testing.T) {
+	prices, err := LoadPriceTable([]byte(`
+currency: EUR
+shapes:
+  VM.Standard.E4.Flex:
+    ocpuHour: 0.02
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", prices.Currency)
+	assert.Equal(t, 0.02, prices.Shapes["VM.Standard.E4.Flex"].OCPUHour)
+	_, err = LoadPriceTable([]byte("shapes: []"))
+	assert.Error(t, err)
+}
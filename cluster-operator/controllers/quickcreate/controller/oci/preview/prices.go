@@ -0,0 +1,55 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package preview
+
+import (
+	_ "embed"
+	"os"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed prices.yaml
+var defaultPrices []byte
+
+type (
+	// PriceTable is the set of prices used to estimate the cost of a cluster
+	PriceTable struct {
+		Currency string `json:"currency"`
+		// Price per hour of a cluster control plane
+		ClusterHour float64 `json:"clusterHour"`
+		// Price per hour of a virtual node
+		VirtualNodeHour float64 `json:"virtualNodeHour"`
+		// Price per month of a gigabyte of boot volume storage
+		BootVolumeGbMonth float64 `json:"bootVolumeGbMonth"`
+		// Compute prices by shape name
+		Shapes map[string]ShapePrice `json:"shapes"`
+	}
+	ShapePrice struct {
+		OCPUHour     float64 `json:"ocpuHour"`
+		MemoryGbHour float64 `json:"memoryGbHour"`
+	}
+)
+
+// DefaultPriceTable returns the built-in price table
+func DefaultPriceTable() (*PriceTable, error) {
+	return LoadPriceTable(defaultPrices)
+}
+
+// LoadPriceTable loads a YAML or JSON price table
+func LoadPriceTable(data []byte) (*PriceTable, error) {
+	prices := &PriceTable{}
+	if err := yaml.Unmarshal(data, prices); err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// LoadPriceTableFile loads a YAML or JSON price table from a file
+func LoadPriceTableFile(path string) (*PriceTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadPriceTable(data)
+}
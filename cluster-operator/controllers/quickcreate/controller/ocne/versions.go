@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package ocne
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/verrazzano/verrazzano/pkg/semver"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +46,40 @@ func GetVersionDefaults(ctx context.Context, cli clipkg.Client, ocneVersion stri
 	return nil, fmt.Errorf("no verion mapping found for OCNE version %s", ocneVersion)
 }
 
+// ValidateUpgrade validates an upgrade from one OCNE version to another. Both OCNE versions must have a version mapping,
+// and the Kubernetes version of the new OCNE version must be a valid upgrade of the current Kubernetes version.
+func ValidateUpgrade(ctx context.Context, cli clipkg.Client, fromOCNEVersion, toOCNEVersion string) error {
+	from, err := GetVersionDefaults(ctx, cli, fromOCNEVersion)
+	if err != nil {
+		return err
+	}
+	to, err := GetVersionDefaults(ctx, cli, toOCNEVersion)
+	if err != nil {
+		return err
+	}
+	return ValidateKubernetesUpgrade(from.KubernetesVersion, to.KubernetesVersion)
+}
+
+// ValidateKubernetesUpgrade validates an upgrade from one Kubernetes version to another.
+// Downgrades are not supported, and Kubernetes may only be upgraded one minor version at a time.
+func ValidateKubernetesUpgrade(fromVersion, toVersion string) error {
+	from, err := semver.NewSemVersion(fromVersion)
+	if err != nil {
+		return err
+	}
+	to, err := semver.NewSemVersion(toVersion)
+	if err != nil {
+		return err
+	}
+	if to.IsLessThan(from) {
+		return fmt.Errorf("downgrading Kubernetes from %s to %s is not supported", fromVersion, toVersion)
+	}
+	if to.Major != from.Major || to.Minor > from.Minor+1 {
+		return fmt.Errorf("upgrading Kubernetes from %s to %s skips a minor version", fromVersion, toVersion)
+	}
+	return nil
+}
+
 func getVersionMapping(ctx context.Context, cli clipkg.Client) (map[string]*VersionDefaults, error) {
 	cm := &corev1.ConfigMap{}
 	err := cli.Get(ctx, types.NamespacedName{
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package ocne
@@ -93,3 +93,65 @@ func TestGetVersionDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpgrade(t *testing.T) {
+	cm := &corev1.ConfigMap{}
+	_ = yaml.Unmarshal(testConfigMapBytes, cm)
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(cm).Build()
+
+	var tests = []struct {
+		name     string
+		from     string
+		to       string
+		hasError bool
+	}{
+		{
+			"no error for same OCNE Version",
+			"1.6",
+			"1.6",
+			false,
+		},
+		{
+			"no error for next OCNE Version",
+			"1.6",
+			"1.7",
+			false,
+		},
+		{
+			"error when skipping a Kubernetes minor version",
+			"1.5",
+			"1.7",
+			true,
+		},
+		{
+			"error when downgrading",
+			"1.7",
+			"1.6",
+			true,
+		},
+		{
+			"error when unknown OCNE Version",
+			"1.6",
+			"1.8",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpgrade(ctx.TODO(), cli, tt.from, tt.to)
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateKubernetesUpgrade(t *testing.T) {
+	assert.NoError(t, ValidateKubernetesUpgrade("v1.26.2", "v1.26.7"))
+	assert.NoError(t, ValidateKubernetesUpgrade("v1.26.2", "v1.27.2"))
+	assert.Error(t, ValidateKubernetesUpgrade("v1.26.2", "v1.28.2"))
+	assert.Error(t, ValidateKubernetesUpgrade("v1.26.2", "v1.25.2"))
+	assert.Error(t, ValidateKubernetesUpgrade("v1.26.2", "invalid"))
+}
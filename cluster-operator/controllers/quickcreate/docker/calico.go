@@ -0,0 +1,56 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"github.com/verrazzano/verrazzano/pkg/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"strings"
+)
+
+const (
+	// calicoConfigMapName is the ConfigMap with the Calico manifest packaged with the cluster operator chart
+	calicoConfigMapName   = "verrazzano-cluster-operator-calico"
+	calicoManifestKey     = "calico.yaml"
+	resourceSetSecretType = "addons.cluster.x-k8s.io/resource-set"
+	calicoPoolCIDRName    = "# - name: CALICO_IPV4POOL_CIDR"
+	calicoPoolCIDRValue   = `#   value: "192.168.0.0/16"`
+)
+
+// applyCalicoSecret creates or updates the ClusterResourceSet Secret that installs Calico on a Docker cluster.
+// The Calico manifest is packaged with the cluster operator, so no content is downloaded when the cluster is created.
+func (r *ClusterReconciler) applyCalicoSecret(ctx context.Context, props *Properties) error {
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Namespace: constants.VerrazzanoSystemNamespace,
+		Name:      calicoConfigMapName,
+	}, cm); err != nil {
+		return fmt.Errorf("failed to get the Calico manifest ConfigMap %s/%s: %v", constants.VerrazzanoSystemNamespace, calicoConfigMapName, err)
+	}
+	manifest, ok := cm.Data[calicoManifestKey]
+	if !ok {
+		return fmt.Errorf("the Calico manifest ConfigMap %s/%s has no %s key", constants.VerrazzanoSystemNamespace, calicoConfigMapName, calicoManifestKey)
+	}
+	secret := &corev1.Secret{}
+	secret.Namespace = props.Namespace
+	secret.Name = props.Name + "-calico"
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Type = resourceSetSecretType
+		secret.Data = map[string][]byte{
+			calicoManifestKey: []byte(setCalicoPodCIDR(manifest, props.Kubernetes.ClusterNetwork.PodCIDR)),
+		}
+		return nil
+	})
+	return err
+}
+
+// setCalicoPodCIDR configures the Calico IP pool with the pod CIDR of the cluster
+func setCalicoPodCIDR(manifest, podCIDR string) string {
+	manifest = strings.Replace(manifest, calicoPoolCIDRName, "- name: CALICO_IPV4POOL_CIDR", 1)
+	return strings.Replace(manifest, calicoPoolCIDRValue, fmt.Sprintf(`  value: "%s"`, podCIDR), 1)
+}
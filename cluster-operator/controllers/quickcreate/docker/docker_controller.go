@@ -0,0 +1,151 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package docker
+
+import (
+	"context"
+	_ "embed"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller"
+	vzstring "github.com/verrazzano/verrazzano/pkg/string"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	finalizerKey = "verrazzano.io/docker-cluster"
+)
+
+var (
+	gvkKubeadmControlPlane = schema.GroupVersionKind{
+		Group:   "controlplane.cluster.x-k8s.io",
+		Version: "v1beta1",
+		Kind:    "KubeadmControlPlane",
+	}
+	//go:embed template/cluster/cluster.goyaml
+	clusterTemplate []byte
+	//go:embed template/addons/addons.goyaml
+	addonsTemplate []byte
+)
+
+// ClusterReconciler creates Cluster API Docker (CAPD) clusters from DockerQuickCreate resources.
+// The CAPI Cluster it creates is registered as a VerrazzanoManagedCluster by the CAPI cluster controller.
+type ClusterReconciler struct {
+	*controller.Base
+	Scheme *runtime.Scheme
+}
+
+func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	q := &vmcv1alpha1.DockerQuickCreate{}
+	err := r.Get(ctx, req.NamespacedName, q)
+	// if cluster not found, no work to be done
+	if apierrors.IsNotFound(err) {
+		return controller.RequeueDelay(), nil
+	}
+	if err != nil {
+		return controller.RequeueDelay(), err
+	}
+	if err := r.SetNewResourceLogger(q); err != nil {
+		return controller.RequeueDelay(), err
+	}
+	return r.reconcile(ctx, q)
+}
+
+func (r *ClusterReconciler) reconcile(ctx context.Context, q *vmcv1alpha1.DockerQuickCreate) (ctrl.Result, error) {
+	// If quick create is being deleted, delete the cluster and clean up the quick create
+	if !q.GetDeletionTimestamp().IsZero() {
+		if err := r.DeleteCluster(ctx, q); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		return ctrl.Result{}, r.Cleanup(ctx, q, finalizerKey)
+	}
+	// Add any finalizers if they are not present
+	if isMissingFinalizer(q) {
+		return r.SetFinalizers(ctx, q, finalizerKey)
+	}
+	// If quick create is completed, apply any spec updates to the cluster
+	if q.Status.Phase == vmcv1alpha1.QuickCreatePhaseComplete {
+		return r.syncUpdates(ctx, q)
+	}
+	return r.syncCluster(ctx, q)
+}
+
+func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.DockerQuickCreate) (ctrl.Result, error) {
+	// If provisioning has not successfully started, attempt to create the cluster
+	if shouldProvision(q) {
+		props := NewProperties(q)
+		if err := r.applyCalicoSecret(ctx, props); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		if err := controller.ApplyTemplates(r.Client, props, q.Namespace, clusterTemplate, addonsTemplate); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		q.Status = vmcv1alpha1.DockerQuickCreateStatus{}
+		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseProvisioning
+		r.Log.Oncef("provisioning Docker cluster: %s/%s", q.Namespace, q.Name)
+		return r.UpdateStatus(ctx, q)
+	}
+	// If the Docker infrastructure is ready, update the quick create to completed phase
+	ready, err := r.isInfrastructureReady(ctx, q)
+	if err != nil {
+		return controller.RequeueDelay(), err
+	}
+	if ready {
+		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+		q.Status.ObservedGeneration = q.Generation
+		r.Log.Oncef("completed provisioning Docker cluster: %s/%s", q.Namespace, q.Name)
+		return r.UpdateStatus(ctx, q)
+	}
+	r.Log.Progressf("waiting for Docker cluster infrastructure: %s/%s", q.Namespace, q.Name)
+	// Quick Create is not complete yet, requeue
+	return controller.RequeueDelay(), nil
+}
+
+// syncUpdates applies control plane and worker node count updates to a provisioned cluster, and reports the node pool status
+func (r *ClusterReconciler) syncUpdates(ctx context.Context, q *vmcv1alpha1.DockerQuickCreate) (ctrl.Result, error) {
+	if q.Status.ObservedGeneration != q.Generation {
+		props := NewProperties(q)
+		if err := controller.ApplyTemplates(r.Client, props, q.Namespace, clusterTemplate); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		// The worker node pool is not rendered when the cluster has no workers
+		desired := map[string]bool{}
+		if props.HasWorkers() {
+			desired[q.Name+"-"+props.WorkerNodePool] = true
+		}
+		if err := controller.DeleteRemovedNodePools(ctx, r.Client, controller.GVKMachineDeployment, q.Namespace, q.Name, desired); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		r.Log.Oncef("updating Docker cluster: %s/%s", q.Namespace, q.Name)
+	}
+	return r.UpdateNodePoolStatus(ctx, q, &q.Status.QuickCreateStatus, controller.GVKMachineDeployment, gvkKubeadmControlPlane, q.Name+"-control-plane")
+}
+
+// isInfrastructureReady returns true if the CAPI Cluster of the quick create reports its infrastructure as ready
+func (r *ClusterReconciler) isInfrastructureReady(ctx context.Context, q *vmcv1alpha1.DockerQuickCreate) (bool, error) {
+	cluster := &v1beta1.Cluster{}
+	if err := r.Get(ctx, clipkg.ObjectKeyFromObject(q), cluster); err != nil {
+		return false, clipkg.IgnoreNotFound(err)
+	}
+	return cluster.Status.InfrastructureReady, nil
+}
+
+func isMissingFinalizer(q *vmcv1alpha1.DockerQuickCreate) bool {
+	return !vzstring.SliceContainsString(q.GetFinalizers(), finalizerKey)
+}
+
+func shouldProvision(q *vmcv1alpha1.DockerQuickCreate) bool {
+	return q.Status.Phase == ""
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmcv1alpha1.DockerQuickCreate{}).
+		Complete(r)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package docker
+
+import (
+	"context"
+	_ "embed"
+	"github.com/stretchr/testify/assert"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller"
+	"github.com/verrazzano/verrazzano/pkg/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+	"testing"
+	"time"
+)
+
+const (
+	testNamespace = "test"
+	testName      = testNamespace
+)
+
+var (
+	scheme *runtime.Scheme
+	//go:embed testdata/base.yaml
+	testBase []byte
+	//go:embed testdata/completed-patch.yaml
+	testCompleted []byte
+	//go:embed testdata/new-cluster-patch.yaml
+	testNewCluster []byte
+	//go:embed testdata/control-plane-only-patch.yaml
+	testControlPlaneOnly []byte
+	//go:embed testdata/provisioning-patch.yaml
+	testProvisioning []byte
+	//go:embed testdata/calico.yaml
+	testCalicoManifest []byte
+
+	gvkDockerMachineTemplate = schema.GroupVersionKind{
+		Group:   "infrastructure.cluster.x-k8s.io",
+		Version: "v1beta1",
+		Kind:    "DockerMachineTemplate",
+	}
+	gvkClusterResourceSet = schema.GroupVersionKind{
+		Group:   "addons.cluster.x-k8s.io",
+		Version: "v1beta1",
+		Kind:    "ClusterResourceSet",
+	}
+)
+
+func init() {
+	scheme = runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = vmcv1alpha1.AddToScheme(scheme)
+	_ = v1beta1.AddToScheme(scheme)
+}
+
+func TestReconcile(t *testing.T) {
+	newClusterCR, err := testCreateCR(testNewCluster)
+	assert.NoError(t, err)
+	controlPlaneOnlyCR, err := testCreateCR(testControlPlaneOnly)
+	assert.NoError(t, err)
+	completedCR, err := testCreateCR(testCompleted)
+	assert.NoError(t, err)
+	provisioningCR, err := testCreateCR(testProvisioning)
+	assert.NoError(t, err)
+	waitingCR, err := testCreateCR(testProvisioning)
+	assert.NoError(t, err)
+	updatedCR, err := testCreateCR(testControlPlaneOnly)
+	assert.NoError(t, err)
+	updatedCR.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+	updatedCR.Generation = 2
+	updatedCR.Status.ObservedGeneration = 1
+	staleWorkers := &v1beta1.MachineDeployment{}
+	staleWorkers.Namespace = testNamespace
+	staleWorkers.Name = testName + "-md-0"
+	staleWorkers.Labels = map[string]string{controller.NodePoolLabel: workerNodePoolName}
+	staleWorkers.Spec.ClusterName = testName
+	deletedCR, err := testCreateCR(testCompleted)
+	assert.NoError(t, err)
+	deletedCR.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	readyCluster := &v1beta1.Cluster{}
+	readyCluster.Namespace = testNamespace
+	readyCluster.Name = testName
+	readyCluster.Status.InfrastructureReady = true
+
+	notFoundReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).Build())
+	newClusterReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(newClusterCR, testCalicoConfigMap()).Build())
+	controlPlaneOnlyReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlaneOnlyCR, testCalicoConfigMap()).Build())
+	missingCalicoReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(newClusterCR.DeepCopy()).Build())
+	completedReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(completedCR).Build())
+	updatedReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(updatedCR, staleWorkers).Build())
+	deletedReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(deletedCR, readyCluster.DeepCopy()).Build())
+	provisioningReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(provisioningCR, readyCluster).Build())
+	waitingReconciler := testReconciler(fake.NewClientBuilder().WithScheme(scheme).WithObjects(waitingCR).Build())
+
+	var tests = []struct {
+		name        string
+		reconciler  *ClusterReconciler
+		hasError    bool
+		assertsFunc func(t *testing.T)
+	}{
+		{
+			"no error when resource not found",
+			notFoundReconciler,
+			false,
+			func(t *testing.T) {},
+		},
+		{
+			"create a cluster with worker nodes",
+			newClusterReconciler,
+			false,
+			func(t *testing.T) {
+				cli := newClusterReconciler.Client
+				assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{
+					Namespace: testNamespace,
+					Name:      testName,
+				}, &v1beta1.Cluster{}))
+				controlPlane := testGetUnstructured(t, cli, gvkKubeadmControlPlane, testName+"-control-plane")
+				replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+				assert.Equal(t, int64(3), replicas)
+				machineTemplate := testGetUnstructured(t, cli, gvkDockerMachineTemplate, testName+"-md-0")
+				image, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "customImage")
+				assert.Equal(t, "kindest/node:v1.26.2", image)
+				md := &v1beta1.MachineDeployment{}
+				assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{
+					Namespace: testNamespace,
+					Name:      testName + "-md-0",
+				}, md))
+				assert.Equal(t, int32(2), *md.Spec.Replicas)
+				assert.Equal(t, workerNodePoolName, md.Labels[controller.NodePoolLabel])
+				testAssertCNI(t, cli)
+				q, err := getTestCR(cli)
+				assert.NoError(t, err)
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseProvisioning, q.Status.Phase)
+			},
+		},
+		{
+			"create a cluster with only control plane nodes",
+			controlPlaneOnlyReconciler,
+			false,
+			func(t *testing.T) {
+				cli := controlPlaneOnlyReconciler.Client
+				machineTemplate := testGetUnstructured(t, cli, gvkDockerMachineTemplate, testName+"-control-plane")
+				image, _, _ := unstructured.NestedString(machineTemplate.Object, "spec", "template", "spec", "customImage")
+				assert.Equal(t, "registry.example.com/kindest/node:v1.26.2", image)
+				mds := &v1beta1.MachineDeploymentList{}
+				assert.NoError(t, cli.List(context.TODO(), mds))
+				assert.Empty(t, mds.Items)
+			},
+		},
+		{
+			"provisioning waits for the packaged Calico manifest",
+			missingCalicoReconciler,
+			true,
+			func(t *testing.T) {
+				q, err := getTestCR(missingCalicoReconciler.Client)
+				assert.NoError(t, err)
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhase(""), q.Status.Phase)
+			},
+		},
+		{
+			"completed CRs are kept",
+			completedReconciler,
+			false,
+			func(t *testing.T) {
+				q, err := getTestCR(completedReconciler.Client)
+				assert.NoError(t, err)
+				assert.True(t, q.GetDeletionTimestamp().IsZero())
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseComplete, q.Status.Phase)
+			},
+		},
+		{
+			"spec updates are applied to completed clusters",
+			updatedReconciler,
+			false,
+			func(t *testing.T) {
+				cli := updatedReconciler.Client
+				controlPlane := testGetUnstructured(t, cli, gvkKubeadmControlPlane, testName+"-control-plane")
+				replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+				assert.Equal(t, int64(1), replicas)
+				// The cluster no longer has workers, so the worker node pool is removed
+				mds := &v1beta1.MachineDeploymentList{}
+				assert.NoError(t, cli.List(context.TODO(), mds))
+				assert.Empty(t, mds.Items)
+				q, err := getTestCR(cli)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), q.Status.ObservedGeneration)
+			},
+		},
+		{
+			"deleting a quick create deletes the cluster",
+			deletedReconciler,
+			false,
+			func(t *testing.T) {
+				assert.True(t, apierrors.IsNotFound(deletedReconciler.Client.Get(context.TODO(), types.NamespacedName{
+					Namespace: testNamespace,
+					Name:      testName,
+				}, &v1beta1.Cluster{})))
+				_, err := getTestCR(deletedReconciler.Client)
+				assert.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		{
+			"quick create moves to completed when the infrastructure is ready",
+			provisioningReconciler,
+			false,
+			func(t *testing.T) {
+				q, err := getTestCR(provisioningReconciler.Client)
+				assert.NoError(t, err)
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseComplete, q.Status.Phase)
+			},
+		},
+		{
+			"quick create waits for the infrastructure",
+			waitingReconciler,
+			false,
+			func(t *testing.T) {
+				q, err := getTestCR(waitingReconciler.Client)
+				assert.NoError(t, err)
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseProvisioning, q.Status.Phase)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.reconciler.Reconcile(context.TODO(), ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: testNamespace,
+					Name:      testName,
+				},
+			})
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			tt.assertsFunc(t)
+		})
+	}
+}
+
+func TestNewProperties(t *testing.T) {
+	q, err := testCreateCR(testProvisioning)
+	assert.NoError(t, err)
+	props := NewProperties(q)
+	assert.Equal(t, 1, props.ControlPlaneReplicas)
+	assert.Equal(t, 1, props.WorkerReplicas)
+	assert.True(t, props.HasWorkers())
+	assert.Equal(t, "kindest/node:v1.26.2", props.NodeImage)
+}
+
+// testAssertCNI asserts the cluster resource set installs the packaged Calico manifest with the pod CIDR of the cluster
+func testAssertCNI(t *testing.T, cli clipkg.Client) {
+	resourceSet := testGetUnstructured(t, cli, gvkClusterResourceSet, testName+"-resource-set")
+	selector, _, _ := unstructured.NestedStringMap(resourceSet.Object, "spec", "clusterSelector", "matchLabels")
+	assert.Equal(t, testName, selector["cluster.x-k8s.io/cluster-name"])
+	resources, _, _ := unstructured.NestedSlice(resourceSet.Object, "spec", "resources")
+	assert.Len(t, resources, 1)
+	assert.Equal(t, testName+"-calico", resources[0].(map[string]interface{})["name"])
+
+	calico := &corev1.Secret{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{
+		Namespace: testNamespace,
+		Name:      testName + "-calico",
+	}, calico))
+	assert.Equal(t, corev1.SecretType(resourceSetSecretType), calico.Type)
+	manifest := string(calico.Data[calicoManifestKey])
+	assert.Contains(t, manifest, "image: registry.example.com/calico/node:v3.24.5")
+	assert.Contains(t, manifest, "- name: CALICO_IPV4POOL_CIDR")
+	assert.Contains(t, manifest, `value: "10.244.0.0/16"`)
+	assert.NotContains(t, manifest, "192.168.0.0/16")
+}
+
+// testCalicoConfigMap returns the Calico manifest ConfigMap packaged with the cluster operator chart
+func testCalicoConfigMap() *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = constants.VerrazzanoSystemNamespace
+	cm.Name = calicoConfigMapName
+	cm.Data = map[string]string{
+		calicoManifestKey: string(testCalicoManifest),
+	}
+	return cm
+}
+
+func testCreateCR(patch []byte) (*vmcv1alpha1.DockerQuickCreate, error) {
+	baseCR := &vmcv1alpha1.DockerQuickCreate{}
+	patchCR := &vmcv1alpha1.DockerQuickCreate{}
+	if err := yaml.Unmarshal(testBase, baseCR); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(patch, patchCR); err != nil {
+		return nil, err
+	}
+	baseCR.Spec = patchCR.Spec
+	baseCR.Status = patchCR.Status
+	return baseCR, nil
+}
+
+func testReconciler(cli clipkg.Client) *ClusterReconciler {
+	return &ClusterReconciler{
+		Base: &controller.Base{
+			Client: cli,
+		},
+		Scheme: scheme,
+	}
+}
+
+func testGetUnstructured(t *testing.T, cli clipkg.Client, gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{
+		Namespace: testNamespace,
+		Name:      name,
+	}, u))
+	return u
+}
+
+func getTestCR(cli clipkg.Client) (*vmcv1alpha1.DockerQuickCreate, error) {
+	ctx := context.TODO()
+	q := &vmcv1alpha1.DockerQuickCreate{}
+	err := cli.Get(ctx, types.NamespacedName{
+		Namespace: testNamespace,
+		Name:      testName,
+	}, q)
+
+	return q, err
+}
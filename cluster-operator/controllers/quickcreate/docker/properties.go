@@ -0,0 +1,56 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package docker
+
+import (
+	"fmt"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+)
+
+const (
+	defaultNodeImageRepository = "kindest/node"
+	defaultReplicas            = 1
+	workerNodePoolName         = "md-0"
+)
+
+type (
+	// Properties contains all the properties for rendering Docker Cluster templates.
+	Properties struct {
+		vmcv1alpha1.DockerQuickCreateSpec
+		Name                 string
+		Namespace            string
+		ControlPlaneReplicas int
+		WorkerReplicas       int
+		NodeImage            string
+		WorkerNodePool       string
+	}
+)
+
+// NewProperties creates a new properties object based on the quick create resource, applying defaults for unset values.
+func NewProperties(q *vmcv1alpha1.DockerQuickCreate) *Properties {
+	props := &Properties{
+		DockerQuickCreateSpec: q.Spec,
+		Name:                  q.Name,
+		Namespace:             q.Namespace,
+		ControlPlaneReplicas:  defaultReplicas,
+		WorkerReplicas:        defaultReplicas,
+		NodeImage:             q.Spec.Docker.NodeImage,
+		WorkerNodePool:        workerNodePoolName,
+	}
+	if q.Spec.Docker.ControlPlaneReplicas != nil {
+		props.ControlPlaneReplicas = *q.Spec.Docker.ControlPlaneReplicas
+	}
+	if q.Spec.Docker.WorkerReplicas != nil {
+		props.WorkerReplicas = *q.Spec.Docker.WorkerReplicas
+	}
+	if props.NodeImage == "" {
+		props.NodeImage = fmt.Sprintf("%s:%s", defaultNodeImageRepository, q.Spec.Kubernetes.Version)
+	}
+	return props
+}
+
+// HasWorkers returns true if the cluster has worker nodes in addition to the control plane nodes.
+func (p *Properties) HasWorkers() bool {
+	return p.WorkerReplicas > 0
+}
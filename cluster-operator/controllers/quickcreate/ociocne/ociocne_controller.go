@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package ociocne
@@ -13,6 +13,7 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/k8s/node"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -22,6 +23,11 @@ const (
 )
 
 var (
+	gvkOCNEControlPlane = schema.GroupVersionKind{
+		Group:   "controlplane.cluster.x-k8s.io",
+		Version: "v1alpha1",
+		Kind:    "OCNEControlPlane",
+	}
 	//go:embed template/addons/addons.goyaml
 	addonsTemplate []byte
 	//go:embed template/cluster/cluster.goyaml
@@ -56,14 +62,21 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 }
 
 func (r *ClusterReconciler) reconcile(ctx context.Context, q *vmcv1alpha1.OCNEOCIQuickCreate) (ctrl.Result, error) {
-	// If quick create is completed, or being deleted, clean up the quick create
-	if !q.GetDeletionTimestamp().IsZero() || q.Status.Phase == vmcv1alpha1.QuickCreatePhaseComplete {
+	// If quick create is being deleted, delete the cluster and clean up the quick create
+	if !q.GetDeletionTimestamp().IsZero() {
+		if err := r.DeleteCluster(ctx, q); err != nil {
+			return controller.RequeueDelay(), err
+		}
 		return ctrl.Result{}, r.Cleanup(ctx, q, finalizerKey)
 	}
 	// Add any finalizers if they are not present
 	if isMissingFinalizer(q) {
 		return r.SetFinalizers(ctx, q, finalizerKey)
 	}
+	// If quick create is completed, apply any spec updates to the cluster
+	if q.Status.Phase == vmcv1alpha1.QuickCreatePhaseComplete {
+		return r.syncUpdates(ctx, q)
+	}
 	return r.syncCluster(ctx, q)
 }
 
@@ -77,9 +90,8 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OCNE
 		if err := controller.ApplyTemplates(r.Client, ocne, q.Namespace, clusterTemplate, nodesTemplate, ocneTemplate); err != nil {
 			return controller.RequeueDelay(), err
 		}
-		q.Status = vmcv1alpha1.OCNEOCIQuickCreateStatus{
-			Phase: vmcv1alpha1.QuickCreatePhaseProvisioning,
-		}
+		q.Status = vmcv1alpha1.OCNEOCIQuickCreateStatus{}
+		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseProvisioning
 		r.Log.Oncef("provisioning OCNE OCI cluster: %s/%s", q.Namespace, q.Name)
 		return r.UpdateStatus(ctx, q)
 	}
@@ -96,6 +108,7 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OCNE
 		}
 		r.Log.Oncef("completed provisioning OCNE OCI cluster: %s/%s", q.Namespace, q.Name)
 		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+		q.Status.ObservedGeneration = q.Generation
 		return r.UpdateStatus(ctx, q)
 	}
 
@@ -104,6 +117,30 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OCNE
 	return controller.RequeueDelay(), nil
 }
 
+// syncUpdates applies control plane, worker node pool and OCNE version updates to a provisioned cluster,
+// and reports the node pool status
+func (r *ClusterReconciler) syncUpdates(ctx context.Context, q *vmcv1alpha1.OCNEOCIQuickCreate) (ctrl.Result, error) {
+	if q.Status.ObservedGeneration != q.Generation {
+		ocne, err := NewProperties(ctx, r.Client, r.CredentialsLoader, r.OCIClientGetter, q)
+		if err != nil {
+			return controller.RequeueDelay(), err
+		}
+		// The control plane and the worker nodes are rolled out by Cluster API when their version changes
+		if err := controller.ApplyTemplates(r.Client, ocne, q.Namespace, ocneTemplate, nodesTemplate); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		desired := map[string]bool{}
+		for _, worker := range q.Spec.OCI.Workers {
+			desired[worker.Name] = true
+		}
+		if err := controller.DeleteRemovedNodePools(ctx, r.Client, controller.GVKMachineDeployment, q.Namespace, q.Name, desired); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		r.Log.Oncef("updating OCNE OCI cluster: %s/%s", q.Namespace, q.Name)
+	}
+	return r.UpdateNodePoolStatus(ctx, q, &q.Status.QuickCreateStatus, controller.GVKMachineDeployment, gvkOCNEControlPlane, q.Name+"-control-plane")
+}
+
 func (r *ClusterReconciler) setControlPlaneSchedulable(ctx context.Context, q *vmcv1alpha1.OCNEOCIQuickCreate) error {
 	cli, err := capi.GetClusterClient(ctx, r.Client, types.NamespacedName{
 		Namespace: q.Namespace,
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package ociocne
@@ -13,7 +13,9 @@ import (
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
 	ocifake "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/fake"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"os"
@@ -171,12 +173,13 @@ func TestReconcile(t *testing.T) {
 			},
 		},
 		{
-			"completed CRs are deleted",
+			"completed CRs are not deleted",
 			completedReconciler,
 			func(t *testing.T) {
 				q, err := getTestCR(completedReconciler.Client)
 				assert.NoError(t, err)
-				assert.False(t, q.GetDeletionTimestamp().IsZero())
+				assert.True(t, q.GetDeletionTimestamp().IsZero())
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseComplete, q.Status.Phase)
 			},
 		},
 		{
@@ -226,3 +229,79 @@ func TestReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileUpdates(t *testing.T) {
+	updatedCR, err := testCreateCR(testExistingVCNPatch)
+	assert.NoError(t, err)
+	updatedCR.Generation = 2
+	updatedCR.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+	updatedCR.Status.ObservedGeneration = 1
+	removedPool := &v1beta1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "removed",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				controller.NodePoolLabel: "removed",
+			},
+		},
+		Spec: v1beta1.MachineDeploymentSpec{
+			ClusterName: testName,
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(updatedCR, removedPool, testOCNEConfigMap()).Build()
+	r := testReconciler(cli)
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      testName,
+		},
+	})
+	assert.NoError(t, err)
+
+	// The control plane is updated to the Kubernetes version of the OCNE version
+	controlPlane := &unstructured.Unstructured{}
+	controlPlane.SetGroupVersionKind(gvkOCNEControlPlane)
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{
+		Namespace: testNamespace,
+		Name:      testName + "-control-plane",
+	}, controlPlane))
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "version")
+	assert.Equal(t, "v1.26.6", version)
+	// Removed node pools are deleted
+	err = cli.Get(context.TODO(), clipkg.ObjectKeyFromObject(removedPool), &v1beta1.MachineDeployment{})
+	assert.True(t, apierrors.IsNotFound(err))
+	// The node pool status is reported
+	q, err := getTestCR(cli)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), q.Status.ObservedGeneration)
+	assert.Len(t, q.Status.NodePools, 2)
+	assert.Equal(t, "np-1", q.Status.NodePools[0].Name)
+	assert.Equal(t, int64(1), q.Status.NodePools[0].Replicas)
+	assert.Equal(t, "v1.26.6", q.Status.NodePools[0].Version)
+}
+
+func TestReconcileDelete(t *testing.T) {
+	deletedCR, err := testCreateCR(testCompletedPatch)
+	assert.NoError(t, err)
+	now := metav1.Now()
+	deletedCR.DeletionTimestamp = &now
+	cluster := &v1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testName,
+			Namespace: testNamespace,
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deletedCR, cluster).Build()
+	r := testReconciler(cli)
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      testName,
+		},
+	})
+	assert.NoError(t, err)
+	err = cli.Get(context.TODO(), clipkg.ObjectKeyFromObject(cluster), &v1beta1.Cluster{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
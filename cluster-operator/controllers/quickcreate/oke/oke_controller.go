@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package oke
@@ -11,8 +11,11 @@ import (
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
 	vzstring "github.com/verrazzano/verrazzano/pkg/string"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -20,6 +23,11 @@ const (
 )
 
 var (
+	gvkOCIManagedControlPlane = schema.GroupVersionKind{
+		Group:   "infrastructure.cluster.x-k8s.io",
+		Version: "v1beta2",
+		Kind:    "OCIManagedControlPlane",
+	}
 	//go:embed template/cluster/cluster.goyaml
 	clusterTemplate []byte
 	//go:embed template/nodes/nodes.goyaml
@@ -50,14 +58,21 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 }
 
 func (r *ClusterReconciler) reconcile(ctx context.Context, q *vmcv1alpha1.OKEQuickCreate) (ctrl.Result, error) {
-	// If quick create is completed, or being deleted, clean up the quick create
-	if !q.GetDeletionTimestamp().IsZero() || q.Status.Phase == vmcv1alpha1.QuickCreatePhaseComplete {
+	// If quick create is being deleted, delete the cluster and clean up the quick create
+	if !q.GetDeletionTimestamp().IsZero() {
+		if err := r.DeleteCluster(ctx, q); err != nil {
+			return controller.RequeueDelay(), err
+		}
 		return ctrl.Result{}, r.Cleanup(ctx, q, finalizerKey)
 	}
 	// Add any finalizers if they are not present
 	if isMissingFinalizer(q) {
 		return r.SetFinalizers(ctx, q, finalizerKey)
 	}
+	// If quick create is completed, apply any spec updates to the cluster
+	if q.Status.Phase == vmcv1alpha1.QuickCreatePhaseComplete {
+		return r.syncUpdates(ctx, q)
+	}
 	return r.syncCluster(ctx, q)
 }
 
@@ -71,9 +86,8 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OKEQ
 		if err := controller.ApplyTemplates(r.Client, props, q.Namespace, clusterTemplate); err != nil {
 			return controller.RequeueDelay(), err
 		}
-		q.Status = vmcv1alpha1.OKEQuickCreateStatus{
-			Phase: vmcv1alpha1.QuickCreatePhaseProvisioning,
-		}
+		q.Status = vmcv1alpha1.OKEQuickCreateStatus{}
+		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseProvisioning
 		r.Log.Oncef("provisioning OKE cluster: %s/%s", q.Namespace, q.Name)
 		return r.UpdateStatus(ctx, q)
 	}
@@ -83,6 +97,7 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OKEQ
 			return controller.RequeueDelay(), err
 		}
 		q.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+		q.Status.ObservedGeneration = q.Generation
 		r.Log.Oncef("completed provisioning OKE cluster: %s/%s", q.Namespace, q.Name)
 		return r.UpdateStatus(ctx, q)
 	}
@@ -91,6 +106,56 @@ func (r *ClusterReconciler) syncCluster(ctx context.Context, q *vmcv1alpha1.OKEQ
 	return controller.RequeueDelay(), nil
 }
 
+// syncUpdates applies node pool and Kubernetes version updates to a provisioned cluster, and reports the node pool status
+func (r *ClusterReconciler) syncUpdates(ctx context.Context, q *vmcv1alpha1.OKEQuickCreate) (ctrl.Result, error) {
+	if q.Status.ObservedGeneration != q.Generation {
+		props, err := NewProperties(ctx, r.Client, r.CredentialsLoader, r.OCIClientGetter, q)
+		if err != nil {
+			return controller.RequeueDelay(), err
+		}
+		if err := r.setControlPlaneVersion(ctx, q); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		if err := controller.ApplyTemplates(r.Client, props, q.Namespace, nodesTemplate); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		if err := controller.DeleteRemovedNodePools(ctx, r.Client, controller.GVKMachinePool, q.Namespace, q.Name, getMachinePoolNames(q)); err != nil {
+			return controller.RequeueDelay(), err
+		}
+		r.Log.Oncef("updating OKE cluster: %s/%s", q.Namespace, q.Name)
+	}
+	return r.UpdateNodePoolStatus(ctx, q, &q.Status.QuickCreateStatus, controller.GVKMachinePool, gvkOCIManagedControlPlane, q.Name)
+}
+
+// setControlPlaneVersion sets the Kubernetes version of the OKE control plane. OKE upgrades the control plane in place.
+func (r *ClusterReconciler) setControlPlaneVersion(ctx context.Context, q *vmcv1alpha1.OKEQuickCreate) error {
+	controlPlane := &unstructured.Unstructured{}
+	controlPlane.SetGroupVersionKind(gvkOCIManagedControlPlane)
+	if err := r.Get(ctx, clipkg.ObjectKeyFromObject(q), controlPlane); err != nil {
+		return err
+	}
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "version")
+	if version == q.Spec.Kubernetes.Version {
+		return nil
+	}
+	if err := unstructured.SetNestedField(controlPlane.Object, q.Spec.Kubernetes.Version, "spec", "version"); err != nil {
+		return err
+	}
+	return r.Update(ctx, controlPlane)
+}
+
+// getMachinePoolNames returns the names of the MachinePools of the node pools and virtual node pools of the cluster
+func getMachinePoolNames(q *vmcv1alpha1.OKEQuickCreate) map[string]bool {
+	names := map[string]bool{}
+	for _, np := range q.Spec.OKE.NodePools {
+		names[np.Name] = true
+	}
+	for _, np := range q.Spec.OKE.VirtualNodePools {
+		names[np.Name+"-virtual"] = true
+	}
+	return names
+}
+
 func isMissingFinalizer(q *vmcv1alpha1.OKEQuickCreate) bool {
 	return !vzstring.SliceContainsString(q.GetFinalizers(), finalizerKey)
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package oke
@@ -12,6 +12,9 @@ import (
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
 	ocifake "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/fake"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
@@ -101,12 +104,13 @@ func TestReconcile(t *testing.T) {
 			},
 		},
 		{
-			"completed CRs are deleted",
+			"completed CRs are not deleted",
 			completedReconciler,
 			func(t *testing.T) {
 				q, err := getTestCR(completedReconciler.Client)
 				assert.NoError(t, err)
-				assert.False(t, q.GetDeletionTimestamp().IsZero())
+				assert.True(t, q.GetDeletionTimestamp().IsZero())
+				assert.Equal(t, vmcv1alpha1.QuickCreatePhaseComplete, q.Status.Phase)
 			},
 		},
 		{
@@ -145,6 +149,75 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileUpdates(t *testing.T) {
+	updatedCR, err := testCreateCR(testExistingVCN)
+	assert.NoError(t, err)
+	updatedCR.Generation = 2
+	updatedCR.Status.Phase = vmcv1alpha1.QuickCreatePhaseComplete
+	updatedCR.Status.ObservedGeneration = 1
+	controlPlane := &unstructured.Unstructured{}
+	controlPlane.SetGroupVersionKind(gvkOCIManagedControlPlane)
+	controlPlane.SetNamespace(testNamespace)
+	controlPlane.SetName(testName)
+	_ = unstructured.SetNestedField(controlPlane.Object, "v1.25.7", "spec", "version")
+	removedPool := &unstructured.Unstructured{}
+	removedPool.SetGroupVersionKind(controller.GVKMachinePool)
+	removedPool.SetNamespace(testNamespace)
+	removedPool.SetName("removed")
+	removedPool.SetLabels(map[string]string{controller.NodePoolLabel: "removed"})
+	_ = unstructured.SetNestedField(removedPool.Object, testName, "spec", "clusterName")
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(updatedCR, controlPlane, removedPool).Build()
+	r := testReconciler(cli)
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      testName,
+		},
+	})
+	assert.NoError(t, err)
+
+	// The control plane is upgraded
+	assert.NoError(t, cli.Get(context.TODO(), clipkg.ObjectKeyFromObject(controlPlane), controlPlane))
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "version")
+	assert.Equal(t, "v1.26.2", version)
+	// Removed node pools are deleted
+	err = cli.Get(context.TODO(), clipkg.ObjectKeyFromObject(removedPool), removedPool)
+	assert.True(t, apierrors.IsNotFound(err))
+	// The node pool status is reported
+	q, err := getTestCR(cli)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), q.Status.ObservedGeneration)
+	assert.Len(t, q.Status.NodePools, 1)
+	assert.Equal(t, "test", q.Status.NodePools[0].Name)
+	assert.Equal(t, "v1.26.2", q.Status.NodePools[0].Version)
+}
+
+func TestReconcileDelete(t *testing.T) {
+	deletedCR, err := testCreateCR(testCompleted)
+	assert.NoError(t, err)
+	now := metav1.Now()
+	deletedCR.DeletionTimestamp = &now
+	cluster := &v1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testName,
+			Namespace: testNamespace,
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deletedCR, cluster).Build()
+	r := testReconciler(cli)
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      testName,
+		},
+	})
+	assert.NoError(t, err)
+	err = cli.Get(context.TODO(), clipkg.ObjectKeyFromObject(cluster), &v1beta1.Cluster{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
 func testCreateCR(patch []byte) (*vmcv1alpha1.OKEQuickCreate, error) {
 	baseCR := &vmcv1alpha1.OKEQuickCreate{}
 	patchCR := &vmcv1alpha1.OKEQuickCreate{}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vmc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+)
+
+const (
+	clusterLabel = "cluster"
+	kindLabel    = "kind"
+)
+
+var (
+	healthScoreMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_health_score",
+		Help: "The health score of the managed cluster reported by the cluster agent, from 0 to 100",
+	}, []string{clusterLabel})
+	healthyMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_healthy",
+		Help: "1 if the managed cluster is healthy, 0 if it is degraded or its health is unknown",
+	}, []string{clusterLabel})
+	componentsUnavailableMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_components_unavailable",
+		Help: "The number of enabled Verrazzano components that are not available on the managed cluster",
+	}, []string{clusterLabel})
+	nodesNotReadyMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_nodes_not_ready",
+		Help: "The number of nodes of the managed cluster that are not ready",
+	}, []string{clusterLabel})
+	certificateExpiryMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_certificate_expiry_timestamp_seconds",
+		Help: "The earliest expiry time of the Verrazzano TLS certificates of the managed cluster, in seconds since the epoch",
+	}, []string{clusterLabel})
+	syncLagMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_sync_lag_seconds",
+		Help: "The time since the cluster agent last synchronized a multicluster resource kind to the managed cluster",
+	}, []string{clusterLabel, kindLabel})
+	lastAgentConnectMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vz_cluster_operator_vmc_last_agent_connect_timestamp_seconds",
+		Help: "The last time the cluster agent of the managed cluster connected to the admin cluster, in seconds since the epoch",
+	}, []string{clusterLabel})
+)
+
+// updateHealth marks the health of the VMC as unknown when its agent is no longer connecting to the admin cluster,
+// since the health summary last reported by the agent can no longer be trusted
+func updateHealth(vmc *clustersv1alpha1.VerrazzanoManagedCluster) {
+	if vmc.Status.Health != nil && vmc.Status.State == clustersv1alpha1.StateInactive {
+		vmc.Status.Health.Status = clustersv1alpha1.HealthUnknown
+	}
+}
+
+// updateHealthMetrics sets the health metrics of the VMC from its status
+func updateHealthMetrics(vmc *clustersv1alpha1.VerrazzanoManagedCluster) {
+	cluster := vmc.Name
+	if vmc.Status.LastAgentConnectTime != nil {
+		lastAgentConnectMetric.WithLabelValues(cluster).Set(float64(vmc.Status.LastAgentConnectTime.Unix()))
+	}
+	health := vmc.Status.Health
+	if health == nil {
+		healthyMetric.WithLabelValues(cluster).Set(0)
+		return
+	}
+	healthy := 0.0
+	if health.Status == clustersv1alpha1.HealthHealthy {
+		healthy = 1
+	}
+	healthyMetric.WithLabelValues(cluster).Set(healthy)
+	healthScoreMetric.WithLabelValues(cluster).Set(float64(health.Score))
+	componentsUnavailableMetric.WithLabelValues(cluster).Set(float64(health.Components.Total - health.Components.Available))
+	nodesNotReadyMetric.WithLabelValues(cluster).Set(float64(health.Nodes.Total - health.Nodes.Ready))
+	if health.CertificateExpiry != nil {
+		certificateExpiryMetric.WithLabelValues(cluster).Set(float64(health.CertificateExpiry.Unix()))
+	} else {
+		certificateExpiryMetric.DeleteLabelValues(cluster)
+	}
+	for _, sync := range health.Sync {
+		syncLagMetric.WithLabelValues(cluster, sync.Kind).Set(float64(sync.LagSeconds))
+	}
+}
+
+// deleteHealthMetrics deletes the health metrics of a VMC that is being deleted
+func deleteHealthMetrics(vmc *clustersv1alpha1.VerrazzanoManagedCluster) {
+	labels := prometheus.Labels{clusterLabel: vmc.Name}
+	for _, metric := range []*prometheus.GaugeVec{healthScoreMetric, healthyMetric, componentsUnavailableMetric,
+		nodesNotReadyMetric, certificateExpiryMetric, syncLagMetric, lastAgentConnectMetric} {
+		metric.DeletePartialMatch(labels)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vmc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestUpdateHealthMetrics tests the health metrics of a VMC
+// GIVEN a VMC with a health summary reported by the agent
+// WHEN the health and health metrics are updated
+// THEN the metrics reflect the health summary, the health is unknown when the agent is inactive, and the metrics are
+// removed when the VMC is deleted
+func TestUpdateHealthMetrics(t *testing.T) {
+	asserts := assert.New(t)
+	lastConnect := metav1.NewTime(time.Now())
+	expiry := metav1.NewTime(time.Now().Add(24 * time.Hour))
+	vmc := &clustersv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "health-test"},
+		Status: clustersv1alpha1.VerrazzanoManagedClusterStatus{
+			State:                clustersv1alpha1.StateActive,
+			LastAgentConnectTime: &lastConnect,
+			Health: &clustersv1alpha1.HealthSummary{
+				Status:            clustersv1alpha1.HealthDegraded,
+				Score:             70,
+				Components:        clustersv1alpha1.ComponentHealth{Available: 8, Total: 10},
+				Nodes:             clustersv1alpha1.NodeHealth{Ready: 2, Total: 3},
+				CertificateExpiry: &expiry,
+				Sync: []clustersv1alpha1.ResourceSyncStatus{
+					{Kind: "MultiClusterSecret", LagSeconds: 600},
+				},
+			},
+		},
+	}
+
+	updateHealth(vmc)
+	updateHealthMetrics(vmc)
+	asserts.Equal(clustersv1alpha1.HealthDegraded, vmc.Status.Health.Status)
+	asserts.Equal(float64(70), testutil.ToFloat64(healthScoreMetric.WithLabelValues(vmc.Name)))
+	asserts.Equal(float64(0), testutil.ToFloat64(healthyMetric.WithLabelValues(vmc.Name)))
+	asserts.Equal(float64(2), testutil.ToFloat64(componentsUnavailableMetric.WithLabelValues(vmc.Name)))
+	asserts.Equal(float64(1), testutil.ToFloat64(nodesNotReadyMetric.WithLabelValues(vmc.Name)))
+	asserts.Equal(float64(expiry.Unix()), testutil.ToFloat64(certificateExpiryMetric.WithLabelValues(vmc.Name)))
+	asserts.Equal(float64(600), testutil.ToFloat64(syncLagMetric.WithLabelValues(vmc.Name, "MultiClusterSecret")))
+	asserts.Equal(float64(lastConnect.Unix()), testutil.ToFloat64(lastAgentConnectMetric.WithLabelValues(vmc.Name)))
+
+	// A healthy cluster
+	vmc.Status.Health.Status = clustersv1alpha1.HealthHealthy
+	updateHealthMetrics(vmc)
+	asserts.Equal(float64(1), testutil.ToFloat64(healthyMetric.WithLabelValues(vmc.Name)))
+
+	// The health is unknown when the agent is inactive
+	vmc.Status.State = clustersv1alpha1.StateInactive
+	updateHealth(vmc)
+	updateHealthMetrics(vmc)
+	asserts.Equal(clustersv1alpha1.HealthUnknown, vmc.Status.Health.Status)
+	asserts.Equal(float64(0), testutil.ToFloat64(healthyMetric.WithLabelValues(vmc.Name)))
+
+	// The metrics are removed when the VMC is deleted
+	deleteHealthMetrics(vmc)
+	asserts.Equal(0, testutil.CollectAndCount(syncLagMetric))
+	asserts.Equal(0, testutil.CollectAndCount(healthScoreMetric))
+}
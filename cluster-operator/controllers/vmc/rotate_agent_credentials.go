@@ -0,0 +1,212 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vmc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// The number of rotations kept in the VMC status
+	credentialRotationHistoryLimit = 10
+	// The time the agent has to connect with a new token before the rotation is abandoned
+	credentialRotationTimeout = time.Hour
+
+	rotationReasonRequested   = "Requested"
+	rotationReasonMaxTokenAge = "MaxTokenAge"
+)
+
+// rotateAgentCredentials rotates the service account token used by the managed cluster agent, when requested with the
+// rotate credentials annotation or when the token is older than the maximum token age of the rotation policy.
+// A rotation mints a new token, which syncAgentSecret puts in the agent secret, and then waits for the agent to report
+// that it connected with the new token before revoking the old token.
+func (r *VerrazzanoManagedClusterReconciler) rotateAgentCredentials(ctx context.Context, vmc *clustersv1alpha1.VerrazzanoManagedCluster) error {
+	rotation := vmc.Status.CredentialRotation
+	if rotation != nil && len(rotation.PendingToken) > 0 {
+		return r.completeAgentCredentialRotation(ctx, vmc)
+	}
+	request := vmc.Annotations[clustersv1alpha1.RotateCredentialsAnnotation]
+	requested := len(request) > 0 && (rotation == nil || rotation.LastRequest != request)
+	if !requested && vmc.Spec.CredentialRotation == nil {
+		return nil
+	}
+	original := rotation.DeepCopy()
+
+	sa := &corev1.ServiceAccount{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: vmc.Namespace, Name: generateManagedResourceName(vmc.Name)}, sa); err != nil {
+		return fmt.Errorf("Failed to fetch the service account for VMC %s/%s, %v", vmc.Namespace, vmc.Name, err)
+	}
+	if rotation == nil {
+		rotation = &clustersv1alpha1.CredentialRotationStatus{}
+		vmc.Status.CredentialRotation = rotation
+	}
+	if len(rotation.CurrentToken) == 0 {
+		rotation.CurrentToken = getServiceAccountTokenName(sa)
+	}
+
+	reason := ""
+	if requested {
+		reason = rotationReasonRequested
+	} else {
+		expired, err := r.isAgentTokenExpired(ctx, vmc)
+		if err != nil {
+			return err
+		}
+		if expired {
+			reason = rotationReasonMaxTokenAge
+		}
+	}
+	if len(reason) == 0 {
+		if reflect.DeepEqual(original, rotation) {
+			return nil
+		}
+		return r.updateCredentialRotationStatus(ctx, vmc)
+	}
+
+	now := metav1.Now()
+	tokenName := fmt.Sprintf("%s-token-%d", sa.Name, now.Unix())
+	r.log.Infof("Rotating the agent credentials of VMC %s, reason %s, new token %s", vmc.Name, reason, tokenName)
+	if _, err := r.createServiceAccountTokenSecret(ctx, sa, tokenName); err != nil {
+		return err
+	}
+	rotation.PendingToken = tokenName
+	rotation.PendingReason = reason
+	rotation.RotationStartTime = &now
+	if requested {
+		rotation.LastRequest = request
+	}
+	return r.updateCredentialRotationStatus(ctx, vmc)
+}
+
+// completeAgentCredentialRotation revokes the old token once the agent reports that it connected with the new token.
+// If the agent does not connect with the new token in time, the new token is revoked instead.
+func (r *VerrazzanoManagedClusterReconciler) completeAgentCredentialRotation(ctx context.Context, vmc *clustersv1alpha1.VerrazzanoManagedCluster) error {
+	rotation := vmc.Status.CredentialRotation
+	now := metav1.Now()
+	record := clustersv1alpha1.CredentialRotationRecord{
+		Reason:         rotation.PendingReason,
+		OldToken:       rotation.CurrentToken,
+		NewToken:       rotation.PendingToken,
+		StartTime:      rotation.RotationStartTime,
+		CompletionTime: &now,
+	}
+	switch {
+	case vmc.Status.AgentCredential == rotation.PendingToken:
+		if err := r.deleteServiceAccountTokenSecret(ctx, vmc.Namespace, rotation.CurrentToken); err != nil {
+			return err
+		}
+		r.log.Infof("The agent of VMC %s connected with token %s, revoked token %s", vmc.Name, rotation.PendingToken, rotation.CurrentToken)
+		record.Result = clustersv1alpha1.CredentialRotationSucceeded
+		record.Message = "The agent connected with the new token"
+		rotation.CurrentToken = rotation.PendingToken
+		rotation.TokenCreationTime = rotation.RotationStartTime
+	case rotation.RotationStartTime == nil || now.Sub(rotation.RotationStartTime.Time) > credentialRotationTimeout:
+		if err := r.deleteServiceAccountTokenSecret(ctx, vmc.Namespace, rotation.PendingToken); err != nil {
+			return err
+		}
+		r.log.Errorf("The agent of VMC %s did not connect with token %s within %v, revoked the new token", vmc.Name, rotation.PendingToken, credentialRotationTimeout)
+		record.Result = clustersv1alpha1.CredentialRotationFailed
+		record.Message = fmt.Sprintf("The agent did not connect with the new token within %v", credentialRotationTimeout)
+	default:
+		r.log.Progressf("Waiting for the agent of VMC %s to connect with token %s", vmc.Name, rotation.PendingToken)
+		return nil
+	}
+	rotation.PendingToken = ""
+	rotation.PendingReason = ""
+	rotation.RotationStartTime = nil
+	rotation.History = append(rotation.History, record)
+	if len(rotation.History) > credentialRotationHistoryLimit {
+		rotation.History = rotation.History[len(rotation.History)-credentialRotationHistoryLimit:]
+	}
+	return r.updateCredentialRotationStatus(ctx, vmc)
+}
+
+// isAgentTokenExpired returns true if the current agent token is older than the maximum token age of the rotation policy
+func (r *VerrazzanoManagedClusterReconciler) isAgentTokenExpired(ctx context.Context, vmc *clustersv1alpha1.VerrazzanoManagedCluster) (bool, error) {
+	policy := vmc.Spec.CredentialRotation
+	if policy == nil || policy.MaxTokenAge == nil {
+		return false, nil
+	}
+	rotation := vmc.Status.CredentialRotation
+	if rotation.TokenCreationTime == nil {
+		// The token was created before the rotation policy, use the creation time of its secret
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: vmc.Namespace, Name: rotation.CurrentToken}, secret); err != nil {
+			return false, fmt.Errorf("Failed to fetch the service account secret %s/%s, %v", vmc.Namespace, rotation.CurrentToken, err)
+		}
+		creationTime := secret.CreationTimestamp
+		rotation.TokenCreationTime = &creationTime
+	}
+	return time.Since(rotation.TokenCreationTime.Time) > policy.MaxTokenAge.Duration, nil
+}
+
+// getAgentTokenSecret returns the service account token secret to use in the agent kubeconfig. The new token of a
+// rotation in progress is used as soon as the token controller has populated it.
+func (r *VerrazzanoManagedClusterReconciler) getAgentTokenSecret(vmc *clustersv1alpha1.VerrazzanoManagedCluster, sa *corev1.ServiceAccount) (*corev1.Secret, error) {
+	tokenName := getServiceAccountTokenName(sa)
+	if rotation := vmc.Status.CredentialRotation; rotation != nil {
+		if len(rotation.PendingToken) > 0 {
+			secret := &corev1.Secret{}
+			err := r.Get(context.TODO(), types.NamespacedName{Namespace: vmc.Namespace, Name: rotation.PendingToken}, secret)
+			if client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			if err == nil && len(secret.Data[mcconstants.TokenKey]) > 0 {
+				return secret, nil
+			}
+		}
+		if len(rotation.CurrentToken) > 0 {
+			tokenName = rotation.CurrentToken
+		}
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Namespace: vmc.Namespace, Name: tokenName}, secret); err != nil {
+		return nil, fmt.Errorf("Failed to fetch the service account secret %s/%s, %v", vmc.Namespace, tokenName, err)
+	}
+	return secret, nil
+}
+
+// getServiceAccountTokenName returns the name of the token secret of the service account of a managed cluster, before
+// any rotation
+func getServiceAccountTokenName(sa *corev1.ServiceAccount) string {
+	if len(sa.Secrets) == 0 {
+		return sa.Name + "-token"
+	}
+	return sa.Secrets[0].Name
+}
+
+// deleteServiceAccountTokenSecret deletes a service account token secret, which revokes the token
+func (r *VerrazzanoManagedClusterReconciler) deleteServiceAccountTokenSecret(ctx context.Context, namespace, name string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("Failed to delete the service account secret %s/%s, %v", namespace, name, err)
+	}
+	return nil
+}
+
+// updateCredentialRotationStatus updates the credential rotation status of the VMC in the cluster
+func (r *VerrazzanoManagedClusterReconciler) updateCredentialRotationStatus(ctx context.Context, vmc *clustersv1alpha1.VerrazzanoManagedCluster) error {
+	existingVMC := &clustersv1alpha1.VerrazzanoManagedCluster{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: vmc.Namespace, Name: vmc.Name}, existingVMC); err != nil {
+		return err
+	}
+	existingVMC.Status.CredentialRotation = vmc.Status.CredentialRotation
+	return r.Status().Update(ctx, existingVMC)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vmc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	rotationTestNamespace = "verrazzano-mc"
+	rotationTestCluster   = "managed1"
+)
+
+func newRotationTestReconciler(objects ...client.Object) *VerrazzanoManagedClusterReconciler {
+	cli := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+	return &VerrazzanoManagedClusterReconciler{
+		Client: cli,
+		Scheme: newScheme(),
+		log:    vzlog.DefaultLogger(),
+	}
+}
+
+func newRotationTestObjects(tokenCreationTime time.Time) (*clustersv1alpha1.VerrazzanoManagedCluster, *corev1.ServiceAccount, *corev1.Secret) {
+	vmc := &clustersv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rotationTestNamespace,
+			Name:      rotationTestCluster,
+		},
+	}
+	saName := generateManagedResourceName(rotationTestCluster)
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rotationTestNamespace,
+			Name:      saName,
+		},
+		Secrets: []corev1.ObjectReference{{Name: saName + "-token"}},
+	}
+	token := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         rotationTestNamespace,
+			Name:              saName + "-token",
+			CreationTimestamp: metav1.NewTime(tokenCreationTime),
+		},
+		Data: map[string][]byte{mcconstants.TokenKey: []byte("token1")},
+	}
+	return vmc, sa, token
+}
+
+func getRotationTestVMC(t *testing.T, r *VerrazzanoManagedClusterReconciler) *clustersv1alpha1.VerrazzanoManagedCluster {
+	vmc := &clustersv1alpha1.VerrazzanoManagedCluster{}
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Namespace: rotationTestNamespace, Name: rotationTestCluster}, vmc))
+	return vmc
+}
+
+func secretExists(t *testing.T, r *VerrazzanoManagedClusterReconciler, name string) bool {
+	err := r.Get(context.TODO(), types.NamespacedName{Namespace: rotationTestNamespace, Name: name}, &corev1.Secret{})
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	assert.NoError(t, err)
+	return true
+}
+
+// TestRotateAgentCredentialsRequested tests a requested rotation of the agent credentials
+// GIVEN a VMC with the rotate credentials annotation
+// WHEN the agent credentials are rotated and the agent reports the new token
+// THEN a new token is created, and the old token is revoked once the agent has connected with the new token
+func TestRotateAgentCredentialsRequested(t *testing.T) {
+	asserts := assert.New(t)
+	vmc, sa, token := newRotationTestObjects(time.Now())
+	vmc.Annotations = map[string]string{clustersv1alpha1.RotateCredentialsAnnotation: "1"}
+	r := newRotationTestReconciler(vmc, sa, token)
+
+	// The rotation starts
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	rotation := vmc.Status.CredentialRotation
+	asserts.NotNil(rotation)
+	asserts.Equal(token.Name, rotation.CurrentToken)
+	asserts.Equal(rotationReasonRequested, rotation.PendingReason)
+	asserts.Equal("1", rotation.LastRequest)
+	pendingToken := rotation.PendingToken
+	asserts.True(secretExists(t, r, pendingToken))
+
+	// The new token is not used until it has been populated
+	secret, err := r.getAgentTokenSecret(vmc, sa)
+	asserts.NoError(err)
+	asserts.Equal(token.Name, secret.Name)
+	newToken := &corev1.Secret{}
+	asserts.NoError(r.Get(context.TODO(), types.NamespacedName{Namespace: rotationTestNamespace, Name: pendingToken}, newToken))
+	newToken.Data = map[string][]byte{mcconstants.TokenKey: []byte("token2")}
+	asserts.NoError(r.Update(context.TODO(), newToken))
+	secret, err = r.getAgentTokenSecret(vmc, sa)
+	asserts.NoError(err)
+	asserts.Equal(pendingToken, secret.Name)
+
+	// The rotation waits for the agent
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	asserts.Equal(pendingToken, vmc.Status.CredentialRotation.PendingToken)
+	asserts.True(secretExists(t, r, token.Name))
+
+	// The agent connects with the new token
+	vmc.Status.AgentCredential = pendingToken
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	rotation = vmc.Status.CredentialRotation
+	asserts.Equal(pendingToken, rotation.CurrentToken)
+	asserts.Empty(rotation.PendingToken)
+	asserts.Len(rotation.History, 1)
+	asserts.Equal(clustersv1alpha1.CredentialRotationSucceeded, rotation.History[0].Result)
+	asserts.False(secretExists(t, r, token.Name))
+
+	// The same request does not rotate the credentials again
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	asserts.Empty(vmc.Status.CredentialRotation.PendingToken)
+}
+
+// TestRotateAgentCredentialsTimeout tests a rotation of the agent credentials that the agent does not confirm
+// GIVEN a VMC with a rotation in progress that started more than the rotation timeout ago
+// WHEN the agent credentials are rotated
+// THEN the new token is revoked, the old token is kept and the rotation is recorded as failed
+func TestRotateAgentCredentialsTimeout(t *testing.T) {
+	asserts := assert.New(t)
+	vmc, sa, token := newRotationTestObjects(time.Now())
+	start := metav1.NewTime(time.Now().Add(-2 * credentialRotationTimeout))
+	newToken := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rotationTestNamespace,
+			Name:      sa.Name + "-token-1",
+		},
+	}
+	vmc.Status.CredentialRotation = &clustersv1alpha1.CredentialRotationStatus{
+		CurrentToken:      token.Name,
+		PendingToken:      newToken.Name,
+		PendingReason:     rotationReasonRequested,
+		RotationStartTime: &start,
+	}
+	r := newRotationTestReconciler(vmc, sa, token, newToken)
+
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	rotation := vmc.Status.CredentialRotation
+	asserts.Equal(token.Name, rotation.CurrentToken)
+	asserts.Empty(rotation.PendingToken)
+	asserts.Len(rotation.History, 1)
+	asserts.Equal(clustersv1alpha1.CredentialRotationFailed, rotation.History[0].Result)
+	asserts.True(secretExists(t, r, token.Name))
+	asserts.False(secretExists(t, r, newToken.Name))
+}
+
+// TestRotateAgentCredentialsMaxTokenAge tests the rotation policy of the agent credentials
+// GIVEN a VMC with a maximum token age
+// WHEN the agent credentials are rotated
+// THEN a rotation is only started when the token is older than the maximum token age
+func TestRotateAgentCredentialsMaxTokenAge(t *testing.T) {
+	asserts := assert.New(t)
+
+	// The token is newer than the maximum token age
+	vmc, sa, token := newRotationTestObjects(time.Now().Add(-time.Hour))
+	vmc.Spec.CredentialRotation = &clustersv1alpha1.CredentialRotationPolicy{MaxTokenAge: &metav1.Duration{Duration: 24 * time.Hour}}
+	r := newRotationTestReconciler(vmc, sa, token)
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	asserts.Equal(token.Name, vmc.Status.CredentialRotation.CurrentToken)
+	asserts.NotNil(vmc.Status.CredentialRotation.TokenCreationTime)
+	asserts.Empty(vmc.Status.CredentialRotation.PendingToken)
+
+	// The token is older than the maximum token age
+	vmc, sa, token = newRotationTestObjects(time.Now().Add(-48 * time.Hour))
+	vmc.Spec.CredentialRotation = &clustersv1alpha1.CredentialRotationPolicy{MaxTokenAge: &metav1.Duration{Duration: 24 * time.Hour}}
+	r = newRotationTestReconciler(vmc, sa, token)
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	vmc = getRotationTestVMC(t, r)
+	asserts.Equal(rotationReasonMaxTokenAge, vmc.Status.CredentialRotation.PendingReason)
+	asserts.NotEmpty(vmc.Status.CredentialRotation.PendingToken)
+}
+
+// TestRotateAgentCredentialsNotConfigured tests a VMC without credential rotation
+// GIVEN a VMC without a rotation request or policy
+// WHEN the agent credentials are rotated
+// THEN the VMC status is not changed
+func TestRotateAgentCredentialsNotConfigured(t *testing.T) {
+	asserts := assert.New(t)
+	vmc, sa, token := newRotationTestObjects(time.Now())
+	r := newRotationTestReconciler(vmc, sa, token)
+	asserts.NoError(r.rotateAgentCredentials(context.TODO(), vmc))
+	asserts.Nil(getRotationTestVMC(t, r).Status.CredentialRotation)
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package vmc
@@ -32,7 +32,7 @@ func setConfigFunc(f func() (*rest.Config, error)) {
 // with restricted access as defined in the verrazzano-managed-cluster role.
 // The code does the following:
 //  1. get the service account for the managed cluster
-//  2. get the name of the service account token from the service account secret name field
+//  2. get the service account token, which is the new token while the agent credentials are being rotated
 //  3. get the in-memory client configuration used to access the admin cluster
 //  4. build a kubeconfig struct using data from the client config and the service account token
 //  5. save the kubeconfig as a secret
@@ -53,34 +53,19 @@ func (r *VerrazzanoManagedClusterReconciler) syncAgentSecret(vmc *clusterapi.Ver
 	if err := r.Get(context.TODO(), saNsn, &sa); err != nil {
 		return fmt.Errorf("Failed to fetch the service account for VMC %s/%s, %v", managedNamespace, saName, err)
 	}
-	var tokenName string
-	if len(sa.Secrets) == 0 {
-		r.log.Oncef("Service account %s/%s is missing a secret name. Using the service account token secret created"+
-			" by the VerrazzanoManagedCluster controller", managedNamespace, saName)
-		tokenName = sa.Name + "-token"
-	} else {
-		// Get the service account token from the secret
-		tokenName = sa.Secrets[0].Name
-	}
-
-	var serviceAccountSecret corev1.Secret
-	secretNsn := types.NamespacedName{
-		Namespace: managedNamespace,
-		Name:      tokenName,
-	}
-	if err := r.Get(context.TODO(), secretNsn, &serviceAccountSecret); err != nil {
-		return fmt.Errorf("Failed to fetch the service account secret %s/%s, %v", managedNamespace, tokenName, err)
+	serviceAccountSecret, err := r.getAgentTokenSecret(vmc, &sa)
+	if err != nil {
+		return err
 	}
 
 	// Build the kubeconfig
-	var err error
 	var kc *vzk8s.KubeConfig
 
 	// Try to use Rancher URL in the kubeconfig - this will fail if Rancher is not enabled
-	kc, err = r.buildKubeConfigUsingRancherURL(serviceAccountSecret)
+	kc, err = r.buildKubeConfigUsingRancherURL(*serviceAccountSecret)
 	if err != nil {
 		r.log.Oncef("Failed to build admin kubeconfig using Rancher URL: %v", err)
-		kc, err = r.buildKubeConfigUsingAdminConfigMap(serviceAccountSecret)
+		kc, err = r.buildKubeConfigUsingAdminConfigMap(*serviceAccountSecret)
 	}
 	if err != nil {
 		return fmt.Errorf("Failed to create kubeconfig for cluster %s: %v", vmc.Name, err)
@@ -91,7 +76,7 @@ func (r *VerrazzanoManagedClusterReconciler) syncAgentSecret(vmc *clusterapi.Ver
 	if err != nil {
 		return err
 	}
-	_, err = r.createOrUpdateAgentSecret(vmc, string(kcBytes), secretName, managedNamespace)
+	_, err = r.createOrUpdateAgentSecret(vmc, string(kcBytes), serviceAccountSecret.Name, secretName, managedNamespace)
 	if err != nil {
 		return err
 	}
@@ -167,13 +152,13 @@ func buildAdminKubeConfig(serverURL string, caCert string, userToken string) (*v
 }
 
 // Create or update the kubeconfig secret
-func (r *VerrazzanoManagedClusterReconciler) createOrUpdateAgentSecret(vmc *clusterapi.VerrazzanoManagedCluster, kubeconfig string, name string, namespace string) (controllerutil.OperationResult, error) {
+func (r *VerrazzanoManagedClusterReconciler) createOrUpdateAgentSecret(vmc *clusterapi.VerrazzanoManagedCluster, kubeconfig string, tokenName string, name string, namespace string) (controllerutil.OperationResult, error) {
 	var secret corev1.Secret
 	secret.Namespace = namespace
 	secret.Name = name
 
 	return controllerutil.CreateOrUpdate(context.TODO(), r.Client, &secret, func() error {
-		r.mutateAgentSecret(&secret, kubeconfig, vmc.Name)
+		r.mutateAgentSecret(&secret, kubeconfig, tokenName, vmc.Name)
 		// This SetControllerReference call will trigger garbage collection i.e. the secret
 		// will automatically get deleted when the VerrazzanoManagedCluster is deleted
 		return controllerutil.SetControllerReference(vmc, &secret, r.Scheme)
@@ -181,10 +166,11 @@ func (r *VerrazzanoManagedClusterReconciler) createOrUpdateAgentSecret(vmc *clus
 }
 
 // Mutate the secret, setting the kubeconfig data
-func (r *VerrazzanoManagedClusterReconciler) mutateAgentSecret(secret *corev1.Secret, kubeconfig string, manageClusterName string) error {
+func (r *VerrazzanoManagedClusterReconciler) mutateAgentSecret(secret *corev1.Secret, kubeconfig string, tokenName string, manageClusterName string) error {
 	secret.Type = corev1.SecretTypeOpaque
 	secret.Data = map[string][]byte{
 		mcconstants.KubeconfigKey:         []byte(kubeconfig),
+		mcconstants.AgentCredentialKey:    []byte(tokenName),
 		mcconstants.ManagedClusterNameKey: []byte(manageClusterName),
 	}
 	return nil
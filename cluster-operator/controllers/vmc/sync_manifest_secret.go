@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package vmc
@@ -127,7 +127,7 @@ func (r *VerrazzanoManagedClusterReconciler) syncManifestSecret(ctx context.Cont
 	if err != nil {
 		r.log.Errorf("Failed to get the existing VMC %s from the cluster: %v", vmc.Name, err)
 	}
-	existingVMC.Spec.ManagedClusterManifestSecret = GetManifestSecretName(vmc.Name)
+	existingVMC.Spec.ManagedClusterManifestSecret = constants2.GetManifestSecretName(vmc.Name)
 
 	err = r.Update(ctx, existingVMC)
 	if err != nil {
@@ -308,7 +308,7 @@ func (r *VerrazzanoManagedClusterReconciler) updateRancherStatus(ctx context.Con
 func (r *VerrazzanoManagedClusterReconciler) createOrUpdateManifestSecret(vmc *clusterapi.VerrazzanoManagedCluster, yamlData string) (controllerutil.OperationResult, error) {
 	var secret corev1.Secret
 	secret.Namespace = vmc.Namespace
-	secret.Name = GetManifestSecretName(vmc.Name)
+	secret.Name = constants2.GetManifestSecretName(vmc.Name)
 
 	return controllerutil.CreateOrUpdate(context.TODO(), r.Client, &secret, func() error {
 		r.mutateManifestSecret(&secret, yamlData)
@@ -399,12 +399,6 @@ func (r *VerrazzanoManagedClusterReconciler) getWorkloadClusterCACert(workloadCl
 	return string(caCrt), nil
 }
 
-// GetManifestSecretName returns the manifest secret name
-func GetManifestSecretName(vmcName string) string {
-	const manifestSecretSuffix = "-manifest"
-	return generateManagedResourceName(vmcName) + manifestSecretSuffix
-}
-
 // getCASecretName returns the CA secret name
 func getCASecretName(vmcName string) string {
 	return "ca-secret-" + vmcName
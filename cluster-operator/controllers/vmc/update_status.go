@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package vmc
@@ -75,6 +75,10 @@ func (r *VerrazzanoManagedClusterReconciler) updateStatus(ctx context.Context, v
 		existingVMC.Status.Kubernetes.Version = vmc.Status.Kubernetes.Version
 	}
 
+	// The health summary is reported by the agent, so use the health of the existing VMC
+	updateHealth(existingVMC)
+	updateHealthMetrics(existingVMC)
+
 	r.log.Debugf("Updating Status of VMC %s: %v", vmc.Name, vmc.Status.Conditions)
 	return r.Status().Update(ctx, existingVMC)
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package vmc
@@ -16,7 +16,6 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
-	"github.com/verrazzano/verrazzano/pkg/k8sutil"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/keycloak"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 
@@ -177,6 +176,13 @@ func (r *VerrazzanoManagedClusterReconciler) doReconcile(ctx context.Context, lo
 		return newRequeueWithDelay(), err
 	}
 
+	log.Debugf("Rotating the agent credentials for VMC %s", vmc.Name)
+	err = r.rotateAgentCredentials(ctx, vmc)
+	if err != nil {
+		r.handleError(ctx, vmc, "Failed to rotate the agent credentials", err, log)
+		return newRequeueWithDelay(), err
+	}
+
 	log.Debugf("Syncing the Agent secret for VMC %s", vmc.Name)
 	err = r.syncAgentSecret(vmc)
 	if err != nil {
@@ -286,8 +292,10 @@ func (r *VerrazzanoManagedClusterReconciler) syncServiceAccount(vmc *clustersv1a
 		return err
 	}
 
-	if len(serviceAccount.Secrets) == 0 {
-		_, err = r.createServiceAccountTokenSecret(context.TODO(), serviceAccount)
+	// Once the agent credentials have been rotated, the original token secret is revoked and must not be recreated
+	rotated := vmc.Status.CredentialRotation != nil && len(vmc.Status.CredentialRotation.CurrentToken) > 0
+	if len(serviceAccount.Secrets) == 0 && !rotated {
+		_, err = r.createServiceAccountTokenSecret(context.TODO(), serviceAccount, serviceAccount.Name+"-token")
 		if err != nil {
 			return err
 		}
@@ -326,9 +334,9 @@ func (r *VerrazzanoManagedClusterReconciler) mutateServiceAccount(vmc *clustersv
 	serviceAccount.Name = generateManagedResourceName(vmc.Name)
 }
 
-func (r *VerrazzanoManagedClusterReconciler) createServiceAccountTokenSecret(ctx context.Context, serviceAccount *corev1.ServiceAccount) (controllerutil.OperationResult, error) {
+func (r *VerrazzanoManagedClusterReconciler) createServiceAccountTokenSecret(ctx context.Context, serviceAccount *corev1.ServiceAccount, name string) (controllerutil.OperationResult, error) {
 	var secret corev1.Secret
-	secret.Name = serviceAccount.Name + "-token"
+	secret.Name = name
 	secret.Namespace = serviceAccount.Namespace
 	secret.Type = corev1.SecretTypeServiceAccountToken
 	secret.Annotations = map[string]string{
@@ -520,6 +528,7 @@ func (r *VerrazzanoManagedClusterReconciler) SetupWithManager(mgr ctrl.Manager)
 
 // reconcileManagedClusterDelete performs all necessary cleanup during cluster deletion
 func (r *VerrazzanoManagedClusterReconciler) reconcileManagedClusterDelete(ctx context.Context, vmc *clustersv1alpha1.VerrazzanoManagedCluster) error {
+	deleteHealthMetrics(vmc)
 	if err := r.deleteClusterPrometheusConfiguration(ctx, vmc); err != nil {
 		return err
 	}
@@ -649,26 +658,21 @@ var createClient = func(r *VerrazzanoManagedClusterReconciler, vmc *clustersv1al
 		return nil
 	}
 
-	// login to keycloak
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return err
-	}
-
 	// create a context that can be leveraged by keycloak method
 	ctx, err := spi.NewMinimalContext(r.Client, r.log)
 	if err != nil {
 		return err
 	}
 
-	err = keycloak.LoginKeycloak(ctx, cfg, cli)
+	// login to keycloak
+	kc, err := keycloak.LoginKeycloak(ctx)
 	if err != nil {
 		return err
 	}
 
 	dnsSubdomain := promHost[len(prometheusHostPrefix)+1:]
 	clientID := fmt.Sprintf("verrazzano-%s", vmc.Name)
-	err = keycloak.CreateOrUpdateClient(ctx, cfg, cli, clientID, keycloak.ManagedClusterClientTmpl, keycloak.ManagedClusterClientUrisTemplate, false, &dnsSubdomain)
+	err = keycloak.CreateOrUpdateClient(ctx, kc, clientID, keycloak.ManagedClusterClientTmpl, keycloak.ManagedClusterClientUrisTemplate, false, &dnsSubdomain)
 	if err != nil {
 		return err
 	}
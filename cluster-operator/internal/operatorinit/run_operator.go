@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operatorinit
@@ -10,6 +10,7 @@ import (
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/capi"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/docker"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/ociocne"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/oke"
 	"github.com/verrazzano/verrazzano/cluster-operator/controllers/rancher"
@@ -161,6 +162,15 @@ func StartClusterOperator(log *zap.SugaredLogger, props Properties) error {
 			log.Error(err, "Failed to setup controller OKEQuickCreate")
 			os.Exit(1)
 		}
+		if err = (&docker.ClusterReconciler{
+			Base: &controller.Base{
+				Client: mgr.GetClient(),
+			},
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			log.Error(err, "Failed to setup controller DockerQuickCreate")
+			os.Exit(1)
+		}
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
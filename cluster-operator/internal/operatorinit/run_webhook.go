@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operatorinit
@@ -88,6 +88,12 @@ func StartWebhookServer(log *zap.SugaredLogger, props Properties) error {
 		log.Errorf("Failed to setup OKEQuickCreate webhook with manager: %v", err)
 		os.Exit(1)
 	}
+	// Set up DockerQuickCreate Webhook Listener
+	log.Debug("Setting up DockerQuickCreate webhook with manager")
+	if err := (&clustersv1alpha1.DockerQuickCreate{}).SetupWebhookWithManager(mgr); err != nil {
+		log.Errorf("Failed to setup DockerQuickCreate webhook with manager: %v", err)
+		os.Exit(1)
+	}
 
 	// +kubebuilder:scaffold:builder
 
@@ -1,10 +1,13 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package certs
 
 import (
+	"bytes"
 	ctx "context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"github.com/verrazzano/verrazzano/application-operator/constants"
 	globalconst "github.com/verrazzano/verrazzano/pkg/constants"
@@ -66,3 +69,36 @@ func getBundleDataFromSecret(cli client.Client, ctx ctx.Context, name types.Name
 	}
 	return nil, false, nil
 }
+
+// AppendPreviousCA appends the previous Verrazzano self-signed CA to a CA bundle, so that the certificates issued by
+// the previous CA are trusted while the CA is rotated. The bundle is returned as is when no rotation is in progress.
+func AppendPreviousCA(cli client.Client, ctx ctx.Context, namespace string, bundle []byte) ([]byte, error) {
+	name := types.NamespacedName{Namespace: namespace, Name: globalconst.PreviousCASecretName}
+	previousCA, found, err := getBundleDataFromSecret(cli, ctx, name, globalconst.CACertKey)
+	if err != nil {
+		return nil, err
+	}
+	previousCA = bytes.TrimSpace(previousCA)
+	if !found || len(previousCA) == 0 || bytes.Contains(bundle, previousCA) {
+		return bundle, nil
+	}
+	var appended bytes.Buffer
+	if trimmed := bytes.TrimSpace(bundle); len(trimmed) > 0 {
+		appended.Write(trimmed)
+		appended.WriteByte('\n')
+	}
+	appended.Write(previousCA)
+	appended.WriteByte('\n')
+	return appended.Bytes(), nil
+}
+
+// GetCABundleFingerprint returns the hex encoded SHA-256 fingerprint of a CA bundle, ignoring the surrounding
+// whitespace, or an empty string if the bundle is empty
+func GetCABundleFingerprint(bundle []byte) string {
+	trimmed := bytes.TrimSpace(bundle)
+	if len(trimmed) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(trimmed)
+	return hex.EncodeToString(sum[:])
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package certs
@@ -102,3 +102,46 @@ func TestGetLocalClusterCABundleData(t *testing.T) {
 		})
 	}
 }
+
+// TestAppendPreviousCA tests that the previous CA is appended to a CA bundle while the CA is rotated
+// GIVEN a CA bundle
+// WHEN AppendPreviousCA is called
+// THEN the previous CA is appended, unless there is no previous CA or the bundle already contains it
+func TestAppendPreviousCA(t *testing.T) {
+	previousCA := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.PreviousCASecretName,
+			Namespace: constants.CertManagerNamespace,
+		},
+		Data: map[string][]byte{
+			constants.CACertKey: []byte("previous-ca\n"),
+		},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	bundle, err := AppendPreviousCA(cli, context.TODO(), constants.CertManagerNamespace, []byte("new-ca\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-ca\n", string(bundle))
+
+	cli = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(previousCA).Build()
+	bundle, err = AppendPreviousCA(cli, context.TODO(), constants.CertManagerNamespace, []byte("new-ca\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-ca\nprevious-ca\n", string(bundle))
+
+	bundle, err = AppendPreviousCA(cli, context.TODO(), constants.CertManagerNamespace, bundle)
+	assert.NoError(t, err)
+	assert.Equal(t, "new-ca\nprevious-ca\n", string(bundle))
+}
+
+// TestGetCABundleFingerprint tests the fingerprint of CA bundles
+// GIVEN CA bundles that differ only by the surrounding whitespace, a different bundle and an empty bundle
+// WHEN GetCABundleFingerprint is called
+// THEN the fingerprint ignores the whitespace, differs for a different bundle, and is empty for an empty bundle
+func TestGetCABundleFingerprint(t *testing.T) {
+	fingerprint := GetCABundleFingerprint([]byte("ca1"))
+	assert.Len(t, fingerprint, 64)
+	assert.Equal(t, fingerprint, GetCABundleFingerprint([]byte("\nca1\n")))
+	assert.NotEqual(t, fingerprint, GetCABundleFingerprint([]byte("ca1\nca2")))
+	assert.Empty(t, GetCABundleFingerprint([]byte(" \n")))
+	assert.Empty(t, GetCABundleFingerprint(nil))
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package constants
@@ -16,6 +16,9 @@ const RestartVersionAnnotation = "verrazzano.io/restart-version"
 // VerrazzanoRestartAnnotation is the annotation used to restart platform workloads
 const VerrazzanoRestartAnnotation = "verrazzano.io/restartedAt"
 
+// RotateCAAnnotation - the annotation used by user to request the rotation of the Verrazzano self-signed CA
+const RotateCAAnnotation = "verrazzano.io/rotate-ca"
+
 // LifecycleActionAnnotation - the annotation perform lifecycle actions on a workload
 const LifecycleActionAnnotation = "verrazzano.io/lifecycle-action"
 
@@ -144,6 +147,11 @@ const KubeSystem = "kube-system"
 // #nosec
 const DefaultVerrazzanoCASecretName = "verrazzano-ca-certificate-secret"
 
+// PreviousCASecretName is the name of the secret that holds the previous Verrazzano self-signed CA, which is trusted
+// while the CA is rotated
+// #nosec
+const PreviousCASecretName = "verrazzano-previous-ca-certificate-secret"
+
 // VmiPromConfigName - The name of the prometheus config map
 const VmiPromConfigName string = "vmi-system-prometheus-config"
 
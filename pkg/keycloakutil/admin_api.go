@@ -0,0 +1,650 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloakutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Realm is a Keycloak realm. Fields left nil are not changed by UpdateRealm.
+type Realm struct {
+	ID                  string  `json:"id,omitempty"`
+	Realm               string  `json:"realm"`
+	Enabled             *bool   `json:"enabled,omitempty"`
+	PasswordPolicy      *string `json:"passwordPolicy,omitempty"`
+	LoginTheme          *string `json:"loginTheme,omitempty"`
+	AccessTokenLifespan *int32  `json:"accessTokenLifespan,omitempty"`
+}
+
+// Group is a Keycloak group and its child groups
+type Group struct {
+	ID        string  `json:"id,omitempty"`
+	Name      string  `json:"name"`
+	Path      string  `json:"path,omitempty"`
+	SubGroups []Group `json:"subGroups,omitempty"`
+}
+
+// Role is a Keycloak realm or client role
+type Role struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Composite   bool   `json:"composite"`
+	ClientRole  bool   `json:"clientRole"`
+	ContainerID string `json:"containerId,omitempty"`
+}
+
+// User is a Keycloak user. Groups holds group paths and is only used when creating a user.
+type User struct {
+	ID               string   `json:"id,omitempty"`
+	CreatedTimestamp int64    `json:"createdTimestamp,omitempty"`
+	Username         string   `json:"username"`
+	Enabled          bool     `json:"enabled"`
+	FirstName        string   `json:"firstName,omitempty"`
+	LastName         string   `json:"lastName,omitempty"`
+	Email            string   `json:"email,omitempty"`
+	EmailVerified    bool     `json:"emailVerified"`
+	Groups           []string `json:"groups,omitempty"`
+}
+
+// Client is the identification of a Keycloak client, ID is the internal ID and ClientID the name used by OIDC
+type Client struct {
+	ID       string `json:"id,omitempty"`
+	ClientID string `json:"clientId"`
+}
+
+// ClientScope is a Keycloak client scope
+type ClientScope struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// Component is a Keycloak component, like a user storage provider used for LDAP federation
+type Component struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name"`
+	ProviderID   string              `json:"providerId"`
+	ProviderType string              `json:"providerType"`
+	ParentID     string              `json:"parentId,omitempty"`
+	Config       map[string][]string `json:"config,omitempty"`
+}
+
+// ClientSecret is the secret of a confidential Keycloak client
+type ClientSecret struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// credential is the password set for a user
+type credential struct {
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	Temporary bool   `json:"temporary"`
+}
+
+// realmPath returns the admin API path of a realm, extended with the given path
+func realmPath(realm string, format string, args ...interface{}) string {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(fmt.Sprint(arg))
+	}
+	return "/" + url.PathEscape(realm) + fmt.Sprintf(format, escaped...)
+}
+
+// GetRealm returns the realm with the given name
+func (c *AdminClient) GetRealm(realm string) (*Realm, error) {
+	result := &Realm{}
+	if _, err := c.do(http.MethodGet, realmPath(realm, ""), nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateRealmIfNotExists creates the realm unless it already exists, returns true if the realm was created
+func (c *AdminClient) CreateRealmIfNotExists(realm Realm) (bool, error) {
+	if _, err := c.GetRealm(realm.Realm); err == nil {
+		return false, nil
+	} else if !IsNotFound(err) {
+		return false, err
+	}
+	if _, err := c.do(http.MethodPost, "", realm, nil); err != nil && !IsConflict(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateRealm updates the fields of the realm that are set
+func (c *AdminClient) UpdateRealm(realm Realm) error {
+	_, err := c.do(http.MethodPut, realmPath(realm.Realm, ""), realm, nil)
+	return err
+}
+
+// GetGroups returns the top level groups of the realm, with their child groups
+func (c *AdminClient) GetGroups(realm string) ([]Group, error) {
+	var groups []Group
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/groups?briefRepresentation=false"), nil, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// FindGroup returns the group with the given name that is a child of the parent group, or a top level group if the
+// parent ID is empty. Nil is returned if there is no such group.
+func FindGroup(groups []Group, name string, parentID string) *Group {
+	for i := range groups {
+		if parentID == "" {
+			if groups[i].Name == name {
+				return &groups[i]
+			}
+			continue
+		}
+		if groups[i].ID == parentID {
+			return FindGroup(groups[i].SubGroups, name, "")
+		}
+		if group := FindGroup(groups[i].SubGroups, name, parentID); group != nil {
+			return group
+		}
+	}
+	return nil
+}
+
+// FindGroupByPath returns the group with the given path, like /parent/child. Nil is returned if there is no such group.
+func FindGroupByPath(groups []Group, path string) *Group {
+	var found *Group
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		found = FindGroup(groups, name, "")
+		if found == nil {
+			return nil
+		}
+		groups = found.SubGroups
+	}
+	return found
+}
+
+// CreateGroupIfNotExists creates the group as child of the parent group, or as top level group if the parent ID is
+// empty, unless it already exists. The ID of the group is returned.
+func (c *AdminClient) CreateGroupIfNotExists(realm string, name string, parentID string) (string, error) {
+	groups, err := c.GetGroups(realm)
+	if err != nil {
+		return "", err
+	}
+	if group := FindGroup(groups, name, parentID); group != nil {
+		return group.ID, nil
+	}
+
+	path := realmPath(realm, "/groups")
+	if parentID != "" {
+		path = realmPath(realm, "/groups/%s/children", parentID)
+	}
+	resp, err := c.do(http.MethodPost, path, Group{Name: name}, nil)
+	if err != nil {
+		return "", err
+	}
+	return idFromLocation(resp)
+}
+
+// DeleteGroup deletes the group with the given ID and its child groups, a group that does not exist is ignored
+func (c *AdminClient) DeleteGroup(realm string, groupID string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/groups/%s", groupID), nil, nil))
+}
+
+// GetRealmRole returns the realm role with the given name
+func (c *AdminClient) GetRealmRole(realm string, name string) (*Role, error) {
+	role := &Role{}
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/roles/%s", name), nil, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateRealmRoleIfNotExists creates the realm role unless it already exists, returns true if the role was created
+func (c *AdminClient) CreateRealmRoleIfNotExists(realm string, name string) (bool, error) {
+	if _, err := c.GetRealmRole(realm, name); err == nil {
+		return false, nil
+	} else if !IsNotFound(err) {
+		return false, err
+	}
+	if _, err := c.do(http.MethodPost, realmPath(realm, "/roles"), Role{Name: name}, nil); err != nil && !IsConflict(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteRealmRole deletes the realm role with the given name, a role that does not exist is ignored
+func (c *AdminClient) DeleteRealmRole(realm string, name string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/roles/%s", name), nil, nil))
+}
+
+// getRealmRoles returns the realm roles with the given names
+func (c *AdminClient) getRealmRoles(realm string, names []string) ([]Role, error) {
+	roles := make([]Role, 0, len(names))
+	for _, name := range names {
+		role, err := c.GetRealmRole(realm, name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
+
+// AddRealmRolesToGroup grants the realm roles to the group, roles already granted are left unchanged
+func (c *AdminClient) AddRealmRolesToGroup(realm string, groupID string, roleNames ...string) error {
+	roles, err := c.getRealmRoles(realm, roleNames)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(http.MethodPost, realmPath(realm, "/groups/%s/role-mappings/realm", groupID), roles, nil)
+	return err
+}
+
+// RemoveRealmRolesFromGroup revokes the realm roles from the group, roles that do not exist are ignored
+func (c *AdminClient) RemoveRealmRolesFromGroup(realm string, groupID string, roleNames ...string) error {
+	return c.removeRealmRoles(realm, realmPath(realm, "/groups/%s/role-mappings/realm", groupID), roleNames)
+}
+
+// AddRealmRolesToUser grants the realm roles to the user, roles already granted are left unchanged
+func (c *AdminClient) AddRealmRolesToUser(realm string, userID string, roleNames ...string) error {
+	roles, err := c.getRealmRoles(realm, roleNames)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(http.MethodPost, realmPath(realm, "/users/%s/role-mappings/realm", userID), roles, nil)
+	return err
+}
+
+// RemoveRealmRolesFromUser revokes the realm roles from the user, roles that do not exist are ignored
+func (c *AdminClient) RemoveRealmRolesFromUser(realm string, userID string, roleNames ...string) error {
+	return c.removeRealmRoles(realm, realmPath(realm, "/users/%s/role-mappings/realm", userID), roleNames)
+}
+
+// removeRealmRoles deletes the existing realm roles with the given names from the role mappings path
+func (c *AdminClient) removeRealmRoles(realm string, path string, names []string) error {
+	var roles []Role
+	for _, name := range names {
+		role, err := c.GetRealmRole(realm, name)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		roles = append(roles, *role)
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	_, err := c.do(http.MethodDelete, path, roles, nil)
+	return err
+}
+
+// GetClientRole returns the role with the given name of the client with the given internal ID
+func (c *AdminClient) GetClientRole(realm string, id string, name string) (*Role, error) {
+	role := &Role{}
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/clients/%s/roles/%s", id, name), nil, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateClientRoleIfNotExists creates the role of the client with the given internal ID unless it already exists,
+// returns true if the role was created
+func (c *AdminClient) CreateClientRoleIfNotExists(realm string, id string, name string) (bool, error) {
+	if _, err := c.GetClientRole(realm, id, name); err == nil {
+		return false, nil
+	} else if !IsNotFound(err) {
+		return false, err
+	}
+	if _, err := c.do(http.MethodPost, realmPath(realm, "/clients/%s/roles", id), Role{Name: name}, nil); err != nil && !IsConflict(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteClientRole deletes the role of the client with the given internal ID, a role that does not exist is ignored
+func (c *AdminClient) DeleteClientRole(realm string, id string, name string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/clients/%s/roles/%s", id, name), nil, nil))
+}
+
+// getClientRoles returns the internal ID of the client with the given client ID and its roles with the given names
+func (c *AdminClient) getClientRoles(realm string, clientID string, names []string) (string, []Role, error) {
+	kcClient, err := c.GetClient(realm, clientID)
+	if err != nil {
+		return "", nil, err
+	}
+	if kcClient == nil {
+		return "", nil, fmt.Errorf("Failed, Keycloak client %s does not exist in realm %s", clientID, realm)
+	}
+	roles := make([]Role, 0, len(names))
+	for _, name := range names {
+		role, err := c.GetClientRole(realm, kcClient.ID, name)
+		if err != nil {
+			return "", nil, err
+		}
+		roles = append(roles, *role)
+	}
+	return kcClient.ID, roles, nil
+}
+
+// AddClientRolesToUser grants the roles of the client with the given client ID to the user
+func (c *AdminClient) AddClientRolesToUser(realm string, userID string, clientID string, roleNames ...string) error {
+	id, roles, err := c.getClientRoles(realm, clientID, roleNames)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(http.MethodPost, realmPath(realm, "/users/%s/role-mappings/clients/%s", userID, id), roles, nil)
+	return err
+}
+
+// AddClientRolesToGroup grants the roles of the client with the given client ID to the group
+func (c *AdminClient) AddClientRolesToGroup(realm string, groupID string, clientID string, roleNames ...string) error {
+	id, roles, err := c.getClientRoles(realm, clientID, roleNames)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(http.MethodPost, realmPath(realm, "/groups/%s/role-mappings/clients/%s", groupID, id), roles, nil)
+	return err
+}
+
+// RemoveClientRolesFromUser revokes the roles of the client with the given client ID from the user, roles that do
+// not exist are ignored
+func (c *AdminClient) RemoveClientRolesFromUser(realm string, userID string, clientID string, roleNames ...string) error {
+	return c.removeClientRoles(realm, clientID, roleNames, func(id string) string {
+		return realmPath(realm, "/users/%s/role-mappings/clients/%s", userID, id)
+	})
+}
+
+// RemoveClientRolesFromGroup revokes the roles of the client with the given client ID from the group, roles that do
+// not exist are ignored
+func (c *AdminClient) RemoveClientRolesFromGroup(realm string, groupID string, clientID string, roleNames ...string) error {
+	return c.removeClientRoles(realm, clientID, roleNames, func(id string) string {
+		return realmPath(realm, "/groups/%s/role-mappings/clients/%s", groupID, id)
+	})
+}
+
+// removeClientRoles deletes the existing roles of the client from the role mappings path of the client internal ID
+func (c *AdminClient) removeClientRoles(realm string, clientID string, names []string, path func(id string) string) error {
+	kcClient, err := c.GetClient(realm, clientID)
+	if err != nil || kcClient == nil {
+		return err
+	}
+	var roles []Role
+	for _, name := range names {
+		role, err := c.GetClientRole(realm, kcClient.ID, name)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		roles = append(roles, *role)
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	_, err = c.do(http.MethodDelete, path(kcClient.ID), roles, nil)
+	return err
+}
+
+// GetUser returns the user with the given user name, or nil if there is no such user
+func (c *AdminClient) GetUser(realm string, username string) (*User, error) {
+	var users []User
+	path := realmPath(realm, "/users?exact=true&username=") + url.QueryEscape(username)
+	if _, err := c.do(http.MethodGet, path, nil, &users); err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if users[i].Username == username {
+			return &users[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateUser creates the user and returns its ID
+func (c *AdminClient) CreateUser(realm string, user User) (string, error) {
+	resp, err := c.do(http.MethodPost, realmPath(realm, "/users"), user, nil)
+	if err != nil {
+		return "", err
+	}
+	return idFromLocation(resp)
+}
+
+// UpdateUser replaces the fields of the user with the ID of the given user, except the groups
+func (c *AdminClient) UpdateUser(realm string, user User) error {
+	user.Groups = nil
+	_, err := c.do(http.MethodPut, realmPath(realm, "/users/%s", user.ID), user, nil)
+	return err
+}
+
+// DeleteUser deletes the user with the given ID, a user that does not exist is ignored
+func (c *AdminClient) DeleteUser(realm string, userID string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/users/%s", userID), nil, nil))
+}
+
+// AddUserToGroup adds the user to the group with the given ID
+func (c *AdminClient) AddUserToGroup(realm string, userID string, groupID string) error {
+	_, err := c.do(http.MethodPut, realmPath(realm, "/users/%s/groups/%s", userID, groupID), nil, nil)
+	return err
+}
+
+// RemoveUserFromGroup removes the user from the group with the given ID
+func (c *AdminClient) RemoveUserFromGroup(realm string, userID string, groupID string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/users/%s/groups/%s", userID, groupID), nil, nil))
+}
+
+// SetPassword sets the permanent password of the user
+func (c *AdminClient) SetPassword(realm string, userID string, password string) error {
+	return c.ResetPassword(realm, userID, password, false)
+}
+
+// ResetPassword sets the password of the user, the user has to change a temporary password at the next login
+func (c *AdminClient) ResetPassword(realm string, userID string, password string, temporary bool) error {
+	_, err := c.do(http.MethodPut, realmPath(realm, "/users/%s/reset-password", userID), credential{Type: "password", Value: password, Temporary: temporary}, nil)
+	return err
+}
+
+// GetClients returns the clients of the realm
+func (c *AdminClient) GetClients(realm string) ([]Client, error) {
+	var clients []Client
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/clients"), nil, &clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// GetClient returns the client with the given client ID, or nil if there is no such client
+func (c *AdminClient) GetClient(realm string, clientID string) (*Client, error) {
+	var clients []Client
+	path := realmPath(realm, "/clients?clientId=") + url.QueryEscape(clientID)
+	if _, err := c.do(http.MethodGet, path, nil, &clients); err != nil {
+		return nil, err
+	}
+	for i := range clients {
+		if clients[i].ClientID == clientID {
+			return &clients[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateOrUpdateClient creates the client from its JSON representation if there is no client with the given client ID.
+// Otherwise, the JSON representation in update is applied to the existing client, unless it is empty, so that only
+// the given fields are changed. The internal ID of the client is returned, and true if the client was created.
+func (c *AdminClient) CreateOrUpdateClient(realm string, clientID string, representation []byte, update []byte) (string, bool, error) {
+	kcClient, err := c.GetClient(realm, clientID)
+	if err != nil {
+		return "", false, err
+	}
+	if kcClient != nil {
+		if len(update) > 0 {
+			if !json.Valid(update) {
+				return "", false, fmt.Errorf("Failed, the update of Keycloak client %s is not valid JSON", clientID)
+			}
+			if _, err := c.do(http.MethodPut, realmPath(realm, "/clients/%s", kcClient.ID), update, nil); err != nil {
+				return "", false, err
+			}
+		}
+		return kcClient.ID, false, nil
+	}
+
+	if !json.Valid(representation) {
+		return "", false, fmt.Errorf("Failed, the representation of Keycloak client %s is not valid JSON", clientID)
+	}
+	resp, err := c.do(http.MethodPost, realmPath(realm, "/clients"), representation, nil)
+	if err != nil {
+		return "", false, err
+	}
+	id, err := idFromLocation(resp)
+	if err != nil {
+		return "", false, err
+	}
+	return id, true, nil
+}
+
+// DeleteClient deletes the client with the given client ID, a client that does not exist is ignored
+func (c *AdminClient) DeleteClient(realm string, clientID string) error {
+	kcClient, err := c.GetClient(realm, clientID)
+	if err != nil || kcClient == nil {
+		return err
+	}
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/clients/%s", kcClient.ID), nil, nil))
+}
+
+// GetClientSecret returns the secret of the client with the given internal ID
+func (c *AdminClient) GetClientSecret(realm string, id string) (string, error) {
+	secret := ClientSecret{}
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/clients/%s/client-secret", id), nil, &secret); err != nil {
+		return "", err
+	}
+	return secret.Value, nil
+}
+
+// RegenerateClientSecret generates a new secret for the client with the given internal ID and returns it
+func (c *AdminClient) RegenerateClientSecret(realm string, id string) (string, error) {
+	secret := ClientSecret{}
+	if _, err := c.do(http.MethodPost, realmPath(realm, "/clients/%s/client-secret", id), nil, &secret); err != nil {
+		return "", err
+	}
+	return secret.Value, nil
+}
+
+// GetClientScopes returns the client scopes of the realm
+func (c *AdminClient) GetClientScopes(realm string) ([]ClientScope, error) {
+	var scopes []ClientScope
+	if _, err := c.do(http.MethodGet, realmPath(realm, "/client-scopes"), nil, &scopes); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+// CreateClientScopeIfNotExists creates the client scope unless a scope with the same name exists, returns true
+// if the client scope was created
+func (c *AdminClient) CreateClientScopeIfNotExists(realm string, scope ClientScope) (bool, error) {
+	scopes, err := c.GetClientScopes(realm)
+	if err != nil {
+		return false, err
+	}
+	for _, existing := range scopes {
+		if existing.Name == scope.Name {
+			return false, nil
+		}
+	}
+	if _, err := c.do(http.MethodPost, realmPath(realm, "/client-scopes"), scope, nil); err != nil && !IsConflict(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateOrUpdateIdentityProvider creates the identity provider with the given alias from its JSON representation,
+// or replaces the existing identity provider with the representation. Returns true if the identity provider was created.
+func (c *AdminClient) CreateOrUpdateIdentityProvider(realm string, alias string, representation []byte) (bool, error) {
+	if !json.Valid(representation) {
+		return false, fmt.Errorf("Failed, the representation of Keycloak identity provider %s is not valid JSON", alias)
+	}
+	path := realmPath(realm, "/identity-provider/instances/%s", alias)
+	if _, err := c.do(http.MethodGet, path, nil, nil); err == nil {
+		_, err = c.do(http.MethodPut, path, representation, nil)
+		return false, err
+	} else if !IsNotFound(err) {
+		return false, err
+	}
+	if _, err := c.do(http.MethodPost, realmPath(realm, "/identity-provider/instances"), representation, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteIdentityProvider deletes the identity provider with the given alias, an identity provider that does not
+// exist is ignored
+func (c *AdminClient) DeleteIdentityProvider(realm string, alias string) error {
+	return ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/identity-provider/instances/%s", alias), nil, nil))
+}
+
+// GetComponents returns the components of the realm with the given provider type, like
+// org.keycloak.storage.UserStorageProvider for user federation
+func (c *AdminClient) GetComponents(realm string, providerType string) ([]Component, error) {
+	var components []Component
+	path := realmPath(realm, "/components?type=") + url.QueryEscape(providerType)
+	if _, err := c.do(http.MethodGet, path, nil, &components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// CreateOrUpdateComponent creates the component unless there is a component with the same name and provider type,
+// which is replaced with the given component instead. The ID of the component is returned, and true if the component
+// was created.
+func (c *AdminClient) CreateOrUpdateComponent(realm string, component Component) (string, bool, error) {
+	components, err := c.GetComponents(realm, component.ProviderType)
+	if err != nil {
+		return "", false, err
+	}
+	for _, existing := range components {
+		if existing.Name == component.Name {
+			component.ID = existing.ID
+			_, err = c.do(http.MethodPut, realmPath(realm, "/components/%s", existing.ID), component, nil)
+			return existing.ID, false, err
+		}
+	}
+	resp, err := c.do(http.MethodPost, realmPath(realm, "/components"), component, nil)
+	if err != nil {
+		return "", false, err
+	}
+	id, err := idFromLocation(resp)
+	if err != nil {
+		return "", false, err
+	}
+	return id, true, nil
+}
+
+// DeleteComponent deletes the component with the given name and provider type, a component that does not exist is
+// ignored
+func (c *AdminClient) DeleteComponent(realm string, name string, providerType string) error {
+	components, err := c.GetComponents(realm, providerType)
+	if err != nil {
+		return err
+	}
+	for _, existing := range components {
+		if existing.Name == name {
+			if err := ignoreNotFound(c.do(http.MethodDelete, realmPath(realm, "/components/%s", existing.ID), nil, nil)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ignoreNotFound returns the error of a request, unless it is a Keycloak admin API error with the HTTP status 404
+func ignoreNotFound(_ *http.Response, err error) error {
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
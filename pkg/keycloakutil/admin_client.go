@@ -0,0 +1,343 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloakutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	cons "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/nginxutil"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	corev1 "k8s.io/api/core/v1"
+	k8net "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	keycloakIngressName   = "keycloak"
+	keycloakTLSSecretName = "keycloak-tls"  //nolint:gosec //#gosec G101
+	keycloakAdminSecret   = "keycloak-http" //nolint:gosec //#gosec G101
+
+	// AdminUsername is the Keycloak master realm administrator created by the Keycloak Helm chart
+	AdminUsername = "keycloakadmin"
+
+	// MasterRealm is the Keycloak realm that holds the administrator
+	MasterRealm = "master"
+
+	adminCLIClientID = "admin-cli"
+	tokenPath        = "/auth/realms/" + MasterRealm + "/protocol/openid-connect/token" //nolint:gosec
+	adminRealmsPath  = "/auth/admin/realms"
+
+	// tokenExpirySkew is subtracted from the token lifetime so that a token is refreshed before it expires in flight
+	tokenExpirySkew = 10 * time.Second
+)
+
+// DefaultKeycloakIngressHostPrefix is the default internal Ingress host prefix used for Keycloak admin API requests
+const DefaultKeycloakIngressHostPrefix = "ingress-controller-ingress-nginx-controller."
+
+// APIError is returned when the Keycloak admin API responds with an unexpected HTTP status
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+// Error returns the HTTP status and the body of the failed request
+func (e *APIError) Error() string {
+	return fmt.Sprintf("Keycloak admin API request %s %s failed with HTTP status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// IsNotFound returns true if the error is a Keycloak admin API error with the HTTP status 404
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if the error is a Keycloak admin API error with the HTTP status 409
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// AdminClient sends requests to the Keycloak admin REST API on behalf of the master realm administrator
+type AdminClient struct {
+	// BaseURL is the scheme and address used to reach Keycloak
+	BaseURL string
+	// Host is the Keycloak host name, used for the Host header and TLS server name
+	Host string
+
+	httpClient *http.Client
+	username   string
+	password   string
+
+	lock        sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// tokenResponse is the OpenID Connect token endpoint response
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// NewAdminClient returns a client of the Keycloak admin REST API that authenticates with the given credentials
+func NewAdminClient(baseURL string, host string, httpClient *http.Client, username string, password string) *AdminClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &AdminClient{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		Host:       host,
+		httpClient: httpClient,
+		username:   username,
+		password:   password,
+	}
+}
+
+// NewClusterAdminClient returns a client of the Keycloak admin REST API of this cluster. The requests go
+// through the NGINX ingress controller service, the Keycloak administrator password is read from the keycloak-http secret.
+func NewClusterAdminClient(rdr client.Reader, log vzlog.VerrazzanoLogger) (*AdminClient, error) {
+	log.Debug("Getting Keycloak ingress host name")
+	host, err := getKeycloakIngressHostname(rdr)
+	if err != nil {
+		log.Errorf("Failed to get Keycloak ingress host name: %v", err)
+		return nil, err
+	}
+
+	password, err := GetAdminPassword(rdr)
+	if err != nil {
+		return nil, log.ErrorfNewErr("Failed to get the Keycloak admin password: %v", err)
+	}
+
+	rootCAs, err := getRootCAs(rdr)
+	if err != nil {
+		log.Errorf("Failed to get Keycloak TLS root CA: %v", err)
+		return nil, err
+	}
+
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		},
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	httpClient := &http.Client{Transport: tr, Timeout: 30 * time.Second}
+	return NewAdminClient("https://"+KeycloakIngressServiceHost(), host, httpClient, AdminUsername, password), nil
+}
+
+// KeycloakIngressServiceHost returns the internal service host name of the ingress controller serving Keycloak
+func KeycloakIngressServiceHost() string {
+	return DefaultKeycloakIngressHostPrefix + nginxutil.IngressNGINXNamespace()
+}
+
+// GetAdminPassword returns the password of the Keycloak administrator
+func GetAdminPassword(rdr client.Reader) (string, error) {
+	secret := &corev1.Secret{}
+	nsName := types.NamespacedName{Namespace: cons.KeycloakNamespace, Name: keycloakAdminSecret}
+	if err := rdr.Get(context.TODO(), nsName, secret); err != nil {
+		return "", err
+	}
+	password := string(secret.Data["password"])
+	if password == "" {
+		return "", fmt.Errorf("Failed, the password of secret %v is empty", nsName)
+	}
+	return password, nil
+}
+
+// getKeycloakIngressHostname gets the Keycloak ingress host name. This is used to set the host for TLS.
+func getKeycloakIngressHostname(rdr client.Reader) (string, error) {
+	ingress := &k8net.Ingress{}
+	nsName := types.NamespacedName{Namespace: cons.KeycloakNamespace, Name: keycloakIngressName}
+	if err := rdr.Get(context.TODO(), nsName, ingress); err != nil {
+		return "", fmt.Errorf("Failed to get Keycloak ingress %v: %v", nsName, err)
+	}
+	if len(ingress.Spec.Rules) > 0 && ingress.Spec.Rules[0].Host != "" {
+		return ingress.Spec.Rules[0].Host, nil
+	}
+	return "", fmt.Errorf("Failed, Keycloak ingress %v is missing host names", nsName)
+}
+
+// getRootCAs returns the system certificates extended with the CA of the Keycloak certificate and the
+// additional Verrazzano CA, or nil if there is neither, in which case the system certificates are used
+func getRootCAs(rdr client.Reader) (*x509.CertPool, error) {
+	secret := &corev1.Secret{}
+	nsName := types.NamespacedName{Namespace: cons.KeycloakNamespace, Name: keycloakTLSSecretName}
+	if err := rdr.Get(context.TODO(), nsName, secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	caCert := secret.Data["ca.crt"]
+	additionalCA := common.GetAdditionalCA(rdr)
+	if len(caCert) == 0 && len(additionalCA) == 0 {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return common.CertPool(caCert, additionalCA), nil
+	}
+	for _, cert := range [][]byte{caCert, additionalCA} {
+		if len(cert) > 0 {
+			pool.AppendCertsFromPEM(cert)
+		}
+	}
+	return pool, nil
+}
+
+// Login gets an access token of the administrator, unless a valid one is already held
+func (c *AdminClient) Login() error {
+	_, err := c.token()
+	return err
+}
+
+// token returns the cached access token, requesting a new one when it is missing or about to expire
+func (c *AdminClient) token() (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.accessToken != "" && time.Now().Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "password")
+	form.Set("client_id", adminCLIClientID)
+	form.Set("username", c.username)
+	form.Set("password", c.password)
+	req, err := http.NewRequest(http.MethodPost, c.BaseURL+tokenPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.setHost(req)
+
+	resp, body, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", &APIError{Method: req.Method, Path: tokenPath, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	token := tokenResponse{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("Failed to parse the Keycloak token response: %v", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("Failed, the Keycloak token response has no access token")
+	}
+	c.accessToken = token.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpirySkew)
+	return c.accessToken, nil
+}
+
+// invalidateToken drops the cached access token so that the next request logs in again
+func (c *AdminClient) invalidateToken() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.accessToken = ""
+}
+
+// do sends a request to the admin API of the given realm path, relative to /auth/admin/realms.
+// The payload is sent as JSON, unless it is nil. The response body is decoded into result, unless it is nil.
+// A request rejected with HTTP status 401 is sent again once with a new access token.
+func (c *AdminClient) do(method string, path string, payload interface{}, result interface{}) (*http.Response, error) {
+	var data []byte
+	if payload != nil {
+		var err error
+		if raw, ok := payload.([]byte); ok {
+			data = raw
+		} else if data, err = json.Marshal(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *http.Response
+	var body []byte
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := c.token()
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequest(method, c.BaseURL+adminRealmsPath+path, bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/json")
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		c.setHost(req)
+
+		resp, body, err = c.send(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized {
+			break
+		}
+		c.invalidateToken()
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, &APIError{Method: method, Path: path, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	if result != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, result); err != nil {
+			return resp, fmt.Errorf("Failed to parse the response of Keycloak admin API request %s %s: %v", method, path, err)
+		}
+	}
+	return resp, nil
+}
+
+// send sends the request and reads the response body
+func (c *AdminClient) send(req *http.Request) (*http.Response, []byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// setHost sets the Keycloak host name of the request, if it differs from the base URL host
+func (c *AdminClient) setHost(req *http.Request) {
+	if c.Host != "" {
+		req.Host = c.Host
+	}
+}
+
+// idFromLocation returns the ID of a created resource, the last segment of the Location header of the response
+func idFromLocation(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", errors.New("Failed, the Keycloak create response has no Location header")
+	}
+	return location[strings.LastIndex(location, "/")+1:], nil
+}
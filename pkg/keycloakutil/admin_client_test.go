@@ -0,0 +1,335 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloakutil
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	cons "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/nginxutil"
+	kctest "github.com/verrazzano/verrazzano/pkg/test/keycloakutil"
+	corev1 "k8s.io/api/core/v1"
+	networkv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testRealm    = "test-realm"
+	testPassword = "secret"
+)
+
+// TestTokenHandling tests the access token handling of the admin client
+// GIVEN a Keycloak admin API
+// WHEN several requests are sent
+// THEN the access token is reused, and renewed when it is rejected
+// WHEN the credentials are wrong
+// THEN an API error with the HTTP status 401 is returned
+func TestTokenHandling(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+	assert.NoError(t, kc.Login())
+	_, err := kc.GetRealm(MasterRealm)
+	assert.NoError(t, err)
+	_, err = kc.GetRealm(MasterRealm)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.TokenRequests)
+
+	server.ExpireToken()
+	realm, err := kc.GetRealm(MasterRealm)
+	assert.NoError(t, err)
+	assert.Equal(t, MasterRealm, realm.Realm)
+	assert.Equal(t, 2, server.TokenRequests)
+
+	kc = NewAdminClient(server.URL, "", server.Client(), AdminUsername, "wrong")
+	err = kc.Login()
+	assert.True(t, hasStatus(err, http.StatusUnauthorized))
+
+	_, err = NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword).GetRealm("unknown")
+	assert.True(t, IsNotFound(err))
+}
+
+// TestCreateIfNotExists tests the idempotent creation of realms, groups, roles and client scopes
+// GIVEN a Keycloak admin API
+// WHEN the resources are created twice
+// THEN they are created once, and the IDs of the existing groups are returned
+func TestCreateIfNotExists(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+
+	enabled := false
+	for i, wantCreated := range []bool{true, false} {
+		created, err := kc.CreateRealmIfNotExists(Realm{Realm: testRealm, Enabled: &enabled})
+		assert.NoError(t, err)
+		assert.Equal(t, wantCreated, created, "realm, iteration %d", i)
+
+		parentID, err := kc.CreateGroupIfNotExists(testRealm, "parent", "")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, parentID)
+		childID, err := kc.CreateGroupIfNotExists(testRealm, "child", parentID)
+		assert.NoError(t, err)
+		assert.Equal(t, server.Realms[testRealm].FindGroup("/parent/child").ID, childID)
+
+		created, err = kc.CreateRealmRoleIfNotExists(testRealm, "role1")
+		assert.NoError(t, err)
+		assert.Equal(t, wantCreated, created, "role, iteration %d", i)
+
+		created, err = kc.CreateClientScopeIfNotExists(testRealm, ClientScope{Name: "groups", Protocol: "openid-connect"})
+		assert.NoError(t, err)
+		assert.Equal(t, wantCreated, created, "client scope, iteration %d", i)
+	}
+
+	realm := server.Realms[testRealm]
+	assert.Equal(t, false, realm.Representation["enabled"])
+	assert.Len(t, realm.Groups, 1)
+	assert.Len(t, realm.Groups[0].SubGroups, 1)
+	assert.Len(t, realm.Roles, 1)
+	assert.Len(t, realm.ClientScopes, 1)
+	assert.Equal(t, "openid-connect", realm.ClientScopes[0]["protocol"])
+
+	// Only the set fields of a realm are updated
+	policy := "length(8)"
+	assert.NoError(t, kc.UpdateRealm(Realm{Realm: testRealm, PasswordPolicy: &policy}))
+	assert.Equal(t, policy, realm.Representation["passwordPolicy"])
+	assert.Equal(t, false, realm.Representation["enabled"])
+	assert.NotContains(t, realm.Representation, "loginTheme")
+}
+
+// TestUsersAndRoleMappings tests the creation of users and the role grants
+// GIVEN a realm with a group and a realm role
+// WHEN a user is created in the group and the roles are granted
+// THEN the user has the password and the roles
+func TestUsersAndRoleMappings(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+
+	_, err := kc.CreateRealmIfNotExists(Realm{Realm: testRealm})
+	assert.NoError(t, err)
+	groupID, err := kc.CreateGroupIfNotExists(testRealm, "users", "")
+	assert.NoError(t, err)
+	_, err = kc.CreateRealmRoleIfNotExists(testRealm, "role1")
+	assert.NoError(t, err)
+
+	user, err := kc.GetUser(testRealm, "user1")
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	userID, err := kc.CreateUser(testRealm, User{Username: "user1", Enabled: true, FirstName: "First", Groups: []string{"/users"}})
+	assert.NoError(t, err)
+	assert.NoError(t, kc.SetPassword(testRealm, userID, "pw"))
+	assert.NoError(t, kc.AddRealmRolesToGroup(testRealm, groupID, "role1"))
+	assert.NoError(t, kc.AddRealmRolesToUser(testRealm, userID, "role1"))
+	assert.NoError(t, kc.AddClientRolesToUser(testRealm, userID, "realm-management", "view-users"))
+
+	user, err = kc.GetUser(testRealm, "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, userID, user.ID)
+	assert.Equal(t, "First", user.FirstName)
+
+	realm := server.Realms[testRealm]
+	assert.Equal(t, "pw", realm.Passwords[userID])
+	assert.Equal(t, []string{"role1"}, realm.GroupRoles[groupID])
+	assert.Equal(t, []string{"role1", "realm-management/view-users"}, realm.UserRoles[userID])
+
+	// Unknown roles and groups are reported
+	assert.True(t, IsNotFound(kc.AddRealmRolesToUser(testRealm, userID, "unknown")))
+	_, err = kc.CreateUser(testRealm, User{Username: "user2", Groups: []string{"/unknown"}})
+	assert.True(t, IsNotFound(err))
+	_, err = kc.CreateUser(testRealm, User{Username: "user1"})
+	assert.True(t, IsConflict(err))
+}
+
+// TestCreateOrUpdateClient tests the creation and update of clients and their secrets
+// GIVEN a realm without the client
+// WHEN CreateOrUpdateClient is called
+// THEN the client is created from its representation
+// WHEN CreateOrUpdateClient is called again
+// THEN only the fields of the update are changed
+func TestCreateOrUpdateClient(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+	_, err := kc.CreateRealmIfNotExists(Realm{Realm: testRealm})
+	assert.NoError(t, err)
+
+	id, created, err := kc.CreateOrUpdateClient(testRealm, "client1",
+		[]byte(`{"clientId": "client1", "publicClient": false, "redirectUris": ["https://a"]}`), []byte(`{"redirectUris": ["https://b"]}`))
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	secret, err := kc.RegenerateClientSecret(testRealm, id)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, secret)
+	current, err := kc.GetClientSecret(testRealm, id)
+	assert.NoError(t, err)
+	assert.Equal(t, secret, current)
+
+	updatedID, created, err := kc.CreateOrUpdateClient(testRealm, "client1",
+		[]byte(`{"clientId": "client1", "publicClient": true}`), []byte(`{"redirectUris": ["https://b"]}`))
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, id, updatedID)
+
+	kcClient, err := kc.GetClient(testRealm, "client1")
+	assert.NoError(t, err)
+	assert.Equal(t, id, kcClient.ID)
+	rep := server.Realms[testRealm].FindClient("client1")
+	assert.Equal(t, false, rep["publicClient"])
+	assert.Equal(t, []interface{}{"https://b"}, rep["redirectUris"])
+
+	_, _, err = kc.CreateOrUpdateClient(testRealm, "client2", []byte(`{"clientId": `), nil)
+	assert.Error(t, err)
+}
+
+// TestNewClusterAdminClient tests the creation of the admin client of the cluster
+// GIVEN the Keycloak ingress and admin secret
+// WHEN NewClusterAdminClient is called
+// THEN the client sends the requests to the ingress controller service for the Keycloak host
+// WHEN the admin secret does not exist
+// THEN an error is returned
+func TestNewClusterAdminClient(t *testing.T) {
+	ingress := &networkv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cons.KeycloakNamespace, Name: keycloakIngressName},
+		Spec:       networkv1.IngressSpec{Rules: []networkv1.IngressRule{{Host: "keycloak.default.example.com"}}},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cons.KeycloakNamespace, Name: keycloakAdminSecret},
+		Data:       map[string][]byte{"password": []byte(testPassword)},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(ingress, secret).Build()
+	kc, err := NewClusterAdminClient(cli, vzlog.DefaultLogger())
+	assert.NoError(t, err)
+	assert.Equal(t, "https://"+DefaultKeycloakIngressHostPrefix+nginxutil.IngressNGINXNamespace(), kc.BaseURL)
+	assert.Equal(t, "keycloak.default.example.com", kc.Host)
+	assert.Equal(t, testPassword, kc.password)
+
+	cli = fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(ingress).Build()
+	_, err = NewClusterAdminClient(cli, vzlog.DefaultLogger())
+	assert.Error(t, err)
+}
+
+// TestIdentityProvidersAndComponents tests the creation and replacement of identity providers, user storage
+// components, and client roles granted to groups
+// GIVEN a realm with a client and a group
+// WHEN the identity provider, component and client role are created twice
+// THEN they are created once, and replaced with the latest representation
+func TestIdentityProvidersAndComponents(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+	_, err := kc.CreateRealmIfNotExists(Realm{Realm: testRealm})
+	assert.NoError(t, err)
+
+	for i, wantCreated := range []bool{true, false} {
+		created, err := kc.CreateOrUpdateIdentityProvider(testRealm, "idp", []byte(`{"alias": "idp", "providerId": "oidc", "displayName": "IdP `+fmt.Sprint(i)+`"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, wantCreated, created, "identity provider, iteration %d", i)
+
+		component := Component{Name: "ldap", ProviderID: "ldap", ProviderType: "org.keycloak.storage.UserStorageProvider",
+			ParentID: testRealm, Config: map[string][]string{"connectionUrl": {fmt.Sprintf("ldap://ldap-%d", i)}}}
+		_, created, err = kc.CreateOrUpdateComponent(testRealm, component)
+		assert.NoError(t, err)
+		assert.Equal(t, wantCreated, created, "component, iteration %d", i)
+	}
+	realm := server.Realms[testRealm]
+	assert.Equal(t, "IdP 1", realm.IdentityProviders["idp"]["displayName"])
+	assert.Len(t, realm.Components, 1)
+	assert.Equal(t, []interface{}{"ldap://ldap-1"}, realm.Components[0]["config"].(map[string]interface{})["connectionUrl"])
+
+	_, err = kc.CreateOrUpdateIdentityProvider(testRealm, "idp", []byte(`{"alias": `))
+	assert.Error(t, err)
+
+	id, _, err := kc.CreateOrUpdateClient(testRealm, "client1", []byte(`{"clientId": "client1"}`), nil)
+	assert.NoError(t, err)
+	created, err := kc.CreateClientRoleIfNotExists(testRealm, id, "viewer")
+	assert.NoError(t, err)
+	assert.True(t, created)
+	created, err = kc.CreateClientRoleIfNotExists(testRealm, id, "viewer")
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	groupID, err := kc.CreateGroupIfNotExists(testRealm, "viewers", "")
+	assert.NoError(t, err)
+	assert.NoError(t, kc.AddClientRolesToGroup(testRealm, groupID, "client1", "viewer"))
+	assert.Equal(t, []string{"client1/viewer"}, realm.GroupRoles[groupID])
+	assert.Error(t, kc.AddClientRolesToGroup(testRealm, groupID, "unknown", "viewer"))
+}
+
+// TestDeleteAndRevoke tests the deletion of realm objects and the revocation of role mappings and group memberships
+// GIVEN a realm with a user in a group, roles granted to the user and the group, and other realm objects
+// WHEN the roles are revoked and the objects are deleted
+// THEN the objects and mappings are removed, and deleting them again is not an error
+func TestDeleteAndRevoke(t *testing.T) {
+	server := kctest.NewFakeAdminServer(AdminUsername, testPassword)
+	defer server.Close()
+	kc := NewAdminClient(server.URL, "", server.Client(), AdminUsername, testPassword)
+	_, err := kc.CreateRealmIfNotExists(Realm{Realm: testRealm})
+	assert.NoError(t, err)
+	groupID, err := kc.CreateGroupIfNotExists(testRealm, "viewers", "")
+	assert.NoError(t, err)
+	_, err = kc.CreateRealmRoleIfNotExists(testRealm, "role1")
+	assert.NoError(t, err)
+	id, _, err := kc.CreateOrUpdateClient(testRealm, "client1", []byte(`{"clientId": "client1"}`), nil)
+	assert.NoError(t, err)
+	_, err = kc.CreateClientRoleIfNotExists(testRealm, id, "viewer")
+	assert.NoError(t, err)
+	_, err = kc.CreateOrUpdateIdentityProvider(testRealm, "idp", []byte(`{"alias": "idp", "providerId": "oidc"}`))
+	assert.NoError(t, err)
+	_, _, err = kc.CreateOrUpdateComponent(testRealm, Component{Name: "ldap", ProviderID: "ldap", ProviderType: "org.keycloak.storage.UserStorageProvider", ParentID: testRealm})
+	assert.NoError(t, err)
+	userID, err := kc.CreateUser(testRealm, User{Username: "user1", Enabled: true})
+	assert.NoError(t, err)
+	assert.NoError(t, kc.ResetPassword(testRealm, userID, "pw", true))
+	assert.NoError(t, kc.UpdateUser(testRealm, User{ID: userID, Username: "user1", Enabled: true, Email: "user1@example.com"}))
+	assert.NoError(t, kc.AddUserToGroup(testRealm, userID, groupID))
+	assert.NoError(t, kc.AddRealmRolesToUser(testRealm, userID, "role1"))
+	assert.NoError(t, kc.AddClientRolesToUser(testRealm, userID, "client1", "viewer"))
+	assert.NoError(t, kc.AddRealmRolesToGroup(testRealm, groupID, "role1"))
+	assert.NoError(t, kc.AddClientRolesToGroup(testRealm, groupID, "client1", "viewer"))
+
+	realm := server.Realms[testRealm]
+	user := realm.FindUser("user1")
+	assert.Equal(t, "user1@example.com", user["email"])
+	assert.Equal(t, []interface{}{"/viewers"}, user["groups"])
+	assert.True(t, realm.TemporaryPasswords[userID])
+	groups, err := kc.GetGroups(testRealm)
+	assert.NoError(t, err)
+	assert.Equal(t, groupID, FindGroupByPath(groups, "/viewers").ID)
+	assert.Nil(t, FindGroupByPath(groups, "/viewers/unknown"))
+
+	assert.NoError(t, kc.RemoveRealmRolesFromUser(testRealm, userID, "role1", "unknown"))
+	assert.NoError(t, kc.RemoveClientRolesFromUser(testRealm, userID, "client1", "viewer"))
+	assert.NoError(t, kc.RemoveRealmRolesFromGroup(testRealm, groupID, "role1"))
+	assert.NoError(t, kc.RemoveClientRolesFromGroup(testRealm, groupID, "client1", "viewer"))
+	assert.NoError(t, kc.RemoveUserFromGroup(testRealm, userID, groupID))
+	assert.Empty(t, realm.UserRoles[userID])
+	assert.Empty(t, realm.GroupRoles[groupID])
+	assert.Empty(t, realm.FindUser("user1")["groups"])
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, kc.DeleteUser(testRealm, userID))
+		assert.NoError(t, kc.DeleteGroup(testRealm, groupID))
+		assert.NoError(t, kc.DeleteClientRole(testRealm, id, "viewer"))
+		assert.NoError(t, kc.DeleteClient(testRealm, "client1"))
+		assert.NoError(t, kc.DeleteRealmRole(testRealm, "role1"))
+		assert.NoError(t, kc.DeleteIdentityProvider(testRealm, "idp"))
+		assert.NoError(t, kc.DeleteComponent(testRealm, "ldap", "org.keycloak.storage.UserStorageProvider"))
+	}
+	assert.Nil(t, realm.FindUser("user1"))
+	assert.Nil(t, realm.FindGroup("/viewers"))
+	assert.Nil(t, realm.FindClient("client1"))
+	assert.Nil(t, realm.Roles["role1"])
+	assert.Empty(t, realm.IdentityProviders)
+	assert.Empty(t, realm.Components)
+}
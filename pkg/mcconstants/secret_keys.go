@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Package mcconstants - Constants in this file are keys in MultiCluster related secrets
@@ -34,6 +34,9 @@ const JaegerManagedClusterSecretName = "verrazzano-jaeger-managed-cluster"
 // KubeconfigKey is the kubeconfig key
 const KubeconfigKey = "admin-kubeconfig"
 
+// AgentCredentialKey is the key for the name of the service account token secret used in the agent kubeconfig
+const AgentCredentialKey = "agent-credential"
+
 // ManagedClusterNameKey is the key for the managed cluster name
 const ManagedClusterNameKey = "managed-cluster-name"
 
@@ -0,0 +1,12 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mcconstants
+
+import "fmt"
+
+// GetManifestSecretName returns the name of the manifest secret of a managed cluster, in the
+// verrazzano-mc namespace of the admin cluster
+func GetManifestSecretName(vmcName string) string {
+	return fmt.Sprintf("verrazzano-cluster-%s-manifest", vmcName)
+}
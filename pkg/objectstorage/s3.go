@@ -0,0 +1,248 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	amzDateFormat    = "20060102T150405Z"
+	// emptyPayloadHash is the SHA-256 hash of an empty request body
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3Client reads, writes, lists and deletes the objects of a bucket of an S3 compatible object storage, for example the Amazon S3
+// Compatibility API of OCI Object Storage. The bucket is addressed in the path of the requests, which are signed with
+// AWS Signature Version 4.
+type S3Client struct {
+	// Endpoint is the URL of the object storage, for example https://<namespace>.compat.objectstorage.<region>.oraclecloud.com
+	Endpoint  string
+	Region    string
+	AccessKey string
+	SecretKey string
+	// HTTPClient sends the requests, http.DefaultClient is used when not set
+	HTTPClient *http.Client
+	// now returns the signing time of the requests, time.Now is used when not set
+	now func() time.Time
+}
+
+// listBucketResult is the result of a ListObjectsV2 request
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// ListObjects returns the keys of the objects of the bucket that start with the prefix
+func (c *S3Client) ListObjects(ctx context.Context, bucket string, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, http.MethodGet, bucket, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the objects of bucket %s: %v", bucket, err)
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// GetObject returns the content of an object of the bucket
+func (c *S3Client) GetObject(ctx context.Context, bucket string, key string) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, bucket, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// PutObject creates or replaces an object of the bucket
+func (c *S3Client) PutObject(ctx context.Context, bucket string, key string, content []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, bucket, key, nil, content)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// DeleteObject deletes an object of the bucket. Nothing is done if the object does not exist.
+func (c *S3Client) DeleteObject(ctx context.Context, bucket string, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, bucket, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// DeleteObjects deletes the objects of the bucket that start with the prefix, and returns the number of deleted
+// objects
+func (c *S3Client) DeleteObjects(ctx context.Context, bucket string, prefix string) (int, error) {
+	keys, err := c.ListObjects(ctx, bucket, prefix)
+	if err != nil {
+		return 0, err
+	}
+	for i, key := range keys {
+		if err := c.DeleteObject(ctx, bucket, key); err != nil {
+			return i, err
+		}
+	}
+	return len(keys), nil
+}
+
+// do sends a signed request for the bucket or one of its objects, with an optional body, and returns the response if
+// it is successful. A missing object is not an error when deleting it.
+func (c *S3Client) do(ctx context.Context, method string, bucket string, key string, query url.Values, body []byte) (*http.Response, error) {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object storage endpoint %s: %v", c.Endpoint, err)
+	}
+	objectPath := "/" + bucket
+	if key != "" {
+		objectPath += "/" + key
+	}
+	endpoint.Path = strings.TrimSuffix(endpoint.Path, "/") + objectPath
+	endpoint.RawPath = ""
+	endpoint.RawQuery = canonicalQuery(query)
+	var reqBody io.Reader
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+		payloadHash = hashHex(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	c.sign(req, payloadHash, now().UTC())
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 || (method == http.MethodDelete && resp.StatusCode == http.StatusNotFound) {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, fmt.Errorf("got status code %d for %s %s: %s", resp.StatusCode, method, objectPath, strings.TrimSpace(string(message)))
+}
+
+// sign adds the AWS Signature Version 4 authorization header to a request. The signed headers are the host, the
+// date and the payload hash.
+func (c *S3Client) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", req.URL.Host, payloadHash, amzDate)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, c.Region, signingService)
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, hashHex([]byte(canonicalRequest))}, "\n")
+	key := hmacSHA256([]byte("AWS4"+c.SecretKey), date)
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, signingService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, c.AccessKey, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the query parameters sorted by name and URI encoded
+func canonicalQuery(query url.Values) string {
+	var names []string
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var params []string
+	for _, name := range names {
+		values := append([]string{}, query[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode encodes all the characters except the unreserved ones, and the slashes unless encodeSlash is true
+func uriEncode(s string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(s) {
+		switch {
+		case b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z', b >= '0' && b <= '9', b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
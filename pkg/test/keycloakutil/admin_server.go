@@ -0,0 +1,675 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloakutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	adminRealmsPrefix = "/auth/admin/realms"
+	tokenPath         = "/auth/realms/master/protocol/openid-connect/token" //nolint:gosec
+)
+
+// FakeAdminServer is an in-memory stand-in of the Keycloak admin REST API, served by an httptest server.
+// Resources are kept as generic JSON objects so that the fields sent by the client can be verified.
+type FakeAdminServer struct {
+	*httptest.Server
+	Username string
+	Password string
+
+	lock   sync.Mutex
+	nextID int
+	token  string
+	// TokenRequests counts the successful logins
+	TokenRequests int
+	// Requests records the method and path of each admin API request
+	Requests []string
+	Realms   map[string]*FakeRealm
+}
+
+// FakeRealm holds the resources of a realm of the FakeAdminServer
+type FakeRealm struct {
+	Representation map[string]interface{}
+	Groups         []*FakeGroup
+	Roles          map[string]map[string]interface{}
+	Users          []map[string]interface{}
+	Passwords      map[string]string
+	// TemporaryPasswords maps the user IDs to true if the password of the user is temporary
+	TemporaryPasswords map[string]bool
+	Clients            []map[string]interface{}
+	ClientRoles        map[string]map[string]map[string]interface{}
+	ClientSecrets      map[string]string
+	ClientScopes       []map[string]interface{}
+	// IdentityProviders maps the aliases to the identity providers
+	IdentityProviders map[string]map[string]interface{}
+	Components        []map[string]interface{}
+	// GroupRoles and UserRoles map group and user IDs to the names of the granted roles, client roles are
+	// prefixed with the client ID and a slash
+	GroupRoles map[string][]string
+	UserRoles  map[string][]string
+}
+
+// FakeGroup is a group of a FakeRealm
+type FakeGroup struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Path      string       `json:"path"`
+	SubGroups []*FakeGroup `json:"subGroups"`
+}
+
+// NewFakeAdminServer starts a fake Keycloak admin API with a master realm, the server must be closed by the caller
+func NewFakeAdminServer(username string, password string) *FakeAdminServer {
+	s := &FakeAdminServer{Username: username, Password: password, Realms: map[string]*FakeRealm{}}
+	s.Realms["master"] = s.newFakeRealm("master")
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// newFakeRealm returns an empty realm with the realm-management client and its view-users role, like Keycloak creates
+func (s *FakeAdminServer) newFakeRealm(name string) *FakeRealm {
+	realmManagementID := s.newID()
+	return &FakeRealm{
+		Representation:     map[string]interface{}{"id": name, "realm": name, "enabled": true},
+		Roles:              map[string]map[string]interface{}{},
+		Passwords:          map[string]string{},
+		TemporaryPasswords: map[string]bool{},
+		Clients:            []map[string]interface{}{{"id": realmManagementID, "clientId": "realm-management"}},
+		ClientRoles: map[string]map[string]map[string]interface{}{realmManagementID: {
+			"view-users": {"id": s.newID(), "name": "view-users", "clientRole": true, "containerId": realmManagementID},
+		}},
+		ClientSecrets:     map[string]string{},
+		IdentityProviders: map[string]map[string]interface{}{},
+		GroupRoles:        map[string][]string{},
+		UserRoles:         map[string][]string{},
+	}
+}
+
+// ExpireToken invalidates the access token handed out by the last login
+func (s *FakeAdminServer) ExpireToken() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.token = ""
+}
+
+// FindGroup returns the group with the given path, like /parent/child
+func (r *FakeRealm) FindGroup(path string) *FakeGroup {
+	groups := r.Groups
+	var found *FakeGroup
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		found = nil
+		for _, g := range groups {
+			if g.Name == name {
+				found = g
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		groups = found.SubGroups
+	}
+	return found
+}
+
+// FindUser returns the user with the given user name
+func (r *FakeRealm) FindUser(username string) map[string]interface{} {
+	for _, u := range r.Users {
+		if u["username"] == username {
+			return u
+		}
+	}
+	return nil
+}
+
+// FindClient returns the client with the given client ID
+func (r *FakeRealm) FindClient(clientID string) map[string]interface{} {
+	for _, c := range r.Clients {
+		if c["clientId"] == clientID {
+			return c
+		}
+	}
+	return nil
+}
+
+func (r *FakeRealm) findClientByID(id string) map[string]interface{} {
+	for _, c := range r.Clients {
+		if c["id"] == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (r *FakeRealm) findGroupByID(groups []*FakeGroup, id string) *FakeGroup {
+	for _, g := range groups {
+		if g.ID == id {
+			return g
+		}
+		if found := r.findGroupByID(g.SubGroups, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (r *FakeRealm) findUserByID(id string) map[string]interface{} {
+	for _, u := range r.Users {
+		if u["id"] == id {
+			return u
+		}
+	}
+	return nil
+}
+
+// deleteGroup removes the group with the given ID from the groups or their child groups
+func (r *FakeRealm) deleteGroup(groups *[]*FakeGroup, id string) bool {
+	for i, g := range *groups {
+		if g.ID == id {
+			*groups = append((*groups)[:i], (*groups)[i+1:]...)
+			return true
+		}
+		if r.deleteGroup(&g.SubGroups, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *FakeAdminServer) newID() string {
+	s.nextID++
+	return fmt.Sprintf("id-%d", s.nextID)
+}
+
+func (s *FakeAdminServer) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if req.URL.Path == tokenPath {
+		s.serveToken(w, req)
+		return
+	}
+	if !strings.HasPrefix(req.URL.Path, adminRealmsPrefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if s.token == "" || req.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.Requests = append(s.Requests, req.Method+" "+req.URL.Path)
+
+	var body interface{}
+	if data, _ := io.ReadAll(req.Body); len(data) > 0 {
+		if err := json.Unmarshal(data, &body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, adminRealmsPrefix), "/"), "/")
+	if segments[0] == "" {
+		if req.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "unsupported")
+			return
+		}
+		rep := body.(map[string]interface{})
+		name := rep["realm"].(string)
+		if s.Realms[name] != nil {
+			writeError(w, http.StatusConflict, "realm exists")
+			return
+		}
+		r := s.newFakeRealm(name)
+		merge(r.Representation, rep)
+		s.Realms[name] = r
+		created(w, req.URL.Path, name)
+		return
+	}
+
+	r := s.Realms[segments[0]]
+	if r == nil {
+		writeError(w, http.StatusNotFound, "realm not found")
+		return
+	}
+	if len(segments) == 1 {
+		switch req.Method {
+		case http.MethodGet:
+			writeJSON(w, r.Representation)
+		case http.MethodPut:
+			merge(r.Representation, body.(map[string]interface{}))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "unsupported")
+		}
+		return
+	}
+
+	switch segments[1] {
+	case "groups":
+		s.serveGroups(w, req, r, segments[2:], body)
+	case "roles":
+		s.serveRoles(w, req, r, segments[2:], body)
+	case "users":
+		s.serveUsers(w, req, r, segments[2:], body)
+	case "clients":
+		s.serveClients(w, req, r, segments[2:], body)
+	case "client-scopes":
+		s.serveClientScopes(w, req, r, body)
+	case "identity-provider":
+		s.serveIdentityProviders(w, req, r, segments[2:], body)
+	case "components":
+		s.serveComponents(w, req, r, segments[2:], body)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveToken(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil || req.Form.Get("grant_type") != "password" || req.Form.Get("client_id") != "admin-cli" {
+		writeError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	if req.Form.Get("username") != s.Username || req.Form.Get("password") != s.Password {
+		writeError(w, http.StatusUnauthorized, "invalid_grant")
+		return
+	}
+	s.TokenRequests++
+	s.token = fmt.Sprintf("token-%d", s.TokenRequests)
+	writeJSON(w, map[string]interface{}{"access_token": s.token, "expires_in": 60, "token_type": "Bearer"})
+}
+
+func (s *FakeAdminServer) serveGroups(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 0 && req.Method == http.MethodGet:
+		writeJSON(w, r.Groups)
+	case len(segments) == 0 && req.Method == http.MethodPost:
+		name := body.(map[string]interface{})["name"].(string)
+		if r.FindGroup(name) != nil {
+			writeError(w, http.StatusConflict, "group exists")
+			return
+		}
+		g := &FakeGroup{ID: s.newID(), Name: name, Path: "/" + name}
+		r.Groups = append(r.Groups, g)
+		created(w, req.URL.Path, g.ID)
+	case len(segments) == 2 && segments[1] == "children" && req.Method == http.MethodPost:
+		parent := r.findGroupByID(r.Groups, segments[0])
+		if parent == nil {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		name := body.(map[string]interface{})["name"].(string)
+		g := &FakeGroup{ID: s.newID(), Name: name, Path: parent.Path + "/" + name}
+		parent.SubGroups = append(parent.SubGroups, g)
+		created(w, req.URL.Path, g.ID)
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		if !r.deleteGroup(&r.Groups, segments[0]) {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		delete(r.GroupRoles, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) >= 3 && segments[1] == "role-mappings" && (req.Method == http.MethodPost || req.Method == http.MethodDelete):
+		if r.findGroupByID(r.Groups, segments[0]) == nil {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		prefix := ""
+		if segments[2] == "clients" && len(segments) == 4 {
+			prefix = r.findClientByID(segments[3])["clientId"].(string) + "/"
+		}
+		if req.Method == http.MethodPost {
+			r.GroupRoles[segments[0]] = addRoles(r.GroupRoles[segments[0]], prefix, body)
+		} else {
+			r.GroupRoles[segments[0]] = removeRoles(r.GroupRoles[segments[0]], prefix, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveRoles(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 0 && req.Method == http.MethodPost:
+		rep := body.(map[string]interface{})
+		name := rep["name"].(string)
+		if r.Roles[name] != nil {
+			writeError(w, http.StatusConflict, "role exists")
+			return
+		}
+		rep["id"] = s.newID()
+		r.Roles[name] = rep
+		created(w, req.URL.Path, name)
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		role := r.Roles[segments[0]]
+		if role == nil {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		writeJSON(w, role)
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		if r.Roles[segments[0]] == nil {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		delete(r.Roles, segments[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveUsers(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 0 && req.Method == http.MethodGet:
+		users := []map[string]interface{}{}
+		for _, u := range r.Users {
+			if username := req.URL.Query().Get("username"); username == "" || u["username"] == username {
+				users = append(users, u)
+			}
+		}
+		writeJSON(w, users)
+	case len(segments) == 0 && req.Method == http.MethodPost:
+		rep := body.(map[string]interface{})
+		if r.FindUser(rep["username"].(string)) != nil {
+			writeError(w, http.StatusConflict, "user exists")
+			return
+		}
+		groups, _ := rep["groups"].([]interface{})
+		for _, path := range groups {
+			if r.FindGroup(path.(string)) == nil {
+				writeError(w, http.StatusNotFound, "group not found")
+				return
+			}
+		}
+		rep["id"] = s.newID()
+		r.Users = append(r.Users, rep)
+		created(w, req.URL.Path, rep["id"].(string))
+	case len(segments) == 1 && (req.Method == http.MethodPut || req.Method == http.MethodDelete):
+		for i, u := range r.Users {
+			if u["id"] != segments[0] {
+				continue
+			}
+			if req.Method == http.MethodDelete {
+				r.Users = append(r.Users[:i], r.Users[i+1:]...)
+				delete(r.UserRoles, segments[0])
+			} else {
+				merge(u, body.(map[string]interface{}))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeError(w, http.StatusNotFound, "user not found")
+	case len(segments) == 2 && segments[1] == "reset-password" && req.Method == http.MethodPut:
+		cred := body.(map[string]interface{})
+		r.Passwords[segments[0]] = cred["value"].(string)
+		r.TemporaryPasswords[segments[0]] = cred["temporary"] == true
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 3 && segments[1] == "groups" && (req.Method == http.MethodPut || req.Method == http.MethodDelete):
+		user := r.findUserByID(segments[0])
+		group := r.findGroupByID(r.Groups, segments[2])
+		if user == nil || group == nil {
+			writeError(w, http.StatusNotFound, "user or group not found")
+			return
+		}
+		groups, _ := user["groups"].([]interface{})
+		var updated []interface{}
+		for _, path := range groups {
+			if path != group.Path {
+				updated = append(updated, path)
+			}
+		}
+		if req.Method == http.MethodPut {
+			updated = append(updated, group.Path)
+		}
+		user["groups"] = updated
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) >= 3 && segments[1] == "role-mappings" && (req.Method == http.MethodPost || req.Method == http.MethodDelete):
+		prefix := ""
+		if segments[2] == "clients" && len(segments) == 4 {
+			prefix = r.findClientByID(segments[3])["clientId"].(string) + "/"
+		}
+		if req.Method == http.MethodPost {
+			r.UserRoles[segments[0]] = addRoles(r.UserRoles[segments[0]], prefix, body)
+		} else {
+			r.UserRoles[segments[0]] = removeRoles(r.UserRoles[segments[0]], prefix, body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveClients(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 0 && req.Method == http.MethodGet:
+		clients := []map[string]interface{}{}
+		for _, c := range r.Clients {
+			if clientID := req.URL.Query().Get("clientId"); clientID == "" || c["clientId"] == clientID {
+				clients = append(clients, c)
+			}
+		}
+		writeJSON(w, clients)
+	case len(segments) == 0 && req.Method == http.MethodPost:
+		rep := body.(map[string]interface{})
+		if r.FindClient(rep["clientId"].(string)) != nil {
+			writeError(w, http.StatusConflict, "client exists")
+			return
+		}
+		rep["id"] = s.newID()
+		r.Clients = append(r.Clients, rep)
+		created(w, req.URL.Path, rep["id"].(string))
+	case len(segments) == 1 && req.Method == http.MethodPut:
+		kcClient := r.findClientByID(segments[0])
+		if kcClient == nil {
+			writeError(w, http.StatusNotFound, "client not found")
+			return
+		}
+		merge(kcClient, body.(map[string]interface{}))
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 1 && req.Method == http.MethodDelete:
+		for i, c := range r.Clients {
+			if c["id"] == segments[0] {
+				r.Clients = append(r.Clients[:i], r.Clients[i+1:]...)
+				delete(r.ClientRoles, segments[0])
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "client not found")
+	case len(segments) == 2 && segments[1] == "client-secret":
+		if r.findClientByID(segments[0]) == nil {
+			writeError(w, http.StatusNotFound, "client not found")
+			return
+		}
+		if req.Method == http.MethodPost {
+			r.ClientSecrets[segments[0]] = "secret-" + s.newID()
+		}
+		writeJSON(w, map[string]interface{}{"type": "secret", "value": r.ClientSecrets[segments[0]]})
+	case len(segments) == 2 && segments[1] == "roles" && req.Method == http.MethodPost:
+		if r.findClientByID(segments[0]) == nil {
+			writeError(w, http.StatusNotFound, "client not found")
+			return
+		}
+		rep := body.(map[string]interface{})
+		name := rep["name"].(string)
+		if r.ClientRoles[segments[0]][name] != nil {
+			writeError(w, http.StatusConflict, "role exists")
+			return
+		}
+		if r.ClientRoles[segments[0]] == nil {
+			r.ClientRoles[segments[0]] = map[string]map[string]interface{}{}
+		}
+		rep["id"] = s.newID()
+		rep["clientRole"] = true
+		rep["containerId"] = segments[0]
+		r.ClientRoles[segments[0]][name] = rep
+		created(w, req.URL.Path, name)
+	case len(segments) == 3 && segments[1] == "roles" && (req.Method == http.MethodGet || req.Method == http.MethodDelete):
+		role := r.ClientRoles[segments[0]][segments[2]]
+		if role == nil {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		if req.Method == http.MethodDelete {
+			delete(r.ClientRoles[segments[0]], segments[2])
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, role)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveClientScopes(w http.ResponseWriter, req *http.Request, r *FakeRealm, body interface{}) {
+	switch req.Method {
+	case http.MethodGet:
+		writeJSON(w, r.ClientScopes)
+	case http.MethodPost:
+		rep := body.(map[string]interface{})
+		for _, scope := range r.ClientScopes {
+			if scope["name"] == rep["name"] {
+				writeError(w, http.StatusConflict, "client scope exists")
+				return
+			}
+		}
+		rep["id"] = s.newID()
+		r.ClientScopes = append(r.ClientScopes, rep)
+		created(w, req.URL.Path, rep["id"].(string))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "unsupported")
+	}
+}
+
+func (s *FakeAdminServer) serveIdentityProviders(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 1 && segments[0] == "instances" && req.Method == http.MethodPost:
+		rep := body.(map[string]interface{})
+		alias := rep["alias"].(string)
+		if r.IdentityProviders[alias] != nil {
+			writeError(w, http.StatusConflict, "identity provider exists")
+			return
+		}
+		rep["internalId"] = s.newID()
+		r.IdentityProviders[alias] = rep
+		created(w, req.URL.Path, alias)
+	case len(segments) == 2 && segments[0] == "instances":
+		idp := r.IdentityProviders[segments[1]]
+		if idp == nil {
+			writeError(w, http.StatusNotFound, "identity provider not found")
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			writeJSON(w, idp)
+		case http.MethodPut:
+			rep := body.(map[string]interface{})
+			rep["internalId"] = idp["internalId"]
+			r.IdentityProviders[segments[1]] = rep
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(r.IdentityProviders, segments[1])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "unsupported")
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *FakeAdminServer) serveComponents(w http.ResponseWriter, req *http.Request, r *FakeRealm, segments []string, body interface{}) {
+	switch {
+	case len(segments) == 0 && req.Method == http.MethodGet:
+		components := []map[string]interface{}{}
+		for _, c := range r.Components {
+			if providerType := req.URL.Query().Get("type"); providerType == "" || c["providerType"] == providerType {
+				components = append(components, c)
+			}
+		}
+		writeJSON(w, components)
+	case len(segments) == 0 && req.Method == http.MethodPost:
+		rep := body.(map[string]interface{})
+		rep["id"] = s.newID()
+		r.Components = append(r.Components, rep)
+		created(w, req.URL.Path, rep["id"].(string))
+	case len(segments) == 1 && (req.Method == http.MethodPut || req.Method == http.MethodDelete):
+		for i, c := range r.Components {
+			if c["id"] == segments[0] {
+				if req.Method == http.MethodDelete {
+					r.Components = append(r.Components[:i], r.Components[i+1:]...)
+				} else {
+					r.Components[i] = body.(map[string]interface{})
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "component not found")
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// addRoles adds the names of the roles in the request body that are not granted yet
+func addRoles(granted []string, prefix string, body interface{}) []string {
+	roles, _ := body.([]interface{})
+	for _, role := range roles {
+		name := prefix + role.(map[string]interface{})["name"].(string)
+		exists := false
+		for _, g := range granted {
+			exists = exists || g == name
+		}
+		if !exists {
+			granted = append(granted, name)
+		}
+	}
+	return granted
+}
+
+// removeRoles removes the names of the roles in the request body from the granted roles
+func removeRoles(granted []string, prefix string, body interface{}) []string {
+	roles, _ := body.([]interface{})
+	var remaining []string
+	for _, g := range granted {
+		removed := false
+		for _, role := range roles {
+			removed = removed || g == prefix+role.(map[string]interface{})["name"].(string)
+		}
+		if !removed {
+			remaining = append(remaining, g)
+		}
+	}
+	return remaining
+}
+
+// merge copies the fields of the update into the representation
+func merge(rep map[string]interface{}, update map[string]interface{}) {
+	for k, v := range update {
+		rep[k] = v
+	}
+}
+
+func created(w http.ResponseWriter, path string, id string) {
+	w.Header().Set("Location", "http://keycloak"+path+"/"+id)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"errorMessage": message})
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package vzcr
@@ -43,6 +43,21 @@ func IsOpenSearchDashboardsEnabled(cr runtime.Object) bool {
 	return true
 }
 
+// IsOpenSearchDashboardsMultitenancyEnabled returns true only if OpenSearch Dashboards multitenancy is explicitly enabled
+// in the CR
+func IsOpenSearchDashboardsMultitenancyEnabled(cr runtime.Object) bool {
+	if vzv1alpha1, ok := cr.(*installv1alpha1.Verrazzano); ok {
+		if vzv1alpha1 != nil && vzv1alpha1.Spec.Components.Kibana != nil && vzv1alpha1.Spec.Components.Kibana.Multitenancy != nil {
+			return *vzv1alpha1.Spec.Components.Kibana.Multitenancy
+		}
+	} else if vzv1beta1, ok := cr.(*installv1beta1.Verrazzano); ok {
+		if vzv1beta1 != nil && vzv1beta1.Spec.Components.OpenSearchDashboards != nil && vzv1beta1.Spec.Components.OpenSearchDashboards.Multitenancy != nil {
+			return *vzv1beta1.Spec.Components.OpenSearchDashboards.Multitenancy
+		}
+	}
+	return false
+}
+
 // IsNGINXEnabled - Returns false only if explicitly disabled in the CR
 func IsNGINXEnabled(cr runtime.Object) bool {
 	if vzv1alpha1, ok := cr.(*installv1alpha1.Verrazzano); ok {
@@ -335,9 +350,22 @@ func IsRancherEnabled(cr runtime.Object) bool {
 	return true
 }
 
-// IsExternalDNSEnabled Indicates if the external-dns service is expected to be deployed, true if OCI DNS is configured
+// IsExternalDNSEnabled Indicates if the external-dns service is expected to be deployed, true if OCI DNS, RFC 2136 DNS
+// or PowerDNS is configured
 func IsExternalDNSEnabled(cr runtime.Object) bool {
-	return IsOCIDNSEnabled(cr)
+	if IsOCIDNSEnabled(cr) {
+		return true
+	}
+	if vzv1alpha1, ok := cr.(*installv1alpha1.Verrazzano); ok {
+		if vzv1alpha1 != nil && vzv1alpha1.Spec.Components.DNS != nil {
+			return vzv1alpha1.Spec.Components.DNS.RFC2136 != nil || vzv1alpha1.Spec.Components.DNS.PowerDNS != nil
+		}
+	} else if vzv1beta1, ok := cr.(*installv1beta1.Verrazzano); ok {
+		if vzv1beta1 != nil && vzv1beta1.Spec.Components.DNS != nil {
+			return vzv1beta1.Spec.Components.DNS.RFC2136 != nil || vzv1beta1.Spec.Components.DNS.PowerDNS != nil
+		}
+	}
+	return false
 }
 
 // IsOCIDNSEnabled Returns true if OCI DNS is configured
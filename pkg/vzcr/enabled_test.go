@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 package vzcr
 
@@ -66,6 +66,23 @@ func TestIsExternalDNSEnabledOCIDNS(t *testing.T) {
 	assert.True(t, IsExternalDNSEnabled(vzv1beta1))
 }
 
+// TestIsExternalDNSEnabledDNSProviders tests the IsExternalDNSEnabled function
+// GIVEN a call to IsExternalDNSEnabled
+//
+//	WHEN the VZ config has RFC 2136 DNS, PowerDNS or webhook DNS configured
+//	THEN true is returned for RFC 2136 DNS and PowerDNS, and false for webhook DNS
+func TestIsExternalDNSEnabledDNSProviders(t *testing.T) {
+	assert.True(t, IsExternalDNSEnabled(&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{
+		DNS: &vzapi.DNSComponent{RFC2136: &vzapi.RFC2136{DNSZoneName: "mydomain.com"}},
+	}}}))
+	assert.True(t, IsExternalDNSEnabled(&installv1beta1.Verrazzano{Spec: installv1beta1.VerrazzanoSpec{Components: installv1beta1.ComponentSpec{
+		DNS: &installv1beta1.DNSComponent{PowerDNS: &installv1beta1.PowerDNS{DNSZoneName: "mydomain.com"}},
+	}}}))
+	assert.False(t, IsExternalDNSEnabled(&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{
+		DNS: &vzapi.DNSComponent{Webhook: &vzapi.WebhookDNS{DNSZoneName: "mydomain.com"}},
+	}}}))
+}
+
 // TestIsExternalDNSEnabledWildcardDNS tests the IsExternalDNSEnabled function
 // GIVEN a call to IsExternalDNSEnabled
 //
@@ -757,6 +774,46 @@ func TestIsKibanaEnabled(t *testing.T) {
 		}}))
 }
 
+// TestIsOpenSearchDashboardsMultitenancyEnabled tests the IsOpenSearchDashboardsMultitenancyEnabled function
+// GIVEN a call to IsOpenSearchDashboardsMultitenancyEnabled
+//
+//	THEN the value of the Multitenancy flag is returned if present, false otherwise (disabled by default)
+func TestIsOpenSearchDashboardsMultitenancyEnabled(t *testing.T) {
+	asserts := assert.New(t)
+	asserts.False(IsOpenSearchDashboardsMultitenancyEnabled(nil))
+	asserts.False(IsOpenSearchDashboardsMultitenancyEnabled(&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{}}))
+	asserts.False(IsOpenSearchDashboardsMultitenancyEnabled(
+		&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Kibana: &vzapi.KibanaComponent{},
+			},
+		}}))
+	asserts.True(IsOpenSearchDashboardsMultitenancyEnabled(
+		&vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Kibana: &vzapi.KibanaComponent{
+					Multitenancy: &trueValue,
+				},
+			},
+		}}))
+	asserts.True(IsOpenSearchDashboardsMultitenancyEnabled(
+		&installv1beta1.Verrazzano{Spec: installv1beta1.VerrazzanoSpec{
+			Components: installv1beta1.ComponentSpec{
+				OpenSearchDashboards: &installv1beta1.OpenSearchDashboardsComponent{
+					Multitenancy: &trueValue,
+				},
+			},
+		}}))
+	asserts.False(IsOpenSearchDashboardsMultitenancyEnabled(
+		&installv1beta1.Verrazzano{Spec: installv1beta1.VerrazzanoSpec{
+			Components: installv1beta1.ComponentSpec{
+				OpenSearchDashboards: &installv1beta1.OpenSearchDashboardsComponent{
+					Multitenancy: &falseValue,
+				},
+			},
+		}}))
+}
+
 // TestIsPrometheusEnabled tests the IsPrometheusEnabled function
 // GIVEN a call to IsPrometheusEnabled
 //
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -31,6 +31,9 @@ func (in *Verrazzano) ConvertFrom(srcRaw conversion.Hub) error {
 	in.Status.Conditions = convertConditionsFromV1Beta1(src.Status.Conditions)
 	in.Status.Components = convertComponentStatusMapFromV1Beta1(src.Status.Components)
 	in.Status.VerrazzanoInstance = convertVerrazzanoInstanceFromV1Beta1(src.Status.VerrazzanoInstance)
+	in.Status.KeycloakRealmObjects = convertKeycloakRealmObjectStatusFromV1Beta1(src.Status.KeycloakRealmObjects)
+	in.Status.Certificates = convertCertificatesStatusFromV1Beta1(src.Status.Certificates)
+	in.Status.CARotation = convertCARotationStatusFromV1Beta1(src.Status.CARotation)
 	in.Status.Available = src.Status.Available
 	return nil
 }
@@ -74,12 +77,90 @@ func convertComponentStatusMapFromV1Beta1(components v1beta1.ComponentStatusMap)
 				Version:                  detail.Version,
 				LastReconciledGeneration: detail.LastReconciledGeneration,
 				ReconcilingGeneration:    detail.ReconcilingGeneration,
+				SnapshotRepositories:     convertSnapshotRepositoryStatusFromV1Beta1(detail.SnapshotRepositories),
+				MySQLBackup:              convertMySQLBackupStatusFromV1Beta1(detail.MySQLBackup),
 			}
 		}
 	}
 	return componentStatusMap
 }
 
+func convertSnapshotRepositoryStatusFromV1Beta1(repositories []v1beta1.SnapshotRepositoryStatus) []SnapshotRepositoryStatus {
+	var out []SnapshotRepositoryStatus
+	for _, repository := range repositories {
+		out = append(out, SnapshotRepositoryStatus{
+			Name:              repository.Name,
+			Verified:          repository.Verified,
+			LastSnapshot:      repository.LastSnapshot,
+			LastSnapshotState: repository.LastSnapshotState,
+			LastSnapshotTime:  repository.LastSnapshotTime,
+			Message:           repository.Message,
+		})
+	}
+	return out
+}
+
+func convertMySQLBackupStatusFromV1Beta1(status *v1beta1.MySQLBackupStatus) *MySQLBackupStatus {
+	if status == nil {
+		return nil
+	}
+	return &MySQLBackupStatus{
+		LastBackup:      status.LastBackup,
+		LastSuccessTime: status.LastSuccessTime,
+		Failures:        status.Failures,
+		Message:         status.Message,
+	}
+}
+
+func convertKeycloakRealmObjectStatusFromV1Beta1(objects []v1beta1.KeycloakRealmObjectStatus) []KeycloakRealmObjectStatus {
+	var out []KeycloakRealmObjectStatus
+	for _, object := range objects {
+		out = append(out, KeycloakRealmObjectStatus{
+			Kind:    object.Kind,
+			Name:    object.Name,
+			Source:  object.Source,
+			State:   KeycloakRealmObjectState(object.State),
+			Message: object.Message,
+		})
+	}
+	return out
+}
+
+func convertCertificatesStatusFromV1Beta1(in *v1beta1.CertificatesStatus) *CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := &CertificatesStatus{
+		Total:    in.Total,
+		Expiring: in.Expiring,
+		Expired:  in.Expired,
+	}
+	for _, certificate := range in.Certificates {
+		out.Certificates = append(out.Certificates, CertificateStatus{
+			Kind:      CertificateKind(certificate.Kind),
+			Namespace: certificate.Namespace,
+			Name:      certificate.Name,
+			NotAfter:  certificate.NotAfter,
+		})
+	}
+	return out
+}
+
+func convertCARotationStatusFromV1Beta1(in *v1beta1.CARotationStatus) *CARotationStatus {
+	if in == nil {
+		return nil
+	}
+	return &CARotationStatus{
+		ID:                 in.ID,
+		Phase:              CARotationPhase(in.Phase),
+		CommonName:         in.CommonName,
+		Message:            in.Message,
+		StartTime:          in.StartTime,
+		LastTransitionTime: in.LastTransitionTime,
+		CompletionTime:     in.CompletionTime,
+	}
+}
+
 func convertAvailabilityFrom(availability *v1beta1.ComponentAvailability) *ComponentAvailability {
 	if availability == nil {
 		return nil
@@ -111,8 +192,18 @@ func convertVerrazzanoInstanceFromV1Beta1(instance *v1beta1.InstanceInfo) *Insta
 
 func convertSecuritySpecFromV1Beta1(security v1beta1.SecuritySpec) SecuritySpec {
 	return SecuritySpec{
-		AdminSubjects:   security.AdminSubjects,
-		MonitorSubjects: security.MonitorSubjects,
+		AdminSubjects:         security.AdminSubjects,
+		MonitorSubjects:       security.MonitorSubjects,
+		CertificateMonitoring: convertCertificateMonitoringFromV1Beta1(security.CertificateMonitoring),
+	}
+}
+
+func convertCertificateMonitoringFromV1Beta1(in *v1beta1.CertificateMonitoring) *CertificateMonitoring {
+	if in == nil {
+		return nil
+	}
+	return &CertificateMonitoring{
+		WarningHorizons: in.WarningHorizons,
 	}
 }
 
@@ -315,7 +406,76 @@ func convertOpenSearchFromV1Beta1(in *v1beta1.OpenSearchComponent) *Elasticsearc
 		Nodes:                convertOSNodesFromV1Beta1(in.Nodes),
 		Plugins:              in.Plugins,
 		DisableDefaultPolicy: in.DisableDefaultPolicy,
+		IndexTemplates:       convertIndexTemplatesFromV1Beta1(in.IndexTemplates),
+		ComponentTemplates:   convertComponentTemplatesFromV1Beta1(in.ComponentTemplates),
+		SnapshotRepositories: convertSnapshotRepositoriesFromV1Beta1(in.SnapshotRepositories),
+		SnapshotPolicies:     convertSnapshotPoliciesFromV1Beta1(in.SnapshotPolicies),
+	}
+}
+
+func convertIndexTemplatesFromV1Beta1(in []v1beta1.OpenSearchIndexTemplate) []OpenSearchIndexTemplate {
+	var out []OpenSearchIndexTemplate
+	for _, template := range in {
+		out = append(out, OpenSearchIndexTemplate{
+			Name:          template.Name,
+			IndexPatterns: template.IndexPatterns,
+			ComposedOf:    template.ComposedOf,
+			Priority:      template.Priority,
+			DataStream:    template.DataStream,
+			Template:      template.Template,
+		})
+	}
+	return out
+}
+
+func convertComponentTemplatesFromV1Beta1(in []v1beta1.OpenSearchComponentTemplate) []OpenSearchComponentTemplate {
+	var out []OpenSearchComponentTemplate
+	for _, template := range in {
+		out = append(out, OpenSearchComponentTemplate{
+			Name:     template.Name,
+			Template: template.Template,
+		})
+	}
+	return out
+}
+
+func convertSnapshotRepositoriesFromV1Beta1(in []v1beta1.OpenSearchSnapshotRepository) []OpenSearchSnapshotRepository {
+	var out []OpenSearchSnapshotRepository
+	for _, repository := range in {
+		var s3 *OpenSearchS3Repository
+		if repository.S3 != nil {
+			s3 = &OpenSearchS3Repository{
+				Bucket:            repository.S3.Bucket,
+				BasePath:          repository.S3.BasePath,
+				Endpoint:          repository.S3.Endpoint,
+				Region:            repository.S3.Region,
+				PathStyleAccess:   repository.S3.PathStyleAccess,
+				CredentialsSecret: repository.S3.CredentialsSecret,
+			}
+		}
+		out = append(out, OpenSearchSnapshotRepository{
+			Name: repository.Name,
+			S3:   s3,
+		})
 	}
+	return out
+}
+
+func convertSnapshotPoliciesFromV1Beta1(in []v1beta1.OpenSearchSnapshotPolicy) []OpenSearchSnapshotPolicy {
+	var out []OpenSearchSnapshotPolicy
+	for _, policy := range in {
+		out = append(out, OpenSearchSnapshotPolicy{
+			Name:       policy.Name,
+			Repository: policy.Repository,
+			Schedule:   policy.Schedule,
+			TimeZone:   policy.TimeZone,
+			Indices:    policy.Indices,
+			MaxAge:     policy.MaxAge,
+			MaxCount:   policy.MaxCount,
+			MinCount:   policy.MinCount,
+		})
+	}
+	return out
 }
 
 func convertOSNodesFromV1Beta1(in []v1beta1.OpenSearchNode) []OpenSearchNode {
@@ -347,6 +507,9 @@ func convertDNSFromV1Beta1(in *v1beta1.DNSComponent) *DNSComponent {
 		Wildcard:         convertWildcardDNSFromV1Beta1(in.Wildcard),
 		OCI:              convertOCIDNSFromV1Beta1(in.OCI),
 		External:         convertExternalDNSFromV1Beta1(in.External),
+		PowerDNS:         convertPowerDNSFromV1Beta1(in.PowerDNS),
+		RFC2136:          convertRFC2136DNSFromV1Beta1(in.RFC2136),
+		Webhook:          convertWebhookDNSFromV1Beta1(in.Webhook),
 		InstallOverrides: convertInstallOverridesFromV1Beta1(in.InstallOverrides),
 	}
 }
@@ -380,6 +543,45 @@ func convertExternalDNSFromV1Beta1(external *v1beta1.External) *External {
 	return &External{Suffix: external.Suffix}
 }
 
+func convertPowerDNSFromV1Beta1(pdns *v1beta1.PowerDNS) *PowerDNS {
+	if pdns == nil {
+		return nil
+	}
+	return &PowerDNS{
+		DNSZoneName:      pdns.DNSZoneName,
+		APIURL:           pdns.APIURL,
+		APIPort:          pdns.APIPort,
+		APIKeySecret:     pdns.APIKeySecret,
+		WebhookGroupName: pdns.WebhookGroupName,
+	}
+}
+
+func convertRFC2136DNSFromV1Beta1(rfc2136 *v1beta1.RFC2136) *RFC2136 {
+	if rfc2136 == nil {
+		return nil
+	}
+	return &RFC2136{
+		DNSZoneName:   rfc2136.DNSZoneName,
+		Nameserver:    rfc2136.Nameserver,
+		Port:          rfc2136.Port,
+		TSIGKeyName:   rfc2136.TSIGKeyName,
+		TSIGAlgorithm: rfc2136.TSIGAlgorithm,
+		TSIGSecret:    rfc2136.TSIGSecret,
+	}
+}
+
+func convertWebhookDNSFromV1Beta1(webhook *v1beta1.WebhookDNS) *WebhookDNS {
+	if webhook == nil {
+		return nil
+	}
+	return &WebhookDNS{
+		DNSZoneName: webhook.DNSZoneName,
+		GroupName:   webhook.GroupName,
+		SolverName:  webhook.SolverName,
+		Config:      webhook.Config,
+	}
+}
+
 func convertFluentdFromV1Beta1(in *v1beta1.FluentdComponent) *FluentdComponent {
 	if in == nil {
 		return nil
@@ -499,12 +701,42 @@ func convertKeycloakFromV1Beta1(in *v1beta1.KeycloakComponent) *KeycloakComponen
 		MySQL: MySQLComponent{
 			VolumeSource:     in.MySQL.VolumeSource,
 			InstallOverrides: convertInstallOverridesFromV1Beta1(in.MySQL.InstallOverrides),
+			Backup:           convertMySQLBackupFromV1Beta1(in.MySQL.Backup),
 		},
 		Enabled:          in.Enabled,
 		InstallOverrides: convertInstallOverridesFromV1Beta1(in.InstallOverrides),
+		RealmObjects:     convertKeycloakRealmObjectsFromV1Beta1(in.RealmObjects),
+	}
+}
+
+func convertMySQLBackupFromV1Beta1(backup *v1beta1.MySQLBackup) *MySQLBackup {
+	if backup == nil {
+		return nil
+	}
+	return &MySQLBackup{
+		Schedule:  backup.Schedule,
+		Retention: backup.Retention,
+		Storage: MySQLBackupStorage{
+			Bucket:            backup.Storage.Bucket,
+			Prefix:            backup.Storage.Prefix,
+			Endpoint:          backup.Storage.Endpoint,
+			Region:            backup.Storage.Region,
+			CredentialsSecret: backup.Storage.CredentialsSecret,
+		},
 	}
 }
 
+func convertKeycloakRealmObjectsFromV1Beta1(sources []v1beta1.KeycloakRealmObjectsSource) []KeycloakRealmObjectsSource {
+	var out []KeycloakRealmObjectsSource
+	for _, source := range sources {
+		out = append(out, KeycloakRealmObjectsSource{
+			ConfigMapRef: source.ConfigMapRef,
+			SecretRef:    source.SecretRef,
+		})
+	}
+	return out
+}
+
 func convertOAMFromV1Beta1(in *v1beta1.OAMComponent) *OAMComponent {
 	if in == nil {
 		return nil
@@ -520,9 +752,10 @@ func convertOSDFromV1Beta1(in *v1beta1.OpenSearchDashboardsComponent) *KibanaCom
 		return nil
 	}
 	return &KibanaComponent{
-		Enabled:  in.Enabled,
-		Replicas: in.Replicas,
-		Plugins:  in.Plugins,
+		Enabled:      in.Enabled,
+		Replicas:     in.Replicas,
+		Plugins:      in.Plugins,
+		Multitenancy: in.Multitenancy,
 	}
 }
 
@@ -671,9 +904,50 @@ func convertThanosFromV1Beta1(src *v1beta1.ThanosComponent) *ThanosComponent {
 		return nil
 	}
 	return &ThanosComponent{
-		Enabled:          src.Enabled,
-		InstallOverrides: convertInstallOverridesFromV1Beta1(src.InstallOverrides),
+		Enabled:                src.Enabled,
+		Storage:                convertThanosStorageFromV1Beta1(src.Storage),
+		Retention:              convertThanosRetentionFromV1Beta1(src.Retention),
+		StoreGatewayPartitions: convertThanosTimePartitionsFromV1Beta1(src.StoreGatewayPartitions),
+		InstallOverrides:       convertInstallOverridesFromV1Beta1(src.InstallOverrides),
+	}
+}
+
+func convertThanosStorageFromV1Beta1(src *v1beta1.ThanosStorage) *ThanosStorage {
+	if src == nil {
+		return nil
 	}
+	storage := &ThanosStorage{}
+	if src.S3 != nil {
+		storage.S3 = &ThanosS3Storage{
+			Bucket:            src.S3.Bucket,
+			Endpoint:          src.S3.Endpoint,
+			Region:            src.S3.Region,
+			CredentialsSecret: src.S3.CredentialsSecret,
+		}
+	}
+	return storage
+}
+
+func convertThanosRetentionFromV1Beta1(src *v1beta1.ThanosRetention) *ThanosRetention {
+	if src == nil {
+		return nil
+	}
+	return &ThanosRetention{
+		Raw:         src.Raw,
+		FiveMinutes: src.FiveMinutes,
+		OneHour:     src.OneHour,
+	}
+}
+
+func convertThanosTimePartitionsFromV1Beta1(src []v1beta1.ThanosTimePartition) []ThanosTimePartition {
+	var out []ThanosTimePartition
+	for _, partition := range src {
+		out = append(out, ThanosTimePartition{
+			MinTime: partition.MinTime,
+			MaxTime: partition.MaxTime,
+		})
+	}
+	return out
 }
 
 func convertInstallOverridesFromV1Beta1(in v1beta1.InstallOverrides) InstallOverrides {
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1alpha1
@@ -72,6 +72,9 @@ func (in *Verrazzano) ConvertTo(dstRaw conversion.Hub) error {
 	out.Status.Conditions = convertConditionsTo(in.Status.Conditions)
 	out.Status.Components = convertComponentStatusMapTo(in.Status.Components)
 	out.Status.VerrazzanoInstance = convertVerrazzanoInstanceTo(in.Status.VerrazzanoInstance)
+	out.Status.KeycloakRealmObjects = convertKeycloakRealmObjectStatusTo(in.Status.KeycloakRealmObjects)
+	out.Status.Certificates = convertCertificatesStatusTo(in.Status.Certificates)
+	out.Status.CARotation = convertCARotationStatusTo(in.Status.CARotation)
 	out.Status.Available = in.Status.Available
 	return nil
 }
@@ -319,6 +322,9 @@ func convertDNSToV1Beta1(src *DNSComponent) *v1beta1.DNSComponent {
 		Wildcard:         convertWildcardDNSToV1Beta1(src.Wildcard),
 		OCI:              convertOCIDNSToV1Beta1(src.OCI),
 		External:         convertExternalDNSToV1Beta1(src.External),
+		PowerDNS:         convertPowerDNSToV1Beta1(src.PowerDNS),
+		RFC2136:          convertRFC2136DNSToV1Beta1(src.RFC2136),
+		Webhook:          convertWebhookDNSToV1Beta1(src.Webhook),
 		InstallOverrides: convertInstallOverridesToV1Beta1(src.InstallOverrides),
 	}
 }
@@ -352,6 +358,45 @@ func convertExternalDNSToV1Beta1(external *External) *v1beta1.External {
 	return &v1beta1.External{Suffix: external.Suffix}
 }
 
+func convertPowerDNSToV1Beta1(pdns *PowerDNS) *v1beta1.PowerDNS {
+	if pdns == nil {
+		return nil
+	}
+	return &v1beta1.PowerDNS{
+		DNSZoneName:      pdns.DNSZoneName,
+		APIURL:           pdns.APIURL,
+		APIPort:          pdns.APIPort,
+		APIKeySecret:     pdns.APIKeySecret,
+		WebhookGroupName: pdns.WebhookGroupName,
+	}
+}
+
+func convertRFC2136DNSToV1Beta1(rfc2136 *RFC2136) *v1beta1.RFC2136 {
+	if rfc2136 == nil {
+		return nil
+	}
+	return &v1beta1.RFC2136{
+		DNSZoneName:   rfc2136.DNSZoneName,
+		Nameserver:    rfc2136.Nameserver,
+		Port:          rfc2136.Port,
+		TSIGKeyName:   rfc2136.TSIGKeyName,
+		TSIGAlgorithm: rfc2136.TSIGAlgorithm,
+		TSIGSecret:    rfc2136.TSIGSecret,
+	}
+}
+
+func convertWebhookDNSToV1Beta1(webhook *WebhookDNS) *v1beta1.WebhookDNS {
+	if webhook == nil {
+		return nil
+	}
+	return &v1beta1.WebhookDNS{
+		DNSZoneName: webhook.DNSZoneName,
+		GroupName:   webhook.GroupName,
+		SolverName:  webhook.SolverName,
+		Config:      webhook.Config,
+	}
+}
+
 func convertOpenSearchToV1Beta1(src *ElasticsearchComponent) (*v1beta1.OpenSearchComponent, error) {
 	if src == nil {
 		return nil, nil
@@ -366,9 +411,78 @@ func convertOpenSearchToV1Beta1(src *ElasticsearchComponent) (*v1beta1.OpenSearc
 		Nodes:                nodes,
 		Plugins:              src.Plugins,
 		DisableDefaultPolicy: src.DisableDefaultPolicy,
+		IndexTemplates:       convertIndexTemplatesToV1Beta1(src.IndexTemplates),
+		ComponentTemplates:   convertComponentTemplatesToV1Beta1(src.ComponentTemplates),
+		SnapshotRepositories: convertSnapshotRepositoriesToV1Beta1(src.SnapshotRepositories),
+		SnapshotPolicies:     convertSnapshotPoliciesToV1Beta1(src.SnapshotPolicies),
 	}, nil
 }
 
+func convertIndexTemplatesToV1Beta1(templates []OpenSearchIndexTemplate) []v1beta1.OpenSearchIndexTemplate {
+	var out []v1beta1.OpenSearchIndexTemplate
+	for _, template := range templates {
+		out = append(out, v1beta1.OpenSearchIndexTemplate{
+			Name:          template.Name,
+			IndexPatterns: template.IndexPatterns,
+			ComposedOf:    template.ComposedOf,
+			Priority:      template.Priority,
+			DataStream:    template.DataStream,
+			Template:      template.Template,
+		})
+	}
+	return out
+}
+
+func convertComponentTemplatesToV1Beta1(templates []OpenSearchComponentTemplate) []v1beta1.OpenSearchComponentTemplate {
+	var out []v1beta1.OpenSearchComponentTemplate
+	for _, template := range templates {
+		out = append(out, v1beta1.OpenSearchComponentTemplate{
+			Name:     template.Name,
+			Template: template.Template,
+		})
+	}
+	return out
+}
+
+func convertSnapshotRepositoriesToV1Beta1(repositories []OpenSearchSnapshotRepository) []v1beta1.OpenSearchSnapshotRepository {
+	var out []v1beta1.OpenSearchSnapshotRepository
+	for _, repository := range repositories {
+		var s3 *v1beta1.OpenSearchS3Repository
+		if repository.S3 != nil {
+			s3 = &v1beta1.OpenSearchS3Repository{
+				Bucket:            repository.S3.Bucket,
+				BasePath:          repository.S3.BasePath,
+				Endpoint:          repository.S3.Endpoint,
+				Region:            repository.S3.Region,
+				PathStyleAccess:   repository.S3.PathStyleAccess,
+				CredentialsSecret: repository.S3.CredentialsSecret,
+			}
+		}
+		out = append(out, v1beta1.OpenSearchSnapshotRepository{
+			Name: repository.Name,
+			S3:   s3,
+		})
+	}
+	return out
+}
+
+func convertSnapshotPoliciesToV1Beta1(policies []OpenSearchSnapshotPolicy) []v1beta1.OpenSearchSnapshotPolicy {
+	var out []v1beta1.OpenSearchSnapshotPolicy
+	for _, policy := range policies {
+		out = append(out, v1beta1.OpenSearchSnapshotPolicy{
+			Name:       policy.Name,
+			Repository: policy.Repository,
+			Schedule:   policy.Schedule,
+			TimeZone:   policy.TimeZone,
+			Indices:    policy.Indices,
+			MaxAge:     policy.MaxAge,
+			MaxCount:   policy.MaxCount,
+			MinCount:   policy.MinCount,
+		})
+	}
+	return out
+}
+
 func convertOSNodesToV1Beta1(args []InstallArgs, nodes []OpenSearchNode) ([]v1beta1.OpenSearchNode, error) {
 	var out []v1beta1.OpenSearchNode
 	installArgNodes, err := convertInstallArgsToOSNodes(args)
@@ -685,12 +799,42 @@ func convertKeycloakToV1Beta1(src *KeycloakComponent) (*v1beta1.KeycloakComponen
 		MySQL: v1beta1.MySQLComponent{
 			VolumeSource:     src.MySQL.VolumeSource,
 			InstallOverrides: mysqlOverrides,
+			Backup:           convertMySQLBackupToV1Beta1(src.MySQL.Backup),
 		},
 		Enabled:          src.Enabled,
 		InstallOverrides: keycloakOverrides,
+		RealmObjects:     convertKeycloakRealmObjectsToV1Beta1(src.RealmObjects),
 	}, nil
 }
 
+func convertMySQLBackupToV1Beta1(backup *MySQLBackup) *v1beta1.MySQLBackup {
+	if backup == nil {
+		return nil
+	}
+	return &v1beta1.MySQLBackup{
+		Schedule:  backup.Schedule,
+		Retention: backup.Retention,
+		Storage: v1beta1.MySQLBackupStorage{
+			Bucket:            backup.Storage.Bucket,
+			Prefix:            backup.Storage.Prefix,
+			Endpoint:          backup.Storage.Endpoint,
+			Region:            backup.Storage.Region,
+			CredentialsSecret: backup.Storage.CredentialsSecret,
+		},
+	}
+}
+
+func convertKeycloakRealmObjectsToV1Beta1(sources []KeycloakRealmObjectsSource) []v1beta1.KeycloakRealmObjectsSource {
+	var out []v1beta1.KeycloakRealmObjectsSource
+	for _, source := range sources {
+		out = append(out, v1beta1.KeycloakRealmObjectsSource{
+			ConfigMapRef: source.ConfigMapRef,
+			SecretRef:    source.SecretRef,
+		})
+	}
+	return out
+}
+
 func convertMySQLOperatorToV1Beta1(src *MySQLOperatorComponent) *v1beta1.MySQLOperatorComponent {
 	if src == nil {
 		return nil
@@ -706,9 +850,10 @@ func convertOSDToV1Beta1(src *KibanaComponent) *v1beta1.OpenSearchDashboardsComp
 		return nil
 	}
 	return &v1beta1.OpenSearchDashboardsComponent{
-		Enabled:  src.Enabled,
-		Replicas: src.Replicas,
-		Plugins:  src.Plugins,
+		Enabled:      src.Enabled,
+		Replicas:     src.Replicas,
+		Plugins:      src.Plugins,
+		Multitenancy: src.Multitenancy,
 	}
 }
 
@@ -871,11 +1016,52 @@ func convertThanosToV1Beta1(src *ThanosComponent) *v1beta1.ThanosComponent {
 		return nil
 	}
 	return &v1beta1.ThanosComponent{
-		Enabled:          src.Enabled,
-		InstallOverrides: convertInstallOverridesToV1Beta1(src.InstallOverrides),
+		Enabled:                src.Enabled,
+		Storage:                convertThanosStorageToV1Beta1(src.Storage),
+		Retention:              convertThanosRetentionToV1Beta1(src.Retention),
+		StoreGatewayPartitions: convertThanosTimePartitionsToV1Beta1(src.StoreGatewayPartitions),
+		InstallOverrides:       convertInstallOverridesToV1Beta1(src.InstallOverrides),
+	}
+}
+
+func convertThanosStorageToV1Beta1(src *ThanosStorage) *v1beta1.ThanosStorage {
+	if src == nil {
+		return nil
+	}
+	storage := &v1beta1.ThanosStorage{}
+	if src.S3 != nil {
+		storage.S3 = &v1beta1.ThanosS3Storage{
+			Bucket:            src.S3.Bucket,
+			Endpoint:          src.S3.Endpoint,
+			Region:            src.S3.Region,
+			CredentialsSecret: src.S3.CredentialsSecret,
+		}
+	}
+	return storage
+}
+
+func convertThanosRetentionToV1Beta1(src *ThanosRetention) *v1beta1.ThanosRetention {
+	if src == nil {
+		return nil
+	}
+	return &v1beta1.ThanosRetention{
+		Raw:         src.Raw,
+		FiveMinutes: src.FiveMinutes,
+		OneHour:     src.OneHour,
 	}
 }
 
+func convertThanosTimePartitionsToV1Beta1(src []ThanosTimePartition) []v1beta1.ThanosTimePartition {
+	var out []v1beta1.ThanosTimePartition
+	for _, partition := range src {
+		out = append(out, v1beta1.ThanosTimePartition{
+			MinTime: partition.MinTime,
+			MaxTime: partition.MaxTime,
+		})
+	}
+	return out
+}
+
 func convertDexToV1Beta1(src *DexComponent) *v1beta1.DexComponent {
 	if src == nil {
 		return nil
@@ -914,12 +1100,41 @@ func convertComponentStatusMapTo(components ComponentStatusMap) v1beta1.Componen
 				Version:                  detail.Version,
 				LastReconciledGeneration: detail.LastReconciledGeneration,
 				ReconcilingGeneration:    detail.ReconcilingGeneration,
+				SnapshotRepositories:     convertSnapshotRepositoryStatusTo(detail.SnapshotRepositories),
+				MySQLBackup:              convertMySQLBackupStatusTo(detail.MySQLBackup),
 			}
 		}
 	}
 	return componentStatusMap
 }
 
+func convertSnapshotRepositoryStatusTo(repositories []SnapshotRepositoryStatus) []v1beta1.SnapshotRepositoryStatus {
+	var out []v1beta1.SnapshotRepositoryStatus
+	for _, repository := range repositories {
+		out = append(out, v1beta1.SnapshotRepositoryStatus{
+			Name:              repository.Name,
+			Verified:          repository.Verified,
+			LastSnapshot:      repository.LastSnapshot,
+			LastSnapshotState: repository.LastSnapshotState,
+			LastSnapshotTime:  repository.LastSnapshotTime,
+			Message:           repository.Message,
+		})
+	}
+	return out
+}
+
+func convertMySQLBackupStatusTo(status *MySQLBackupStatus) *v1beta1.MySQLBackupStatus {
+	if status == nil {
+		return nil
+	}
+	return &v1beta1.MySQLBackupStatus{
+		LastBackup:      status.LastBackup,
+		LastSuccessTime: status.LastSuccessTime,
+		Failures:        status.Failures,
+		Message:         status.Message,
+	}
+}
+
 func convertAvailabilityTo(availability *ComponentAvailability) *v1beta1.ComponentAvailability {
 	if availability == nil {
 		return nil
@@ -928,6 +1143,20 @@ func convertAvailabilityTo(availability *ComponentAvailability) *v1beta1.Compone
 	return &newAvailability
 }
 
+func convertKeycloakRealmObjectStatusTo(objects []KeycloakRealmObjectStatus) []v1beta1.KeycloakRealmObjectStatus {
+	var out []v1beta1.KeycloakRealmObjectStatus
+	for _, object := range objects {
+		out = append(out, v1beta1.KeycloakRealmObjectStatus{
+			Kind:    object.Kind,
+			Name:    object.Name,
+			Source:  object.Source,
+			State:   v1beta1.KeycloakRealmObjectState(object.State),
+			Message: object.Message,
+		})
+	}
+	return out
+}
+
 func convertVerrazzanoInstanceTo(instance *InstanceInfo) *v1beta1.InstanceInfo {
 	if instance == nil {
 		return nil
@@ -951,8 +1180,53 @@ func convertVerrazzanoInstanceTo(instance *InstanceInfo) *v1beta1.InstanceInfo {
 
 func convertSecuritySpecTo(security SecuritySpec) v1beta1.SecuritySpec {
 	return v1beta1.SecuritySpec{
-		AdminSubjects:   security.AdminSubjects,
-		MonitorSubjects: security.MonitorSubjects,
+		AdminSubjects:         security.AdminSubjects,
+		MonitorSubjects:       security.MonitorSubjects,
+		CertificateMonitoring: convertCertificateMonitoringTo(security.CertificateMonitoring),
+	}
+}
+
+func convertCertificateMonitoringTo(in *CertificateMonitoring) *v1beta1.CertificateMonitoring {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.CertificateMonitoring{
+		WarningHorizons: in.WarningHorizons,
+	}
+}
+
+func convertCertificatesStatusTo(in *CertificatesStatus) *v1beta1.CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := &v1beta1.CertificatesStatus{
+		Total:    in.Total,
+		Expiring: in.Expiring,
+		Expired:  in.Expired,
+	}
+	for _, certificate := range in.Certificates {
+		out.Certificates = append(out.Certificates, v1beta1.CertificateStatus{
+			Kind:      v1beta1.CertificateKind(certificate.Kind),
+			Namespace: certificate.Namespace,
+			Name:      certificate.Name,
+			NotAfter:  certificate.NotAfter,
+		})
+	}
+	return out
+}
+
+func convertCARotationStatusTo(in *CARotationStatus) *v1beta1.CARotationStatus {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.CARotationStatus{
+		ID:                 in.ID,
+		Phase:              v1beta1.CARotationPhase(in.Phase),
+		CommonName:         in.CommonName,
+		Message:            in.Message,
+		StartTime:          in.StartTime,
+		LastTransitionTime: in.LastTransitionTime,
+		CompletionTime:     in.CompletionTime,
 	}
 }
 
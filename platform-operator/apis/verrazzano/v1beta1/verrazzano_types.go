@@ -1,4 +1,4 @@
-// Copyright (c) 2020, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package v1beta1
@@ -102,6 +102,18 @@ type SecuritySpec struct {
 	// Specifies subjects that should be bound to the verrazzano-monitor role.
 	// +optional
 	MonitorSubjects []rbacv1.Subject `json:"monitorSubjects,omitempty"`
+	// Configures the expiry monitoring of the certificates managed by Verrazzano.
+	// +optional
+	CertificateMonitoring *CertificateMonitoring `json:"certificateMonitoring,omitempty"`
+}
+
+// CertificateMonitoring configures the expiry monitoring of the cert-manager Certificates, TLS secrets, CA bundles,
+// webhook CA bundles, and managed cluster CA secrets managed by Verrazzano.
+type CertificateMonitoring struct {
+	// The remaining validity periods at which a warning event is emitted for a certificate, for example `720h`.
+	// The default is `720h`, `168h`, and `24h`.
+	// +optional
+	WarningHorizons []metav1.Duration `json:"warningHorizons,omitempty"`
 }
 
 // VolumeClaimSpecTemplate Contains common PVC configuration that can be referenced from Components; these
@@ -109,7 +121,6 @@ type SecuritySpec struct {
 // declare a PersistentVolumeClaimVolumeSource.
 type VolumeClaimSpecTemplate struct {
 	// Metadata about the PersistentVolumeClaimSpec template.
-	// +kubebuilder:pruning:PreserveUnknownFields
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// A `PersistentVolumeClaimSpec` template that can be referenced by a Component to override its default storage
 	// settings for a profile. At present, only a subset of the `resources.requests` object are honored depending on
@@ -152,6 +163,10 @@ type InstanceInfo struct {
 type VerrazzanoStatus struct {
 	// The summary of Verrazzano component availability.
 	Available *string `json:"available,omitempty"`
+	// The status of the last rotation of the Verrazzano self-signed CA.
+	CARotation *CARotationStatus `json:"caRotation,omitempty"`
+	// The summary of the certificates managed by Verrazzano.
+	Certificates *CertificatesStatus `json:"certificates,omitempty"`
 	// States of the individual installed components.
 	Components ComponentStatusMap `json:"components,omitempty"`
 	// The latest available observations of an object's current state.
@@ -160,10 +175,122 @@ type VerrazzanoStatus struct {
 	State VzStateType `json:"state,omitempty"`
 	// The Verrazzano instance info.
 	VerrazzanoInstance *InstanceInfo `json:"instance,omitempty"`
+	// The status of the Keycloak realm objects declared by the Keycloak component.
+	KeycloakRealmObjects []KeycloakRealmObjectStatus `json:"keycloakRealmObjects,omitempty"`
 	// The version of Verrazzano that is installed.
 	Version string `json:"version,omitempty"`
 }
 
+// KeycloakRealmObjectState identifies the state of a declared Keycloak realm object.
+type KeycloakRealmObjectState string
+
+const (
+	// KeycloakRealmObjectReady means the object was created or updated in Keycloak.
+	KeycloakRealmObjectReady KeycloakRealmObjectState = "Ready"
+	// KeycloakRealmObjectFailed means the object could not be created, updated, or deleted in Keycloak.
+	KeycloakRealmObjectFailed KeycloakRealmObjectState = "Failed"
+)
+
+// CertificateKind identifies the source of a certificate managed by Verrazzano.
+type CertificateKind string
+
+const (
+	// CertificateKindCertificate is a cert-manager Certificate.
+	CertificateKindCertificate CertificateKind = "Certificate"
+	// CertificateKindSecret is a TLS secret that is not issued by cert-manager.
+	CertificateKindSecret CertificateKind = "Secret"
+	// CertificateKindCABundle is a secret holding a bundle of CA certificates.
+	CertificateKindCABundle CertificateKind = "CABundle"
+	// CertificateKindWebhook is the CA bundle of a webhook configuration.
+	CertificateKindWebhook CertificateKind = "Webhook"
+	// CertificateKindManagedClusterCA is the CA secret of a VerrazzanoManagedCluster.
+	CertificateKindManagedClusterCA CertificateKind = "ManagedClusterCA"
+)
+
+// CertificatesStatus summarizes the certificates managed by Verrazzano.
+type CertificatesStatus struct {
+	// The number of certificates managed by Verrazzano.
+	Total int `json:"total"`
+	// The number of certificates that expire within the largest warning horizon.
+	Expiring int `json:"expiring"`
+	// The number of expired certificates.
+	Expired int `json:"expired"`
+	// The expired and expiring certificates, the first to expire first.
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+}
+
+// CertificateStatus defines the expiry of a certificate managed by Verrazzano.
+type CertificateStatus struct {
+	// The source of the certificate.
+	Kind CertificateKind `json:"kind"`
+	// The namespace of the certificate source, empty for cluster-scoped sources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// The name of the certificate source.
+	Name string `json:"name"`
+	// The expiry time of the certificate. For bundles, the expiry time of the first certificate to expire.
+	NotAfter metav1.Time `json:"notAfter"`
+}
+
+// CARotationPhase identifies the phase of a rotation of the Verrazzano self-signed CA.
+type CARotationPhase string
+
+const (
+	// CARotationPhaseIssuingCA means the new CA is being issued. The previous CA is kept in the Verrazzano CA bundle.
+	CARotationPhaseIssuingCA CARotationPhase = "IssuingCA"
+	// CARotationPhaseReissuingCertificates means the certificates issued by the previous CA are being reissued by the new CA.
+	CARotationPhaseReissuingCertificates CARotationPhase = "ReissuingCertificates"
+	// CARotationPhaseDistributingBundle means the rotation waits for Verrazzano to be ready and for the managed clusters to
+	// synchronize the CA bundle.
+	CARotationPhaseDistributingBundle CARotationPhase = "DistributingBundle"
+	// CARotationPhaseCompleted means the previous CA was removed from the Verrazzano CA bundle.
+	CARotationPhaseCompleted CARotationPhase = "Completed"
+	// CARotationPhaseFailed means the CA could not be rotated.
+	CARotationPhaseFailed CARotationPhase = "Failed"
+)
+
+// CARotationStatus defines the observed state of a rotation of the Verrazzano self-signed CA.
+type CARotationStatus struct {
+	// The value of the `verrazzano.io/rotate-ca` annotation that requested the rotation.
+	ID string `json:"id"`
+	// The phase of the rotation.
+	Phase CARotationPhase `json:"phase"`
+	// The common name of the new CA.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+	// Information about the current phase of the rotation.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// The time at which the rotation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// The time at which the current phase started.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The time at which the rotation completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// KeycloakRealmObjectStatus defines the observed state of a declared Keycloak realm object.
+type KeycloakRealmObjectStatus struct {
+	// The kind of the object: `RealmRole`, `Client`, `ClientRole`, `Group`, `IdentityProvider`, `UserFederation`,
+	// or `User`.
+	// The kind is `Source` when the ConfigMap or Secret can not be read.
+	Kind string `json:"kind"`
+	// The name of the object, which is the client ID of clients, the path of groups, the alias of identity providers,
+	// and the username of users.
+	Name string `json:"name"`
+	// The ConfigMap or Secret key that declares the object. It is empty for objects that are no longer declared.
+	Source string `json:"source"`
+	// The state of the object.
+	State KeycloakRealmObjectState `json:"state"`
+	// Information about the failure of the object.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // ComponentStatusMap is a map of components status details.
 type ComponentStatusMap map[string]*ComponentStatusDetails
 
@@ -183,6 +310,38 @@ type ComponentStatusDetails struct {
 	State CompStateType `json:"state,omitempty"`
 	// The version of a component.
 	Version string `json:"version,omitempty"`
+	// The health of the snapshot repositories managed by the component. Only reported for OpenSearch.
+	SnapshotRepositories []SnapshotRepositoryStatus `json:"snapshotRepositories,omitempty"`
+	// The state of the scheduled backups of the database. Only reported for MySQL.
+	MySQLBackup *MySQLBackupStatus `json:"mysqlBackup,omitempty"`
+}
+
+// MySQLBackupStatus is the state of the scheduled backups of the Keycloak MySQL database.
+type MySQLBackupStatus struct {
+	// Name of the most recent completed backup.
+	LastBackup string `json:"lastBackup,omitempty"`
+	// The time the most recent completed backup ended.
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+	// The number of backups that failed since the most recent completed backup.
+	Failures int32 `json:"failures,omitempty"`
+	// Details about the most recent failed backup.
+	Message string `json:"message,omitempty"`
+}
+
+// SnapshotRepositoryStatus is the health of an OpenSearch snapshot repository.
+type SnapshotRepositoryStatus struct {
+	// Name of the snapshot repository.
+	Name string `json:"name"`
+	// True if every OpenSearch node can access the repository.
+	Verified bool `json:"verified"`
+	// Name of the most recent snapshot in the repository.
+	LastSnapshot string `json:"lastSnapshot,omitempty"`
+	// State of the most recent snapshot, for example `SUCCESS`, `PARTIAL`, or `FAILED`.
+	LastSnapshotState string `json:"lastSnapshotState,omitempty"`
+	// The time the most recent snapshot ended.
+	LastSnapshotTime *metav1.Time `json:"lastSnapshotTime,omitempty"`
+	// Details about an unhealthy repository.
+	Message string `json:"message,omitempty"`
 }
 
 // ConditionType identifies the condition of the install, uninstall, or upgrade, which can be checked with `kubectl wait`.
@@ -511,6 +670,112 @@ type OpenSearchComponent struct {
 	Plugins vmov1.OpenSearchPlugins `json:"plugins,omitempty"`
 	// To disable the default ISM policies.
 	DisableDefaultPolicy bool `json:"disableDefaultPolicy,omitempty"`
+	// A list of index templates to create or update in OpenSearch.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	IndexTemplates []OpenSearchIndexTemplate `json:"indexTemplates,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+	// A list of component templates to create or update in OpenSearch.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	ComponentTemplates []OpenSearchComponentTemplate `json:"componentTemplates,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+	// A list of snapshot repositories to register in OpenSearch.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	SnapshotRepositories []OpenSearchSnapshotRepository `json:"snapshotRepositories,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+	// A list of snapshot policies that take and delete snapshots on a schedule.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge,retainKeys
+	SnapshotPolicies []OpenSearchSnapshotPolicy `json:"snapshotPolicies,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+}
+
+// OpenSearchIndexTemplate specifies a composable <a href="https://opensearch.org/docs/2.3/opensearch/index-templates/">index template</a>
+// that is created or updated in OpenSearch.
+type OpenSearchIndexTemplate struct {
+	// Name of the index template.
+	Name string `json:"name"`
+	// The index patterns that the template applies to.
+	IndexPatterns []string `json:"indexPatterns"`
+	// The names of the component templates, in order, that the index template is composed of.
+	// +optional
+	ComposedOf []string `json:"composedOf,omitempty"`
+	// Priority of the template when more than one index template matches an index.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// If true, then indices matching the index patterns are created as data streams.
+	// +optional
+	DataStream bool `json:"dataStream,omitempty"`
+	// The settings, mappings and aliases applied to matching indices.
+	// +optional
+	Template *apiextensionsv1.JSON `json:"template,omitempty"`
+}
+
+// OpenSearchComponentTemplate specifies a component template, the building block of index templates,
+// that is created or updated in OpenSearch.
+type OpenSearchComponentTemplate struct {
+	// Name of the component template.
+	Name string `json:"name"`
+	// The settings, mappings and aliases contributed by the component template.
+	Template *apiextensionsv1.JSON `json:"template"`
+}
+
+// OpenSearchSnapshotRepository specifies a snapshot repository that is registered in OpenSearch.
+type OpenSearchSnapshotRepository struct {
+	// Name of the snapshot repository.
+	Name string `json:"name"`
+	// An S3-compatible object store that holds the snapshots. The OpenSearch image must include the
+	// `repository-s3` plugin, for example by adding it to the OpenSearch plugins list.
+	S3 *OpenSearchS3Repository `json:"s3"`
+}
+
+// OpenSearchS3Repository specifies the location and credentials of an S3-compatible snapshot repository.
+type OpenSearchS3Repository struct {
+	// Name of the bucket that holds the snapshots.
+	Bucket string `json:"bucket"`
+	// The path within the bucket under which the snapshots are stored.
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+	// The endpoint of the S3-compatible object store. Defaults to the Amazon S3 endpoint.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// The region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// If true, then path-style access is used for the bucket instead of virtual-hosted-style access.
+	// +optional
+	PathStyleAccess bool `json:"pathStyleAccess,omitempty"`
+	// The name of a Secret in the `verrazzano-install` namespace, with the keys `access_key` and `secret_key`,
+	// that holds the object store credentials.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// OpenSearchSnapshotPolicy specifies a <a href="https://opensearch.org/docs/latest/tuning-your-cluster/availability-and-recovery/snapshots/snapshot-management/">Snapshot Management</a>
+// policy that takes and deletes snapshots on a schedule.
+type OpenSearchSnapshotPolicy struct {
+	// Name of the snapshot policy.
+	Name string `json:"name"`
+	// Name of the snapshot repository where the snapshots are stored.
+	Repository string `json:"repository"`
+	// A cron expression for when snapshots are taken.
+	Schedule string `json:"schedule"`
+	// The time zone of the schedule. Defaults to `UTC`.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// The index patterns to include in the snapshots. Defaults to all indices.
+	// +optional
+	Indices []string `json:"indices,omitempty"`
+	// Snapshots older than this age, for example `7d`, are deleted.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+	// The maximum number of snapshots to keep.
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+	// The minimum number of snapshots to keep, regardless of their age.
+	// +optional
+	MinCount *int32 `json:"minCount,omitempty"`
 }
 
 // OpenSearchNode specifies a node group in the OpenSearch cluster.
@@ -549,6 +814,10 @@ type OpenSearchDashboardsComponent struct {
 	// Enable to add 3rd Party / Custom plugins not offered in the default OpenSearch-Dashboard image
 	// +optional
 	Plugins vmov1.OpenSearchDashboardsPlugins `json:"plugins,omitempty"`
+	// If true, then OpenSearch Dashboards multitenancy is enabled, and each VerrazzanoProject gets its own Dashboards
+	// tenant. The default is `false`.
+	// +optional
+	Multitenancy *bool `json:"multitenancy,omitempty"`
 }
 
 // KubeStateMetricsComponent specifies the kube-state-metrics configuration.
@@ -859,6 +1128,15 @@ type DNSComponent struct {
 	// Oracle Cloud Infrastructure DNS configuration.
 	// +optional
 	OCI *OCI `json:"oci,omitempty"`
+	// PowerDNS configuration.
+	// +optional
+	PowerDNS *PowerDNS `json:"powerDNS,omitempty"`
+	// RFC 2136 DNS configuration, for example a BIND server accepting dynamic updates.
+	// +optional
+	RFC2136 *RFC2136 `json:"rfc2136,omitempty"`
+	// Webhook DNS configuration, for a DNS provider supported by a cert-manager webhook solver.
+	// +optional
+	Webhook *WebhookDNS `json:"webhook,omitempty"`
 	// Wildcard DNS configuration. This is the default with a domain of nip.io.
 	// +optional
 	Wildcard *Wildcard `json:"wildcard,omitempty"`
@@ -943,6 +1221,24 @@ type KeycloakComponent struct {
 	// Contains the MySQL component configuration needed for Keycloak.
 	// +optional
 	MySQL MySQLComponent `json:"mysql,omitempty"`
+	// List of ConfigMaps and Secrets that declare additional clients, groups, users, role mappings, and identity
+	// providers of the `verrazzano-system` realm. The declared objects are reconciled every time Keycloak is
+	// reconciled. Objects created from a declaration are deleted, and declared role mappings are revoked, when they
+	// are no longer declared.
+	// +optional
+	RealmObjects []KeycloakRealmObjectsSource `json:"realmObjects,omitempty"`
+}
+
+// KeycloakRealmObjectsSource identifies a ConfigMap or Secret key, in the namespace of the Verrazzano resource,
+// which contains a YAML declaration of Keycloak realm objects.
+type KeycloakRealmObjectsSource struct {
+	// Selector for a ConfigMap key containing realm objects.
+	// +optional
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+	// Selector for a Secret key containing realm objects. Use a Secret when the objects contain credentials, for
+	// example, the bind credential of an LDAP provider or the client secret of an identity provider.
+	// +optional
+	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
 }
 
 // MySQLComponent specifies the MySQL configuration.
@@ -961,6 +1257,39 @@ type MySQLComponent struct {
 	// +optional
 	// +patchStrategy=replace
 	VolumeSource *corev1.VolumeSource `json:"volumeSource,omitempty" patchStrategy:"replace"`
+	// Scheduled backups of the Keycloak MySQL database to an S3 compatible object storage.
+	// +optional
+	Backup *MySQLBackup `json:"backup,omitempty"`
+}
+
+// MySQLBackup defines scheduled backups of the Keycloak MySQL database. The backups are taken by the MySQL Operator,
+// which dumps the database to the object storage.
+type MySQLBackup struct {
+	// The schedule of the backups in cron format, for example `0 2 * * *`.
+	Schedule string `json:"schedule"`
+	// The number of completed backups that are kept. Older backups are deleted with their dump in the object storage.
+	// The default is 7.
+	// +optional
+	Retention *int32 `json:"retention,omitempty"`
+	// The S3 compatible object storage where the backups are stored.
+	Storage MySQLBackupStorage `json:"storage"`
+}
+
+// MySQLBackupStorage defines an S3 compatible object storage bucket.
+type MySQLBackupStorage struct {
+	// The name of the bucket.
+	Bucket string `json:"bucket"`
+	// The folder of the bucket where the backups are stored.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// The URL of the S3 compatible object storage endpoint.
+	Endpoint string `json:"endpoint"`
+	// The region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// The name of the secret, in the verrazzano-install namespace, holding the access key and secret key of the
+	// object storage in the `access_key` and `secret_key` keys.
+	CredentialsSecret string `json:"credentialsSecret"`
 }
 
 // MySQLOperatorComponent specifies the MySQL Operator configuration.
@@ -1106,6 +1435,17 @@ type ThanosComponent struct {
 	// If true, then Thanos will be installed.
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
+	// The object storage where the Prometheus Thanos sidecar uploads the metrics for long-term storage. When
+	// specified, the Thanos Compactor and Store Gateway are enabled.
+	// +optional
+	Storage *ThanosStorage `json:"storage,omitempty"`
+	// The retention of the metrics in the object storage, per downsampling resolution. Requires the object storage.
+	// +optional
+	Retention *ThanosRetention `json:"retention,omitempty"`
+	// The time partitions of the metrics in the object storage. A Store Gateway is deployed for each partition.
+	// Requires the object storage.
+	// +optional
+	StoreGatewayPartitions []ThanosTimePartition `json:"storeGatewayPartitions,omitempty"`
 	// List of overrides for the default `values.yaml` file for the component Helm chart. Overrides are merged together,
 	// but in the event of conflicting fields, the last override in the list takes precedence over any others. You can
 	// find all possible values
@@ -1115,6 +1455,52 @@ type ThanosComponent struct {
 	InstallOverrides `json:",inline"`
 }
 
+// ThanosStorage specifies the object storage of the Thanos metrics.
+type ThanosStorage struct {
+	// An S3-compatible object storage.
+	S3 *ThanosS3Storage `json:"s3"`
+}
+
+// ThanosS3Storage specifies the location and credentials of an S3-compatible object storage bucket.
+type ThanosS3Storage struct {
+	// The name of the bucket.
+	Bucket string `json:"bucket"`
+	// The URL of the S3 compatible object storage endpoint, for example `https://s3.us-ashburn-1.amazonaws.com`.
+	// An `http` URL disables TLS.
+	Endpoint string `json:"endpoint"`
+	// The region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// The name of the secret, in the verrazzano-install namespace, holding the access key and secret key of the
+	// object storage in the `access_key` and `secret_key` keys.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// ThanosRetention specifies how long the Thanos Compactor keeps the metrics of each downsampling resolution, as a
+// duration such as `30d` or `1y`. A retention of `0d` keeps the metrics forever.
+type ThanosRetention struct {
+	// The retention of the raw metrics. The default is `30d`.
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// The retention of the metrics downsampled to 5 minutes. The default is `30d`.
+	// +optional
+	FiveMinutes string `json:"fiveMinutes,omitempty"`
+	// The retention of the metrics downsampled to 1 hour. The default is `10y`.
+	// +optional
+	OneHour string `json:"oneHour,omitempty"`
+}
+
+// ThanosTimePartition specifies the time range of the metrics served by a Store Gateway. The times are either
+// RFC 3339 times or durations relative to the current time, such as `-2w`.
+type ThanosTimePartition struct {
+	// The start of the time range. If not specified, the time range has no start.
+	// +optional
+	MinTime string `json:"minTime,omitempty"`
+	// The end of the time range. If not specified, the time range has no end.
+	// +optional
+	MaxTime string `json:"maxTime,omitempty"`
+}
+
 // DexComponent specifies the Dex configuration.
 type DexComponent struct {
 	// If true, then Dex will be installed.
@@ -1240,6 +1626,57 @@ type External struct {
 	Suffix string `json:"suffix"`
 }
 
+// RFC2136 DNS type. The DNS records and the ACME DNS01 challenges are updated with TSIG authenticated dynamic updates.
+type RFC2136 struct {
+	// Name of the DNS zone.
+	DNSZoneName string `json:"dnsZoneName"`
+	// Host name or IP address of the DNS server.
+	Nameserver string `json:"nameserver"`
+	// Port of the DNS server. If not specified, then defaults to 53.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Name of the TSIG key.
+	TSIGKeyName string `json:"tsigKeyName"`
+	// TSIG algorithm (`hmac-md5`, `hmac-sha1`, `hmac-sha256`, `hmac-sha512`). If not specified, then defaults to
+	// `hmac-sha256`.
+	// +optional
+	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
+	// Name of the secret, in the verrazzano-install namespace, holding the TSIG key in the `tsig-secret` key.
+	TSIGSecret string `json:"tsigSecret"`
+}
+
+// PowerDNS DNS type. The DNS records are updated with the PowerDNS API.
+type PowerDNS struct {
+	// Name of the DNS zone.
+	DNSZoneName string `json:"dnsZoneName"`
+	// URL of the PowerDNS API server, without the port. For example, `https://pdns.example.com`.
+	APIURL string `json:"apiURL"`
+	// Port of the PowerDNS API server. If not specified, then defaults to 8081.
+	// +optional
+	APIPort *int32 `json:"apiPort,omitempty"`
+	// Name of the secret, in the verrazzano-install namespace, holding the API key in the `api-key` key.
+	APIKeySecret string `json:"apiKeySecret"`
+	// Group name of the PowerDNS cert-manager webhook solver, which must be installed to use Let's Encrypt
+	// certificates.
+	// +optional
+	WebhookGroupName string `json:"webhookGroupName,omitempty"`
+}
+
+// WebhookDNS DNS type. The DNS records are managed outside of Verrazzano, and the ACME DNS01 challenges are solved by
+// a cert-manager webhook solver that must be installed.
+type WebhookDNS struct {
+	// Name of the DNS zone.
+	DNSZoneName string `json:"dnsZoneName"`
+	// Group name of the webhook solver.
+	GroupName string `json:"groupName"`
+	// Name of the webhook solver.
+	SolverName string `json:"solverName"`
+	// Configuration of the webhook solver. Secrets referenced by the configuration must be in the cluster resource
+	// namespace of the cluster issuer.
+	// +optional
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+}
+
 // IngressType is the type of ingress.
 type IngressType string
 
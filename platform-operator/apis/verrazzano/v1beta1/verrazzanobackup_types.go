@@ -0,0 +1,193 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupPhase is the phase of a platform backup or restore, or of one of its steps.
+type BackupPhase string
+
+const (
+	// BackupPhasePending means that the backup or restore, or the step, has not started.
+	BackupPhasePending BackupPhase = "Pending"
+	// BackupPhaseInProgress means that the backup or restore, or the step, is running.
+	BackupPhaseInProgress BackupPhase = "InProgress"
+	// BackupPhaseCompleted means that the backup or restore, or the step, has completed successfully.
+	BackupPhaseCompleted BackupPhase = "Completed"
+	// BackupPhaseFailed means that the backup or restore, or the step, has failed.
+	BackupPhaseFailed BackupPhase = "Failed"
+	// BackupPhaseSkipped means that the step was skipped because the component is not installed.
+	BackupPhaseSkipped BackupPhase = "Skipped"
+)
+
+// BackupStepName identifies a step of a platform backup or restore.
+type BackupStepName string
+
+const (
+	// BackupStepMySQL backs up or restores a dump of the Keycloak MySQL database.
+	BackupStepMySQL BackupStepName = "mysql"
+	// BackupStepOpenSearch backs up or restores an OpenSearch snapshot.
+	BackupStepOpenSearch BackupStepName = "opensearch"
+	// BackupStepRancher backs up or restores the Rancher resources with rancher-backup.
+	BackupStepRancher BackupStepName = "rancher"
+	// BackupStepVelero backs up or restores the resources of the Verrazzano namespaces with Velero.
+	BackupStepVelero BackupStepName = "velero"
+)
+
+// VerrazzanoBackupSpec defines the specification of a platform backup.
+type VerrazzanoBackupSpec struct {
+	// The S3 compatible object storage where the backups of all the components are stored.
+	Storage BackupStorage `json:"storage"`
+	// How long the backup is kept. The backup and all its component backups are deleted when the backup expires.
+	// The default is 720h.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// The name of the OpenSearch snapshot repository, declared in the Verrazzano CR, where the OpenSearch
+	// snapshot is taken. OpenSearch is not backed up if no repository is specified.
+	// +optional
+	OpenSearchSnapshotRepository string `json:"openSearchSnapshotRepository,omitempty"`
+}
+
+// BackupStorage defines an S3 compatible object storage bucket.
+type BackupStorage struct {
+	// The name of the bucket.
+	Bucket string `json:"bucket"`
+	// The folder of the bucket where the backups are stored. Each backup is stored in a folder named after the
+	// backup under this folder.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// The URL of the S3 compatible object storage endpoint.
+	Endpoint string `json:"endpoint"`
+	// The region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// The name of the secret, in the verrazzano-install namespace, holding the access key and secret key of the
+	// object storage in the `access_key` and `secret_key` keys.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// BackupStepStatus defines the observed state of a step of a platform backup or restore.
+type BackupStepStatus struct {
+	// The name of the step.
+	Name BackupStepName `json:"name"`
+	// The phase of the step.
+	Phase BackupPhase `json:"phase"`
+	// The component backup created or restored by the step, such as the name of the MySQL dump,
+	// OpenSearch snapshot, rancher-backup file or Velero backup.
+	// +optional
+	Artifact string `json:"artifact,omitempty"`
+	// Information about the step, such as the reason of a failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VerrazzanoBackupStatus defines the observed state of a platform backup.
+type VerrazzanoBackupStatus struct {
+	// The phase of the backup.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+	// The time the backup started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// The time the backup completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// The time the backup expires and is deleted.
+	// +optional
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+	// The status of the component backups, in the order they are taken.
+	// +optional
+	Steps []BackupStepStatus `json:"steps,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanobackups
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vzbackup;vzbackups
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The phase of the backup."
+// +kubebuilder:printcolumn:name="Expiration",type="string",JSONPath=".status.expiration",description="The time the backup expires."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VerrazzanoBackup is a consistent backup of the Verrazzano platform. The Keycloak MySQL database, OpenSearch,
+// Rancher and the resources of the Verrazzano namespaces are backed up one after the other, and all the
+// component backups are labelled with the name of the backup.
+type VerrazzanoBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerrazzanoBackupSpec   `json:"spec,omitempty"`
+	Status VerrazzanoBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoBackupList contains a list of platform backups.
+type VerrazzanoBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerrazzanoBackup `json:"items"`
+}
+
+// VerrazzanoRestoreSpec defines the specification of a platform restore.
+type VerrazzanoRestoreSpec struct {
+	// The name of the completed VerrazzanoBackup to restore.
+	BackupName string `json:"backupName"`
+	// The object storage where the backup is stored. When set, the backup is read from the object storage instead
+	// of from the VerrazzanoBackup in the namespace of the restore, so that a backup can be restored to a new cluster
+	// after the loss of the cluster where it was taken. The credentials secret must exist in the verrazzano-install
+	// namespace of the cluster being restored.
+	// +optional
+	Storage *BackupStorage `json:"storage,omitempty"`
+}
+
+// VerrazzanoRestoreStatus defines the observed state of a platform restore.
+type VerrazzanoRestoreStatus struct {
+	// The phase of the restore.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+	// The time the restore started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// The time the restore completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// The status of the component restores, in the order they are replayed.
+	// +optional
+	Steps []BackupStepStatus `json:"steps,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=verrazzanorestores
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vzrestore;vzrestores
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="The backup being restored."
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The phase of the restore."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VerrazzanoRestore restores the Verrazzano platform from a VerrazzanoBackup. The component backups are restored
+// in dependency order: the resources of the Verrazzano namespaces, the Keycloak MySQL database, Rancher and OpenSearch.
+// The resources of the Verrazzano namespaces that already exist are updated from the backup, so Verrazzano must be
+// installed, with the same components, before it is restored.
+type VerrazzanoRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerrazzanoRestoreSpec   `json:"spec,omitempty"`
+	Status VerrazzanoRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VerrazzanoRestoreList contains a list of platform restores.
+type VerrazzanoRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerrazzanoRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VerrazzanoBackup{}, &VerrazzanoBackupList{}, &VerrazzanoRestore{}, &VerrazzanoRestoreList{})
+}
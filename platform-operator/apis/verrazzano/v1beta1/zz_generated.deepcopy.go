@@ -1,7 +1,7 @@
 //go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
-// Copyright (c) 2020, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2020, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 // Code generated by controller-gen. DO NOT EDIT.
@@ -13,6 +13,7 @@ import (
 	"k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -94,6 +95,36 @@ func (in *AuthProxyComponent) DeepCopy() *AuthProxyComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStepStatus) DeepCopyInto(out *BackupStepStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStepStatus.
+func (in *BackupStepStatus) DeepCopy() *BackupStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorage) DeepCopyInto(out *BackupStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorage.
+func (in *BackupStorage) DeepCopy() *BackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CA) DeepCopyInto(out *CA) {
 	*out = *in
@@ -124,6 +155,33 @@ func (in *CAIssuer) DeepCopy() *CAIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CARotationStatus) DeepCopyInto(out *CARotationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CARotationStatus.
+func (in *CARotationStatus) DeepCopy() *CARotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CARotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerComponent) DeepCopyInto(out *CertManagerComponent) {
 	*out = *in
@@ -184,6 +242,64 @@ func (in *Certificate) DeepCopy() *Certificate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateMonitoring) DeepCopyInto(out *CertificateMonitoring) {
+	*out = *in
+	if in.WarningHorizons != nil {
+		in, out := &in.WarningHorizons, &out.WarningHorizons
+		*out = make([]metav1.Duration, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateMonitoring.
+func (in *CertificateMonitoring) DeepCopy() *CertificateMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesStatus) DeepCopyInto(out *CertificatesStatus) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesStatus.
+func (in *CertificatesStatus) DeepCopy() *CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAPIComponent) DeepCopyInto(out *ClusterAPIComponent) {
 	*out = *in
@@ -512,6 +628,18 @@ func (in *ComponentStatusDetails) DeepCopyInto(out *ComponentStatusDetails) {
 		*out = make([]Condition, len(*in))
 		copy(*out, *in)
 	}
+	if in.SnapshotRepositories != nil {
+		in, out := &in.SnapshotRepositories, &out.SnapshotRepositories
+		*out = make([]SnapshotRepositoryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MySQLBackup != nil {
+		in, out := &in.MySQLBackup, &out.MySQLBackup
+		*out = new(MySQLBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatusDetails.
@@ -603,6 +731,21 @@ func (in *DNSComponent) DeepCopyInto(out *DNSComponent) {
 		*out = new(OCI)
 		**out = **in
 	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(PowerDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(RFC2136)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Wildcard != nil {
 		in, out := &in.Wildcard, &out.Wildcard
 		*out = new(Wildcard)
@@ -634,6 +777,21 @@ func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
 		*out = new(OCI)
 		**out = **in
 	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(PowerDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(RFC2136)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Wildcard != nil {
 		in, out := &in.Wildcard, &out.Wildcard
 		*out = new(Wildcard)
@@ -1034,6 +1192,13 @@ func (in *KeycloakComponent) DeepCopyInto(out *KeycloakComponent) {
 	}
 	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
 	in.MySQL.DeepCopyInto(&out.MySQL)
+	if in.RealmObjects != nil {
+		in, out := &in.RealmObjects, &out.RealmObjects
+		*out = make([]KeycloakRealmObjectsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakComponent.
@@ -1046,6 +1211,46 @@ func (in *KeycloakComponent) DeepCopy() *KeycloakComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakRealmObjectStatus) DeepCopyInto(out *KeycloakRealmObjectStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmObjectStatus.
+func (in *KeycloakRealmObjectStatus) DeepCopy() *KeycloakRealmObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakRealmObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeycloakRealmObjectsSource) DeepCopyInto(out *KeycloakRealmObjectsSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRealmObjectsSource.
+func (in *KeycloakRealmObjectsSource) DeepCopy() *KeycloakRealmObjectsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KeycloakRealmObjectsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KialiComponent) DeepCopyInto(out *KialiComponent) {
 	*out = *in
@@ -1103,6 +1308,61 @@ func (in *LetsEncryptACMEIssuer) DeepCopy() *LetsEncryptACMEIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackup) DeepCopyInto(out *MySQLBackup) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+	out.Storage = in.Storage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackup.
+func (in *MySQLBackup) DeepCopy() *MySQLBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupStatus) DeepCopyInto(out *MySQLBackupStatus) {
+	*out = *in
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupStatus.
+func (in *MySQLBackupStatus) DeepCopy() *MySQLBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLBackupStorage) DeepCopyInto(out *MySQLBackupStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLBackupStorage.
+func (in *MySQLBackupStorage) DeepCopy() *MySQLBackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLBackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MySQLComponent) DeepCopyInto(out *MySQLComponent) {
 	*out = *in
@@ -1112,6 +1372,11 @@ func (in *MySQLComponent) DeepCopyInto(out *MySQLComponent) {
 		*out = new(v1.VolumeSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(MySQLBackup)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLComponent.
@@ -1219,6 +1484,34 @@ func (in *OpenSearchComponent) DeepCopyInto(out *OpenSearchComponent) {
 		}
 	}
 	in.Plugins.DeepCopyInto(&out.Plugins)
+	if in.IndexTemplates != nil {
+		in, out := &in.IndexTemplates, &out.IndexTemplates
+		*out = make([]OpenSearchIndexTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ComponentTemplates != nil {
+		in, out := &in.ComponentTemplates, &out.ComponentTemplates
+		*out = make([]OpenSearchComponentTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnapshotRepositories != nil {
+		in, out := &in.SnapshotRepositories, &out.SnapshotRepositories
+		*out = make([]OpenSearchSnapshotRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnapshotPolicies != nil {
+		in, out := &in.SnapshotPolicies, &out.SnapshotPolicies
+		*out = make([]OpenSearchSnapshotPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchComponent.
@@ -1231,6 +1524,26 @@ func (in *OpenSearchComponent) DeepCopy() *OpenSearchComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchComponentTemplate) DeepCopyInto(out *OpenSearchComponentTemplate) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchComponentTemplate.
+func (in *OpenSearchComponentTemplate) DeepCopy() *OpenSearchComponentTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchComponentTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenSearchDashboardsComponent) DeepCopyInto(out *OpenSearchDashboardsComponent) {
 	*out = *in
@@ -1245,6 +1558,11 @@ func (in *OpenSearchDashboardsComponent) DeepCopyInto(out *OpenSearchDashboardsC
 		**out = **in
 	}
 	in.Plugins.DeepCopyInto(&out.Plugins)
+	if in.Multitenancy != nil {
+		in, out := &in.Multitenancy, &out.Multitenancy
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchDashboardsComponent.
@@ -1257,6 +1575,41 @@ func (in *OpenSearchDashboardsComponent) DeepCopy() *OpenSearchDashboardsCompone
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenSearchIndexTemplate) DeepCopyInto(out *OpenSearchIndexTemplate) {
+	*out = *in
+	if in.IndexPatterns != nil {
+		in, out := &in.IndexPatterns, &out.IndexPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ComposedOf != nil {
+		in, out := &in.ComposedOf, &out.ComposedOf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchIndexTemplate.
+func (in *OpenSearchIndexTemplate) DeepCopy() *OpenSearchIndexTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenSearchNode) DeepCopyInto(out *OpenSearchNode) {
 	*out = *in
@@ -1329,58 +1682,143 @@ func (in *OpenSearchOperatorComponent) DeepCopy() *OpenSearchOperatorComponent {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Overrides) DeepCopyInto(out *Overrides) {
+func (in *OpenSearchS3Repository) DeepCopyInto(out *OpenSearchS3Repository) {
 	*out = *in
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(v1.ConfigMapKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Overrides.
-func (in *Overrides) DeepCopy() *Overrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchS3Repository.
+func (in *OpenSearchS3Repository) DeepCopy() *OpenSearchS3Repository {
 	if in == nil {
 		return nil
 	}
-	out := new(Overrides)
+	out := new(OpenSearchS3Repository)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusAdapterComponent) DeepCopyInto(out *PrometheusAdapterComponent) {
+func (in *OpenSearchSnapshotPolicy) DeepCopyInto(out *OpenSearchSnapshotPolicy) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int32)
 		**out = **in
 	}
-	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAdapterComponent.
-func (in *PrometheusAdapterComponent) DeepCopy() *PrometheusAdapterComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotPolicy.
+func (in *OpenSearchSnapshotPolicy) DeepCopy() *OpenSearchSnapshotPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusAdapterComponent)
+	out := new(OpenSearchSnapshotPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusComponent) DeepCopyInto(out *PrometheusComponent) {
+func (in *OpenSearchSnapshotRepository) DeepCopyInto(out *OpenSearchSnapshotRepository) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(OpenSearchS3Repository)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenSearchSnapshotRepository.
+func (in *OpenSearchSnapshotRepository) DeepCopy() *OpenSearchSnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchSnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Overrides) DeepCopyInto(out *Overrides) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Overrides.
+func (in *Overrides) DeepCopy() *Overrides {
+	if in == nil {
+		return nil
+	}
+	out := new(Overrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerDNS) DeepCopyInto(out *PowerDNS) {
+	*out = *in
+	if in.APIPort != nil {
+		in, out := &in.APIPort, &out.APIPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerDNS.
+func (in *PowerDNS) DeepCopy() *PowerDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusAdapterComponent) DeepCopyInto(out *PrometheusAdapterComponent) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAdapterComponent.
+func (in *PrometheusAdapterComponent) DeepCopy() *PrometheusAdapterComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusAdapterComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusComponent) DeepCopyInto(out *PrometheusComponent) {
 	*out = *in
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
@@ -1462,6 +1900,26 @@ func (in *PrometheusPushgatewayComponent) DeepCopy() *PrometheusPushgatewayCompo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RFC2136) DeepCopyInto(out *RFC2136) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RFC2136.
+func (in *RFC2136) DeepCopy() *RFC2136 {
+	if in == nil {
+		return nil
+	}
+	out := new(RFC2136)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RancherBackupComponent) DeepCopyInto(out *RancherBackupComponent) {
 	*out = *in
@@ -1522,6 +1980,11 @@ func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
 		*out = make([]rbacv1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.CertificateMonitoring != nil {
+		in, out := &in.CertificateMonitoring, &out.CertificateMonitoring
+		*out = new(CertificateMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
@@ -1534,6 +1997,25 @@ func (in *SecuritySpec) DeepCopy() *SecuritySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRepositoryStatus) DeepCopyInto(out *SnapshotRepositoryStatus) {
+	*out = *in
+	if in.LastSnapshotTime != nil {
+		in, out := &in.LastSnapshotTime, &out.LastSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRepositoryStatus.
+func (in *SnapshotRepositoryStatus) DeepCopy() *SnapshotRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosComponent) DeepCopyInto(out *ThanosComponent) {
 	*out = *in
@@ -1542,6 +2024,21 @@ func (in *ThanosComponent) DeepCopyInto(out *ThanosComponent) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(ThanosStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ThanosRetention)
+		**out = **in
+	}
+	if in.StoreGatewayPartitions != nil {
+		in, out := &in.StoreGatewayPartitions, &out.StoreGatewayPartitions
+		*out = make([]ThanosTimePartition, len(*in))
+		copy(*out, *in)
+	}
 	in.InstallOverrides.DeepCopyInto(&out.InstallOverrides)
 }
 
@@ -1555,6 +2052,71 @@ func (in *ThanosComponent) DeepCopy() *ThanosComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRetention) DeepCopyInto(out *ThanosRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRetention.
+func (in *ThanosRetention) DeepCopy() *ThanosRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosS3Storage) DeepCopyInto(out *ThanosS3Storage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosS3Storage.
+func (in *ThanosS3Storage) DeepCopy() *ThanosS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStorage) DeepCopyInto(out *ThanosStorage) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ThanosS3Storage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStorage.
+func (in *ThanosStorage) DeepCopy() *ThanosStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosTimePartition) DeepCopyInto(out *ThanosTimePartition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosTimePartition.
+func (in *ThanosTimePartition) DeepCopy() *ThanosTimePartition {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosTimePartition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VeleroComponent) DeepCopyInto(out *VeleroComponent) {
 	*out = *in
@@ -1603,6 +2165,118 @@ func (in *Verrazzano) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackup) DeepCopyInto(out *VerrazzanoBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackup.
+func (in *VerrazzanoBackup) DeepCopy() *VerrazzanoBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupList) DeepCopyInto(out *VerrazzanoBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VerrazzanoBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupList.
+func (in *VerrazzanoBackupList) DeepCopy() *VerrazzanoBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupSpec) DeepCopyInto(out *VerrazzanoBackupSpec) {
+	*out = *in
+	out.Storage = in.Storage
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupSpec.
+func (in *VerrazzanoBackupSpec) DeepCopy() *VerrazzanoBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoBackupStatus) DeepCopyInto(out *VerrazzanoBackupStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]BackupStepStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoBackupStatus.
+func (in *VerrazzanoBackupStatus) DeepCopy() *VerrazzanoBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoComponent) DeepCopyInto(out *VerrazzanoComponent) {
 	*out = *in
@@ -1656,6 +2330,113 @@ func (in *VerrazzanoList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoRestore) DeepCopyInto(out *VerrazzanoRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoRestore.
+func (in *VerrazzanoRestore) DeepCopy() *VerrazzanoRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoRestoreList) DeepCopyInto(out *VerrazzanoRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VerrazzanoRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoRestoreList.
+func (in *VerrazzanoRestoreList) DeepCopy() *VerrazzanoRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VerrazzanoRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoRestoreSpec) DeepCopyInto(out *VerrazzanoRestoreSpec) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(BackupStorage)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoRestoreSpec.
+func (in *VerrazzanoRestoreSpec) DeepCopy() *VerrazzanoRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerrazzanoRestoreStatus) DeepCopyInto(out *VerrazzanoRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]BackupStepStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoRestoreStatus.
+func (in *VerrazzanoRestoreStatus) DeepCopy() *VerrazzanoRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerrazzanoRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerrazzanoSpec) DeepCopyInto(out *VerrazzanoSpec) {
 	*out = *in
@@ -1693,6 +2474,16 @@ func (in *VerrazzanoStatus) DeepCopyInto(out *VerrazzanoStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CARotation != nil {
+		in, out := &in.CARotation, &out.CARotation
+		*out = new(CARotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make(ComponentStatusMap, len(*in))
@@ -1718,6 +2509,11 @@ func (in *VerrazzanoStatus) DeepCopyInto(out *VerrazzanoStatus) {
 		*out = new(InstanceInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KeycloakRealmObjects != nil {
+		in, out := &in.KeycloakRealmObjects, &out.KeycloakRealmObjects
+		*out = make([]KeycloakRealmObjectStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerrazzanoStatus.
@@ -1788,6 +2584,26 @@ func (in *WebLogicOperatorComponent) DeepCopy() *WebLogicOperatorComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDNS) DeepCopyInto(out *WebhookDNS) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDNS.
+func (in *WebhookDNS) DeepCopy() *WebhookDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Wildcard) DeepCopyInto(out *Wildcard) {
 	*out = *in
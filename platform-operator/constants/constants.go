@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package constants
@@ -129,6 +129,10 @@ const KeycloakNamespace = "keycloak"
 // KeycloakIngress - the name of the ingress for Keycloak console and api
 const KeycloakIngress = "keycloak"
 
+// KeycloakRestoreReplicasAnnotation records the replicas of the Keycloak StatefulSet while a VerrazzanoRestore has
+// scaled it down to restore the Keycloak database
+const KeycloakRestoreReplicasAnnotation = "verrazzano.io/restore-replicas"
+
 // ArgoCDNamespace - the name of the Argo CD namespace
 const ArgoCDNamespace = "argocd"
 
@@ -0,0 +1,251 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vzctrl "github.com/verrazzano/verrazzano/pkg/controller"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	finalizerName = "verrazzano.io/backup"
+
+	// defaultTTL is how long a backup is kept when the TTL is not specified
+	defaultTTL = 720 * time.Hour
+)
+
+// BackupReconciler reconciles the VerrazzanoBackup resources. The steps of a backup are taken one after the other,
+// and the component backups are deleted when the backup expires or is deleted.
+type BackupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&installv1beta1.VerrazzanoBackup{}).
+		Complete(r)
+}
+
+// Reconcile takes the next step of a backup
+func (r *BackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	backup := &installv1beta1.VerrazzanoBackup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log, err := vzlog.EnsureResourceLogger(&vzlog.ResourceConfig{
+		Name:           backup.Name,
+		Namespace:      backup.Namespace,
+		ID:             string(backup.UID),
+		Generation:     backup.Generation,
+		ControllerName: "verrazzanobackup",
+	})
+	if err != nil {
+		zap.S().Errorf("Failed to create controller logger for VerrazzanoBackup controller: %v", err)
+		return newRequeueWithDelay(), err
+	}
+
+	res, err := r.doReconcile(ctx, backup, log)
+	if err != nil {
+		log.ErrorfThrottled("Failed to reconcile VerrazzanoBackup %s/%s: %v", backup.Namespace, backup.Name, err)
+		return newRequeueWithDelay(), nil
+	}
+	return res, nil
+}
+
+func (r *BackupReconciler) doReconcile(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if !backup.DeletionTimestamp.IsZero() {
+		return r.deleteBackup(ctx, backup, log)
+	}
+	if !controllerutil.ContainsFinalizer(backup, finalizerName) {
+		controllerutil.AddFinalizer(backup, finalizerName)
+		if err := r.Update(ctx, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	switch backup.Status.Phase {
+	case "", installv1beta1.BackupPhasePending:
+		return r.startBackup(ctx, backup, log)
+	case installv1beta1.BackupPhaseInProgress:
+		return r.takeNextStep(ctx, backup, log)
+	case installv1beta1.BackupPhaseCompleted:
+		return r.expireBackup(ctx, backup, log)
+	}
+	return ctrl.Result{}, nil
+}
+
+// startBackup initializes the status of the backup, skipping the components that are not installed
+func (r *BackupReconciler) startBackup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	vz, err := getVerrazzano(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	now := metav1.Now()
+	backup.Status.StartTime = &now
+	if vz == nil {
+		backup.Status.Phase = installv1beta1.BackupPhaseFailed
+		backup.Status.CompletionTime = &now
+		return ctrl.Result{}, r.Status().Update(ctx, backup)
+	}
+
+	backup.Status.Phase = installv1beta1.BackupPhaseInProgress
+	backup.Status.Steps = nil
+	for _, s := range r.getSteps() {
+		phase := installv1beta1.BackupPhasePending
+		if !s.isEnabled(vz, backup) {
+			phase = installv1beta1.BackupPhaseSkipped
+		}
+		backup.Status.Steps = append(backup.Status.Steps, newStepStatus(s.name(), phase, "", ""))
+	}
+	log.Oncef("Starting the backup %s/%s", backup.Namespace, backup.Name)
+	return vzctrl.ShortRequeue(), r.Status().Update(ctx, backup)
+}
+
+// takeNextStep runs the first step of the backup that is not finished, and completes the backup when all the steps
+// are finished
+func (r *BackupReconciler) takeNextStep(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	steps := r.getSteps()
+	i := getNextStep(backup.Status.Steps)
+	if i < 0 {
+		now := metav1.Now()
+		expiration := metav1.NewTime(now.Add(getTTL(backup)))
+		backup.Status.Phase = installv1beta1.BackupPhaseCompleted
+		backup.Status.CompletionTime = &now
+		backup.Status.Expiration = &expiration
+		// The metadata is written last, so that only a complete backup can be restored from the object storage
+		if err := putBackupMetadata(ctx, r.Client, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Infof("Completed the backup %s/%s", backup.Namespace, backup.Name)
+		return ctrl.Result{}, r.Status().Update(ctx, backup)
+	}
+
+	s := findStep(steps, backup.Status.Steps[i].Name)
+	if s == nil {
+		return ctrl.Result{}, fmt.Errorf("Unknown backup step %s", backup.Status.Steps[i].Name)
+	}
+	status, err := s.backup(ctx, backup, backup.Status.Steps[i])
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	backup.Status.Steps[i] = status
+	if status.Phase == installv1beta1.BackupPhaseFailed {
+		now := metav1.Now()
+		backup.Status.Phase = installv1beta1.BackupPhaseFailed
+		backup.Status.CompletionTime = &now
+		log.Errorf("Failed the backup %s/%s at step %s: %s", backup.Namespace, backup.Name, status.Name, status.Message)
+		return ctrl.Result{}, r.Status().Update(ctx, backup)
+	}
+	return vzctrl.ShortRequeue(), r.Status().Update(ctx, backup)
+}
+
+// expireBackup deletes the backup when it expires
+func (r *BackupReconciler) expireBackup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if backup.Status.Expiration == nil {
+		return ctrl.Result{}, nil
+	}
+	if remaining := time.Until(backup.Status.Expiration.Time); remaining > 0 {
+		return ctrl.Result{Requeue: true, RequeueAfter: remaining}, nil
+	}
+	log.Infof("Deleting the expired backup %s/%s", backup.Namespace, backup.Name)
+	return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, backup))
+}
+
+// deleteBackup deletes the backup metadata and the component backups, then removes the finalizer
+func (r *BackupReconciler) deleteBackup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(backup, finalizerName) {
+		return ctrl.Result{}, nil
+	}
+	// The metadata is deleted first, so that a backup that is partially deleted can't be restored
+	if backup.Status.Phase == installv1beta1.BackupPhaseCompleted {
+		if err := deleteBackupMetadata(ctx, r.Client, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	steps := r.getSteps()
+	done := true
+	for _, status := range backup.Status.Steps {
+		if status.Phase == installv1beta1.BackupPhasePending || status.Phase == installv1beta1.BackupPhaseSkipped {
+			continue
+		}
+		s := findStep(steps, status.Name)
+		if s == nil {
+			continue
+		}
+		deleted, err := s.cleanup(ctx, backup, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		done = done && deleted
+	}
+	if !done {
+		log.Progressf("Waiting for the component backups of %s/%s to be deleted", backup.Namespace, backup.Name)
+		return vzctrl.ShortRequeue(), nil
+	}
+	controllerutil.RemoveFinalizer(backup, finalizerName)
+	return ctrl.Result{}, r.Update(ctx, backup)
+}
+
+func (r *BackupReconciler) getSteps() []step {
+	return newBackupSteps(r.Client)
+}
+
+// getNextStep returns the index of the first step that is not finished, or -1 if all the steps are finished
+func getNextStep(statuses []installv1beta1.BackupStepStatus) int {
+	for i, status := range statuses {
+		if status.Phase != installv1beta1.BackupPhaseCompleted && status.Phase != installv1beta1.BackupPhaseSkipped {
+			return i
+		}
+	}
+	return -1
+}
+
+// findStep returns the step with the given name
+func findStep(steps []step, name installv1beta1.BackupStepName) step {
+	for _, s := range steps {
+		if s.name() == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// getTTL returns how long the backup is kept
+func getTTL(backup *installv1beta1.VerrazzanoBackup) time.Duration {
+	if backup.Spec.TTL == nil {
+		return defaultTTL
+	}
+	return backup.Spec.TTL.Duration
+}
+
+// getVerrazzano returns the Verrazzano CR, or nil if Verrazzano is not installed
+func getVerrazzano(ctx context.Context, c client.Client) (*installv1alpha1.Verrazzano, error) {
+	vzList := installv1alpha1.VerrazzanoList{}
+	if err := c.List(ctx, &vzList); err != nil {
+		return nil, err
+	}
+	if len(vzList.Items) == 0 {
+		return nil, nil
+	}
+	return &vzList.Items[0], nil
+}
+
+// Create a new Result that will cause a reconcile requeue after a short delay
+func newRequeueWithDelay() ctrl.Result {
+	return vzctrl.NewRequeueWithDelay(3, 5, time.Second)
+}
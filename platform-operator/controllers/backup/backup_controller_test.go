@@ -0,0 +1,300 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace   = "default"
+	testBackupName  = "backup1"
+	testCredentials = "object-storage"
+)
+
+// TestBackup tests a platform backup
+// GIVEN a Verrazzano installation with Keycloak and Velero, without OpenSearch and Rancher
+// WHEN the backup is reconciled until it completes
+// THEN the Keycloak database is dumped, then the Verrazzano namespaces are backed up with Velero,
+// the OpenSearch and Rancher steps are skipped, and the backup completes with an expiration and its metadata is
+// written to the object storage
+func TestBackup(t *testing.T) {
+	var metadata []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/bucket/platform/backup1/verrazzano-backup.json", r.URL.Path)
+		metadata, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	testBackup := newTestBackup()
+	testBackup.Spec.Storage.Endpoint = server.URL
+	c := newTestClient(newTestVerrazzano(), testBackup,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "verrazzano-system"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: constants.VeleroNameSpace}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+	r := newTestBackupReconciler(c)
+
+	reconcileBackup(t, r)
+	backup := getBackup(t, c)
+	assert.Contains(t, backup.Finalizers, finalizerName)
+	assert.Equal(t, installv1beta1.BackupPhaseInProgress, backup.Status.Phase)
+	assert.Equal(t, []installv1beta1.BackupPhase{
+		installv1beta1.BackupPhasePending,
+		installv1beta1.BackupPhaseSkipped,
+		installv1beta1.BackupPhaseSkipped,
+		installv1beta1.BackupPhasePending,
+	}, getStepPhases(backup.Status.Steps))
+
+	// The Keycloak database is dumped to the object storage
+	reconcileBackup(t, r)
+	mysqlBackup := getTestObject(t, c, mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, testBackupName)
+	assert.Equal(t, testBackupName, mysqlBackup.GetLabels()[backupLabel])
+	prefix, _, _ := unstructured.NestedString(mysqlBackup.Object, "spec", "backupProfile", "dumpInstance", "storage", "s3", "prefix")
+	assert.Equal(t, "platform/backup1/mysql", prefix)
+	secret := corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: "verrazzano-backup-backup1"}, &secret))
+	assert.Equal(t, "[default]\naws_access_key_id=key\naws_secret_access_key=secret\n", string(secret.Data["credentials"]))
+
+	setTestObjectStatus(t, c, mysqlBackup, map[string]interface{}{"status": "Completed", "output": "dump1"})
+	reconcileBackup(t, r)
+	backup = getBackup(t, c)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, backup.Status.Steps[0].Phase)
+	assert.Equal(t, "dump1", backup.Status.Steps[0].Artifact)
+
+	// The Verrazzano namespaces are backed up with Velero
+	reconcileBackup(t, r)
+	location := getTestObject(t, c, veleroAPIVersion, veleroStorageLocationKind, constants.VeleroNameSpace, testBackupName)
+	bucket, _, _ := unstructured.NestedString(location.Object, "spec", "objectStorage", "bucket")
+	assert.Equal(t, "bucket", bucket)
+	veleroBackup := getTestObject(t, c, veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, testBackupName)
+	namespaces, _, _ := unstructured.NestedStringSlice(veleroBackup.Object, "spec", "includedNamespaces")
+	assert.Equal(t, []string{"verrazzano-system"}, namespaces)
+	ttl, _, _ := unstructured.NestedString(veleroBackup.Object, "spec", "ttl")
+	assert.Equal(t, "720h0m0s", ttl)
+
+	setTestObjectStatus(t, c, veleroBackup, map[string]interface{}{"phase": "Completed"})
+	reconcileBackup(t, r)
+	reconcileBackup(t, r)
+	backup = getBackup(t, c)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, backup.Status.Phase)
+	assert.NotNil(t, backup.Status.CompletionTime)
+	assert.Equal(t, backup.Status.CompletionTime.Add(defaultTTL).Unix(), backup.Status.Expiration.Unix())
+	stored := installv1beta1.VerrazzanoBackup{}
+	assert.NoError(t, json.Unmarshal(metadata, &stored))
+	assert.Equal(t, testBackupName, stored.Name)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, stored.Status.Phase)
+	assert.Equal(t, "dump1", stored.Status.Steps[0].Artifact)
+}
+
+// TestBackupStepFailed tests a failed step of a platform backup
+// GIVEN a backup whose MySQL dump fails
+// WHEN the backup is reconciled
+// THEN the backup fails and the next steps are not taken
+func TestBackupStepFailed(t *testing.T) {
+	c := newTestClient(newTestVerrazzano(), newTestBackup())
+	r := newTestBackupReconciler(c)
+
+	reconcileBackup(t, r)
+	reconcileBackup(t, r)
+	mysqlBackup := getTestObject(t, c, mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, testBackupName)
+	setTestObjectStatus(t, c, mysqlBackup, map[string]interface{}{"status": "Error"})
+	reconcileBackup(t, r)
+
+	backup := getBackup(t, c)
+	assert.Equal(t, installv1beta1.BackupPhaseFailed, backup.Status.Phase)
+	assert.Equal(t, installv1beta1.BackupPhaseFailed, backup.Status.Steps[0].Phase)
+	assert.Equal(t, installv1beta1.BackupPhasePending, backup.Status.Steps[3].Phase)
+}
+
+// TestBackupExpired tests the expiration of a platform backup
+// GIVEN a completed backup that has expired
+// WHEN the backup is reconciled
+// THEN the backup is deleted, its finalizer keeping it until the component backups are deleted
+func TestBackupExpired(t *testing.T) {
+	backup := newTestBackup()
+	expiration := metav1.NewTime(time.Now().Add(-time.Minute))
+	backup.Status.Phase = installv1beta1.BackupPhaseCompleted
+	backup.Status.Expiration = &expiration
+	c := newTestClient(newTestVerrazzano(), backup)
+	r := newTestBackupReconciler(c)
+
+	reconcileBackup(t, r)
+	assert.NotNil(t, getBackup(t, c).DeletionTimestamp)
+}
+
+// TestDeleteBackup tests the deletion of a platform backup
+// GIVEN a completed backup that is being deleted
+// WHEN the backup is reconciled
+// THEN the deletion of the Velero backup is requested and the finalizer is kept
+// WHEN Velero has deleted its backup and the backup is reconciled
+// THEN the backup metadata, the component backups and the dump in the object storage are deleted and the finalizer
+// is removed, so that the backup is deleted
+func TestDeleteBackup(t *testing.T) {
+	dumpObject := "platform/backup1/mysql/dump1/@.json"
+	metadataObject := "platform/backup1/verrazzano-backup.json"
+	deleted := false
+	metadataDeleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/bucket/"+metadataObject {
+			metadataDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			assert.Equal(t, "/bucket/"+dumpObject, r.URL.Path)
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		assert.Equal(t, "platform/backup1/mysql/", r.URL.Query().Get("prefix"))
+		if deleted {
+			_, _ = w.Write([]byte(`<ListBucketResult></ListBucketResult>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<ListBucketResult><Contents><Key>` + dumpObject + `</Key></Contents></ListBucketResult>`))
+	}))
+	defer server.Close()
+
+	now := metav1.Now()
+	backup := newTestBackup()
+	backup.Spec.Storage.Endpoint = server.URL
+	backup.Finalizers = []string{finalizerName}
+	backup.DeletionTimestamp = &now
+	backup.Status.Phase = installv1beta1.BackupPhaseCompleted
+	backup.Status.Steps = []installv1beta1.BackupStepStatus{
+		newStepStatus(installv1beta1.BackupStepMySQL, installv1beta1.BackupPhaseCompleted, "dump1", ""),
+		newStepStatus(installv1beta1.BackupStepOpenSearch, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepRancher, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepVelero, installv1beta1.BackupPhaseCompleted, testBackupName, ""),
+	}
+	mysqlBackup := newObject(mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, testBackupName)
+	veleroBackup := newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, testBackupName)
+	c := newTestClient(newTestVerrazzano(), backup, mysqlBackup, veleroBackup)
+	r := newTestBackupReconciler(c)
+
+	reconcileBackup(t, r)
+	assert.True(t, metadataDeleted)
+	assert.Contains(t, getBackup(t, c).Finalizers, finalizerName)
+	getTestObject(t, c, veleroAPIVersion, veleroDeleteBackupRequest, constants.VeleroNameSpace, testBackupName)
+	_, err := getObject(context.TODO(), c, newObject(mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, testBackupName))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Delete(context.TODO(), veleroBackup))
+	reconcileBackup(t, r)
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testBackupName}, &installv1beta1.VerrazzanoBackup{})
+	assert.True(t, apierrors.IsNotFound(err))
+	obj, err := getObject(context.TODO(), c, newObject(mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, testBackupName))
+	assert.NoError(t, err)
+	assert.Nil(t, obj)
+	assert.True(t, deleted)
+}
+
+func newTestBackupReconciler(c client.Client) *BackupReconciler {
+	return &BackupReconciler{Client: c, Scheme: c.Scheme()}
+}
+
+func reconcileBackup(t *testing.T, r *BackupReconciler) {
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testBackupName}})
+	assert.NoError(t, err)
+}
+
+func getBackup(t *testing.T, c client.Client) *installv1beta1.VerrazzanoBackup {
+	backup := &installv1beta1.VerrazzanoBackup{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testBackupName}, backup))
+	return backup
+}
+
+func getStepPhases(steps []installv1beta1.BackupStepStatus) []installv1beta1.BackupPhase {
+	var phases []installv1beta1.BackupPhase
+	for _, s := range steps {
+		phases = append(phases, s.Phase)
+	}
+	return phases
+}
+
+// newTestClient returns a fake client with the object storage credentials secret
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = networkingv1.AddToScheme(scheme)
+	_ = installv1alpha1.AddToScheme(scheme)
+	_ = installv1beta1.AddToScheme(scheme)
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoInstallNamespace, Name: testCredentials},
+		Data:       map[string][]byte{accessKeyKey: []byte("key"), secretKeyKey: []byte("secret")},
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, credentials)...).Build()
+}
+
+// newTestVerrazzano returns a Verrazzano CR with Keycloak and Velero, without OpenSearch and Rancher
+func newTestVerrazzano() *installv1alpha1.Verrazzano {
+	enabled, disabled := true, false
+	return &installv1alpha1.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "verrazzano"},
+		Spec: installv1alpha1.VerrazzanoSpec{
+			Components: installv1alpha1.ComponentSpec{
+				Elasticsearch: &installv1alpha1.ElasticsearchComponent{Enabled: &disabled},
+				Rancher:       &installv1alpha1.RancherComponent{Enabled: &disabled},
+				Velero:        &installv1alpha1.VeleroComponent{Enabled: &enabled},
+			},
+		},
+	}
+}
+
+func newTestBackup() *installv1beta1.VerrazzanoBackup {
+	return &installv1beta1.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testBackupName},
+		Spec: installv1beta1.VerrazzanoBackupSpec{
+			Storage: installv1beta1.BackupStorage{
+				Bucket:            "bucket",
+				Prefix:            "platform",
+				Endpoint:          "https://objectstorage.example.com",
+				Region:            "us-ashburn-1",
+				CredentialsSecret: testCredentials,
+			},
+			OpenSearchSnapshotRepository: "backups",
+		},
+	}
+}
+
+// getTestObject gets an unstructured object of a component
+func getTestObject(t *testing.T, c client.Client, apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj, err := getObject(context.TODO(), c, newObject(apiVersion, kind, namespace, name))
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	return obj
+}
+
+// setTestObjectStatus sets the status of an unstructured object, as the component operator would
+func setTestObjectStatus(t *testing.T, c client.Client, obj *unstructured.Unstructured, status map[string]interface{}) {
+	obj.Object["status"] = status
+	assert.NoError(t, c.Update(context.TODO(), obj))
+}
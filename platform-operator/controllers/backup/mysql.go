@@ -0,0 +1,280 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	mysqlBackupAPIVersion = "mysql.oracle.com/v2"
+	mysqlBackupKind       = "MySQLBackup"
+	innoDBClusterName     = "mysql"
+	mysqlPodName          = "mysql-0"
+	mysqlContainerName    = "mysql"
+	mysqlRootSecretName   = "mysql"
+	mysqlRootPasswordKey  = "rootPassword" //nolint:gosec //#gosec G101
+	keycloakStatefulSet   = "keycloak"
+	awsConfigDir          = "/var/run/verrazzano-backup"
+
+	// The MySQL operator sets the status of a MySQLBackup to Completed or Error
+	mysqlBackupCompleted = "Completed"
+	mysqlBackupError     = "Error"
+
+	// loadDumpScript drops the Keycloak database and loads it from the dump in the object storage
+	loadDumpScript = `session.runSql("DROP DATABASE IF EXISTS keycloak");
+util.loadDump("%s", {s3BucketName: "%s", s3EndpointOverride: "%s", s3Profile: "%s", s3CredentialsFile: "%s/credentials", s3ConfigFile: "%s/config", includeSchemas: ["keycloak"], loadUsers: false, resetProgress: true, ignoreVersion: true});`
+)
+
+// mysqlStep dumps the Keycloak MySQL database to the object storage with the MySQL operator, and restores it with
+// a job that loads the dump
+type mysqlStep struct {
+	client.Client
+}
+
+func (s *mysqlStep) name() installv1beta1.BackupStepName {
+	return installv1beta1.BackupStepMySQL
+}
+
+func (s *mysqlStep) isEnabled(vz *installv1alpha1.Verrazzano, _ *installv1beta1.VerrazzanoBackup) bool {
+	return vzcr.IsKeycloakEnabled(vz)
+}
+
+// backup creates a MySQLBackup that dumps the InnoDB cluster to the object storage
+func (s *mysqlStep) backup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, vzconst.KeycloakNamespace, mysqlCredentials(backup.Spec.Storage.Region)); err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	mysqlBackup := newObject(mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, backup.Name)
+	existing, err := getObject(ctx, s.Client, mysqlBackup.DeepCopy())
+	if err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	if existing == nil {
+		mysqlBackup.SetLabels(setBackupLabel(nil, backupLabel, backup.Name))
+		mysqlBackup.Object["spec"] = map[string]interface{}{
+			"clusterName": innoDBClusterName,
+			"backupProfile": map[string]interface{}{
+				"name": backup.Name,
+				"dumpInstance": map[string]interface{}{
+					"storage": map[string]interface{}{
+						"s3": map[string]interface{}{
+							"bucketName": backup.Spec.Storage.Bucket,
+							"prefix":     getStoragePath(backup, installv1beta1.BackupStepMySQL),
+							"endpoint":   backup.Spec.Storage.Endpoint,
+							"config":     getCredentialsSecretName(backup),
+							"profile":    awsProfile,
+						},
+					},
+				},
+			},
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, "", ""), s.Create(ctx, mysqlBackup)
+	}
+
+	state, _, _ := unstructured.NestedString(existing.Object, "status", "status")
+	output, _, _ := unstructured.NestedString(existing.Object, "status", "output")
+	switch state {
+	case mysqlBackupCompleted:
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseCompleted, output, ""), nil
+	case mysqlBackupError:
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseFailed, output, "The MySQL operator failed to dump the Keycloak database"), nil
+	}
+	return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, output, ""), nil
+}
+
+// restore scales Keycloak down, so that it doesn't write to the database or use cached data while the database is
+// replaced, runs a job that loads the Keycloak database from the dump, then scales Keycloak back up
+func (s *mysqlStep) restore(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, vzconst.KeycloakNamespace, mysqlCredentials(backup.Spec.Storage.Region)); err != nil {
+		return status, err
+	}
+	job := batchv1.Job{}
+	nsn := types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: getRestoreJobName(restore)}
+	if err := s.Get(ctx, nsn, &job); client.IgnoreNotFound(err) != nil {
+		return status, err
+	} else if err != nil {
+		stopped, err := s.scaleDownKeycloak(ctx)
+		if err != nil {
+			return status, err
+		}
+		if !stopped {
+			return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), nil
+		}
+		newJob, err := s.newLoadDumpJob(ctx, restore, backup, status.Artifact)
+		if err != nil {
+			return status, err
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), s.Create(ctx, newJob)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			if err := s.scaleUpKeycloak(ctx); err != nil {
+				return status, err
+			}
+			return newStepStatus(s.name(), installv1beta1.BackupPhaseFailed, status.Artifact, fmt.Sprintf("The job loading the Keycloak database failed: %s", condition.Message)), nil
+		}
+	}
+	if job.Status.Succeeded == 0 {
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), nil
+	}
+	if err := s.scaleUpKeycloak(ctx); err != nil {
+		return status, err
+	}
+	return newStepStatus(s.name(), installv1beta1.BackupPhaseCompleted, status.Artifact, ""), nil
+}
+
+// cleanup deletes the dump from the object storage, then the MySQLBackup. The MySQL operator doesn't delete the dump
+// of a deleted MySQLBackup.
+func (s *mysqlStep) cleanup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (bool, error) {
+	if err := deleteStorageObjects(ctx, s.Client, backup, s.name()); err != nil {
+		return false, err
+	}
+	mysqlBackup := newObject(mysqlBackupAPIVersion, mysqlBackupKind, vzconst.KeycloakNamespace, backup.Name)
+	if err := s.Delete(ctx, mysqlBackup); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	return true, deleteCredentialsSecret(ctx, s.Client, backup, vzconst.KeycloakNamespace)
+}
+
+// newLoadDumpJob returns a job that loads the Keycloak database from a dump with MySQL Shell. The job uses the image
+// of the MySQL server, which includes MySQL Shell.
+func (s *mysqlStep) newLoadDumpJob(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, dump string) (*batchv1.Job, error) {
+	pod := corev1.Pod{}
+	if err := s.Get(ctx, types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: mysqlPodName}, &pod); err != nil {
+		return nil, fmt.Errorf("Failed to get the MySQL pod: %v", err)
+	}
+	var image string
+	for _, container := range pod.Spec.Containers {
+		if container.Name == mysqlContainerName {
+			image = container.Image
+		}
+	}
+	if image == "" {
+		return nil, fmt.Errorf("Failed to find the %s container of the MySQL pod", mysqlContainerName)
+	}
+
+	dumpPath := path.Join(getStoragePath(backup, installv1beta1.BackupStepMySQL), dump)
+	script := fmt.Sprintf(loadDumpScript, dumpPath, backup.Spec.Storage.Bucket, backup.Spec.Storage.Endpoint, awsProfile, awsConfigDir, awsConfigDir)
+	backoffLimit := int32(2)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vzconst.KeycloakNamespace,
+			Name:      getRestoreJobName(restore),
+			Labels:    setBackupLabel(nil, restoreLabel, restore.Name),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					// The job doesn't need a sidecar and must complete
+					Annotations: map[string]string{"sidecar.istio.io/inject": "false"},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "load-dump",
+						Image:   image,
+						Command: []string{"bash", "-c", `mysqlsh -uroot -p"${MYSQL_ROOT_PASSWORD}" -h` + innoDBClusterName + ` --js -e "${LOAD_DUMP_SCRIPT}"`},
+						Env: []corev1.EnvVar{
+							{
+								Name: "MYSQL_ROOT_PASSWORD",
+								ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: mysqlRootSecretName},
+									Key:                  mysqlRootPasswordKey,
+								}},
+							},
+							{Name: "LOAD_DUMP_SCRIPT", Value: script},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "credentials", MountPath: awsConfigDir, ReadOnly: true}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "credentials",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{SecretName: getCredentialsSecretName(backup)},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// scaleDownKeycloak scales the Keycloak StatefulSet down to zero replicas, recording its replicas in an annotation.
+// Returns true once the Keycloak pods are stopped.
+func (s *mysqlStep) scaleDownKeycloak(ctx context.Context) (bool, error) {
+	sts := appsv1.StatefulSet{}
+	if err := s.Get(ctx, types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: keycloakStatefulSet}, &sts); err != nil {
+		return apierrors.IsNotFound(err), client.IgnoreNotFound(err)
+	}
+	if _, ok := sts.Annotations[constants.KeycloakRestoreReplicasAnnotation]; !ok {
+		replicas := int32(1)
+		if sts.Spec.Replicas != nil {
+			replicas = *sts.Spec.Replicas
+		}
+		if sts.Annotations == nil {
+			sts.Annotations = map[string]string{}
+		}
+		sts.Annotations[constants.KeycloakRestoreReplicasAnnotation] = strconv.Itoa(int(replicas))
+		zero := int32(0)
+		sts.Spec.Replicas = &zero
+		if err := s.Update(ctx, &sts); err != nil {
+			return false, err
+		}
+	}
+	return sts.Status.Replicas == 0, nil
+}
+
+// scaleUpKeycloak restores the replicas of the Keycloak StatefulSet recorded when it was scaled down
+func (s *mysqlStep) scaleUpKeycloak(ctx context.Context) error {
+	sts := appsv1.StatefulSet{}
+	if err := s.Get(ctx, types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: keycloakStatefulSet}, &sts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	value, ok := sts.Annotations[constants.KeycloakRestoreReplicasAnnotation]
+	if !ok {
+		return nil
+	}
+	replicas, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("Invalid %s annotation of the Keycloak StatefulSet: %v", constants.KeycloakRestoreReplicasAnnotation, err)
+	}
+	scaled := int32(replicas)
+	sts.Spec.Replicas = &scaled
+	delete(sts.Annotations, constants.KeycloakRestoreReplicasAnnotation)
+	return s.Update(ctx, &sts)
+}
+
+// mysqlCredentials returns the AWS credentials and config files used by the MySQL operator and MySQL Shell
+func mysqlCredentials(region string) func(accessKey, secretKey string) map[string][]byte {
+	return func(accessKey, secretKey string) map[string][]byte {
+		return map[string][]byte{
+			"credentials": []byte(awsCredentials(accessKey, secretKey)),
+			"config":      []byte(awsConfig(region)),
+		}
+	}
+}
+
+// getRestoreJobName returns the name of the job that loads the Keycloak database of a restore
+func getRestoreJobName(restore *installv1beta1.VerrazzanoRestore) string {
+	return fmt.Sprintf("verrazzano-restore-%s", restore.Name)
+}
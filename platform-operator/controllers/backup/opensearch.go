@@ -0,0 +1,202 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// restoreIndexPrefix is the prefix of the Verrazzano data streams and indices restored from the snapshot
+	restoreIndexPrefix = "verrazzano-"
+	// fluentdPausedLabel is the node selector of the Fluentd pods while the ingestion is paused. No node has the label,
+	// so the Fluentd pods are stopped.
+	fluentdPausedLabel = "verrazzano.io/restore-paused"
+)
+
+// opensearchStep takes a snapshot of OpenSearch in a snapshot repository declared in the Verrazzano CR
+type opensearchStep struct {
+	client.Client
+	newOSClient func(client.Client) (*opensearch.OSClient, error)
+}
+
+func newOpenSearchStep(c client.Client) *opensearchStep {
+	return &opensearchStep{Client: c, newOSClient: newOSClient}
+}
+
+// newOSClient returns an OpenSearch client authenticated with the Verrazzano password
+func newOSClient(c client.Client) (*opensearch.OSClient, error) {
+	pas, err := opensearch.GetVerrazzanoPassword(c)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.NewOSClient(pas), nil
+}
+
+func (s *opensearchStep) name() installv1beta1.BackupStepName {
+	return installv1beta1.BackupStepOpenSearch
+}
+
+func (s *opensearchStep) isEnabled(vz *installv1alpha1.Verrazzano, backup *installv1beta1.VerrazzanoBackup) bool {
+	return vzcr.IsOpenSearchEnabled(vz) && backup.Spec.OpenSearchSnapshotRepository != ""
+}
+
+// backup starts a snapshot named after the backup and returns the state of the snapshot
+func (s *opensearchStep) backup(_ context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	osClient, err := s.newOSClient(s.Client)
+	if err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	repository := backup.Spec.OpenSearchSnapshotRepository
+	if err := osClient.CreateSnapshot(s.Client, repository, backup.Name, map[string]string{backupLabel: backup.Name}); err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	snapshot, err := osClient.GetSnapshot(s.Client, repository, backup.Name)
+	if err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	if snapshot == nil {
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, backup.Name, ""), nil
+	}
+	switch snapshot.State {
+	case opensearch.SnapshotStateSuccess:
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseCompleted, snapshot.Snapshot, ""), nil
+	case opensearch.SnapshotStatePartial, opensearch.SnapshotStateFailed:
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseFailed, snapshot.Snapshot, snapshotFailureMessage(snapshot)), nil
+	}
+	return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, snapshot.Snapshot, ""), nil
+}
+
+// restore stops the ingestion of the logs, so that Fluentd doesn't recreate the data streams while they are restored,
+// then restores the Verrazzano data streams and indices of the snapshot by name. The ingestion is resumed once their
+// shards are recovered.
+func (s *opensearchStep) restore(ctx context.Context, _ *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	osClient, err := s.newOSClient(s.Client)
+	if err != nil {
+		return status, err
+	}
+	repository := backup.Spec.OpenSearchSnapshotRepository
+	snapshot, err := osClient.GetSnapshot(s.Client, repository, status.Artifact)
+	if err != nil {
+		return status, err
+	}
+	if snapshot == nil {
+		if err := s.resumeIngestion(ctx); err != nil {
+			return status, err
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseFailed, status.Artifact, fmt.Sprintf("The snapshot %s does not exist in the repository %s", status.Artifact, repository)), nil
+	}
+	dataStreams, indices := getRestoredIndices(snapshot)
+
+	if status.Phase == installv1beta1.BackupPhasePending {
+		paused, err := s.pauseIngestion(ctx)
+		if err != nil || !paused {
+			return status, err
+		}
+		if err := osClient.RestoreSnapshot(s.Client, repository, status.Artifact, dataStreams, indices); err != nil {
+			return status, err
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), nil
+	}
+	inProgress, err := osClient.IsSnapshotRecoveryInProgress(s.Client, append(dataStreams, indices...))
+	if err != nil {
+		return status, err
+	}
+	if inProgress {
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), nil
+	}
+	if err := s.resumeIngestion(ctx); err != nil {
+		return status, err
+	}
+	return newStepStatus(s.name(), installv1beta1.BackupPhaseCompleted, status.Artifact, ""), nil
+}
+
+// getRestoredIndices returns the Verrazzano data streams of a snapshot, and its Verrazzano indices that are not
+// backing indices of the data streams
+func getRestoredIndices(snapshot *opensearch.Snapshot) ([]string, []string) {
+	var dataStreams, indices []string
+	for _, dataStream := range snapshot.DataStreams {
+		if strings.HasPrefix(dataStream, restoreIndexPrefix) {
+			dataStreams = append(dataStreams, dataStream)
+		}
+	}
+	for _, index := range snapshot.Indices {
+		if strings.HasPrefix(index, restoreIndexPrefix) {
+			indices = append(indices, index)
+		}
+	}
+	sort.Strings(dataStreams)
+	sort.Strings(indices)
+	return dataStreams, indices
+}
+
+// pauseIngestion stops the Fluentd pods with a node selector that matches no node. Returns true once the Fluentd
+// pods are stopped.
+func (s *opensearchStep) pauseIngestion(ctx context.Context) (bool, error) {
+	ds := appsv1.DaemonSet{}
+	if err := s.Get(ctx, types.NamespacedName{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.FluentdDaemonSetName}, &ds); err != nil {
+		return apierrors.IsNotFound(err), client.IgnoreNotFound(err)
+	}
+	if _, ok := ds.Spec.Template.Spec.NodeSelector[fluentdPausedLabel]; !ok {
+		if ds.Spec.Template.Spec.NodeSelector == nil {
+			ds.Spec.Template.Spec.NodeSelector = map[string]string{}
+		}
+		ds.Spec.Template.Spec.NodeSelector[fluentdPausedLabel] = "true"
+		if err := s.Update(ctx, &ds); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	return ds.Status.ObservedGeneration >= ds.Generation && ds.Status.CurrentNumberScheduled == 0 && ds.Status.NumberMisscheduled == 0, nil
+}
+
+// resumeIngestion removes the node selector that stops the Fluentd pods
+func (s *opensearchStep) resumeIngestion(ctx context.Context) error {
+	ds := appsv1.DaemonSet{}
+	if err := s.Get(ctx, types.NamespacedName{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.FluentdDaemonSetName}, &ds); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := ds.Spec.Template.Spec.NodeSelector[fluentdPausedLabel]; !ok {
+		return nil
+	}
+	delete(ds.Spec.Template.Spec.NodeSelector, fluentdPausedLabel)
+	return s.Update(ctx, &ds)
+}
+
+// cleanup deletes the snapshot from the snapshot repository
+func (s *opensearchStep) cleanup(_ context.Context, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (bool, error) {
+	if status.Artifact == "" {
+		return true, nil
+	}
+	osClient, err := s.newOSClient(s.Client)
+	if err != nil {
+		return false, err
+	}
+	return true, osClient.DeleteSnapshot(s.Client, backup.Spec.OpenSearchSnapshotRepository, status.Artifact)
+}
+
+// snapshotFailureMessage returns the reasons the shards of a snapshot failed
+func snapshotFailureMessage(snapshot *opensearch.Snapshot) string {
+	var reasons []string
+	for _, failure := range snapshot.Failures {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", failure.Index, failure.Reason))
+	}
+	if len(reasons) == 0 {
+		return fmt.Sprintf("The snapshot %s is %s", snapshot.Snapshot, snapshot.State)
+	}
+	return fmt.Sprintf("The snapshot %s is %s: %s", snapshot.Snapshot, snapshot.State, strings.Join(reasons, ", "))
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	rancherBackupAPIVersion = "resources.cattle.io/v1"
+	rancherBackupKind       = "Backup"
+	rancherRestoreKind      = "Restore"
+	rancherResourceSetName  = "rancher-resource-set"
+	rancherReadyCondition   = "Ready"
+	rancherErrorReason      = "Error"
+)
+
+// rancherStep backs up the Rancher resources to the object storage with rancher-backup
+type rancherStep struct {
+	client.Client
+}
+
+func (s *rancherStep) name() installv1beta1.BackupStepName {
+	return installv1beta1.BackupStepRancher
+}
+
+func (s *rancherStep) isEnabled(vz *installv1alpha1.Verrazzano, _ *installv1beta1.VerrazzanoBackup) bool {
+	return vzcr.IsRancherEnabled(vz) && vzcr.IsRancherBackupEnabled(vz)
+}
+
+// backup creates a rancher-backup Backup and returns its status. The artifact is the name of the backup file.
+func (s *rancherStep) backup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, constants.RancherBackupNamesSpace, rancherCredentials); err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	rancherBackup := newObject(rancherBackupAPIVersion, rancherBackupKind, "", getRancherObjectName(backup.Name))
+	existing, err := getObject(ctx, s.Client, rancherBackup.DeepCopy())
+	if err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	if existing == nil {
+		rancherBackup.SetLabels(setBackupLabel(nil, backupLabel, backup.Name))
+		rancherBackup.Object["spec"] = map[string]interface{}{
+			"resourceSetName": rancherResourceSetName,
+			"storageLocation": rancherStorageLocation(backup),
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, "", ""), s.Create(ctx, rancherBackup)
+	}
+
+	filename, _, _ := unstructured.NestedString(existing.Object, "status", "filename")
+	phase, message := getRancherPhase(existing)
+	return newStepStatus(s.name(), phase, filename, message), nil
+}
+
+// restore creates a rancher-backup Restore of the backup file. The resources created after the backup are not pruned,
+// so that the resources restored by Velero are kept.
+func (s *rancherStep) restore(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, constants.RancherBackupNamesSpace, rancherCredentials); err != nil {
+		return status, err
+	}
+	rancherRestore := newObject(rancherBackupAPIVersion, rancherRestoreKind, "", getRancherObjectName(restore.Name))
+	existing, err := getObject(ctx, s.Client, rancherRestore.DeepCopy())
+	if err != nil {
+		return status, err
+	}
+	if existing == nil {
+		rancherRestore.SetLabels(setBackupLabel(nil, restoreLabel, restore.Name))
+		rancherRestore.Object["spec"] = map[string]interface{}{
+			"backupFilename":  status.Artifact,
+			"storageLocation": rancherStorageLocation(backup),
+			"prune":           false,
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), s.Create(ctx, rancherRestore)
+	}
+
+	phase, message := getRancherPhase(existing)
+	return newStepStatus(s.name(), phase, status.Artifact, message), nil
+}
+
+// cleanup deletes the backup file from the object storage, then the rancher-backup Backup. rancher-backup doesn't
+// delete the backup files.
+func (s *rancherStep) cleanup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (bool, error) {
+	if err := deleteStorageObjects(ctx, s.Client, backup, s.name()); err != nil {
+		return false, err
+	}
+	rancherBackup := newObject(rancherBackupAPIVersion, rancherBackupKind, "", getRancherObjectName(backup.Name))
+	if err := s.Delete(ctx, rancherBackup); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	return true, deleteCredentialsSecret(ctx, s.Client, backup, constants.RancherBackupNamesSpace)
+}
+
+// getRancherPhase returns the phase of a rancher-backup Backup or Restore from its Ready condition
+func getRancherPhase(obj *unstructured.Unstructured) (installv1beta1.BackupPhase, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != rancherReadyCondition {
+			continue
+		}
+		message, _ := condition["message"].(string)
+		if condition["status"] == "True" {
+			return installv1beta1.BackupPhaseCompleted, ""
+		}
+		if condition["reason"] == rancherErrorReason {
+			return installv1beta1.BackupPhaseFailed, message
+		}
+	}
+	return installv1beta1.BackupPhaseInProgress, ""
+}
+
+// rancherStorageLocation returns the S3 storage location of a rancher-backup Backup or Restore
+func rancherStorageLocation(backup *installv1beta1.VerrazzanoBackup) map[string]interface{} {
+	return map[string]interface{}{
+		"s3": map[string]interface{}{
+			"credentialSecretName":      getCredentialsSecretName(backup),
+			"credentialSecretNamespace": constants.RancherBackupNamesSpace,
+			"bucketName":                backup.Spec.Storage.Bucket,
+			"folder":                    getStoragePath(backup, installv1beta1.BackupStepRancher),
+			"region":                    backup.Spec.Storage.Region,
+			"endpoint":                  backup.Spec.Storage.Endpoint,
+		},
+	}
+}
+
+// rancherCredentials returns the credentials in the format of rancher-backup
+func rancherCredentials(accessKey, secretKey string) map[string][]byte {
+	return map[string][]byte{
+		"accessKey": []byte(accessKey),
+		"secretKey": []byte(secretKey),
+	}
+}
+
+// getRancherObjectName returns the name of the cluster scoped rancher-backup Backup or Restore of a platform backup
+// or restore
+func getRancherObjectName(name string) string {
+	return fmt.Sprintf("verrazzano-%s", name)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	vzctrl "github.com/verrazzano/verrazzano/pkg/controller"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RestoreReconciler reconciles the VerrazzanoRestore resources. The component backups of a completed
+// VerrazzanoBackup, or of a backup stored in an object storage, are restored one after the other, in dependency order.
+type RestoreReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *RestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&installv1beta1.VerrazzanoRestore{}).
+		Complete(r)
+}
+
+// Reconcile takes the next step of a restore
+func (r *RestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	restore := &installv1beta1.VerrazzanoRestore{}
+	if err := r.Get(ctx, req.NamespacedName, restore); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !restore.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	log, err := vzlog.EnsureResourceLogger(&vzlog.ResourceConfig{
+		Name:           restore.Name,
+		Namespace:      restore.Namespace,
+		ID:             string(restore.UID),
+		Generation:     restore.Generation,
+		ControllerName: "verrazzanorestore",
+	})
+	if err != nil {
+		zap.S().Errorf("Failed to create controller logger for VerrazzanoRestore controller: %v", err)
+		return newRequeueWithDelay(), err
+	}
+
+	res, err := r.doReconcile(ctx, restore, log)
+	if err != nil {
+		log.ErrorfThrottled("Failed to reconcile VerrazzanoRestore %s/%s: %v", restore.Namespace, restore.Name, err)
+		return newRequeueWithDelay(), nil
+	}
+	return res, nil
+}
+
+func (r *RestoreReconciler) doReconcile(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	if restore.Status.Phase == installv1beta1.BackupPhaseCompleted || restore.Status.Phase == installv1beta1.BackupPhaseFailed {
+		return ctrl.Result{}, nil
+	}
+
+	backup, err := r.getBackup(ctx, restore)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if backup.Status.Phase != installv1beta1.BackupPhaseCompleted {
+		log.Progressf("Waiting for the backup %s/%s to complete before restoring it", backup.Namespace, backup.Name)
+		return vzctrl.LongRequeue(), nil
+	}
+
+	if restore.Status.Phase == "" || restore.Status.Phase == installv1beta1.BackupPhasePending {
+		return r.startRestore(ctx, restore, backup, log)
+	}
+	return r.takeNextStep(ctx, restore, backup, log)
+}
+
+// getBackup returns the backup to restore. When the restore specifies the object storage, the backup is read from
+// its metadata in the object storage, so that it can be restored to a cluster where the VerrazzanoBackup doesn't
+// exist.
+func (r *RestoreReconciler) getBackup(ctx context.Context, restore *installv1beta1.VerrazzanoRestore) (*installv1beta1.VerrazzanoBackup, error) {
+	if restore.Spec.Storage != nil {
+		return getBackupMetadata(ctx, r.Client, restore.Namespace, restore.Spec.BackupName, *restore.Spec.Storage)
+	}
+	backup := &installv1beta1.VerrazzanoBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.BackupName}, backup); err != nil {
+		return nil, fmt.Errorf("Failed to get the backup %s/%s: %v", restore.Namespace, restore.Spec.BackupName, err)
+	}
+	return backup, nil
+}
+
+// startRestore initializes the status of the restore from the steps of the backup. The steps skipped by the backup
+// are skipped by the restore.
+func (r *RestoreReconciler) startRestore(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	now := metav1.Now()
+	restore.Status.StartTime = &now
+	restore.Status.Phase = installv1beta1.BackupPhaseInProgress
+	restore.Status.Steps = nil
+	for _, s := range r.getSteps() {
+		status := newStepStatus(s.name(), installv1beta1.BackupPhaseSkipped, "", "")
+		for _, backupStatus := range backup.Status.Steps {
+			if backupStatus.Name == s.name() && backupStatus.Phase == installv1beta1.BackupPhaseCompleted {
+				status = newStepStatus(s.name(), installv1beta1.BackupPhasePending, backupStatus.Artifact, "")
+			}
+		}
+		restore.Status.Steps = append(restore.Status.Steps, status)
+	}
+	log.Oncef("Starting the restore %s/%s of backup %s", restore.Namespace, restore.Name, backup.Name)
+	return vzctrl.ShortRequeue(), r.Status().Update(ctx, restore)
+}
+
+// takeNextStep runs the first step of the restore that is not finished, and completes the restore when all the
+// steps are finished
+func (r *RestoreReconciler) takeNextStep(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	i := getNextStep(restore.Status.Steps)
+	if i < 0 {
+		now := metav1.Now()
+		restore.Status.Phase = installv1beta1.BackupPhaseCompleted
+		restore.Status.CompletionTime = &now
+		log.Infof("Completed the restore %s/%s", restore.Namespace, restore.Name)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	}
+
+	s := findStep(r.getSteps(), restore.Status.Steps[i].Name)
+	if s == nil {
+		return ctrl.Result{}, fmt.Errorf("Unknown restore step %s", restore.Status.Steps[i].Name)
+	}
+	status, err := s.restore(ctx, restore, backup, restore.Status.Steps[i])
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	restore.Status.Steps[i] = status
+	if status.Phase == installv1beta1.BackupPhaseFailed {
+		now := metav1.Now()
+		restore.Status.Phase = installv1beta1.BackupPhaseFailed
+		restore.Status.CompletionTime = &now
+		log.Errorf("Failed the restore %s/%s at step %s: %s", restore.Namespace, restore.Name, status.Name, status.Message)
+		return ctrl.Result{}, r.Status().Update(ctx, restore)
+	}
+	return vzctrl.ShortRequeue(), r.Status().Update(ctx, restore)
+}
+
+func (r *RestoreReconciler) getSteps() []step {
+	return newRestoreSteps(r.Client)
+}
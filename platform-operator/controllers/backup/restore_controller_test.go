@@ -0,0 +1,269 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testRestoreName = "restore1"
+
+// TestRestore tests a platform restore
+// GIVEN a completed backup of the Velero resources and the Keycloak database
+// WHEN the restore is reconciled until it completes
+// THEN the Velero backup is restored, then Keycloak is scaled down while the Keycloak database is loaded from the dump
+// and scaled back up, the OpenSearch and Rancher steps are skipped, and the restore completes
+func TestRestore(t *testing.T) {
+	mysqlPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.KeycloakNamespace, Name: mysqlPodName},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: mysqlContainerName, Image: "mysql-server:8.0"}}},
+	}
+	replicas := int32(2)
+	keycloak := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.KeycloakNamespace, Name: keycloakStatefulSet},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+		Status:     appsv1.StatefulSetStatus{Replicas: replicas},
+	}
+	veleroBackup := newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, testBackupName)
+	c := newTestClient(newTestVerrazzano(), newCompletedTestBackup(), newTestRestore(), mysqlPod, keycloak, veleroBackup)
+	r := &RestoreReconciler{Client: c, Scheme: c.Scheme()}
+
+	reconcileRestore(t, r)
+	restore := getRestore(t, c)
+	assert.Equal(t, installv1beta1.BackupPhaseInProgress, restore.Status.Phase)
+	assert.Equal(t, []installv1beta1.BackupStepStatus{
+		newStepStatus(installv1beta1.BackupStepVelero, installv1beta1.BackupPhasePending, testBackupName, ""),
+		newStepStatus(installv1beta1.BackupStepMySQL, installv1beta1.BackupPhasePending, "dump1", ""),
+		newStepStatus(installv1beta1.BackupStepRancher, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepOpenSearch, installv1beta1.BackupPhaseSkipped, "", ""),
+	}, restore.Status.Steps)
+
+	// The resources of the Verrazzano namespaces are restored with Velero
+	reconcileRestore(t, r)
+	veleroRestore := getTestObject(t, c, veleroAPIVersion, veleroRestoreKind, constants.VeleroNameSpace, testRestoreName)
+	backupName, _, _ := unstructured.NestedString(veleroRestore.Object, "spec", "backupName")
+	assert.Equal(t, testBackupName, backupName)
+	policy, _, _ := unstructured.NestedString(veleroRestore.Object, "spec", "existingResourcePolicy")
+	assert.Equal(t, "update", policy)
+	setTestObjectStatus(t, c, veleroRestore, map[string]interface{}{"phase": "Completed"})
+	reconcileRestore(t, r)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, getRestore(t, c).Status.Steps[0].Phase)
+
+	// Keycloak is scaled down before its database is replaced
+	reconcileRestore(t, r)
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(keycloak), keycloak))
+	assert.Equal(t, int32(0), *keycloak.Spec.Replicas)
+	assert.Equal(t, "2", keycloak.Annotations[constants.KeycloakRestoreReplicasAnnotation])
+	job := &batchv1.Job{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: "verrazzano-restore-restore1"}, job)
+	assert.True(t, apierrors.IsNotFound(err))
+	keycloak.Status.Replicas = 0
+	assert.NoError(t, c.Update(context.TODO(), keycloak))
+
+	// The Keycloak database is loaded from the dump once the Keycloak pods are stopped
+	reconcileRestore(t, r)
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.KeycloakNamespace, Name: "verrazzano-restore-restore1"}, job))
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "mysql-server:8.0", container.Image)
+	assert.Contains(t, container.Env[1].Value, `util.loadDump("platform/backup1/mysql/dump1"`)
+	job.Status.Succeeded = 1
+	assert.NoError(t, c.Update(context.TODO(), job))
+	reconcileRestore(t, r)
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(keycloak), keycloak))
+	assert.Equal(t, int32(2), *keycloak.Spec.Replicas)
+	assert.NotContains(t, keycloak.Annotations, constants.KeycloakRestoreReplicasAnnotation)
+
+	reconcileRestore(t, r)
+	restore = getRestore(t, c)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, restore.Status.Phase)
+	assert.NotNil(t, restore.Status.CompletionTime)
+}
+
+// TestRestoreOpenSearch tests the restore of the OpenSearch snapshot
+// GIVEN a snapshot with Verrazzano data streams and indices, and other indices
+// WHEN the OpenSearch step is restored
+// THEN Fluentd is stopped, then the Verrazzano data streams and indices are deleted and restored by name, and
+// Fluentd is started once their shards are recovered
+func TestRestoreOpenSearch(t *testing.T) {
+	fluentd := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.FluentdDaemonSetName},
+		Status:     appsv1.DaemonSetStatus{CurrentNumberScheduled: 3},
+	}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoSystemNamespace, Name: "opensearch"},
+		Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "opensearch.example.com"}}},
+	}
+	c := newTestClient(fluentd, ingress)
+
+	var requests []string
+	recovering := true
+	osClient := opensearch.NewOSClient("password")
+	osClient.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		requests = append(requests, request.Method+" "+request.URL.Path)
+		body := ""
+		switch {
+		case request.Method == "GET" && request.URL.Path == "/_snapshot/backups/backup1":
+			body = `{"snapshots":[{"snapshot":"backup1","state":"SUCCESS","data_streams":["verrazzano-system"],` +
+				`"indices":[".ds-verrazzano-system-000001","verrazzano-audit",".opendistro_security"]}]}`
+		case request.Method == "GET" && recovering:
+			body = `{"verrazzano-audit":{"shards":[{"id":0}]}}`
+		case request.Method == "GET":
+			body = `{"verrazzano-audit":{"shards":[]}}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}
+	s := &opensearchStep{Client: c, newOSClient: func(client.Client) (*opensearch.OSClient, error) { return osClient, nil }}
+	backup := newTestBackup()
+	status := newStepStatus(installv1beta1.BackupStepOpenSearch, installv1beta1.BackupPhasePending, testBackupName, "")
+
+	// Fluentd is stopped before restoring
+	status, err := s.restore(context.TODO(), newTestRestore(), backup, status)
+	assert.NoError(t, err)
+	assert.Equal(t, installv1beta1.BackupPhasePending, status.Phase)
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(fluentd), fluentd))
+	assert.Equal(t, "true", fluentd.Spec.Template.Spec.NodeSelector[fluentdPausedLabel])
+	assert.Equal(t, []string{"GET /_snapshot/backups/backup1"}, requests)
+
+	fluentd.Status.CurrentNumberScheduled = 0
+	assert.NoError(t, c.Update(context.TODO(), fluentd))
+	requests = nil
+	status, err = s.restore(context.TODO(), newTestRestore(), backup, status)
+	assert.NoError(t, err)
+	assert.Equal(t, installv1beta1.BackupPhaseInProgress, status.Phase)
+	assert.Equal(t, []string{
+		"GET /_snapshot/backups/backup1",
+		"DELETE /_data_stream/verrazzano-system",
+		"DELETE /verrazzano-audit",
+		"POST /_snapshot/backups/backup1/_restore",
+	}, requests)
+
+	// Fluentd is started once the shards are recovered
+	status, err = s.restore(context.TODO(), newTestRestore(), backup, status)
+	assert.NoError(t, err)
+	assert.Equal(t, installv1beta1.BackupPhaseInProgress, status.Phase)
+	recovering = false
+	status, err = s.restore(context.TODO(), newTestRestore(), backup, status)
+	assert.NoError(t, err)
+	assert.Equal(t, installv1beta1.BackupPhaseCompleted, status.Phase)
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(fluentd), fluentd))
+	assert.NotContains(t, fluentd.Spec.Template.Spec.NodeSelector, fluentdPausedLabel)
+}
+
+// TestRestoreFromStorage tests the restore of a backup taken in another cluster
+// GIVEN a restore of a backup that is stored in the object storage, and no VerrazzanoBackup
+// WHEN the restore is reconciled
+// THEN the steps of the restore are read from the backup metadata in the object storage
+// WHEN Velero has not synchronized the backup from the object storage
+// THEN the Velero step waits for the Velero backup before creating the Velero restore
+func TestRestoreFromStorage(t *testing.T) {
+	metadata, err := json.Marshal(newCompletedTestBackup())
+	assert.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bucket/platform/backup1/verrazzano-backup.json", r.URL.Path)
+		_, _ = w.Write(metadata)
+	}))
+	defer server.Close()
+
+	restore := newTestRestore()
+	restore.Spec.Storage = &installv1beta1.BackupStorage{
+		Bucket:            "bucket",
+		Prefix:            "platform",
+		Endpoint:          server.URL,
+		Region:            "us-ashburn-1",
+		CredentialsSecret: testCredentials,
+	}
+	c := newTestClient(newTestVerrazzano(), restore)
+	r := &RestoreReconciler{Client: c, Scheme: c.Scheme()}
+
+	reconcileRestore(t, r)
+	assert.Equal(t, []installv1beta1.BackupStepStatus{
+		newStepStatus(installv1beta1.BackupStepVelero, installv1beta1.BackupPhasePending, testBackupName, ""),
+		newStepStatus(installv1beta1.BackupStepMySQL, installv1beta1.BackupPhasePending, "dump1", ""),
+		newStepStatus(installv1beta1.BackupStepRancher, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepOpenSearch, installv1beta1.BackupPhaseSkipped, "", ""),
+	}, getRestore(t, c).Status.Steps)
+
+	reconcileRestore(t, r)
+	location := getTestObject(t, c, veleroAPIVersion, veleroStorageLocationKind, constants.VeleroNameSpace, testBackupName)
+	prefix, _, _ := unstructured.NestedString(location.Object, "spec", "objectStorage", "prefix")
+	assert.Equal(t, "platform/backup1/velero", prefix)
+	obj, err := getObject(context.TODO(), c, newObject(veleroAPIVersion, veleroRestoreKind, constants.VeleroNameSpace, testRestoreName))
+	assert.NoError(t, err)
+	assert.Nil(t, obj)
+	assert.Equal(t, installv1beta1.BackupPhaseInProgress, getRestore(t, c).Status.Steps[0].Phase)
+
+	assert.NoError(t, c.Create(context.TODO(), newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, testBackupName)))
+	reconcileRestore(t, r)
+	getTestObject(t, c, veleroAPIVersion, veleroRestoreKind, constants.VeleroNameSpace, testRestoreName)
+}
+
+// TestRestoreBackupNotCompleted tests the restore of a backup that is not completed
+// GIVEN a backup that is in progress
+// WHEN the restore is reconciled
+// THEN the restore waits for the backup to complete
+func TestRestoreBackupNotCompleted(t *testing.T) {
+	backup := newTestBackup()
+	backup.Status.Phase = installv1beta1.BackupPhaseInProgress
+	c := newTestClient(newTestVerrazzano(), backup, newTestRestore())
+	r := &RestoreReconciler{Client: c, Scheme: c.Scheme()}
+
+	reconcileRestore(t, r)
+	restore := getRestore(t, c)
+	assert.Empty(t, restore.Status.Phase)
+	assert.Empty(t, restore.Status.Steps)
+}
+
+func reconcileRestore(t *testing.T, r *RestoreReconciler) {
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testRestoreName}})
+	assert.NoError(t, err)
+}
+
+func getRestore(t *testing.T, c client.Client) *installv1beta1.VerrazzanoRestore {
+	restore := &installv1beta1.VerrazzanoRestore{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testRestoreName}, restore))
+	return restore
+}
+
+func newTestRestore() *installv1beta1.VerrazzanoRestore {
+	return &installv1beta1.VerrazzanoRestore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testRestoreName},
+		Spec:       installv1beta1.VerrazzanoRestoreSpec{BackupName: testBackupName},
+	}
+}
+
+// newCompletedTestBackup returns a completed backup of the Keycloak database and the Velero resources
+func newCompletedTestBackup() *installv1beta1.VerrazzanoBackup {
+	backup := newTestBackup()
+	backup.Status.Phase = installv1beta1.BackupPhaseCompleted
+	backup.Status.Steps = []installv1beta1.BackupStepStatus{
+		newStepStatus(installv1beta1.BackupStepMySQL, installv1beta1.BackupPhaseCompleted, "dump1", ""),
+		newStepStatus(installv1beta1.BackupStepOpenSearch, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepRancher, installv1beta1.BackupPhaseSkipped, "", ""),
+		newStepStatus(installv1beta1.BackupStepVelero, installv1beta1.BackupPhaseCompleted, testBackupName, ""),
+	}
+	return backup
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/verrazzano/verrazzano/pkg/objectstorage"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// backupLabel labels the component backups and restores with the name of the platform backup or restore
+	backupLabel  = "verrazzano.io/backup"
+	restoreLabel = "verrazzano.io/restore"
+
+	// The keys of the object storage credentials secret
+	accessKeyKey = "access_key"
+	secretKeyKey = "secret_key"
+
+	// awsProfile is the profile of the AWS credentials and config files generated for the S3 clients
+	awsProfile = "default"
+
+	// backupMetadataObject is the object of the backup folder that records the specification and the status of the
+	// backup, so that the backup can be restored to a cluster where the VerrazzanoBackup doesn't exist
+	backupMetadataObject = "verrazzano-backup.json"
+)
+
+// step backs up and restores a component of the platform. A step is called on every reconcile until it has completed
+// or failed, so it must be idempotent. The status passed to a step is the last status it returned, with the artifact
+// of the backup when restoring.
+type step interface {
+	// name returns the name of the step
+	name() installv1beta1.BackupStepName
+	// isEnabled returns true if the component is installed and is backed up
+	isEnabled(vz *installv1alpha1.Verrazzano, backup *installv1beta1.VerrazzanoBackup) bool
+	// backup creates the component backup and returns its status
+	backup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error)
+	// restore restores the component backup and returns the status of the restore
+	restore(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error)
+	// cleanup deletes the component backup, returning false while the deletion is in progress
+	cleanup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (bool, error)
+}
+
+// newBackupSteps returns the steps of a backup in the order they are taken. The databases are backed up before the
+// Kubernetes resources, so that the resources restored by Velero refer to data that exists in the backup.
+func newBackupSteps(c client.Client) []step {
+	return []step{
+		&mysqlStep{Client: c},
+		newOpenSearchStep(c),
+		&rancherStep{Client: c},
+		&veleroStep{Client: c},
+	}
+}
+
+// newRestoreSteps returns the steps of a restore in dependency order. The resources of the Verrazzano namespaces,
+// including the secrets used by the other components, are restored first, then the Keycloak database so that users
+// can log in to Rancher and OpenSearch Dashboards, then Rancher and finally the OpenSearch data.
+func newRestoreSteps(c client.Client) []step {
+	return []step{
+		&veleroStep{Client: c},
+		&mysqlStep{Client: c},
+		&rancherStep{Client: c},
+		newOpenSearchStep(c),
+	}
+}
+
+// newStepStatus returns the status of a step that is complete, failed or still running
+func newStepStatus(name installv1beta1.BackupStepName, phase installv1beta1.BackupPhase, artifact string, message string) installv1beta1.BackupStepStatus {
+	return installv1beta1.BackupStepStatus{Name: name, Phase: phase, Artifact: artifact, Message: message}
+}
+
+// getStoragePath returns the folder of the bucket where a component of the backup is stored
+func getStoragePath(backup *installv1beta1.VerrazzanoBackup, component installv1beta1.BackupStepName) string {
+	return path.Join(backup.Spec.Storage.Prefix, backup.Name, string(component))
+}
+
+// getCredentialsSecretName returns the name of the object storage credentials secret of a backup in the
+// namespace of a component
+func getCredentialsSecretName(backup *installv1beta1.VerrazzanoBackup) string {
+	return fmt.Sprintf("verrazzano-backup-%s", backup.Name)
+}
+
+// getStorageCredentials returns the access key and the secret key of the object storage of a backup
+func getStorageCredentials(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup) (string, string, error) {
+	credentials := corev1.Secret{}
+	nsn := types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: backup.Spec.Storage.CredentialsSecret}
+	if err := c.Get(ctx, nsn, &credentials); err != nil {
+		return "", "", fmt.Errorf("Failed to get the object storage credentials secret %s: %v", nsn, err)
+	}
+	accessKey, secretKey := credentials.Data[accessKeyKey], credentials.Data[secretKeyKey]
+	if len(accessKey) == 0 || len(secretKey) == 0 {
+		return "", "", fmt.Errorf("The object storage credentials secret %s must have the %s and %s keys", nsn, accessKeyKey, secretKeyKey)
+	}
+	return string(accessKey), string(secretKey), nil
+}
+
+// createOrUpdateCredentialsSecret copies the object storage credentials of a backup to a secret in the namespace of
+// a component, in the format of the component
+func createOrUpdateCredentialsSecret(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup, namespace string, format func(accessKey, secretKey string) map[string][]byte) error {
+	accessKey, secretKey, err := getStorageCredentials(ctx, c, backup)
+	if err != nil {
+		return err
+	}
+	secret := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: getCredentialsSecretName(backup)}}
+	_, err = controllerutil.CreateOrUpdate(ctx, c, &secret, func() error {
+		secret.Labels = setBackupLabel(secret.Labels, backupLabel, backup.Name)
+		secret.Data = format(accessKey, secretKey)
+		return nil
+	})
+	return err
+}
+
+// deleteCredentialsSecret deletes the object storage credentials secret of a backup in the namespace of a component
+func deleteCredentialsSecret(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup, namespace string) error {
+	secret := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: getCredentialsSecretName(backup)}}
+	return client.IgnoreNotFound(c.Delete(ctx, &secret))
+}
+
+// newS3Client returns a client of the object storage of a backup
+func newS3Client(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup) (*objectstorage.S3Client, error) {
+	accessKey, secretKey, err := getStorageCredentials(ctx, c, backup)
+	if err != nil {
+		return nil, err
+	}
+	return &objectstorage.S3Client{
+		Endpoint:  backup.Spec.Storage.Endpoint,
+		Region:    backup.Spec.Storage.Region,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// deleteStorageObjects deletes the objects of a component of a backup from the object storage
+func deleteStorageObjects(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup, component installv1beta1.BackupStepName) error {
+	s3, err := newS3Client(ctx, c, backup)
+	if err != nil {
+		return err
+	}
+	// The trailing slash keeps the objects of other components whose folder starts with the same name
+	_, err = s3.DeleteObjects(ctx, backup.Spec.Storage.Bucket, getStoragePath(backup, component)+"/")
+	return err
+}
+
+// getMetadataPath returns the path of the metadata object of a backup in the object storage
+func getMetadataPath(storage installv1beta1.BackupStorage, backupName string) string {
+	return path.Join(storage.Prefix, backupName, backupMetadataObject)
+}
+
+// putBackupMetadata writes the specification and the status of a backup to the object storage
+func putBackupMetadata(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup) error {
+	s3, err := newS3Client(ctx, c, backup)
+	if err != nil {
+		return err
+	}
+	metadata := installv1beta1.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: backup.Name},
+		Spec:       backup.Spec,
+		Status:     backup.Status,
+	}
+	content, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if err := s3.PutObject(ctx, backup.Spec.Storage.Bucket, getMetadataPath(backup.Spec.Storage, backup.Name), content); err != nil {
+		return fmt.Errorf("Failed to write the metadata of the backup %s to the object storage: %v", backup.Name, err)
+	}
+	return nil
+}
+
+// getBackupMetadata reads a backup from its metadata in the object storage. The storage of the returned backup is
+// the given storage, so that the credentials of the cluster reading the backup are used.
+func getBackupMetadata(ctx context.Context, c client.Client, namespace string, backupName string, storage installv1beta1.BackupStorage) (*installv1beta1.VerrazzanoBackup, error) {
+	backup := &installv1beta1.VerrazzanoBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: backupName},
+		Spec:       installv1beta1.VerrazzanoBackupSpec{Storage: storage},
+	}
+	s3, err := newS3Client(ctx, c, backup)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s3.GetObject(ctx, storage.Bucket, getMetadataPath(storage, backupName))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read the metadata of the backup %s from the object storage: %v", backupName, err)
+	}
+	metadata := installv1beta1.VerrazzanoBackup{}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil, fmt.Errorf("Invalid metadata of the backup %s in the object storage: %v", backupName, err)
+	}
+	backup.Spec = metadata.Spec
+	backup.Spec.Storage = storage
+	backup.Status = metadata.Status
+	return backup, nil
+}
+
+// deleteBackupMetadata deletes the metadata object of a backup from the object storage
+func deleteBackupMetadata(ctx context.Context, c client.Client, backup *installv1beta1.VerrazzanoBackup) error {
+	s3, err := newS3Client(ctx, c, backup)
+	if err != nil {
+		return err
+	}
+	return s3.DeleteObject(ctx, backup.Spec.Storage.Bucket, getMetadataPath(backup.Spec.Storage, backup.Name))
+}
+
+// awsCredentials returns an AWS credentials file, used by the Velero and MySQL S3 clients
+func awsCredentials(accessKey, secretKey string) string {
+	return fmt.Sprintf("[%s]\naws_access_key_id=%s\naws_secret_access_key=%s\n", awsProfile, accessKey, secretKey)
+}
+
+// awsConfig returns an AWS config file
+func awsConfig(region string) string {
+	return fmt.Sprintf("[%s]\nregion=%s\n", awsProfile, region)
+}
+
+// setBackupLabel sets the label of a component backup or restore
+func setBackupLabel(labels map[string]string, label string, name string) map[string]string {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[label] = name
+	return labels
+}
+
+// getObject gets an unstructured object, returning nil if the object does not exist
+func getObject(ctx context.Context, c client.Client, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return obj, nil
+}
+
+// newObject returns an unstructured object of a component
+func newObject(apiVersion string, kind string, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package backup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	veleroAPIVersion            = "velero.io/v1"
+	veleroBackupKind            = "Backup"
+	veleroRestoreKind           = "Restore"
+	veleroStorageLocationKind   = "BackupStorageLocation"
+	veleroDeleteBackupRequest   = "DeleteBackupRequest"
+	veleroCredentialsKey        = "cloud"
+	verrazzanoNamespacePrefix   = "verrazzano-"
+	veleroPhaseCompleted        = "Completed"
+	veleroPhaseFailed           = "Failed"
+	veleroPhasePartiallyFailed  = "PartiallyFailed"
+	veleroPhaseFailedValidation = "FailedValidation"
+
+	// veleroExistingResourceUpdate is the Velero existing resource policy that updates the resources that exist in
+	// the cluster
+	veleroExistingResourceUpdate = "update"
+)
+
+// veleroStep backs up the resources of the Verrazzano namespaces to the object storage with Velero
+type veleroStep struct {
+	client.Client
+}
+
+func (s *veleroStep) name() installv1beta1.BackupStepName {
+	return installv1beta1.BackupStepVelero
+}
+
+func (s *veleroStep) isEnabled(vz *installv1alpha1.Verrazzano, _ *installv1beta1.VerrazzanoBackup) bool {
+	return vzcr.IsVeleroEnabled(vz)
+}
+
+// backup creates a backup storage location in the object storage and a Velero Backup of the Verrazzano namespaces
+func (s *veleroStep) backup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, constants.VeleroNameSpace, veleroCredentials); err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	if err := s.createStorageLocation(ctx, backup); err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	veleroBackup := newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, backup.Name)
+	existing, err := getObject(ctx, s.Client, veleroBackup.DeepCopy())
+	if err != nil {
+		return installv1beta1.BackupStepStatus{}, err
+	}
+	if existing == nil {
+		namespaces, err := s.getVerrazzanoNamespaces(ctx)
+		if err != nil {
+			return installv1beta1.BackupStepStatus{}, err
+		}
+		veleroBackup.SetLabels(setBackupLabel(nil, backupLabel, backup.Name))
+		veleroBackup.Object["spec"] = map[string]interface{}{
+			"includedNamespaces": namespaces,
+			"storageLocation":    backup.Name,
+			"ttl":                getTTL(backup).String(),
+			"snapshotVolumes":    false,
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, backup.Name, ""), s.Create(ctx, veleroBackup)
+	}
+
+	phase, message := getVeleroPhase(existing)
+	return newStepStatus(s.name(), phase, backup.Name, message), nil
+}
+
+// restore creates a Velero Restore of the backup. The resources that already exist are updated from the backup, since
+// the Verrazzano namespaces are recreated by the installation of Verrazzano.
+func (s *veleroStep) restore(ctx context.Context, restore *installv1beta1.VerrazzanoRestore, backup *installv1beta1.VerrazzanoBackup, status installv1beta1.BackupStepStatus) (installv1beta1.BackupStepStatus, error) {
+	if err := createOrUpdateCredentialsSecret(ctx, s.Client, backup, constants.VeleroNameSpace, veleroCredentials); err != nil {
+		return status, err
+	}
+	if err := s.createStorageLocation(ctx, backup); err != nil {
+		return status, err
+	}
+	veleroRestore := newObject(veleroAPIVersion, veleroRestoreKind, constants.VeleroNameSpace, restore.Name)
+	existing, err := getObject(ctx, s.Client, veleroRestore.DeepCopy())
+	if err != nil {
+		return status, err
+	}
+	if existing == nil {
+		// When the backup was taken in another cluster, Velero creates the Backup once it has synchronized the
+		// storage location
+		veleroBackup, err := getObject(ctx, s.Client, newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, status.Artifact))
+		if err != nil {
+			return status, err
+		}
+		if veleroBackup == nil {
+			return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, "Waiting for Velero to synchronize the backup from the object storage"), nil
+		}
+		veleroRestore.SetLabels(setBackupLabel(nil, restoreLabel, restore.Name))
+		veleroRestore.Object["spec"] = map[string]interface{}{
+			"backupName":             status.Artifact,
+			"existingResourcePolicy": veleroExistingResourceUpdate,
+		}
+		return newStepStatus(s.name(), installv1beta1.BackupPhaseInProgress, status.Artifact, ""), s.Create(ctx, veleroRestore)
+	}
+
+	phase, message := getVeleroPhase(existing)
+	return newStepStatus(s.name(), phase, status.Artifact, message), nil
+}
+
+// cleanup requests Velero to delete the backup and its data from the object storage. The storage location and the
+// credentials are deleted once Velero has deleted the backup.
+func (s *veleroStep) cleanup(ctx context.Context, backup *installv1beta1.VerrazzanoBackup, _ installv1beta1.BackupStepStatus) (bool, error) {
+	veleroBackup, err := getObject(ctx, s.Client, newObject(veleroAPIVersion, veleroBackupKind, constants.VeleroNameSpace, backup.Name))
+	if err != nil {
+		return false, err
+	}
+	if veleroBackup != nil {
+		request := newObject(veleroAPIVersion, veleroDeleteBackupRequest, constants.VeleroNameSpace, backup.Name)
+		existing, err := getObject(ctx, s.Client, request.DeepCopy())
+		if err != nil || existing != nil {
+			return false, err
+		}
+		request.SetLabels(setBackupLabel(nil, backupLabel, backup.Name))
+		request.Object["spec"] = map[string]interface{}{
+			"backupName": backup.Name,
+		}
+		return false, s.Create(ctx, request)
+	}
+
+	location := newObject(veleroAPIVersion, veleroStorageLocationKind, constants.VeleroNameSpace, backup.Name)
+	if err := s.Delete(ctx, location); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	return true, deleteCredentialsSecret(ctx, s.Client, backup, constants.VeleroNameSpace)
+}
+
+// createStorageLocation creates the Velero backup storage location of a backup, if it does not exist
+func (s *veleroStep) createStorageLocation(ctx context.Context, backup *installv1beta1.VerrazzanoBackup) error {
+	location := newObject(veleroAPIVersion, veleroStorageLocationKind, constants.VeleroNameSpace, backup.Name)
+	existing, err := getObject(ctx, s.Client, location.DeepCopy())
+	if err != nil || existing != nil {
+		return err
+	}
+	location.SetLabels(setBackupLabel(nil, backupLabel, backup.Name))
+	location.Object["spec"] = map[string]interface{}{
+		"provider": "aws",
+		"objectStorage": map[string]interface{}{
+			"bucket": backup.Spec.Storage.Bucket,
+			"prefix": getStoragePath(backup, installv1beta1.BackupStepVelero),
+		},
+		"credential": map[string]interface{}{
+			"name": getCredentialsSecretName(backup),
+			"key":  veleroCredentialsKey,
+		},
+		"config": map[string]interface{}{
+			"region":           backup.Spec.Storage.Region,
+			"s3ForcePathStyle": "true",
+			"s3Url":            backup.Spec.Storage.Endpoint,
+		},
+	}
+	return s.Create(ctx, location)
+}
+
+// getVerrazzanoNamespaces returns the Verrazzano namespaces backed up by Velero. The Velero namespace is excluded
+// because the Velero resources must not be restored.
+func (s *veleroStep) getVerrazzanoNamespaces(ctx context.Context) ([]interface{}, error) {
+	nsList := corev1.NamespaceList{}
+	if err := s.List(ctx, &nsList); err != nil {
+		return nil, err
+	}
+	var namespaces []interface{}
+	for _, ns := range nsList.Items {
+		if strings.HasPrefix(ns.Name, verrazzanoNamespacePrefix) && ns.Name != constants.VeleroNameSpace {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+	return namespaces, nil
+}
+
+// getVeleroPhase returns the phase of a Velero Backup or Restore
+func getVeleroPhase(obj *unstructured.Unstructured) (installv1beta1.BackupPhase, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case veleroPhaseCompleted:
+		return installv1beta1.BackupPhaseCompleted, ""
+	case veleroPhaseFailed, veleroPhasePartiallyFailed, veleroPhaseFailedValidation:
+		reason, _, _ := unstructured.NestedString(obj.Object, "status", "failureReason")
+		if reason == "" {
+			reason = "Velero phase is " + phase
+		}
+		return installv1beta1.BackupPhaseFailed, reason
+	}
+	return installv1beta1.BackupPhaseInProgress, ""
+}
+
+// veleroCredentials returns the credentials in the format of the Velero AWS plugin
+func veleroCredentials(accessKey, secretKey string) map[string][]byte {
+	return map[string][]byte{
+		veleroCredentialsKey: []byte(awsCredentials(accessKey, secretKey)),
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package carotation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/certs"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzctrl "github.com/verrazzano/verrazzano/pkg/controller"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager/issuer"
+	vzstatus "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/healthcheck"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/transform"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const controllerName = "carotation"
+
+// CARotationReconciler rotates the Verrazzano self-signed CA when the verrazzano.io/rotate-ca annotation of the
+// Verrazzano resource is set to a new value. A rotation goes through the following phases, recorded in the status of
+// the Verrazzano resource:
+//   - IssuingCA: the current CA is kept in the Verrazzano CA bundle, and a new CA is issued and added to the bundle
+//   - DistributingBundle: waits for the agents of the managed clusters to report that they hold the bundle with both CAs
+//   - ReissuingCertificates: the certificates issued by the previous CA are reissued, and the rotation waits for
+//     Verrazzano to be ready
+//   - Completed: the previous CA is removed from the Verrazzano CA bundle
+type CARotationReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	StatusUpdater vzstatus.Updater
+}
+
+// SetupWithManager creates a new controller and adds it to the manager
+func (r *CARotationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&installv1alpha1.Verrazzano{}).
+		Complete(r)
+}
+
+// Reconcile starts a CA rotation, or takes the next step of the rotation in progress
+func (r *CARotationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	vz := &installv1alpha1.Verrazzano{}
+	if err := r.Get(ctx, req.NamespacedName, vz); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !vz.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	log, err := vzlog.EnsureResourceLogger(&vzlog.ResourceConfig{
+		Name:           vz.Name,
+		Namespace:      vz.Namespace,
+		ID:             string(vz.UID),
+		Generation:     vz.Generation,
+		ControllerName: controllerName,
+	})
+	if err != nil {
+		zap.S().Errorf("Failed to create controller logger for CA rotation controller: %v", err)
+		return newRequeueWithDelay(), err
+	}
+
+	res, err := r.doReconcile(ctx, vz, log)
+	if err != nil {
+		log.ErrorfThrottled("Failed to rotate the CA of Verrazzano %s/%s: %v", vz.Namespace, vz.Name, err)
+		return newRequeueWithDelay(), nil
+	}
+	return res, nil
+}
+
+func (r *CARotationReconciler) doReconcile(ctx context.Context, vz *installv1alpha1.Verrazzano, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	status := vz.Status.CARotation
+	if status == nil || isFinished(status) {
+		id := vz.Annotations[vzconst.RotateCAAnnotation]
+		if len(id) == 0 || (status != nil && status.ID == id) {
+			return ctrl.Result{}, nil
+		}
+		return r.startRotation(ctx, vz, id, log)
+	}
+
+	effectiveCR, err := transform.GetEffectiveCR(vz)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	config := effectiveCR.Spec.Components.ClusterIssuer
+	if !issuer.IsCARotationSupported(config) {
+		// The issuer configuration was changed during the rotation
+		return r.failRotation(vz, log, "The cluster issuer no longer uses the Verrazzano self-signed CA")
+	}
+
+	switch status.Phase {
+	case installv1alpha1.CARotationPhaseIssuingCA:
+		return r.issueCA(ctx, vz, config, log)
+	case installv1alpha1.CARotationPhaseDistributingBundle:
+		return r.distributeBundle(ctx, vz, log)
+	case installv1alpha1.CARotationPhaseReissuingCertificates:
+		return r.reissueCertificates(ctx, vz, config, log)
+	}
+	return ctrl.Result{}, nil
+}
+
+// startRotation starts a rotation with the CA common name of the rotation ID, if the cluster issuer uses the Verrazzano
+// self-signed CA. The status is updated asynchronously, so the rotation can be started again with the same ID before
+// the status is recorded, which then issues the same CA.
+func (r *CARotationReconciler) startRotation(ctx context.Context, vz *installv1alpha1.Verrazzano, id string, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	now := metav1.Now()
+	vz.Status.CARotation = &installv1alpha1.CARotationStatus{
+		ID:                 id,
+		StartTime:          &now,
+		LastTransitionTime: &now,
+	}
+
+	effectiveCR, err := transform.GetEffectiveCR(vz)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !issuer.IsCARotationSupported(effectiveCR.Spec.Components.ClusterIssuer) {
+		return r.failRotation(vz, log, "Only the Verrazzano self-signed CA can be rotated")
+	}
+	commonName := issuer.RotationCACommonName(id)
+
+	log.Infof("Starting the rotation %s of the Verrazzano CA", id)
+	vz.Status.CARotation.Phase = installv1alpha1.CARotationPhaseIssuingCA
+	vz.Status.CARotation.CommonName = commonName
+	vz.Status.CARotation.Message = fmt.Sprintf("Issuing the new CA %s", commonName)
+	r.updateStatus(vz)
+	return vzctrl.ShortRequeue(), nil
+}
+
+// issueCA waits for the new CA to be issued and to be added to the Verrazzano CA bundle
+func (r *CARotationReconciler) issueCA(ctx context.Context, vz *installv1alpha1.Verrazzano, config *installv1alpha1.ClusterIssuerComponent, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	issued, err := issuer.IssueNewCA(log, r.Client, config, vz.Status.CARotation.CommonName)
+	if err != nil || !issued {
+		return newRequeueWithDelay(), err
+	}
+	caSecret := corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: config.CA.SecretName}, &caSecret); err != nil {
+		return ctrl.Result{}, err
+	}
+	newCA := caSecret.Data[corev1.TLSCertKey]
+	if distributed, err := r.isBundled(ctx, types.NamespacedName{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.PrivateCABundle}, vzconst.CABundleKey, newCA); err != nil || !distributed {
+		return newRequeueWithDelay(), err
+	}
+	if distributed, err := r.isBundled(ctx, types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: constants.VerrazzanoLocalCABundleSecret}, mcconstants.AdminCaBundleKey, newCA); err != nil || !distributed {
+		return newRequeueWithDelay(), err
+	}
+	return r.setPhase(vz, log, installv1alpha1.CARotationPhaseDistributingBundle, "Waiting for the managed clusters to synchronize the CA bundle")
+}
+
+// distributeBundle waits for the agents of the managed clusters to report that they hold the Verrazzano CA bundle with
+// both CAs, so that the managed clusters trust the certificates reissued by the new CA
+func (r *CARotationReconciler) distributeBundle(ctx context.Context, vz *installv1alpha1.Verrazzano, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	bundleSecret := corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: constants.VerrazzanoLocalCABundleSecret}, &bundleSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// No managed cluster was ever registered
+			return r.setPhase(vz, log, installv1alpha1.CARotationPhaseReissuingCertificates, "Reissuing the certificates with the new CA")
+		}
+		return ctrl.Result{}, err
+	}
+	fingerprint := certs.GetCABundleFingerprint(bundleSecret.Data[mcconstants.AdminCaBundleKey])
+
+	vmcList := clustersv1alpha1.VerrazzanoManagedClusterList{}
+	if err := r.List(ctx, &vmcList, client.InNamespace(constants.VerrazzanoMultiClusterNamespace)); err != nil && !meta.IsNoMatchError(err) {
+		return ctrl.Result{}, err
+	}
+	for _, vmc := range vmcList.Items {
+		if vmc.Status.LastAgentConnectTime == nil {
+			// The managed cluster is not registered yet, it gets the CA bundle when it registers
+			continue
+		}
+		if vmc.Status.AdminCABundleFingerprint != fingerprint {
+			return r.setMessage(vz, fmt.Sprintf("Waiting for the managed cluster %s to synchronize the CA bundle", vmc.Name))
+		}
+	}
+	return r.setPhase(vz, log, installv1alpha1.CARotationPhaseReissuingCertificates, "Reissuing the certificates with the new CA")
+}
+
+// reissueCertificates reissues the certificates with the new CA, and retires the previous CA once all of them are
+// reissued and Verrazzano is ready
+func (r *CARotationReconciler) reissueCertificates(ctx context.Context, vz *installv1alpha1.Verrazzano, config *installv1alpha1.ClusterIssuerComponent, log vzlog.VerrazzanoLogger) (ctrl.Result, error) {
+	done, err := issuer.ReissueCertificates(log, r.Client, vz.Status.CARotation.CommonName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !done {
+		return r.setMessage(vz, "Waiting for the certificates to be reissued with the new CA")
+	}
+	if vz.Status.State != installv1alpha1.VzStateReady {
+		return r.setMessage(vz, "Waiting for Verrazzano to be ready")
+	}
+
+	if err := issuer.RetirePreviousCA(log, r.Client, config); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Infof("Completed the rotation %s of the Verrazzano CA, the previous CA is retired", vz.Status.CARotation.ID)
+	now := metav1.Now()
+	vz.Status.CARotation.CompletionTime = &now
+	return r.setPhase(vz, log, installv1alpha1.CARotationPhaseCompleted, fmt.Sprintf("The CA was rotated to %s", vz.Status.CARotation.CommonName))
+}
+
+// failRotation fails the rotation, the previous CA is kept in the Verrazzano CA bundle if it was replaced
+func (r *CARotationReconciler) failRotation(vz *installv1alpha1.Verrazzano, log vzlog.VerrazzanoLogger, message string) (ctrl.Result, error) {
+	log.Errorf("Failed the rotation %s of the Verrazzano CA: %s", vz.Status.CARotation.ID, message)
+	now := metav1.Now()
+	vz.Status.CARotation.CompletionTime = &now
+	return r.setPhase(vz, log, installv1alpha1.CARotationPhaseFailed, message)
+}
+
+func (r *CARotationReconciler) setPhase(vz *installv1alpha1.Verrazzano, log vzlog.VerrazzanoLogger, phase installv1alpha1.CARotationPhase, message string) (ctrl.Result, error) {
+	log.Oncef("CA rotation %s: %s", vz.Status.CARotation.ID, message)
+	now := metav1.Now()
+	vz.Status.CARotation.Phase = phase
+	vz.Status.CARotation.Message = message
+	vz.Status.CARotation.LastTransitionTime = &now
+	r.updateStatus(vz)
+	if isFinished(vz.Status.CARotation) {
+		return ctrl.Result{}, nil
+	}
+	return vzctrl.ShortRequeue(), nil
+}
+
+// setMessage updates the message of the current phase, and requeues to check the progress of the phase again
+func (r *CARotationReconciler) setMessage(vz *installv1alpha1.Verrazzano, message string) (ctrl.Result, error) {
+	if vz.Status.CARotation.Message == message {
+		return newRequeueWithDelay(), nil
+	}
+	vz.Status.CARotation.Message = message
+	r.updateStatus(vz)
+	return newRequeueWithDelay(), nil
+}
+
+// updateStatus sends the state of the CA rotation to the Verrazzano status updater, which serializes the updates of
+// the Verrazzano status
+func (r *CARotationReconciler) updateStatus(vz *installv1alpha1.Verrazzano) {
+	r.StatusUpdater.Update(&vzstatus.UpdateEvent{
+		Verrazzano: vz,
+		CARotation: vz.Status.CARotation.DeepCopy(),
+	})
+}
+
+// isBundled returns true if the CA bundle in the given secret key holds the CA, or if the secret does not exist
+func (r *CARotationReconciler) isBundled(ctx context.Context, name types.NamespacedName, key string, ca []byte) (bool, error) {
+	secret := corev1.Secret{}
+	if err := r.Get(ctx, name, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return bytes.Contains(secret.Data[key], bytes.TrimSpace(ca)), nil
+}
+
+func isFinished(status *installv1alpha1.CARotationStatus) bool {
+	return status.Phase == installv1alpha1.CARotationPhaseCompleted || status.Phase == installv1alpha1.CARotationPhaseFailed
+}
+
+// newRequeueWithDelay returns a result that requeues after the time it usually takes to make progress in a phase
+func newRequeueWithDelay() ctrl.Result {
+	return vzctrl.NewRequeueWithDelay(10, 20, time.Second)
+}
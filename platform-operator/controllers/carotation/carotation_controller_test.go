@@ -0,0 +1,289 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package carotation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	certv1fake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	certv1client "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
+	"github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/pkg/certs"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager/common/fake"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager/issuer"
+	vzstatus "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/healthcheck"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace        = "default"
+	testVZName           = "verrazzano"
+	caCertificateName    = "verrazzano-ca-certificate"
+	previousCACommonName = "verrazzano-root-ca-previous"
+)
+
+// TestRotateCA tests a rotation of the Verrazzano self-signed CA
+// GIVEN a Verrazzano installation using the self-signed CA, with a managed cluster
+// WHEN the rotate-ca annotation is set and the Verrazzano resource is reconciled until the rotation completes
+// THEN a new CA is issued while the previous CA is kept in the CA bundle, the managed cluster synchronizes the bundle,
+// the certificates are reissued by the new CA, and the previous CA is retired
+func TestRotateCA(t *testing.T) {
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	caSecret := newTestCertSecret(vzconst.CertManagerNamespace, vzconst.DefaultVerrazzanoCASecretName, previousCACommonName, "")
+	previousCA := caSecret.Data[corev1.TLSCertKey]
+	leaf := newTestLeafCertificate()
+	leafSecret := newTestCertSecret(leaf.Namespace, leaf.Spec.SecretName, leaf.Name, previousCACommonName)
+	vmc := &clustersv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: "managed1"},
+		Status: clustersv1alpha1.VerrazzanoManagedClusterStatus{
+			LastAgentConnectTime:     &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			AdminCABundleFingerprint: certs.GetCABundleFingerprint(previousCA),
+		},
+	}
+	adminBundle := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMultiClusterNamespace, Name: constants.VerrazzanoLocalCABundleSecret},
+		Data:       map[string][]byte{mcconstants.AdminCaBundleKey: previousCA},
+	}
+	c := newTestClient(newTestVerrazzano("1"), caSecret, leaf, leafSecret, vmc, adminBundle,
+		&certv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.CertManagerNamespace, Name: caCertificateName},
+			Spec:       certv1.CertificateSpec{CommonName: previousCACommonName, SecretName: vzconst.DefaultVerrazzanoCASecretName, IsCA: true},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.PrivateCABundle},
+			Data:       map[string][]byte{vzconst.CABundleKey: previousCA},
+		})
+	cmClient := certv1fake.NewSimpleClientset(leaf.DeepCopy())
+	defer issuer.ResetCMClientFunc()
+	issuer.SetCMClientFunc(func() (certv1client.CertmanagerV1Interface, error) {
+		return cmClient.CertmanagerV1(), nil
+	})
+	r := &CARotationReconciler{Client: c, Scheme: c.Scheme(), StatusUpdater: &vzstatus.FakeVerrazzanoStatusUpdater{Client: c}}
+
+	// The rotation starts with the CA common name of the rotation ID
+	reconcileVerrazzano(t, r)
+	status := getCARotationStatus(t, c)
+	assert.Equal(t, "1", status.ID)
+	assert.Equal(t, installv1alpha1.CARotationPhaseIssuingCA, status.Phase)
+	assert.Equal(t, issuer.RotationCACommonName("1"), status.CommonName)
+	assert.NotNil(t, status.StartTime)
+
+	// The previous CA is kept, and the new CA is requested
+	reconcileVerrazzano(t, r)
+	previousCASecret := corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.CertManagerNamespace, Name: vzconst.PreviousCASecretName}, &previousCASecret))
+	assert.Equal(t, previousCA, previousCASecret.Data[vzconst.CACertKey])
+	caCert := certv1.Certificate{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.CertManagerNamespace, Name: caCertificateName}, &caCert))
+	assert.Equal(t, status.CommonName, caCert.Spec.CommonName)
+
+	// cert-manager issues the new CA, the rotation waits for the new CA to be added to the CA bundle
+	newCASecret := newTestCertSecret(vzconst.CertManagerNamespace, vzconst.DefaultVerrazzanoCASecretName, status.CommonName, "")
+	newCA := newCASecret.Data[corev1.TLSCertKey]
+	caSecret.Data = newCASecret.Data
+	assert.NoError(t, c.Update(context.TODO(), caSecret))
+	cmutil.SetCertificateCondition(&caCert, caCert.Generation, certv1.CertificateConditionReady, certmetav1.ConditionTrue, "Ready", "")
+	assert.NoError(t, c.Update(context.TODO(), &caCert))
+	reconcileVerrazzano(t, r)
+	assert.Equal(t, installv1alpha1.CARotationPhaseIssuingCA, getCARotationStatus(t, c).Phase)
+
+	bundle := corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.VerrazzanoSystemNamespace, Name: vzconst.PrivateCABundle}, &bundle))
+	bundle.Data[vzconst.CABundleKey] = append(append([]byte{}, newCA...), previousCA...)
+	assert.NoError(t, c.Update(context.TODO(), &bundle))
+	reconcileVerrazzano(t, r)
+	assert.Equal(t, installv1alpha1.CARotationPhaseIssuingCA, getCARotationStatus(t, c).Phase)
+
+	adminBundle.Data[mcconstants.AdminCaBundleKey] = bundle.Data[vzconst.CABundleKey]
+	assert.NoError(t, c.Update(context.TODO(), adminBundle))
+	reconcileVerrazzano(t, r)
+	assert.Equal(t, installv1alpha1.CARotationPhaseDistributingBundle, getCARotationStatus(t, c).Phase)
+
+	// The rotation waits for the managed cluster to synchronize the CA bundle
+	reconcileVerrazzano(t, r)
+	status = getCARotationStatus(t, c)
+	assert.Equal(t, installv1alpha1.CARotationPhaseDistributingBundle, status.Phase)
+	assert.Equal(t, "Waiting for the managed cluster managed1 to synchronize the CA bundle", status.Message)
+
+	// A connection of the agent does not mean that the managed cluster holds the new bundle
+	vmc.Status.LastAgentConnectTime = &metav1.Time{Time: time.Now().Add(time.Minute)}
+	assert.NoError(t, c.Update(context.TODO(), vmc))
+	reconcileVerrazzano(t, r)
+	assert.Equal(t, installv1alpha1.CARotationPhaseDistributingBundle, getCARotationStatus(t, c).Phase)
+
+	vmc.Status.AdminCABundleFingerprint = certs.GetCABundleFingerprint(adminBundle.Data[mcconstants.AdminCaBundleKey])
+	assert.NoError(t, c.Update(context.TODO(), vmc))
+	reconcileVerrazzano(t, r)
+	assert.Equal(t, installv1alpha1.CARotationPhaseReissuingCertificates, getCARotationStatus(t, c).Phase)
+
+	// The certificates issued by the previous CA are reissued
+	reconcileVerrazzano(t, r)
+	status = getCARotationStatus(t, c)
+	assert.Equal(t, installv1alpha1.CARotationPhaseReissuingCertificates, status.Phase)
+	assert.Equal(t, "Waiting for the certificates to be reissued with the new CA", status.Message)
+	renewed, err := cmClient.CertmanagerV1().Certificates(leaf.Namespace).Get(context.TODO(), leaf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, cmutil.CertificateHasCondition(renewed, certv1.CertificateCondition{Type: certv1.CertificateConditionIssuing, Status: certmetav1.ConditionTrue}))
+
+	reissuedSecret := newTestCertSecret(leaf.Namespace, leaf.Spec.SecretName, leaf.Name, status.CommonName)
+	leafSecret.Data = reissuedSecret.Data
+	assert.NoError(t, c.Update(context.TODO(), leafSecret))
+	reconcileVerrazzano(t, r)
+
+	// The previous CA is retired
+	status = getCARotationStatus(t, c)
+	assert.Equal(t, installv1alpha1.CARotationPhaseCompleted, status.Phase)
+	assert.NotNil(t, status.CompletionTime)
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.CertManagerNamespace, Name: vzconst.PreviousCASecretName}, &previousCASecret)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// The same rotation is not started again
+	res, err := r.Reconcile(context.TODO(), newTestRequest())
+	assert.NoError(t, err)
+	assert.False(t, res.Requeue)
+	assert.Equal(t, status, getCARotationStatus(t, c))
+}
+
+// TestRotateCustomCA tests the rotation of a CA that is not the Verrazzano self-signed CA
+// GIVEN a Verrazzano installation using a custom CA
+// WHEN the rotate-ca annotation is set
+// THEN the rotation fails
+func TestRotateCustomCA(t *testing.T) {
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	vz := newTestVerrazzano("1")
+	vz.Spec.Components.ClusterIssuer = &installv1alpha1.ClusterIssuerComponent{
+		ClusterResourceNamespace: "custom-ca-namespace",
+		IssuerConfig: installv1alpha1.IssuerConfig{
+			CA: &installv1alpha1.CAIssuer{SecretName: "custom-ca"},
+		},
+	}
+	c := newTestClient(vz)
+	r := &CARotationReconciler{Client: c, Scheme: c.Scheme(), StatusUpdater: &vzstatus.FakeVerrazzanoStatusUpdater{Client: c}}
+
+	reconcileVerrazzano(t, r)
+	status := getCARotationStatus(t, c)
+	assert.Equal(t, installv1alpha1.CARotationPhaseFailed, status.Phase)
+	assert.Equal(t, "Only the Verrazzano self-signed CA can be rotated", status.Message)
+	assert.NotNil(t, status.CompletionTime)
+}
+
+// TestRestartRotation tests a rotation that is started again before its status is recorded
+// GIVEN a Verrazzano installation using the self-signed CA
+// WHEN the rotate-ca annotation is set and the Verrazzano resource is reconciled twice before the status is updated
+// THEN both reconciles start the rotation with the same CA common name
+func TestRestartRotation(t *testing.T) {
+	config.TestProfilesDir = "../../manifests/profiles"
+	defer func() { config.TestProfilesDir = "" }()
+
+	c := newTestClient(newTestVerrazzano("1"))
+	updater := &recordingStatusUpdater{}
+	r := &CARotationReconciler{Client: c, Scheme: c.Scheme(), StatusUpdater: updater}
+
+	reconcileVerrazzano(t, r)
+	reconcileVerrazzano(t, r)
+	assert.Len(t, updater.events, 2)
+	for _, event := range updater.events {
+		assert.Equal(t, installv1alpha1.CARotationPhaseIssuingCA, event.CARotation.Phase)
+		assert.Equal(t, issuer.RotationCACommonName("1"), event.CARotation.CommonName)
+	}
+}
+
+// recordingStatusUpdater records the status updates without applying them
+type recordingStatusUpdater struct {
+	events []*vzstatus.UpdateEvent
+}
+
+func (u *recordingStatusUpdater) Update(event *vzstatus.UpdateEvent) {
+	u.events = append(u.events, event)
+}
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = installv1alpha1.AddToScheme(scheme)
+	_ = certv1.AddToScheme(scheme)
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	return ctrlfake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newTestVerrazzano(rotateCA string) *installv1alpha1.Verrazzano {
+	return &installv1alpha1.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   testNamespace,
+			Name:        testVZName,
+			Annotations: map[string]string{vzconst.RotateCAAnnotation: rotateCA},
+		},
+		Spec: installv1alpha1.VerrazzanoSpec{
+			Profile: installv1alpha1.Dev,
+		},
+		Status: installv1alpha1.VerrazzanoStatus{
+			State: installv1alpha1.VzStateReady,
+		},
+	}
+}
+
+func newTestLeafCertificate() *certv1.Certificate {
+	cert := &certv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoSystemNamespace, Name: "verrazzano-tls"},
+		Spec: certv1.CertificateSpec{
+			SecretName: "verrazzano-tls",
+			IssuerRef:  certmetav1.ObjectReference{Name: vzconst.VerrazzanoClusterIssuerName, Kind: "ClusterIssuer"},
+		},
+	}
+	cmutil.SetCertificateCondition(cert, cert.Generation, certv1.CertificateConditionReady, certmetav1.ConditionTrue, "Ready", "")
+	return cert
+}
+
+// newTestCertSecret returns a TLS secret with a certificate issued by the given CA, or self-signed if no CA is given
+func newTestCertSecret(namespace string, name string, commonName string, issuerCN string) *corev1.Secret {
+	var parent = fake.CreateFakeCertificate(issuerCN)
+	if len(issuerCN) == 0 {
+		parent = nil
+	}
+	certBytes, _ := fake.CreateFakeCertBytes(commonName, parent)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: certBytes},
+	}
+}
+
+func newTestRequest() ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testVZName}}
+}
+
+func reconcileVerrazzano(t *testing.T, r *CARotationReconciler) {
+	_, err := r.Reconcile(context.TODO(), newTestRequest())
+	assert.NoError(t, err)
+}
+
+func getCARotationStatus(t *testing.T, c client.Client) *installv1alpha1.CARotationStatus {
+	vz := &installv1alpha1.Verrazzano{}
+	assert.NoError(t, c.Get(context.TODO(), newTestRequest().NamespacedName, vz))
+	assert.NotNil(t, vz.Status.CARotation)
+	return vz.Status.CARotation
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearch
@@ -9,6 +9,7 @@ import (
 	"github.com/verrazzano/verrazzano-modules/pkg/controller/spi/controllerspi"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/healthcheck"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -19,11 +20,12 @@ import (
 var _ controllerspi.Reconciler = Reconciler{}
 
 type Reconciler struct {
-	Client      client.Client
-	log         vzlog.VerrazzanoLogger
-	Scheme      *runtime.Scheme
-	ModuleClass moduleapi.ModuleClassType
-	DryRun      bool
+	Client        client.Client
+	log           vzlog.VerrazzanoLogger
+	Scheme        *runtime.Scheme
+	ModuleClass   moduleapi.ModuleClassType
+	DryRun        bool
+	StatusUpdater healthcheck.Updater
 }
 
 // InitController start the  controller
@@ -43,6 +45,7 @@ func InitController(mgr ctrlruntime.Manager) error {
 	// init other controller fields
 	controller.Client = baseController.Client
 	controller.Scheme = baseController.Scheme
+	controller.StatusUpdater = healthcheck.NewStatusUpdater(mgr.GetClient())
 	return nil
 }
 
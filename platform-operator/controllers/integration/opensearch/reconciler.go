@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearch
@@ -6,6 +6,7 @@ package opensearch
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/verrazzano/verrazzano-modules/pkg/controller/result"
@@ -17,6 +18,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearchdashboards"
 	componentspi "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/healthcheck"
 
 	"github.com/verrazzano/verrazzano-modules/pkg/controller/spi/controllerspi"
 	"go.uber.org/zap"
@@ -103,6 +105,16 @@ func (r Reconciler) Reconcile(controllerCtx controllerspi.ReconcileContext, u *u
 		return result.NewResultShortRequeueDelayWithError(err)
 	}
 
+	err = r.ConfigureTemplates(controllerCtx, effectiveCR)
+	if err != nil {
+		return result.NewResultShortRequeueDelayWithError(err)
+	}
+
+	err = r.ConfigureSnapshots(controllerCtx, effectiveCR)
+	if err != nil {
+		return result.NewResultShortRequeueDelayWithError(err)
+	}
+
 	return result.NewResultRequeueDelay(5, 6, time.Minute)
 }
 
@@ -161,6 +173,51 @@ func (r Reconciler) ConfigureISMPolicies(controllerCtx controllerspi.ReconcileCo
 	return err
 }
 
+// ConfigureTemplates configures index templates and component templates added by user in Vz cr
+func (r Reconciler) ConfigureTemplates(controllerCtx controllerspi.ReconcileContext, vz *vzv1alpha1.Verrazzano) error {
+	osClient, err := r.getOSClient()
+	if err != nil {
+		return err
+	}
+	return osClient.ConfigureTemplates(r.log, r.Client, vz)
+}
+
+// ConfigureSnapshots configures snapshot repositories and policies added by user in Vz cr, and reports
+// the health of the snapshot repositories in the OpenSearch component status
+func (r Reconciler) ConfigureSnapshots(controllerCtx controllerspi.ReconcileContext, vz *vzv1alpha1.Verrazzano) error {
+	osClient, err := r.getOSClient()
+	if err != nil {
+		return err
+	}
+	statuses, err := osClient.ConfigureSnapshots(r.log, r.Client, vz)
+	// Keep the last known health while OpenSearch is not ready to report it
+	if len(statuses) > 0 || len(vz.Spec.Components.Elasticsearch.SnapshotRepositories) == 0 {
+		if updateErr := r.updateSnapshotRepositoryStatus(statuses); updateErr != nil && err == nil {
+			err = updateErr
+		}
+	}
+	return err
+}
+
+// updateSnapshotRepositoryStatus updates the snapshot repository health in the OpenSearch component status
+func (r Reconciler) updateSnapshotRepositoryStatus(statuses []vzv1alpha1.SnapshotRepositoryStatus) error {
+	vz, err := r.GetVerrazzanoCR()
+	if err != nil {
+		return err
+	}
+	compStatus := vz.Status.Components[opensearch.ComponentName]
+	if compStatus == nil || (len(statuses) == 0 && len(compStatus.SnapshotRepositories) == 0) || reflect.DeepEqual(compStatus.SnapshotRepositories, statuses) {
+		return nil
+	}
+	if statuses == nil {
+		statuses = []vzv1alpha1.SnapshotRepositoryStatus{}
+	}
+	r.StatusUpdater.Update(&healthcheck.UpdateEvent{
+		SnapshotRepositories: statuses,
+	})
+	return nil
+}
+
 // AddAutoExpandTemplate adds template to add auto expand setting for the indices
 func (r Reconciler) AddTemplateAutoExpand(controllerCtx controllerspi.ReconcileContext, vz *vzv1alpha1.Verrazzano) error {
 	osClient, err := r.getOSClient()
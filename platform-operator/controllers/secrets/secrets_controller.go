@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package secrets
@@ -85,6 +85,12 @@ func (r *VerrazzanoSecretsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return r.reconcileVerrazzanoTLS(ctx, req.NamespacedName, corev1.TLSCertKey)
 	}
 
+	// Update the private CA bundle when the previous CA is added or removed by a CA rotation
+	if isPreviousCASecret(req.NamespacedName, clusterIssuer) {
+		zap.S().Debugf("Reconciling previous CA secret %s/%s", req.Namespace, req.Name)
+		return r.reconcileVerrazzanoTLS(ctx, types.NamespacedName{Namespace: req.Namespace, Name: clusterIssuer.CA.SecretName}, corev1.TLSCertKey)
+	}
+
 	// Handle changes to the verrazzano-tls-ca secret
 	if isVerrazzanoPrivateCABundle(req.NamespacedName) {
 		zap.S().Debugf("Reconciling changes to secret %s/%s", req.Namespace, req.Name)
@@ -157,6 +163,13 @@ func isClusterIssuerSecret(secretName types.NamespacedName, clusterIssuer *insta
 	return secretName.Name == clusterIssuer.CA.SecretName && secretName.Namespace == clusterIssuer.ClusterResourceNamespace
 }
 
+func isPreviousCASecret(secretName types.NamespacedName, clusterIssuer *installv1alpha1.ClusterIssuerComponent) bool {
+	if clusterIssuer == nil || clusterIssuer.CA == nil {
+		return false
+	}
+	return secretName.Name == vzconst.PreviousCASecretName && secretName.Namespace == clusterIssuer.ClusterResourceNamespace
+}
+
 func (r *VerrazzanoSecretsReconciler) multiclusterNamespaceExists() bool {
 	ns := corev1.Namespace{}
 	err := r.Get(context.TODO(), types.NamespacedName{Name: constants.VerrazzanoMultiClusterNamespace}, &ns)
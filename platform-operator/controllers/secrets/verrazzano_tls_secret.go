@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package secrets
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/verrazzano/verrazzano/pkg/certs"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/pkg/log"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
@@ -49,6 +50,16 @@ func (r *VerrazzanoSecretsReconciler) reconcileVerrazzanoTLS(ctx context.Context
 		return result, err
 	}
 
+	// Keep trusting the previous CA while the CA is rotated
+	if ca, ok := caSecret.Data[caKey]; ok {
+		bundle, err := certs.AppendPreviousCA(r.Client, ctx, secret.Namespace, ca)
+		if err != nil {
+			r.log.ErrorfThrottled("Failed to get the previous CA of secret %s/%s: %v", secret.Namespace, secret.Name, err)
+			return newRequeueWithDelay(), nil
+		}
+		caSecret.Data[caKey] = bundle
+	}
+
 	// Update the Verrazzano private CA bundle; the source of truth from a VZ perspective
 	_, err := r.updateSecret(vzconst.VerrazzanoSystemNamespace, vzconst.PrivateCABundle,
 		vzconst.CABundleKey, caKey, &caSecret, false)
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package issuer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	cmutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	certv1client "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	"github.com/verrazzano/verrazzano/pkg/security/password"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// IsCARotationSupported returns true if the cluster issuer uses the Verrazzano self-signed CA, which is the only CA
+// that Verrazzano can rotate
+func IsCARotationSupported(config *vzapi.ClusterIssuerComponent) bool {
+	if config == nil {
+		return false
+	}
+	isDefault, err := config.IsDefaultIssuer()
+	return err == nil && isDefault
+}
+
+// NewCACommonName returns a new, random, common name for the Verrazzano self-signed CA
+func NewCACommonName() (string, error) {
+	commonNameSuffix, err := password.GenerateRandomAlphaLower(8)
+	if err != nil {
+		return "", fmt.Errorf("Failed to generate CA common name suffix: %v", err)
+	}
+	return fmt.Sprintf("%s-%s", caCertCommonName, commonNameSuffix), nil
+}
+
+// RotationCACommonName returns the common name of the Verrazzano self-signed CA issued by the CA rotation with the
+// given ID. The common name is derived from the ID, so that a rotation that is started again issues the same CA.
+func RotationCACommonName(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return fmt.Sprintf("%s-%s", caCertCommonName, hex.EncodeToString(sum[:])[:8])
+}
+
+// IssueNewCA issues a new Verrazzano self-signed CA with the given common name and a new private key. The current CA
+// is first copied to the previous CA secret, so that it stays in the Verrazzano CA bundle until the rotation completes.
+// Returns true once the new CA is issued.
+func IssueNewCA(log vzlog.VerrazzanoLogger, cli crtclient.Client, config *vzapi.ClusterIssuerComponent, commonName string) (bool, error) {
+	caCert := certv1.Certificate{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: caCertificateName}, &caCert); err != nil {
+		return false, fmt.Errorf("Failed to get the CA certificate %s/%s: %v", config.ClusterResourceNamespace, caCertificateName, err)
+	}
+	caSecret := v1.Secret{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: config.CA.SecretName}, &caSecret); err != nil {
+		return false, fmt.Errorf("Failed to get the CA secret %s/%s: %v", config.ClusterResourceNamespace, config.CA.SecretName, err)
+	}
+
+	if caCert.Spec.CommonName != commonName {
+		// The CA secret still holds the current CA, keep it before requesting the new one
+		previousCASecret := v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vzconst.PreviousCASecretName,
+				Namespace: config.ClusterResourceNamespace,
+			},
+		}
+		if _, err := controllerutil.CreateOrUpdate(context.TODO(), cli, &previousCASecret, func() error {
+			previousCASecret.Data = map[string][]byte{vzconst.CACertKey: caSecret.Data[v1.TLSCertKey]}
+			return nil
+		}); err != nil {
+			return false, fmt.Errorf("Failed to create or update the previous CA secret: %v", err)
+		}
+
+		log.Infof("Issuing the new CA %s", commonName)
+		caCert.Spec.CommonName = commonName
+		if caCert.Spec.PrivateKey == nil {
+			caCert.Spec.PrivateKey = &certv1.CertificatePrivateKey{}
+		}
+		caCert.Spec.PrivateKey.RotationPolicy = certv1.RotationPolicyAlways
+		if err := cli.Update(context.TODO(), &caCert); err != nil {
+			return false, fmt.Errorf("Failed to update the CA certificate %s/%s: %v", caCert.Namespace, caCert.Name, err)
+		}
+		return false, nil
+	}
+
+	if !cmutil.CertificateHasCondition(&caCert, certv1.CertificateCondition{Type: certv1.CertificateConditionReady, Status: certmetav1.ConditionTrue}) {
+		return false, nil
+	}
+	issuerCN, err := extractCommonNameFromCertSecret(&caSecret)
+	if err != nil {
+		return false, nil
+	}
+	return issuerCN == commonName, nil
+}
+
+// ReissueCertificates renews the certificates issued by the Verrazzano cluster issuer that are not yet issued by the
+// CA with the given common name. Returns true once all of them are issued by that CA and are ready.
+func ReissueCertificates(log vzlog.VerrazzanoLogger, cli crtclient.Client, commonName string) (bool, error) {
+	certList := certv1.CertificateList{}
+	if err := cli.List(context.TODO(), &certList); err != nil {
+		return false, err
+	}
+	var cmClient certv1client.CertmanagerV1Interface
+	done := true
+	for i, cert := range certList.Items {
+		if cert.Name == caCertificateName || cert.Spec.IssuerRef.Name != vzconst.VerrazzanoClusterIssuerName {
+			continue
+		}
+		secret := v1.Secret{}
+		if err := cli.Get(context.TODO(), types.NamespacedName{Namespace: cert.Namespace, Name: cert.Spec.SecretName}, &secret); err != nil {
+			if crtclient.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+			// The certificate is being issued
+			done = false
+			continue
+		}
+		if issuerCN, err := extractCommonNameFromCertSecret(&secret); err == nil && issuerCN == commonName {
+			done = done && cmutil.CertificateHasCondition(&certList.Items[i], certv1.CertificateCondition{Type: certv1.CertificateConditionReady, Status: certmetav1.ConditionTrue})
+			continue
+		}
+		done = false
+		if cmutil.CertificateHasCondition(&certList.Items[i], certv1.CertificateCondition{Type: certv1.CertificateConditionIssuing, Status: certmetav1.ConditionTrue}) {
+			// The renewal was already requested
+			continue
+		}
+		if cmClient == nil {
+			var err error
+			if cmClient, err = getCMClientFunc(); err != nil {
+				return false, err
+			}
+		}
+		if err := RenewCertificate(context.TODO(), cmClient, log, &certList.Items[i]); err != nil {
+			return false, err
+		}
+	}
+	return done, nil
+}
+
+// RetirePreviousCA deletes the previous CA secret, which removes the previous CA from the Verrazzano CA bundle
+func RetirePreviousCA(log vzlog.VerrazzanoLogger, cli crtclient.Client, config *vzapi.ClusterIssuerComponent) error {
+	return deleteObject(log, cli, vzconst.PreviousCASecretName, config.ClusterResourceNamespace, &v1.Secret{})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package issuer
+
+import (
+	"context"
+	"testing"
+
+	cmutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	certmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	certv1fake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	certv1client "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
+	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	cmcommonfake "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager/common/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	previousCACommonName = "verrazzano-root-ca-previous"
+	newCACommonName      = "verrazzano-root-ca-new"
+)
+
+// TestIsCARotationSupported tests the IsCARotationSupported function
+// GIVEN a cluster issuer configuration
+// WHEN IsCARotationSupported is called
+// THEN true is returned only for the Verrazzano self-signed CA, and a CA common name is returned for new CAs and rotations
+func TestIsCARotationSupported(t *testing.T) {
+	assert.True(t, IsCARotationSupported(vzapi.NewDefaultClusterIssuer()))
+	assert.False(t, IsCARotationSupported(nil))
+
+	customCA := vzapi.NewDefaultClusterIssuer()
+	customCA.CA.SecretName = "custom-ca"
+	assert.False(t, IsCARotationSupported(customCA))
+
+	acme := &vzapi.ClusterIssuerComponent{IssuerConfig: vzapi.IssuerConfig{LetsEncrypt: &vzapi.LetsEncryptACMEIssuer{}}}
+	assert.False(t, IsCARotationSupported(acme))
+
+	commonName, err := NewCACommonName()
+	assert.NoError(t, err)
+	assert.Regexp(t, "^verrazzano-root-ca-[a-z]{8}$", commonName)
+
+	// The common name of a rotation only depends on the rotation ID
+	assert.Regexp(t, "^verrazzano-root-ca-[0-9a-f]{8}$", RotationCACommonName("1"))
+	assert.Equal(t, RotationCACommonName("1"), RotationCACommonName("1"))
+	assert.NotEqual(t, RotationCACommonName("1"), RotationCACommonName("2"))
+}
+
+// TestIssueNewCA tests the IssueNewCA function
+// GIVEN the Verrazzano self-signed CA
+// WHEN IssueNewCA is called until the new CA is issued
+// THEN the current CA is kept in the previous CA secret, and the CA certificate is updated with the new common name
+// and a new private key
+func TestIssueNewCA(t *testing.T) {
+	config := vzapi.NewDefaultClusterIssuer()
+	caSecret, err := createCertSecretNoParent(config.CA.SecretName, config.ClusterResourceNamespace, previousCACommonName)
+	assert.NoError(t, err)
+	previousCA := caSecret.Data[corev1.TLSCertKey]
+	caCert := &certv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: caCertificateName, Namespace: config.ClusterResourceNamespace},
+		Spec:       certv1.CertificateSpec{CommonName: previousCACommonName, SecretName: config.CA.SecretName, IsCA: true},
+	}
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(caSecret, caCert).Build()
+
+	issued, err := IssueNewCA(vzlog.DefaultLogger(), cli, config, newCACommonName)
+	assert.NoError(t, err)
+	assert.False(t, issued)
+	previousCASecret := &corev1.Secret{}
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: vzconst.PreviousCASecretName}, previousCASecret))
+	assert.Equal(t, previousCA, previousCASecret.Data[vzconst.CACertKey])
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: caCertificateName}, caCert))
+	assert.Equal(t, newCACommonName, caCert.Spec.CommonName)
+	assert.Equal(t, certv1.RotationPolicyAlways, caCert.Spec.PrivateKey.RotationPolicy)
+
+	// cert-manager has not issued the new CA yet
+	issued, err = IssueNewCA(vzlog.DefaultLogger(), cli, config, newCACommonName)
+	assert.NoError(t, err)
+	assert.False(t, issued)
+
+	// cert-manager issues the new CA
+	newCASecret, err := createCertSecretNoParent(config.CA.SecretName, config.ClusterResourceNamespace, newCACommonName)
+	assert.NoError(t, err)
+	caSecret.Data = newCASecret.Data
+	assert.NoError(t, cli.Update(context.TODO(), caSecret))
+	cmutil.SetCertificateCondition(caCert, caCert.Generation, certv1.CertificateConditionReady, certmetav1.ConditionTrue, "Ready", "")
+	assert.NoError(t, cli.Update(context.TODO(), caCert))
+
+	issued, err = IssueNewCA(vzlog.DefaultLogger(), cli, config, newCACommonName)
+	assert.NoError(t, err)
+	assert.True(t, issued)
+	assert.NoError(t, cli.Get(context.TODO(), types.NamespacedName{Namespace: config.ClusterResourceNamespace, Name: vzconst.PreviousCASecretName}, previousCASecret))
+	assert.Equal(t, previousCA, previousCASecret.Data[vzconst.CACertKey])
+
+	assert.NoError(t, RetirePreviousCA(vzlog.DefaultLogger(), cli, config))
+	assertNotFound(t, cli, vzconst.PreviousCASecretName, config.ClusterResourceNamespace, &corev1.Secret{})
+}
+
+// TestReissueCertificates tests the ReissueCertificates function
+// GIVEN certificates issued by the previous and the new CA, and by another issuer
+// WHEN ReissueCertificates is called
+// THEN the renewal of the certificates issued by the previous CA is requested once, and true is returned once all the
+// certificates issued by the Verrazzano cluster issuer are issued by the new CA
+func TestReissueCertificates(t *testing.T) {
+	newLeaf := newLeafCertificate("new-leaf", vzconst.VerrazzanoClusterIssuerName)
+	previousLeaf := newLeafCertificate("previous-leaf", vzconst.VerrazzanoClusterIssuerName)
+	otherLeaf := newLeafCertificate("other-leaf", "other-issuer")
+	newLeafSecret, err := createLeafSecret(newLeaf, newCACommonName)
+	assert.NoError(t, err)
+	previousLeafSecret, err := createLeafSecret(previousLeaf, previousCACommonName)
+	assert.NoError(t, err)
+	otherLeafSecret, err := createLeafSecret(otherLeaf, "other-ca")
+	assert.NoError(t, err)
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(newLeaf, previousLeaf, otherLeaf, newLeafSecret, previousLeafSecret, otherLeafSecret).Build()
+
+	cmClient := certv1fake.NewSimpleClientset(previousLeaf.DeepCopy())
+	defer func() { getCMClientFunc = GetCertManagerClientset }()
+	getCMClientFunc = func() (certv1client.CertmanagerV1Interface, error) {
+		return cmClient.CertmanagerV1(), nil
+	}
+
+	done, err := ReissueCertificates(vzlog.DefaultLogger(), cli, newCACommonName)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	renewed, err := cmClient.CertmanagerV1().Certificates(previousLeaf.Namespace).Get(context.TODO(), previousLeaf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, cmutil.CertificateHasCondition(renewed, certv1.CertificateCondition{Type: certv1.CertificateConditionIssuing, Status: certmetav1.ConditionTrue}))
+
+	// cert-manager reissues the certificate with the new CA
+	reissuedSecret, err := createLeafSecret(previousLeaf, newCACommonName)
+	assert.NoError(t, err)
+	previousLeafSecret.Data = reissuedSecret.Data
+	assert.NoError(t, cli.Update(context.TODO(), previousLeafSecret))
+
+	done, err = ReissueCertificates(vzlog.DefaultLogger(), cli, newCACommonName)
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+func newLeafCertificate(name string, issuer string) *certv1.Certificate {
+	cert := &certv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vzconst.VerrazzanoSystemNamespace},
+		Spec: certv1.CertificateSpec{
+			SecretName: name + "-secret",
+			IssuerRef:  certmetav1.ObjectReference{Name: issuer, Kind: "ClusterIssuer"},
+		},
+	}
+	cmutil.SetCertificateCondition(cert, cert.Generation, certv1.CertificateConditionReady, certmetav1.ConditionTrue, "Ready", "")
+	return cert
+}
+
+func createLeafSecret(cert *certv1.Certificate, issuerCN string) (*corev1.Secret, error) {
+	certBytes, err := cmcommonfake.CreateFakeCertBytes(cert.Name, cmcommonfake.CreateFakeCertificate(issuerCN))
+	if err != nil {
+		return nil, err
+	}
+	return createCertSecret(cert.Spec.SecretName, cert.Namespace, certBytes)
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package issuer
@@ -22,16 +22,18 @@ import (
 	vzresource "github.com/verrazzano/verrazzano/pkg/k8s/resource"
 	"github.com/verrazzano/verrazzano/pkg/k8sutil"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
-	"github.com/verrazzano/verrazzano/pkg/security/password"
 	vzstring "github.com/verrazzano/verrazzano/pkg/string"
 	"github.com/verrazzano/verrazzano/pkg/vzcr"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	cmcommon "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/certmanager/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -374,7 +376,7 @@ func createOrUpdateAcmeResources(log vzlog.VerrazzanoLogger, client crtclient.Cl
 		return opResult, err
 	}
 	// Update or create the unstructured object
-	log.Debug("Applying ClusterIssuer with OCI DNS")
+	log.Debug("Applying ClusterIssuer with DNS01 challenge solver")
 	if opResult, err = controllerutil.CreateOrUpdate(context.TODO(), client, getCIObject, func() error {
 		ciObject, err := createACMEIssuerObject(log, client, vz, config)
 		if err != nil {
@@ -389,6 +391,9 @@ func createOrUpdateAcmeResources(log vzlog.VerrazzanoLogger, client crtclient.Cl
 }
 
 func createACMEIssuerObject(log vzlog.VerrazzanoLogger, client crtclient.Client, vz *vzapi.Verrazzano, config *vzapi.ClusterIssuerComponent) (*unstructured.Unstructured, error) {
+	if provider := vzconfig.GetDNSProvider(vz.Spec.Components.DNS); provider != nil {
+		return createProviderACMEIssuerObject(log, provider, config)
+	}
 	// Initialize Acme variables for the cluster issuer
 	var ociDNSConfigSecret string
 	var ociDNSZoneName string
@@ -438,6 +443,40 @@ func createACMEIssuerObject(log vzlog.VerrazzanoLogger, client crtclient.Client,
 	return ciObject, err
 }
 
+// createProviderACMEIssuerObject creates the ACME ClusterIssuer solving the DNS01 challenges with a DNS provider other
+// than OCI DNS
+func createProviderACMEIssuerObject(log vzlog.VerrazzanoLogger, provider vzconfig.DNSProvider, config *vzapi.ClusterIssuerComponent) (*unstructured.Unstructured, error) {
+	solver, err := provider.DNS01Solver()
+	if err != nil {
+		return nil, log.ErrorfNewErr("Failed to create the DNS01 challenge solver: %v", err)
+	}
+	vzCertAcme := config.LetsEncrypt
+	acmeServer := letsEncryptProdEndpoint
+	if certs.IsLetsEncryptStagingEnv(*vzCertAcme) {
+		acmeServer = letsEncryptStageEndpoint
+	}
+	clusterIssuer := &certv1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: vzconst.VerrazzanoClusterIssuerName},
+		Spec: certv1.IssuerSpec{
+			IssuerConfig: certv1.IssuerConfig{
+				ACME: &acmev1.ACMEIssuer{
+					Email:  vzCertAcme.EmailAddress,
+					Server: acmeServer,
+					PrivateKey: certmetav1.SecretKeySelector{
+						LocalObjectReference: certmetav1.LocalObjectReference{Name: caAcmeSecretName},
+					},
+					Solvers: []acmev1.ACMEChallengeSolver{{DNS01: solver}},
+				},
+			},
+		},
+	}
+	ciObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clusterIssuer)
+	if err != nil {
+		return nil, log.ErrorfNewErr("Failed to convert the ClusterIssuer: %v", err)
+	}
+	return &unstructured.Unstructured{Object: ciObject}, nil
+}
+
 func createAcmeClusterIssuer(log vzlog.VerrazzanoLogger, clusterIssuerData templateData) (*unstructured.Unstructured, error) {
 	var buff bytes.Buffer
 	// Parse the template string and create the template object
@@ -508,15 +547,14 @@ func createOrUpdateCAResources(log vzlog.VerrazzanoLogger, crtClient crtclient.C
 				Namespace: issuerConfig.ClusterResourceNamespace,
 			},
 		}
-		commonNameSuffix, err := password.GenerateRandomAlphaLower(8)
+		commonName, err := NewCACommonName()
 		if err != nil {
-			return controllerutil.OperationResultNone,
-				log.ErrorfNewErr("Failed to generate CA common name suffix: %v", err)
+			return controllerutil.OperationResultNone, log.ErrorfNewErr("%v", err)
 		}
 		if _, err := controllerutil.CreateOrUpdate(context.TODO(), crtClient, &certObject, func() error {
 			certObject.Spec = certv1.CertificateSpec{
 				SecretName: vzCertCA.SecretName,
-				CommonName: fmt.Sprintf("%s-%s", caCertCommonName, commonNameSuffix),
+				CommonName: commonName,
 				IsCA:       true,
 				IssuerRef: certmetav1.ObjectReference{
 					Name: issuer.Name,
@@ -680,6 +718,9 @@ func (c clusterIssuerComponent) createOrUpdateClusterIssuer(compContext spi.Comp
 	var opResult controllerutil.OperationResult
 	if !isCAValue {
 		// Create resources needed for Acme certificates
+		if err := common.CopyDNSProviderSecret(compContext, clusterIssuerConfig.ClusterResourceNamespace); err != nil {
+			return err
+		}
 		if opResult, err = createOrUpdateAcmeResources(compContext.Log(), compContext.Client(), effectiveCR, clusterIssuerConfig); err != nil {
 			return compContext.Log().ErrorfNewErr("Failed creating Acme resources: %v", err)
 		}
@@ -773,7 +814,8 @@ func createPrivateCABundle(log vzlog.VerrazzanoLogger, c crtclient.Client, clust
 			caSecretNamespace,
 			caSecretName, certKey)
 	}
-	return caSecret.Data[certKey], nil
+	// Keep trusting the previous CA while the CA is rotated
+	return certs.AppendPreviousCA(c, context.TODO(), caSecretNamespace, caSecret.Data[certKey])
 }
 
 func getPrivateBundleData(log vzlog.VerrazzanoLogger, c crtclient.Client, clusterIssuer *vzapi.ClusterIssuerComponent) ([]byte, error) {
@@ -822,6 +864,11 @@ func (c clusterIssuerComponent) uninstallVerrazzanoCertManagerResources(compCont
 		return err
 	}
 
+	// Delete the previous CA secret if a CA rotation did not complete
+	if err := deleteObject(compContext.Log(), compContext.Client(), vzconst.PreviousCASecretName, issuerConfig.ClusterResourceNamespace, &v1.Secret{}); err != nil {
+		return err
+	}
+
 	// Delete the LetsEncrypt secret if present
 	err = vzresource.Resource{
 		Name:      caAcmeSecretName,
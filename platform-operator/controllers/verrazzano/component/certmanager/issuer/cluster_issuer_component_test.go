@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package issuer
@@ -305,6 +305,55 @@ func TestPostInstallAcme(t *testing.T) {
 	verifyPrivateCABundleExists(t, client, assert.NoError, leStagingBundleBytes)
 }
 
+// TestInstallAcmeRFC2136 tests the Install function
+// GIVEN a call to Install
+//
+//	WHEN the cert type is Acme and an RFC 2136 DNS provider is configured
+//	THEN the ClusterIssuer solves the DNS01 challenges with RFC 2136, and the TSIG secret is copied to the cluster
+//	     resource namespace
+func TestInstallAcmeRFC2136(t *testing.T) {
+	localvz := defaultVZConfig.DeepCopy()
+	localvz.Spec.Components.CertManager.Certificate.Acme = acme
+	localvz.Spec.Components.DNS = &vzapi.DNSComponent{
+		RFC2136: &vzapi.RFC2136{
+			DNSZoneName:   testDNSDomain,
+			Nameserver:    "10.0.0.53",
+			TSIGKeyName:   "verrazzano",
+			TSIGAlgorithm: "hmac-sha512",
+			TSIGSecret:    "tsig",
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tsig", Namespace: constants.VerrazzanoInstallNamespace},
+		Data:       map[string][]byte{"tsig-secret": []byte("secret")},
+	}).Build()
+
+	defer func() { getCMClientFunc = GetCertManagerClientset }()
+	cmClient := certv1fake.NewSimpleClientset()
+	getCMClientFunc = func() (certv1client.CertmanagerV1Interface, error) {
+		return cmClient.CertmanagerV1(), nil
+	}
+
+	err := fakeComponent.Install(spi.NewFakeContext(client, localvz, nil, false, profileDir))
+	assert.NoError(t, err)
+
+	secret := &corev1.Secret{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Name: "tsig", Namespace: ComponentNamespace}, secret))
+	assert.Equal(t, []byte("secret"), secret.Data["tsig-secret"])
+
+	issuer := &certv1.ClusterIssuer{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Name: constants2.VerrazzanoClusterIssuerName}, issuer))
+	assert.NotNil(t, issuer.Spec.ACME)
+	assert.Equal(t, acme.EmailAddress, issuer.Spec.ACME.Email)
+	assert.Equal(t, letsEncryptStageEndpoint, issuer.Spec.ACME.Server)
+	assert.Len(t, issuer.Spec.ACME.Solvers, 1)
+	rfc2136 := issuer.Spec.ACME.Solvers[0].DNS01.RFC2136
+	assert.NotNil(t, rfc2136)
+	assert.Equal(t, "10.0.0.53:53", rfc2136.Nameserver)
+	assert.Equal(t, "HMACSHA512", rfc2136.TSIGAlgorithm)
+	assert.Equal(t, "tsig", rfc2136.TSIGSecret.Name)
+}
+
 // TestPostUpgradeAcmeUpdate tests the PostUpgrade function
 // GIVEN a call to PostUpgrade
 //
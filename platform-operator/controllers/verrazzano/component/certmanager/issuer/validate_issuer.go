@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package issuer
@@ -57,31 +57,18 @@ func getEnvironmentName(effectiveCR runtime.Object) string {
 }
 
 func getDNSSuffix(effectiveCR runtime.Object) (string, bool) {
-	dnsSuffix, wildcard := "0.0.0.0", true
-	if cr, ok := effectiveCR.(*vzapi.Verrazzano); ok {
-		if cr.Spec.Components.DNS == nil || cr.Spec.Components.DNS.Wildcard != nil {
-			return fmt.Sprintf("%s.%s", dnsSuffix, vzconfig.GetWildcardDomain(cr.Spec.Components.DNS)), wildcard
-		} else if cr.Spec.Components.DNS.OCI != nil {
-			wildcard = false
-			dnsSuffix = cr.Spec.Components.DNS.OCI.DNSZoneName
-		} else if cr.Spec.Components.DNS.External != nil {
-			wildcard = false
-			dnsSuffix = cr.Spec.Components.DNS.External.Suffix
+	cr, ok := effectiveCR.(*vzapi.Verrazzano)
+	if !ok {
+		// The DNS zone name is resolved from the v1alpha1 DNS component
+		cr = &vzapi.Verrazzano{}
+		if err := cr.ConvertFrom(effectiveCR.(*v1beta1.Verrazzano)); err != nil {
+			return "", false
 		}
-		return dnsSuffix, wildcard
 	}
-
-	cr := effectiveCR.(*v1beta1.Verrazzano)
 	if cr.Spec.Components.DNS == nil || cr.Spec.Components.DNS.Wildcard != nil {
-		return fmt.Sprintf("%s.%s", dnsSuffix, vzconfig.GetWildcardDomain(cr.Spec.Components.DNS)), wildcard
-	} else if cr.Spec.Components.DNS.OCI != nil {
-		wildcard = false
-		dnsSuffix = cr.Spec.Components.DNS.OCI.DNSZoneName
-	} else if cr.Spec.Components.DNS.External != nil {
-		wildcard = false
-		dnsSuffix = cr.Spec.Components.DNS.External.Suffix
-	}
-	return dnsSuffix, wildcard
+		return fmt.Sprintf("0.0.0.0.%s", vzconfig.GetWildcardDomain(cr.Spec.Components.DNS)), true
+	}
+	return vzconfig.GetDNSZoneName(cr.Spec.Components.DNS), false
 }
 
 // validateConfiguration Validates the ClusterIssuer Certificate configuration
@@ -92,9 +79,53 @@ func validateConfiguration(vz *v1beta1.Verrazzano) (err error) {
 	if err := validateCertificate(vz.Spec.Components.CertManager); err != nil {
 		return err
 	}
+	if err := validateDNSProviders(vz.Spec.Components.DNS, vz.Spec.Components.ClusterIssuer); err != nil {
+		return err
+	}
 	return validateIssuerConfig(vz.Spec.Components.ClusterIssuer)
 }
 
+// validateDNSProviders validates the DNS providers used to solve the ACME DNS01 challenges; only one provider can be
+// configured, and it must define the settings needed by external-dns and the DNS01 challenge solver. Wildcard and
+// external DNS are not checked, they do not manage DNS records.
+func validateDNSProviders(dns *v1beta1.DNSComponent, issuerComponent *v1beta1.ClusterIssuerComponent) error {
+	if dns == nil {
+		return nil
+	}
+	count := 0
+	for _, configured := range []bool{dns.OCI != nil, dns.RFC2136 != nil, dns.PowerDNS != nil, dns.Webhook != nil} {
+		if configured {
+			count++
+		}
+	}
+	if count > 1 {
+		return errors.New("Only one DNS provider can be configured: oci, rfc2136, powerDNS or webhook")
+	}
+	isACME := issuerComponent != nil && issuerComponent.LetsEncrypt != nil
+	if rfc2136 := dns.RFC2136; rfc2136 != nil {
+		if len(rfc2136.DNSZoneName) == 0 || len(rfc2136.Nameserver) == 0 || len(rfc2136.TSIGKeyName) == 0 || len(rfc2136.TSIGSecret) == 0 {
+			return errors.New("The RFC 2136 DNS configuration requires dnsZoneName, nameserver, tsigKeyName and tsigSecret")
+		}
+		if !vzconfig.IsValidTSIGAlgorithm(rfc2136.TSIGAlgorithm) {
+			return fmt.Errorf("Invalid RFC 2136 TSIG algorithm %s, must be one of hmac-md5, hmac-sha1, hmac-sha256 or hmac-sha512", rfc2136.TSIGAlgorithm)
+		}
+	}
+	if powerDNS := dns.PowerDNS; powerDNS != nil {
+		if len(powerDNS.DNSZoneName) == 0 || len(powerDNS.APIURL) == 0 || len(powerDNS.APIKeySecret) == 0 {
+			return errors.New("The PowerDNS configuration requires dnsZoneName, apiURL and apiKeySecret")
+		}
+		if isACME && len(powerDNS.WebhookGroupName) == 0 {
+			return errors.New("The PowerDNS configuration requires webhookGroupName to solve the Let's Encrypt DNS01 challenges")
+		}
+	}
+	if webhook := dns.Webhook; webhook != nil {
+		if len(webhook.DNSZoneName) == 0 || len(webhook.GroupName) == 0 || len(webhook.SolverName) == 0 {
+			return errors.New("The webhook DNS configuration requires dnsZoneName, groupName and solverName")
+		}
+	}
+	return nil
+}
+
 func validateIssuerConfig(issuerComponent *v1beta1.ClusterIssuerComponent) error {
 	if issuerComponent == nil {
 		return nil
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package issuer
@@ -507,3 +507,57 @@ func getCaSecretCR() *vzapi.Verrazzano {
 		},
 	}
 }
+
+// TestValidateDNSProviders tests the validateDNSProviders function
+// GIVEN a call to validateDNSProviders
+//
+//	WHEN for various DNS provider configurations
+//	THEN an error is returned if a provider is misconfigured, or more than one provider is configured
+func TestValidateDNSProviders(t *testing.T) {
+	letsEncrypt := &v1beta1.ClusterIssuerComponent{
+		IssuerConfig: v1beta1.IssuerConfig{
+			LetsEncrypt: &v1beta1.LetsEncryptACMEIssuer{EmailAddress: emailAddress},
+		},
+	}
+	rfc2136 := &v1beta1.RFC2136{DNSZoneName: "example.com", Nameserver: "10.0.0.1", TSIGKeyName: "vz", TSIGSecret: "tsig"}
+	powerDNS := &v1beta1.PowerDNS{DNSZoneName: "example.com", APIURL: "http://pdns", APIKeySecret: "pdns"}
+	tests := []struct {
+		name    string
+		dns     *v1beta1.DNSComponent
+		issuer  *v1beta1.ClusterIssuerComponent
+		wantErr bool
+	}{
+		{name: "no DNS", dns: nil, issuer: letsEncrypt},
+		{name: "valid RFC 2136", dns: &v1beta1.DNSComponent{RFC2136: rfc2136}, issuer: letsEncrypt},
+		{
+			name:    "RFC 2136 without nameserver",
+			dns:     &v1beta1.DNSComponent{RFC2136: &v1beta1.RFC2136{DNSZoneName: "example.com", TSIGKeyName: "vz", TSIGSecret: "tsig"}},
+			wantErr: true,
+		},
+		{
+			name:    "RFC 2136 with invalid TSIG algorithm",
+			dns:     &v1beta1.DNSComponent{RFC2136: &v1beta1.RFC2136{DNSZoneName: "example.com", Nameserver: "10.0.0.1", TSIGKeyName: "vz", TSIGSecret: "tsig", TSIGAlgorithm: "hmac-sha384"}},
+			wantErr: true,
+		},
+		{name: "PowerDNS without Let's Encrypt", dns: &v1beta1.DNSComponent{PowerDNS: powerDNS}},
+		{name: "PowerDNS with Let's Encrypt and no webhook group", dns: &v1beta1.DNSComponent{PowerDNS: powerDNS}, issuer: letsEncrypt, wantErr: true},
+		{
+			name:   "PowerDNS with Let's Encrypt and webhook group",
+			dns:    &v1beta1.DNSComponent{PowerDNS: &v1beta1.PowerDNS{DNSZoneName: "example.com", APIURL: "http://pdns", APIKeySecret: "pdns", WebhookGroupName: "acme.example.com"}},
+			issuer: letsEncrypt,
+		},
+		{name: "webhook without solver name", dns: &v1beta1.DNSComponent{Webhook: &v1beta1.WebhookDNS{DNSZoneName: "example.com", GroupName: "acme.example.com"}}, wantErr: true},
+		{name: "webhook", dns: &v1beta1.DNSComponent{Webhook: &v1beta1.WebhookDNS{DNSZoneName: "example.com", GroupName: "acme.example.com", SolverName: "solver"}}},
+		{name: "OCI and RFC 2136", dns: &v1beta1.DNSComponent{OCI: &v1beta1.OCI{DNSZoneName: "example.com"}, RFC2136: rfc2136}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDNSProviders(tt.dns, tt.issuer)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
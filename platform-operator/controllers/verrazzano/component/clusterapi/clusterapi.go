@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
@@ -56,6 +56,24 @@ images:
     repository: {{.GetVerrazzanoAddonRepository}}
     tag: {{.GetVerrazzanoAddonTag}}
   {{ end }}
+
+  {{- if not .KubeadmBootstrapOverridesExists }}
+  bootstrap-kubeadm:
+    repository: {{.GetKubeadmBootstrapRepository}}
+    tag: {{.GetKubeadmBootstrapTag}}
+  {{ end }}
+
+  {{- if not .KubeadmControlPlaneOverridesExists }}
+  control-plane-kubeadm:
+    repository: {{.GetKubeadmControlPlaneRepository}}
+    tag: {{.GetKubeadmControlPlaneTag}}
+  {{ end }}
+
+  {{- if not .DockerOverridesExists }}
+  infrastructure-docker:
+    repository: {{.GetDockerRepository}}
+    tag: {{.GetDockerTag}}
+  {{ end }}
 {{ end }}
 
 providers:
@@ -74,26 +92,41 @@ providers:
   - name: "verrazzano"
     url: "{{.GetVerrazzanoAddonURL}}"
     type: "AddonProvider"
+  - name: "kubeadm"
+    url: "{{.GetKubeadmBootstrapURL}}"
+    type: "BootstrapProvider"
+  - name: "kubeadm"
+    url: "{{.GetKubeadmControlPlaneURL}}"
+    type: "ControlPlaneProvider"
+  - name: "docker"
+    url: "{{.GetDockerURL}}"
+    type: "InfrastructureProvider"
 `
 
 const (
-	clusterTopology                           = "CLUSTER_TOPOLOGY"
-	expClusterResourceSet                     = "EXP_CLUSTER_RESOURCE_SET"
-	expMachinePool                            = "EXP_MACHINE_POOL"
-	initOCIClientsOnStartup                   = "INIT_OCI_CLIENTS_ON_STARTUP"
-	clusterAPIControllerImage                 = "cluster-api-controller"
-	clusterAPIOCIControllerImage              = "cluster-api-oci-controller"
-	clusterAPIOCNEBoostrapControllerImage     = "cluster-api-ocne-bootstrap-controller"
-	clusterAPIOCNEControlPLaneControllerImage = "cluster-api-ocne-control-plane-controller"
-	clusterAPIVerrazzanoAddonControllerImage  = "cluster-api-verrazzano-addon-controller"
-	defaultClusterAPIDir                      = "/verrazzano/.cluster-api"
-	clusterAPIDirEnv                          = "VERRAZZANO_CLUSTER_API_DIR"
-	providerLabel                             = "cluster.x-k8s.io/provider"
-	clusterAPIProvider                        = "cluster-api"
-	bootstrapOcneProvider                     = "bootstrap-ocne"
-	controlPlaneOcneProvider                  = "control-plane-ocne"
-	infrastructureOciProvider                 = "infrastructure-oci"
-	verrazzanoAddonProvider                   = "addon-verrazzano"
+	clusterTopology                              = "CLUSTER_TOPOLOGY"
+	expClusterResourceSet                        = "EXP_CLUSTER_RESOURCE_SET"
+	expMachinePool                               = "EXP_MACHINE_POOL"
+	initOCIClientsOnStartup                      = "INIT_OCI_CLIENTS_ON_STARTUP"
+	clusterAPIControllerImage                    = "cluster-api-controller"
+	clusterAPIOCIControllerImage                 = "cluster-api-oci-controller"
+	clusterAPIOCNEBoostrapControllerImage        = "cluster-api-ocne-bootstrap-controller"
+	clusterAPIOCNEControlPLaneControllerImage    = "cluster-api-ocne-control-plane-controller"
+	clusterAPIVerrazzanoAddonControllerImage     = "cluster-api-verrazzano-addon-controller"
+	clusterAPIKubeadmBootstrapControllerImage    = "kubeadm-bootstrap-controller"
+	clusterAPIKubeadmControlPlaneControllerImage = "kubeadm-control-plane-controller"
+	clusterAPIDockerControllerImage              = "capd-manager"
+	defaultClusterAPIDir                         = "/verrazzano/.cluster-api"
+	clusterAPIDirEnv                             = "VERRAZZANO_CLUSTER_API_DIR"
+	providerLabel                                = "cluster.x-k8s.io/provider"
+	clusterAPIProvider                           = "cluster-api"
+	bootstrapOcneProvider                        = "bootstrap-ocne"
+	controlPlaneOcneProvider                     = "control-plane-ocne"
+	infrastructureOciProvider                    = "infrastructure-oci"
+	verrazzanoAddonProvider                      = "addon-verrazzano"
+	bootstrapKubeadmProvider                     = "bootstrap-kubeadm"
+	controlPlaneKubeadmProvider                  = "control-plane-kubeadm"
+	infrastructureDockerProvider                 = "infrastructure-docker"
 )
 
 type ImageConfig struct {
@@ -104,11 +137,14 @@ type ImageConfig struct {
 
 // PodMatcherClusterAPI matches pods with an out of date CoreProvider, BootstrapProvider, ControlPlaneProvider, or InfrastructureProvider.
 type PodMatcherClusterAPI struct {
-	coreProvider           string
-	bootstrapProvider      string
-	controlPlaneProvider   string
-	infrastructureProvider string
-	addonProvider          string
+	coreProvider                string
+	bootstrapProvider           string
+	controlPlaneProvider        string
+	infrastructureProvider      string
+	addonProvider               string
+	kubeadmBootstrapProvider    string
+	kubeadmControlPlaneProvider string
+	dockerProvider              string
 }
 
 var clusterAPIDir = defaultClusterAPIDir
@@ -242,6 +278,9 @@ func (c *PodMatcherClusterAPI) initializeImageVersionsOverrides(log vzlog.Verraz
 	c.bootstrapProvider = overrides.GetOCNEBootstrapControllerFullImagePath()
 	c.controlPlaneProvider = overrides.GetOCNEControlPlaneControllerFullImagePath()
 	c.addonProvider = overrides.GetVerrazzanoAddonControllerFullImagePath()
+	c.kubeadmBootstrapProvider = overrides.GetKubeadmBootstrapControllerFullImagePath()
+	c.kubeadmControlPlaneProvider = overrides.GetKubeadmControlPlaneControllerFullImagePath()
+	c.dockerProvider = overrides.GetDockerControllerFullImagePath()
 	return nil
 }
 
@@ -291,6 +330,15 @@ func (c *PodMatcherClusterAPI) matchAndPrepareUpgradeOptions(ctx spi.ComponentCo
 			if ok, version := applyUpgradeVersion(ctx.Log(), overrides.GetVerrazzanoAddonOverridesURL(), overrides.GetVerrazzanoAddonOverridesVersion(), overrides.GetVerrazzanoAddonBomVersion(), clusterAPIVerrazzanoAddonControllerImage, co.Image, c.addonProvider); ok {
 				applyUpgradeOptions.AddonProviders = append(applyUpgradeOptions.AddonProviders, fmt.Sprintf(formatString, ComponentNamespace, verrazzanoAddonProviderName, version))
 			}
+			if ok, version := applyUpgradeVersion(ctx.Log(), overrides.GetKubeadmBootstrapOverridesURL(), overrides.GetKubeadmBootstrapOverridesVersion(), overrides.GetKubeadmBootstrapBomVersion(), clusterAPIKubeadmBootstrapControllerImage, co.Image, c.kubeadmBootstrapProvider); ok {
+				applyUpgradeOptions.BootstrapProviders = append(applyUpgradeOptions.BootstrapProviders, fmt.Sprintf(formatString, ComponentNamespace, kubeadmProviderName, version))
+			}
+			if ok, version := applyUpgradeVersion(ctx.Log(), overrides.GetKubeadmControlPlaneOverridesURL(), overrides.GetKubeadmControlPlaneOverridesVersion(), overrides.GetKubeadmControlPlaneBomVersion(), clusterAPIKubeadmControlPlaneControllerImage, co.Image, c.kubeadmControlPlaneProvider); ok {
+				applyUpgradeOptions.ControlPlaneProviders = append(applyUpgradeOptions.ControlPlaneProviders, fmt.Sprintf(formatString, ComponentNamespace, kubeadmProviderName, version))
+			}
+			if ok, version := applyUpgradeVersion(ctx.Log(), overrides.GetDockerOverridesURL(), overrides.GetDockerOverridesVersion(), overrides.GetDockerBomVersion(), clusterAPIDockerControllerImage, co.Image, c.dockerProvider); ok {
+				applyUpgradeOptions.InfrastructureProviders = append(applyUpgradeOptions.InfrastructureProviders, fmt.Sprintf(formatString, ComponentNamespace, dockerProviderName, version))
+			}
 		}
 	}
 
@@ -307,48 +355,54 @@ func isUpgradeOptionsNotEmpty(upgradeOptions capiUpgradeOptions) bool {
 }
 
 func getComponentsToUpgrade(c client.Client, options capiUpgradeOptions) ([]client.Object, error) {
-	var componentObjects []client.Object
-
+	var providerLabels []string
 	if options.CoreProvider != "" {
-		coreComponents, err := getComponentsForProviderType(c, clusterAPIProvider, constants.VerrazzanoCAPINamespace)
-		if err != nil {
-			return componentObjects, err
-		}
-		componentObjects = append(componentObjects, coreComponents...)
-	}
+		providerLabels = append(providerLabels, clusterAPIProvider)
+	}
+	providerLabels = append(providerLabels, getProviderLabels(options.BootstrapProviders, map[string]string{
+		ocneProviderName:    bootstrapOcneProvider,
+		kubeadmProviderName: bootstrapKubeadmProvider,
+	})...)
+	providerLabels = append(providerLabels, getProviderLabels(options.ControlPlaneProviders, map[string]string{
+		ocneProviderName:    controlPlaneOcneProvider,
+		kubeadmProviderName: controlPlaneKubeadmProvider,
+	})...)
+	providerLabels = append(providerLabels, getProviderLabels(options.InfrastructureProviders, map[string]string{
+		ociProviderName:    infrastructureOciProvider,
+		dockerProviderName: infrastructureDockerProvider,
+	})...)
+	providerLabels = append(providerLabels, getProviderLabels(options.AddonProviders, map[string]string{
+		verrazzanoAddonProviderName: verrazzanoAddonProvider,
+	})...)
 
-	if len(options.BootstrapProviders) != 0 {
-		boostrapComponents, err := getComponentsForProviderType(c, bootstrapOcneProvider, constants.VerrazzanoCAPINamespace)
+	var componentObjects []client.Object
+	for _, providerLabel := range providerLabels {
+		components, err := getComponentsForProviderType(c, providerLabel, constants.VerrazzanoCAPINamespace)
 		if err != nil {
 			return componentObjects, err
 		}
-		componentObjects = append(componentObjects, boostrapComponents...)
+		componentObjects = append(componentObjects, components...)
 	}
+	return componentObjects, nil
+}
 
-	if len(options.ControlPlaneProviders) != 0 {
-		controlPlaneComponents, err := getComponentsForProviderType(c, controlPlaneOcneProvider, constants.VerrazzanoCAPINamespace)
-		if err != nil {
-			return componentObjects, err
+// getProviderLabels maps upgrade options of the form <namespace>/<provider>:<version> to the provider label values
+// of the components being upgraded.
+func getProviderLabels(options []string, labelsByProvider map[string]string) []string {
+	var providerLabels []string
+	for _, option := range options {
+		name := option
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
 		}
-		componentObjects = append(componentObjects, controlPlaneComponents...)
-	}
-
-	if len(options.InfrastructureProviders) != 0 {
-		infrastructureComponents, err := getComponentsForProviderType(c, infrastructureOciProvider, constants.VerrazzanoCAPINamespace)
-		if err != nil {
-			return componentObjects, err
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[:i]
 		}
-		componentObjects = append(componentObjects, infrastructureComponents...)
-	}
-
-	if len(options.AddonProviders) != 0 {
-		addonComponents, err := getComponentsForProviderType(c, verrazzanoAddonProvider, constants.VerrazzanoCAPINamespace)
-		if err != nil {
-			return componentObjects, err
+		if providerLabel, ok := labelsByProvider[name]; ok {
+			providerLabels = append(providerLabels, providerLabel)
 		}
-		componentObjects = append(componentObjects, addonComponents...)
 	}
-	return componentObjects, nil
+	return providerLabels
 }
 
 // getComponentsForProviderType - return a list of ClusterRoles, ClusterRoleBindings, Roles and RoleBindings that are associated with provider specified.
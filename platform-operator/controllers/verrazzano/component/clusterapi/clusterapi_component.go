@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
@@ -36,15 +36,20 @@ const ComponentNamespace = constants.VerrazzanoCAPINamespace
 const ComponentJSONName = "clusterAPI"
 
 const (
-	capiCMDeployment                 = "capi-controller-manager"
-	capiOcneBootstrapCMDeployment    = "capi-ocne-bootstrap-controller-manager"
-	capiOcneControlPlaneCMDeployment = "capi-ocne-control-plane-controller-manager"
-	capiociCMDeployment              = "capoci-controller-manager"
-	capiVerrazzanoAddonCMDeployment  = "capi-verrazzano-addon-controller-manager"
-	ocneProviderName                 = "ocne"
-	ociProviderName                  = "oci"
-	clusterAPIProviderName           = "cluster-api"
-	verrazzanoAddonProviderName      = "verrazzano"
+	capiCMDeployment                    = "capi-controller-manager"
+	capiOcneBootstrapCMDeployment       = "capi-ocne-bootstrap-controller-manager"
+	capiOcneControlPlaneCMDeployment    = "capi-ocne-control-plane-controller-manager"
+	capiociCMDeployment                 = "capoci-controller-manager"
+	capiVerrazzanoAddonCMDeployment     = "capi-verrazzano-addon-controller-manager"
+	capiKubeadmBootstrapCMDeployment    = "capi-kubeadm-bootstrap-controller-manager"
+	capiKubeadmControlPlaneCMDeployment = "capi-kubeadm-control-plane-controller-manager"
+	capdCMDeployment                    = "capd-controller-manager"
+	ocneProviderName                    = "ocne"
+	ociProviderName                     = "oci"
+	clusterAPIProviderName              = "cluster-api"
+	verrazzanoAddonProviderName         = "verrazzano"
+	kubeadmProviderName                 = "kubeadm"
+	dockerProviderName                  = "docker"
 )
 
 var capiDeployments = []types.NamespacedName{
@@ -68,6 +73,18 @@ var capiDeployments = []types.NamespacedName{
 		Name:      capiVerrazzanoAddonCMDeployment,
 		Namespace: ComponentNamespace,
 	},
+	{
+		Name:      capiKubeadmBootstrapCMDeployment,
+		Namespace: ComponentNamespace,
+	},
+	{
+		Name:      capiKubeadmControlPlaneCMDeployment,
+		Namespace: ComponentNamespace,
+	},
+	{
+		Name:      capdCMDeployment,
+		Namespace: ComponentNamespace,
+	},
 }
 
 type capiUpgradeOptions struct {
@@ -262,9 +279,12 @@ func (c clusterAPIComponent) Install(ctx spi.ComponentContext) error {
 
 	overridesContext := newOverridesContext(overrides)
 	coreArgValue := fmt.Sprintf("%s:%s", clusterAPIProviderName, overridesContext.GetClusterAPIVersion())
-	controlPlaneArgValue := fmt.Sprintf("%s:%s", ocneProviderName, overridesContext.GetOCNEControlPlaneVersion())
-	infrastructureArgValue := fmt.Sprintf("%s:%s", ociProviderName, overridesContext.GetOCIVersion())
-	bootstrapArgValue := fmt.Sprintf("%s:%s", ocneProviderName, overridesContext.GetOCNEBootstrapVersion())
+	controlPlaneArgValue := fmt.Sprintf("%s:%s,%s:%s", ocneProviderName, overridesContext.GetOCNEControlPlaneVersion(),
+		kubeadmProviderName, overridesContext.GetKubeadmControlPlaneVersion())
+	infrastructureArgValue := fmt.Sprintf("%s:%s,%s:%s", ociProviderName, overridesContext.GetOCIVersion(),
+		dockerProviderName, overridesContext.GetDockerVersion())
+	bootstrapArgValue := fmt.Sprintf("%s:%s,%s:%s", ocneProviderName, overridesContext.GetOCNEBootstrapVersion(),
+		kubeadmProviderName, overridesContext.GetKubeadmBootstrapVersion())
 	addonArgValue := fmt.Sprintf("%s:%s", verrazzanoAddonProviderName, overridesContext.GetVerrazzanoAddonVersion())
 	cmd := exec.Command("clusterctl", "init",
 		"--target-namespace", ComponentNamespace,
@@ -352,19 +372,19 @@ func (c clusterAPIComponent) Upgrade(ctx spi.ComponentContext) error {
 		}
 		if len(applyUpgradeOptions.BootstrapProviders) > 0 {
 			args = append(args, "--bootstrap")
-			args = append(args, applyUpgradeOptions.BootstrapProviders[0])
+			args = append(args, strings.Join(applyUpgradeOptions.BootstrapProviders, ","))
 		}
 		if len(applyUpgradeOptions.ControlPlaneProviders) > 0 {
 			args = append(args, "--control-plane")
-			args = append(args, applyUpgradeOptions.ControlPlaneProviders[0])
+			args = append(args, strings.Join(applyUpgradeOptions.ControlPlaneProviders, ","))
 		}
 		if len(applyUpgradeOptions.InfrastructureProviders) > 0 {
 			args = append(args, "--infrastructure")
-			args = append(args, applyUpgradeOptions.InfrastructureProviders[0])
+			args = append(args, strings.Join(applyUpgradeOptions.InfrastructureProviders, ","))
 		}
 		if len(applyUpgradeOptions.AddonProviders) > 0 {
 			args = append(args, "--addon")
-			args = append(args, applyUpgradeOptions.AddonProviders[0])
+			args = append(args, strings.Join(applyUpgradeOptions.AddonProviders, ","))
 		}
 
 		cmd := exec.Command("clusterctl", args...)
@@ -393,6 +413,21 @@ func (c clusterAPIComponent) Upgrade(ctx spi.ComponentContext) error {
 		return err
 	}
 
+	// Earlier versions also did not install the kubeadm and Docker providers used by Docker quick create clusters.
+	namespacedName.Name = capdCMDeployment
+	if err := ctx.Client().Get(context.TODO(), namespacedName, &deployment); err != nil {
+		if errors.IsNotFound(err) {
+			cmd := exec.Command("clusterctl", "init",
+				"--target-namespace", ComponentNamespace,
+				"--bootstrap", fmt.Sprintf("%s:%s", kubeadmProviderName, overridesContext.GetKubeadmBootstrapVersion()),
+				"--control-plane", fmt.Sprintf("%s:%s", kubeadmProviderName, overridesContext.GetKubeadmControlPlaneVersion()),
+				"--infrastructure", fmt.Sprintf("%s:%s", dockerProviderName, overridesContext.GetDockerVersion()))
+			return runCAPICmd(cmd, ctx.Log())
+		}
+		ctx.Log().ErrorfThrottled("Failed to get deployment %v: %v", namespacedName, err)
+		return err
+	}
+
 	return nil
 }
 
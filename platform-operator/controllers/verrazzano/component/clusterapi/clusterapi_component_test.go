@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -911,7 +912,50 @@ func getReadyDeployments() *fake.ClientBuilder {
 				Annotations: map[string]string{deploymentRevisionAnnotation: "1"},
 			},
 		},
-	)
+	).WithObjects(getReadyDeploymentObjects(capiKubeadmBootstrapCMDeployment, bootstrapKubeadmProvider)...).
+		WithObjects(getReadyDeploymentObjects(capiKubeadmControlPlaneCMDeployment, controlPlaneKubeadmProvider)...).
+		WithObjects(getReadyDeploymentObjects(capdCMDeployment, infrastructureDockerProvider)...)
+}
+
+// getReadyDeploymentObjects returns a ready deployment with its pod and replica set for the given provider
+func getReadyDeploymentObjects(name string, provider string) []client.Object {
+	return []client.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ComponentNamespace,
+				Name:      name,
+				Labels:    map[string]string{providerLabel: provider},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{providerLabel: provider},
+				},
+			},
+			Status: appsv1.DeploymentStatus{
+				AvailableReplicas: 1,
+				ReadyReplicas:     1,
+				Replicas:          1,
+				UpdatedReplicas:   1,
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ComponentNamespace,
+				Name:      name + "-95d8c5d91-m6mbr",
+				Labels: map[string]string{
+					podTemplateHashLabel: "95d8c5d91",
+					providerLabel:        provider,
+				},
+			},
+		},
+		&appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ComponentNamespace,
+				Name:        name + "-95d8c5d91",
+				Annotations: map[string]string{deploymentRevisionAnnotation: "1"},
+			},
+		},
+	}
 }
 
 func fakeCAPICmdRunner(cmd *exec.Cmd) error {
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
@@ -29,11 +29,14 @@ type globalOverrides struct {
 }
 
 type defaultProviders struct {
-	OCNEBootstrap    capiProvider `json:"ocneBootstrap,omitempty"`
-	OCNEControlPlane capiProvider `json:"ocneControlPlane,omitempty"`
-	Core             capiProvider `json:"core,omitempty"`
-	OCI              capiProvider `json:"oci,omitempty"`
-	VerrazzanoAddon  capiProvider `json:"verrazzanoAddon,omitempty"`
+	OCNEBootstrap       capiProvider `json:"ocneBootstrap,omitempty"`
+	OCNEControlPlane    capiProvider `json:"ocneControlPlane,omitempty"`
+	Core                capiProvider `json:"core,omitempty"`
+	OCI                 capiProvider `json:"oci,omitempty"`
+	VerrazzanoAddon     capiProvider `json:"verrazzanoAddon,omitempty"`
+	KubeadmBootstrap    capiProvider `json:"kubeadmBootstrap,omitempty"`
+	KubeadmControlPlane capiProvider `json:"kubeadmControlPlane,omitempty"`
+	Docker              capiProvider `json:"docker,omitempty"`
 }
 
 type capiProvider struct {
@@ -98,6 +101,33 @@ type OverridesInterface interface {
 	GetVerrazzanoAddonOverridesURL() string
 	GetVerrazzanoAddonVersion() string
 	GetVerrazzanoAddonURL() string
+	GetKubeadmBootstrapRepository() string
+	GetKubeadmBootstrapControllerFullImagePath() string
+	GetKubeadmBootstrapTag() string
+	GetKubeadmBootstrapURL() string
+	GetKubeadmBootstrapOverridesURL() string
+	GetKubeadmBootstrapVersion() string
+	GetKubeadmBootstrapOverridesVersion() string
+	GetKubeadmBootstrapBomVersion() string
+	KubeadmBootstrapOverridesExists() bool
+	GetKubeadmControlPlaneRepository() string
+	GetKubeadmControlPlaneControllerFullImagePath() string
+	GetKubeadmControlPlaneTag() string
+	GetKubeadmControlPlaneURL() string
+	GetKubeadmControlPlaneOverridesURL() string
+	GetKubeadmControlPlaneVersion() string
+	GetKubeadmControlPlaneOverridesVersion() string
+	GetKubeadmControlPlaneBomVersion() string
+	KubeadmControlPlaneOverridesExists() bool
+	GetDockerRepository() string
+	GetDockerControllerFullImagePath() string
+	GetDockerTag() string
+	GetDockerURL() string
+	GetDockerOverridesURL() string
+	GetDockerVersion() string
+	GetDockerOverridesVersion() string
+	GetDockerBomVersion() string
+	DockerOverridesExists() bool
 	IncludeImagesHeader() bool
 }
 
@@ -265,11 +295,108 @@ func (c capiOverrides) GetVerrazzanoAddonVersion() string {
 	return getProviderVersion(c.DefaultProviders.VerrazzanoAddon)
 }
 
+func (c capiOverrides) GetKubeadmBootstrapRepository() string {
+	return getRepositoryForProvider(c, c.DefaultProviders.KubeadmBootstrap)
+}
+
+func (c capiOverrides) GetKubeadmBootstrapTag() string {
+	return c.DefaultProviders.KubeadmBootstrap.Image.Tag
+}
+
+func (c capiOverrides) GetKubeadmBootstrapURL() string {
+	return getURLForProvider(c.DefaultProviders.KubeadmBootstrap, "cluster-api")
+}
+
+func (c capiOverrides) GetKubeadmBootstrapOverridesURL() string {
+	return c.DefaultProviders.KubeadmBootstrap.URL
+}
+
+func (c capiOverrides) GetKubeadmBootstrapVersion() string {
+	return getProviderVersion(c.DefaultProviders.KubeadmBootstrap)
+}
+
+func (c capiOverrides) GetKubeadmBootstrapOverridesVersion() string {
+	return c.DefaultProviders.KubeadmBootstrap.Version
+}
+
+func (c capiOverrides) GetKubeadmBootstrapBomVersion() string {
+	return c.DefaultProviders.KubeadmBootstrap.Image.BomVersion
+}
+
+func (c capiOverrides) KubeadmBootstrapOverridesExists() bool {
+	return len(c.GetKubeadmBootstrapOverridesVersion()) > 0 || len(c.GetKubeadmBootstrapOverridesURL()) > 0
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneRepository() string {
+	return getRepositoryForProvider(c, c.DefaultProviders.KubeadmControlPlane)
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneTag() string {
+	return c.DefaultProviders.KubeadmControlPlane.Image.Tag
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneURL() string {
+	return getURLForProvider(c.DefaultProviders.KubeadmControlPlane, "cluster-api")
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneOverridesURL() string {
+	return c.DefaultProviders.KubeadmControlPlane.URL
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneVersion() string {
+	return getProviderVersion(c.DefaultProviders.KubeadmControlPlane)
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneOverridesVersion() string {
+	return c.DefaultProviders.KubeadmControlPlane.Version
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneBomVersion() string {
+	return c.DefaultProviders.KubeadmControlPlane.Image.BomVersion
+}
+
+func (c capiOverrides) KubeadmControlPlaneOverridesExists() bool {
+	return len(c.GetKubeadmControlPlaneOverridesVersion()) > 0 || len(c.GetKubeadmControlPlaneOverridesURL()) > 0
+}
+
+func (c capiOverrides) GetDockerRepository() string {
+	return getRepositoryForProvider(c, c.DefaultProviders.Docker)
+}
+
+func (c capiOverrides) GetDockerTag() string {
+	return c.DefaultProviders.Docker.Image.Tag
+}
+
+func (c capiOverrides) GetDockerURL() string {
+	return getURLForProvider(c.DefaultProviders.Docker, "cluster-api")
+}
+
+func (c capiOverrides) GetDockerOverridesURL() string {
+	return c.DefaultProviders.Docker.URL
+}
+
+func (c capiOverrides) GetDockerVersion() string {
+	return getProviderVersion(c.DefaultProviders.Docker)
+}
+
+func (c capiOverrides) GetDockerOverridesVersion() string {
+	return c.DefaultProviders.Docker.Version
+}
+
+func (c capiOverrides) GetDockerBomVersion() string {
+	return c.DefaultProviders.Docker.Image.BomVersion
+}
+
+func (c capiOverrides) DockerOverridesExists() bool {
+	return len(c.GetDockerOverridesVersion()) > 0 || len(c.GetDockerOverridesURL()) > 0
+}
+
 // IncludeImagesHeader returns true if the overrides for any of the default providers is not specified.
 // Otherwise, returns false.
 func (c capiOverrides) IncludeImagesHeader() bool {
 	if !c.ClusterAPIOverridesExists() || !c.OCIOverridesExists() || !c.OCNEControlPlaneOverridesExists() ||
-		!c.OCNEBootstrapOverridesExists() || len(c.GetVerrazzanoAddonVersion()) == 0 {
+		!c.OCNEBootstrapOverridesExists() || len(c.GetVerrazzanoAddonVersion()) == 0 || !c.KubeadmBootstrapOverridesExists() ||
+		!c.KubeadmControlPlaneOverridesExists() || !c.DockerOverridesExists() {
 		return true
 	}
 	return false
@@ -295,6 +422,18 @@ func (c capiOverrides) GetVerrazzanoAddonControllerFullImagePath() string {
 	return fmt.Sprintf("%s/%s:%s", c.GetVerrazzanoAddonRepository(), clusterAPIVerrazzanoAddonControllerImage, c.GetVerrazzanoAddonTag())
 }
 
+func (c capiOverrides) GetKubeadmBootstrapControllerFullImagePath() string {
+	return fmt.Sprintf("%s/%s:%s", c.GetKubeadmBootstrapRepository(), clusterAPIKubeadmBootstrapControllerImage, c.GetKubeadmBootstrapTag())
+}
+
+func (c capiOverrides) GetKubeadmControlPlaneControllerFullImagePath() string {
+	return fmt.Sprintf("%s/%s:%s", c.GetKubeadmControlPlaneRepository(), clusterAPIKubeadmControlPlaneControllerImage, c.GetKubeadmControlPlaneTag())
+}
+
+func (c capiOverrides) GetDockerControllerFullImagePath() string {
+	return fmt.Sprintf("%s/%s:%s", c.GetDockerRepository(), clusterAPIDockerControllerImage, c.GetDockerTag())
+}
+
 // getRepositoryForProvider - return the repository in the format that clusterctl
 // expects (registry/owner)
 func getRepositoryForProvider(overrides capiOverrides, provider capiProvider) string {
@@ -394,6 +533,12 @@ func getBaseOverrides() (*capiOverrides, error) {
 	overrides.DefaultProviders.OCNEControlPlane.MetadataFile = "control-plane-components.yaml"
 	overrides.DefaultProviders.VerrazzanoAddon.Name = verrazzanoAddonProvider
 	overrides.DefaultProviders.VerrazzanoAddon.MetadataFile = "addon-components.yaml"
+	overrides.DefaultProviders.KubeadmBootstrap.Name = bootstrapKubeadmProvider
+	overrides.DefaultProviders.KubeadmBootstrap.MetadataFile = "bootstrap-components.yaml"
+	overrides.DefaultProviders.KubeadmControlPlane.Name = controlPlaneKubeadmProvider
+	overrides.DefaultProviders.KubeadmControlPlane.MetadataFile = "control-plane-components.yaml"
+	overrides.DefaultProviders.Docker.Name = infrastructureDockerProvider
+	overrides.DefaultProviders.Docker.MetadataFile = "infrastructure-components-development.yaml"
 	return overrides, err
 }
 
@@ -450,6 +595,30 @@ func mergeBOMOverrides(ctx spi.ComponentContext, overrides *capiOverrides) error
 	}
 	updateImage(imageConfig, addon)
 
+	// Populate kubeadm bootstrap provider values
+	kubeadmBootstrap := &overrides.DefaultProviders.KubeadmBootstrap.Image
+	imageConfig, err = getImageOverride(ctx, bomFile, "capi-kubeadm", "capi-kubeadm", "kubeadm-bootstrap-controller")
+	if err != nil {
+		return err
+	}
+	updateImage(imageConfig, kubeadmBootstrap)
+
+	// Populate kubeadm controlPlane provider values
+	kubeadmControlPlane := &overrides.DefaultProviders.KubeadmControlPlane.Image
+	imageConfig, err = getImageOverride(ctx, bomFile, "capi-kubeadm", "capi-kubeadm", "kubeadm-control-plane-controller")
+	if err != nil {
+		return err
+	}
+	updateImage(imageConfig, kubeadmControlPlane)
+
+	// Populate Docker provider values
+	docker := &overrides.DefaultProviders.Docker.Image
+	imageConfig, err = getImageOverride(ctx, bomFile, "capi-docker", "capi-docker", "capd-manager")
+	if err != nil {
+		return err
+	}
+	updateImage(imageConfig, docker)
+
 	return nil
 }
 
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
@@ -84,6 +84,24 @@ func TestBomOverrides(t *testing.T) {
 	assert.Equal(t, "v1.0.0", addon.Image.BomVersion)
 	assert.Equal(t, "", addon.Version)
 	assert.Equal(t, "", addon.URL)
+
+	kubeadmBootstrap := overrides.DefaultProviders.KubeadmBootstrap
+	assert.Equal(t, "verrazzano", kubeadmBootstrap.Image.Repository)
+	assert.Equal(t, CoreImageTag, kubeadmBootstrap.Image.Tag)
+	assert.Equal(t, "v1.3.3", kubeadmBootstrap.Image.BomVersion)
+	assert.Equal(t, bootstrapKubeadmProvider, kubeadmBootstrap.Name)
+
+	kubeadmControlPlane := overrides.DefaultProviders.KubeadmControlPlane
+	assert.Equal(t, "verrazzano", kubeadmControlPlane.Image.Repository)
+	assert.Equal(t, CoreImageTag, kubeadmControlPlane.Image.Tag)
+	assert.Equal(t, "v1.3.3", kubeadmControlPlane.Image.BomVersion)
+	assert.Equal(t, controlPlaneKubeadmProvider, kubeadmControlPlane.Name)
+
+	docker := overrides.DefaultProviders.Docker
+	assert.Equal(t, "verrazzano", docker.Image.Repository)
+	assert.Equal(t, CoreImageTag, docker.Image.Tag)
+	assert.Equal(t, "v1.3.3", docker.Image.BomVersion)
+	assert.Equal(t, infrastructureDockerProvider, docker.Name)
 }
 
 // TestUserOverrides tests getting the override values for the Cluster API component
@@ -1,9 +1,10 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package clusterapi
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -262,3 +263,21 @@ func TestToSetUpgradeOptions(t *testing.T) {
 	assert.Equal(t, expectedUpgradeOption, applyUpgradeOptions.ControlPlaneProviders)
 	assert.NoError(t, err)
 }
+
+// TestGetProviderLabels tests the getProviderLabels function
+// GIVEN upgrade options for more than one provider of the same type
+//
+//	WHEN getProviderLabels is called
+//	THEN the provider label of each option is returned
+func TestGetProviderLabels(t *testing.T) {
+	options := []string{
+		fmt.Sprintf("%s/%s:v0.1.0", ComponentNamespace, ocneProviderName),
+		fmt.Sprintf("%s/%s:v1.5.3", ComponentNamespace, kubeadmProviderName),
+		fmt.Sprintf("%s/unknown:v1.0.0", ComponentNamespace),
+	}
+	providerLabels := getProviderLabels(options, map[string]string{
+		ocneProviderName:    bootstrapOcneProvider,
+		kubeadmProviderName: bootstrapKubeadmProvider,
+	})
+	assert.Equal(t, []string{bootstrapOcneProvider, bootstrapKubeadmProvider}, providerLabels)
+}
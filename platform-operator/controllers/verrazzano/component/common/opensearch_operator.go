@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package common
@@ -40,6 +40,11 @@ const (
 	clusterName         = "opensearch"
 	opensearchNodeLabel = "verrazzano.io/opensearch-nodepool"
 	testBomFilePath     = "../../../../verrazzano-bom.json"
+
+	// s3ClientSettingsPrefix prefixes the OpenSearch settings of the S3 clients used by snapshot repositories
+	s3ClientSettingsPrefix = "s3.client."
+	s3AccessKey            = "access_key"
+	s3SecretKey            = "secret_key"
 )
 
 var (
@@ -75,6 +80,7 @@ func BuildArgsForOpenSearchCR(ctx spi.ComponentContext) (map[string]interface{},
 
 	args["isOpenSearchEnabled"] = vzcr.IsOpenSearchEnabled(effectiveCR)
 	args["isOpenSearchDashboardsEnabled"] = vzcr.IsOpenSearchDashboardsEnabled(effectiveCR)
+	args["isMultitenancyEnabled"] = vzcr.IsOpenSearchDashboardsMultitenancyEnabled(effectiveCR)
 
 	// Bootstrap pod overrides
 	args["bootstrapConfig"] = ""
@@ -129,6 +135,19 @@ func BuildArgsForOpenSearchCR(ctx spi.ComponentContext) (map[string]interface{},
 		}
 	}
 
+	// Append the keystore entries holding the snapshot repository credentials
+	args["keystore"] = ""
+	if opensearch != nil {
+		keystore := buildSnapshotKeystore(opensearch.SnapshotRepositories)
+		if len(keystore) > 0 {
+			keystoreList, err := yaml.Marshal(keystore)
+			if err != nil {
+				return args, err
+			}
+			args["keystore"] = string(keystoreList)
+		}
+	}
+
 	err := buildNodePoolOverrides(ctx, args, masterNode)
 	if err != nil {
 		return args, ctx.Log().ErrorfNewErr("Failed to build nodepool overrides: %v", err)
@@ -339,9 +358,89 @@ func convertOSNodesToNodePools(ctx spi.ComponentContext, masterNode string) ([]N
 			"cluster.initial_master_nodes": fmt.Sprintf("%s-%s-0", clusterName, masterNode),
 		}
 	}
+	addS3ClientSettings(nodePools, effectiveCR.Spec.Components.Elasticsearch.SnapshotRepositories)
 	return nodePools, nil
 }
 
+// addS3ClientSettings sets the S3 client settings of the snapshot repositories on every node pool, replacing
+// the settings of any repositories that were removed
+func addS3ClientSettings(nodePools []NodePool, repositories []vzapi.OpenSearchSnapshotRepository) {
+	settings := map[string]string{}
+	for _, repository := range repositories {
+		if repository.S3 == nil {
+			continue
+		}
+		prefix := s3ClientSettingPrefix(repository.Name)
+		if repository.S3.Endpoint != "" {
+			settings[prefix+"endpoint"] = repository.S3.Endpoint
+		}
+		if repository.S3.Region != "" {
+			settings[prefix+"region"] = repository.S3.Region
+		}
+		if repository.S3.PathStyleAccess {
+			settings[prefix+"path_style_access"] = "true"
+		}
+	}
+	for i := range nodePools {
+		for key := range nodePools[i].AdditionalConfig {
+			if strings.HasPrefix(key, s3ClientSettingsPrefix) {
+				delete(nodePools[i].AdditionalConfig, key)
+			}
+		}
+		if len(settings) == 0 {
+			continue
+		}
+		if nodePools[i].AdditionalConfig == nil {
+			nodePools[i].AdditionalConfig = map[string]string{}
+		}
+		for key, value := range settings {
+			nodePools[i].AdditionalConfig[key] = value
+		}
+	}
+}
+
+// buildSnapshotKeystore returns the OpenSearch keystore entries that map the snapshot repository credentials
+// to the secure settings of the S3 client of each repository
+func buildSnapshotKeystore(repositories []vzapi.OpenSearchSnapshotRepository) []KeystoreEntry {
+	var keystore []KeystoreEntry
+	for _, repository := range repositories {
+		if repository.S3 == nil || repository.S3.CredentialsSecret == "" {
+			continue
+		}
+		prefix := s3ClientSettingPrefix(repository.Name)
+		keystore = append(keystore, KeystoreEntry{
+			Secret: corev1.LocalObjectReference{Name: repository.S3.CredentialsSecret},
+			KeyMappings: map[string]string{
+				s3AccessKey: prefix + s3AccessKey,
+				s3SecretKey: prefix + s3SecretKey,
+			},
+		})
+	}
+	return keystore
+}
+
+// CopySnapshotCredentialsSecrets copies the snapshot repository credentials from the verrazzano-install namespace
+// to the namespace of the OpenSearch cluster, where they are loaded into the OpenSearch keystore
+func CopySnapshotCredentialsSecrets(ctx spi.ComponentContext) error {
+	opensearch := ctx.EffectiveCR().Spec.Components.Elasticsearch
+	if opensearch == nil {
+		return nil
+	}
+	for _, repository := range opensearch.SnapshotRepositories {
+		if repository.S3 == nil || repository.S3.CredentialsSecret == "" {
+			continue
+		}
+		if err := CopySecret(ctx, repository.S3.CredentialsSecret, constants.VerrazzanoLoggingNamespace, "OpenSearch snapshot repository credentials"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func s3ClientSettingPrefix(repositoryName string) string {
+	return fmt.Sprintf("%s%s.", s3ClientSettingsPrefix, repositoryName)
+}
+
 // skipNode returns true if the replica count for node is zero
 // and has no roles defined
 func skipNode(node vzapi.OpenSearchNode) bool {
@@ -400,6 +499,12 @@ type NodePool struct {
 	AdditionalConfig map[string]string           `json:"additionalConfig,omitempty"`
 }
 
+// KeystoreEntry loads the keys of a secret into the OpenSearch keystore
+type KeystoreEntry struct {
+	Secret      corev1.LocalObjectReference `json:"secret"`
+	KeyMappings map[string]string           `json:"keyMappings,omitempty"`
+}
+
 // PersistenceConfig defines options for data persistence
 type PersistenceConfig struct {
 	PersistenceSource `json:","`
@@ -467,6 +572,7 @@ func MergeSecretData(ctx spi.ComponentContext, helmManifestsDir string) error {
 	if err != nil {
 		return err
 	}
+	setMultitenancyEnabled(mergedConfig, vzcr.IsOpenSearchDashboardsMultitenancyEnabled(ctx.EffectiveCR()))
 	mergedConfigYAML, err := yaml.Marshal(mergedConfig)
 	if err != nil {
 		return err
@@ -603,6 +709,25 @@ func mergeConfigYamlData(dataSecret, dataFile map[string]interface{}) (map[strin
 	return dataSecret, nil
 }
 
+// setMultitenancyEnabled sets the Dashboards multitenancy flag in the config.yml data,
+// so that tenants are only used when multitenancy is enabled in the Verrazzano CR
+func setMultitenancyEnabled(config map[string]interface{}, enabled bool) {
+	configData, ok := config["config"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	dynamic, ok := configData["dynamic"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	kibana, ok := dynamic["kibana"].(map[string]interface{})
+	if !ok {
+		kibana = make(map[string]interface{})
+		dynamic["kibana"] = kibana
+	}
+	kibana["multitenancy_enabled"] = enabled
+}
+
 // mergeUserYamlData merges the internal_users.yml data from the secret and the helm config
 func mergeUserYamlData(dataFile, dataSecret map[string]interface{}, hashFromSecret string) (map[string]interface{}, error) {
 	mergedData := make(map[string]interface{})
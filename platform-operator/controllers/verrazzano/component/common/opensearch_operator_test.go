@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package common
@@ -60,6 +60,47 @@ func TestMergeSecurityConfigs(t *testing.T) {
 	asserts.NoError(err)
 }
 
+// TestMergeSecurityConfigsMultitenancy tests the MergeSecretData function
+// GIVEN a Verrazzano CR with OpenSearch Dashboards multitenancy enabled
+// WHEN the security configs are merged
+// THEN multitenancy is enabled in the security config secret
+func TestMergeSecurityConfigsMultitenancy(t *testing.T) {
+	asserts := assert.New(t)
+	mocker := gomock.NewController(t)
+	mock := mocks.NewMockClient(mocker)
+
+	enabled := true
+	vz := &v1alpha1.Verrazzano{
+		Spec: v1alpha1.VerrazzanoSpec{
+			Components: v1alpha1.ComponentSpec{
+				Kibana: &v1alpha1.KibanaComponent{Multitenancy: &enabled},
+			},
+		},
+	}
+	fakeCtx := spi.NewFakeContext(mock, vz, nil, false)
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: securityNamespace, Name: SecuritySecretName}, gomock.Not(gomock.Nil()), gomock.Any()).DoAndReturn(func(ctx context.Context, name types.NamespacedName, secret *corev1.Secret, opts ...client.GetOption) error {
+		secret.Name = SecuritySecretName
+		secret.Namespace = securityNamespace
+		secret.Data = map[string][]byte{configYaml: []byte(testConfigData), usersYaml: []byte(testUsersData)}
+		return nil
+	})
+	mock.EXPECT().
+		Get(gomock.Any(), types.NamespacedName{Namespace: securityNamespace, Name: hashSecName}, gomock.Not(gomock.Nil()), gomock.Any()).DoAndReturn(func(ctx context.Context, name types.NamespacedName, secret *corev1.Secret, opts ...client.GetOption) error {
+		secret.Name = hashSecName
+		secret.Namespace = securityNamespace
+		secret.Data = map[string][]byte{"hash": []byte("abcdef")}
+		return nil
+	})
+	mock.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, secret *corev1.Secret, opts ...client.UpdateOption) error {
+		asserts.Contains(string(secret.Data[configYaml]), "multitenancy_enabled: true")
+		return nil
+	})
+	config.TestThirdPartyManifestDir = "../../../../thirdparty/manifests"
+	err := MergeSecretData(fakeCtx, config.GetThirdPartyManifestsDir())
+	asserts.NoError(err)
+}
+
 // TestMergeSecurityConfigsGetConfigError tests the MergeSecretData function
 // GIVEN a call to MergeSecretData
 // WHEN get security config secret fails
@@ -227,3 +268,86 @@ func TestGetImagesOverrides(t *testing.T) {
 	assert.True(t, osdImageFound)
 	assert.True(t, initImageFound)
 }
+
+// TestSnapshotRepositorySettings tests the OpenSearch cluster settings for snapshot repositories
+// GIVEN node pools and S3 snapshot repositories
+// WHEN addS3ClientSettings and buildSnapshotKeystore are called
+// THEN the S3 client settings are set on every node pool, stale client settings are removed
+// and the credentials are mapped to the keystore
+func TestSnapshotRepositorySettings(t *testing.T) {
+	repositories := []v1alpha1.OpenSearchSnapshotRepository{
+		{
+			Name: "backups",
+			S3: &v1alpha1.OpenSearchS3Repository{
+				Bucket:            "opensearch-backups",
+				Endpoint:          "https://objectstorage.example.com",
+				Region:            "us-ashburn-1",
+				PathStyleAccess:   true,
+				CredentialsSecret: "backup-credentials",
+			},
+		},
+	}
+	nodePools := []NodePool{
+		{Component: "es-master", AdditionalConfig: map[string]string{"s3.client.old.endpoint": "https://old.example.com", "other": "value"}},
+		{Component: "es-data"},
+	}
+	addS3ClientSettings(nodePools, repositories)
+	expected := map[string]string{
+		"s3.client.backups.endpoint":          "https://objectstorage.example.com",
+		"s3.client.backups.region":            "us-ashburn-1",
+		"s3.client.backups.path_style_access": "true",
+	}
+	assert.Equal(t, expected, nodePools[1].AdditionalConfig)
+	expected["other"] = "value"
+	assert.Equal(t, expected, nodePools[0].AdditionalConfig)
+
+	keystore := buildSnapshotKeystore(repositories)
+	assert.Equal(t, []KeystoreEntry{
+		{
+			Secret: corev1.LocalObjectReference{Name: "backup-credentials"},
+			KeyMappings: map[string]string{
+				"access_key": "s3.client.backups.access_key",
+				"secret_key": "s3.client.backups.secret_key",
+			},
+		},
+	}, keystore)
+
+	// Removing the repositories removes the client settings
+	addS3ClientSettings(nodePools, nil)
+	assert.Equal(t, map[string]string{"other": "value"}, nodePools[0].AdditionalConfig)
+	assert.Empty(t, nodePools[1].AdditionalConfig)
+	assert.Empty(t, buildSnapshotKeystore(nil))
+}
+
+// TestCopySnapshotCredentialsSecrets tests the CopySnapshotCredentialsSecrets function
+// GIVEN a VZ CR with an S3 snapshot repository
+// WHEN CopySnapshotCredentialsSecrets is called
+// THEN the credentials secret is copied to the OpenSearch cluster namespace
+func TestCopySnapshotCredentialsSecrets(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup-credentials", Namespace: constants.VerrazzanoInstallNamespace},
+		Data:       map[string][]byte{"access_key": []byte("key"), "secret_key": []byte("secret")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(secret).Build()
+	vz := &v1alpha1.Verrazzano{
+		Spec: v1alpha1.VerrazzanoSpec{
+			Components: v1alpha1.ComponentSpec{
+				Elasticsearch: &v1alpha1.ElasticsearchComponent{
+					SnapshotRepositories: []v1alpha1.OpenSearchSnapshotRepository{
+						{Name: "backups", S3: &v1alpha1.OpenSearchS3Repository{Bucket: "b", CredentialsSecret: "backup-credentials"}},
+					},
+				},
+			},
+		},
+	}
+	fakeCtx := spi.NewFakeContext(fakeClient, vz, nil, false, profilesRelativePath)
+	assert.NoError(t, CopySnapshotCredentialsSecrets(fakeCtx))
+
+	copied := &corev1.Secret{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: "backup-credentials", Namespace: constants.VerrazzanoLoggingNamespace}, copied))
+	assert.Equal(t, secret.Data, copied.Data)
+
+	vz.Spec.Components.Elasticsearch.SnapshotRepositories[0].S3.CredentialsSecret = "missing"
+	fakeCtx = spi.NewFakeContext(fakeClient, vz, nil, false, profilesRelativePath)
+	assert.Error(t, CopySnapshotCredentialsSecrets(fakeCtx))
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package common
@@ -8,6 +8,7 @@ import (
 	globalconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,3 +51,13 @@ func CopySecret(ctx spi.ComponentContext, secretName string, destNamespace strin
 
 	return nil
 }
+
+// CopyDNSProviderSecret copies the credentials secret of the DNS provider from the verrazzano-install namespace to the
+// target namespace
+func CopyDNSProviderSecret(compContext spi.ComponentContext, targetNamespace string) error {
+	provider := vzconfig.GetDNSProvider(compContext.EffectiveCR().Spec.Components.DNS)
+	if provider == nil || len(provider.CredentialsSecret()) == 0 {
+		return nil
+	}
+	return CopySecret(compContext, provider.CredentialsSecret(), targetNamespace, "DNS provider")
+}
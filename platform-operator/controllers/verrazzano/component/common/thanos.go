@@ -0,0 +1,148 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/verrazzano/verrazzano-modules/pkg/controller/spi/controllerspi"
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ThanosObjstoreSecretName is the secret holding the object storage configuration shared by the Thanos components
+	// and the Prometheus Thanos sidecar
+	ThanosObjstoreSecretName = "verrazzano-thanos-objstore" //nolint:gosec //#gosec G101
+	// ThanosObjstoreKey is the key of the object storage configuration in the secret
+	ThanosObjstoreKey = "objstore.yml"
+
+	thanosAccessKeyKey = "access_key"
+	thanosSecretKeyKey = "secret_key" //nolint:gosec //#gosec G101
+)
+
+// GetThanosStorage returns the object storage of the Thanos metrics, or nil if Thanos is disabled or has no object
+// storage
+func GetThanosStorage(cr *vzapi.Verrazzano) *vzapi.ThanosStorage {
+	if cr == nil || !vzcr.IsThanosEnabled(cr) || cr.Spec.Components.Thanos == nil {
+		return nil
+	}
+	storage := cr.Spec.Components.Thanos.Storage
+	if storage == nil || storage.S3 == nil {
+		return nil
+	}
+	return storage
+}
+
+// ParseThanosS3Endpoint returns the host of an S3 endpoint URL, and whether TLS is disabled for the endpoint.
+// An endpoint without a scheme is a host, with TLS enabled.
+func ParseThanosS3Endpoint(endpoint string) (string, bool, error) {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint, false, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false, fmt.Errorf("unsupported scheme %s", u.Scheme)
+	}
+	if len(u.Host) == 0 {
+		return "", false, fmt.Errorf("no host")
+	}
+	return u.Host, u.Scheme == "http", nil
+}
+
+// CreateOrUpdateThanosObjstoreSecret renders the Thanos object storage configuration, with the credentials copied
+// from the verrazzano-install namespace, into the secret read by the Thanos components and the Prometheus Thanos
+// sidecar
+func CreateOrUpdateThanosObjstoreSecret(ctx spi.ComponentContext, storage *vzapi.ThanosStorage) error {
+	s3 := storage.S3
+	source := &corev1.Secret{}
+	if err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoInstallNamespace, Name: s3.CredentialsSecret}, source); err != nil {
+		return ctx.Log().ErrorfNewErr("Failed getting the Thanos object storage credentials secret %s/%s: %v", constants.VerrazzanoInstallNamespace, s3.CredentialsSecret, err)
+	}
+	for _, key := range []string{thanosAccessKeyKey, thanosSecretKeyKey} {
+		if _, ok := source.Data[key]; !ok {
+			return ctx.Log().ErrorfNewErr("The Thanos object storage credentials secret %s/%s has no %s key", constants.VerrazzanoInstallNamespace, s3.CredentialsSecret, key)
+		}
+	}
+	host, insecure, err := ParseThanosS3Endpoint(s3.Endpoint)
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Invalid Thanos object storage endpoint %s: %v", s3.Endpoint, err)
+	}
+
+	config := map[string]interface{}{
+		"bucket":     s3.Bucket,
+		"endpoint":   host,
+		"insecure":   insecure,
+		"access_key": string(source.Data[thanosAccessKeyKey]),
+		"secret_key": string(source.Data[thanosSecretKeyKey]),
+	}
+	if len(s3.Region) > 0 {
+		config["region"] = s3.Region
+	}
+	objstore, err := yaml.Marshal(map[string]interface{}{"type": "S3", "config": config})
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed rendering the Thanos object storage configuration: %v", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMonitoringNamespace, Name: ThanosObjstoreSecretName}}
+	_, err = controllerruntime.CreateOrUpdate(context.TODO(), ctx.Client(), secret, func() error {
+		secret.Data = map[string][]byte{ThanosObjstoreKey: objstore}
+		return nil
+	})
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed creating or updating the Thanos object storage secret %s/%s: %v", constants.VerrazzanoMonitoringNamespace, ThanosObjstoreSecretName, err)
+	}
+	return nil
+}
+
+// DeleteThanosObjstoreSecret deletes the Thanos object storage configuration, with the copied credentials, when the
+// object storage is not configured or the components reading it are uninstalled
+func DeleteThanosObjstoreSecret(ctx spi.ComponentContext) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoMonitoringNamespace, Name: ThanosObjstoreSecretName}}
+	if err := ctx.Client().Delete(context.TODO(), secret); err != nil && !errors.IsNotFound(err) {
+		return ctx.Log().ErrorfNewErr("Failed deleting the Thanos object storage secret %s/%s: %v", constants.VerrazzanoMonitoringNamespace, ThanosObjstoreSecretName, err)
+	}
+	return nil
+}
+
+// GetThanosCredentialsSecretWatch watches for changes of the Thanos object storage credentials secret in the
+// verrazzano-install namespace, so that rotated credentials are copied to the Thanos object storage configuration
+func GetThanosCredentialsSecretWatch() []controllerspi.WatchDescriptor {
+	return []controllerspi.WatchDescriptor{
+		{
+			WatchedResourceKind: source.Kind{Type: &corev1.Secret{}},
+			FuncShouldReconcile: func(cli client.Client, wev controllerspi.WatchEvent) bool {
+				if wev.NewWatchedObject.GetNamespace() != constants.VerrazzanoInstallNamespace {
+					return false
+				}
+				vzList := &vzapi.VerrazzanoList{}
+				if err := cli.List(context.TODO(), vzList); err != nil {
+					return false
+				}
+				for i := range vzList.Items {
+					if storage := GetThanosStorage(&vzList.Items[i]); storage != nil && storage.S3.CredentialsSecret == wev.NewWatchedObject.GetName() {
+						return true
+					}
+				}
+				return false
+			},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano-modules/pkg/controller/spi/controllerspi"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+// TestParseThanosS3Endpoint tests the parsing of the Thanos S3 endpoints
+// GIVEN S3 endpoint URLs and hosts
+// WHEN ParseThanosS3Endpoint is called
+// THEN the host is returned, with TLS disabled only for http URLs
+func TestParseThanosS3Endpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		host     string
+		insecure bool
+		wantErr  bool
+	}{
+		{endpoint: "https://objectstorage.example.com", host: "objectstorage.example.com"},
+		{endpoint: "http://minio.minio.svc:9000", host: "minio.minio.svc:9000", insecure: true},
+		{endpoint: "objectstorage.example.com:443", host: "objectstorage.example.com:443"},
+		{endpoint: "s3://objectstorage.example.com", wantErr: true},
+		{endpoint: "https://", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			host, insecure, err := ParseThanosS3Endpoint(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.insecure, insecure)
+		})
+	}
+}
+
+// TestCreateOrUpdateThanosObjstoreSecret tests the rendering of the Thanos object storage configuration
+// GIVEN a Thanos S3 object storage and its credentials secret
+// WHEN CreateOrUpdateThanosObjstoreSecret is called
+// THEN the object storage secret holds the S3 configuration with the credentials
+func TestCreateOrUpdateThanosObjstoreSecret(t *testing.T) {
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoInstallNamespace, Name: "object-storage"},
+		Data:       map[string][]byte{thanosAccessKeyKey: []byte("key"), thanosSecretKeyKey: []byte("secret")},
+	}
+	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(credentials).Build()
+	ctx := spi.NewFakeContext(client, &vzapi.Verrazzano{}, nil, false)
+	storage := &vzapi.ThanosStorage{S3: &vzapi.ThanosS3Storage{
+		Bucket:            "thanos-metrics",
+		Endpoint:          "http://minio.minio.svc:9000",
+		Region:            "us-ashburn-1",
+		CredentialsSecret: "object-storage",
+	}}
+
+	assert.NoError(t, CreateOrUpdateThanosObjstoreSecret(ctx, storage))
+	secret := &corev1.Secret{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: constants.VerrazzanoMonitoringNamespace, Name: ThanosObjstoreSecretName}, secret))
+	objstore := map[string]interface{}{}
+	assert.NoError(t, yaml.Unmarshal(secret.Data[ThanosObjstoreKey], &objstore))
+	assert.Equal(t, map[string]interface{}{
+		"type": "S3",
+		"config": map[string]interface{}{
+			"bucket":     "thanos-metrics",
+			"endpoint":   "minio.minio.svc:9000",
+			"insecure":   true,
+			"region":     "us-ashburn-1",
+			"access_key": "key",
+			"secret_key": "secret",
+		},
+	}, objstore)
+
+	// the credentials secret must hold both keys
+	delete(credentials.Data, thanosSecretKeyKey)
+	assert.NoError(t, client.Update(context.TODO(), credentials))
+	assert.Error(t, CreateOrUpdateThanosObjstoreSecret(ctx, storage))
+}
+
+// TestGetThanosCredentialsSecretWatch tests the watch of the Thanos object storage credentials secret
+// GIVEN a Verrazzano CR with a Thanos object storage
+// WHEN secrets are created or updated
+// THEN only the credentials secret of the object storage in the verrazzano-install namespace triggers a reconcile
+func TestGetThanosCredentialsSecretWatch(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, vzapi.AddToScheme(scheme))
+	enabled := true
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"},
+		Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{Thanos: &vzapi.ThanosComponent{
+			Enabled: &enabled,
+			Storage: &vzapi.ThanosStorage{S3: &vzapi.ThanosS3Storage{CredentialsSecret: "object-storage"}},
+		}}},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vz).Build()
+	watches := GetThanosCredentialsSecretWatch()
+	assert.Len(t, watches, 1)
+
+	tests := []struct {
+		name      string
+		namespace string
+		secret    string
+		reconcile bool
+	}{
+		{"credentials secret", constants.VerrazzanoInstallNamespace, "object-storage", true},
+		{"other secret", constants.VerrazzanoInstallNamespace, "other", false},
+		{"other namespace", constants.VerrazzanoMonitoringNamespace, "object-storage", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: tt.namespace, Name: tt.secret}}
+			wev := controllerspi.WatchEvent{WatchEventType: controllerspi.Updated, OldWatchedObject: secret, NewWatchedObject: secret}
+			assert.Equal(t, tt.reconcile, watches[0].FuncShouldReconcile(client, wev))
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package externaldns
@@ -35,6 +35,7 @@ import (
 	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
 )
 
 // ComponentName is the name of the component
@@ -70,7 +71,7 @@ func preInstall(compContext spi.ComponentContext) error {
 	if err := common.CopyOCIDNSSecret(compContext, ComponentNamespace); err != nil {
 		return err
 	}
-	return nil
+	return common.CopyDNSProviderSecret(compContext, ComponentNamespace)
 }
 
 // resolveExernalDNSNamespace implements the HelmComponent contract to resolve a component namespace dynamically
@@ -209,11 +210,17 @@ func (c *externalDNSComponent) isExternalDNSReady(compContext spi.ComponentConte
 // AppendOverrides builds the set of external-dns overrides for the helm install
 func AppendOverrides(compContext spi.ComponentContext, releaseName string, namespace string, _ string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
 	effectiveCR := compContext.EffectiveCR()
-	oci, err := getOCIDNS(effectiveCR)
-	if err != nil {
-		return kvs, err
+	provider := vzconfig.GetDNSProvider(effectiveCR.Spec.Components.DNS)
+	var oci *vzapi.OCI
+	if provider == nil {
+		var err error
+		if oci, err = getOCIDNS(effectiveCR); err != nil {
+			return kvs, err
+		}
+	} else if provider.ExternalDNSOverrides() == nil {
+		return kvs, fmt.Errorf("The DNS provider is not supported by component %s", ComponentName)
 	}
-	// OCI DNS is configured, append all helm overrides for external DNS
+	// A DNS provider is configured, append all helm overrides for external DNS
 	ids, err := getOrBuildIDs(compContext, releaseName, namespace)
 	if err != nil {
 		return kvs, err
@@ -221,16 +228,24 @@ func AppendOverrides(compContext spi.ComponentContext, releaseName string, names
 	ownerID := ids[0]
 	txtPrefix := ids[1]
 	compContext.Log().Debugf("Owner ID: %s, TXT record prefix: %s", ownerID, txtPrefix)
-	arguments := []bom.KeyValue{
-		{Key: "domainFilters[0]", Value: oci.DNSZoneName},
-		{Key: "zoneIDFilters[0]", Value: oci.DNSZoneOCID},
-		{Key: "ociDnsScope", Value: oci.DNSScope},
-		{Key: "txtOwnerId", Value: ownerID},
-		{Key: "txtPrefix", Value: txtPrefix},
-		{Key: "extraVolumes[0].name", Value: "config"},
-		{Key: "extraVolumes[0].secret.secretName", Value: oci.OCIConfigSecret},
-		{Key: "extraVolumeMounts[0].name", Value: "config"},
-		{Key: "extraVolumeMounts[0].mountPath", Value: "/etc/kubernetes/"},
+	var arguments []bom.KeyValue
+	if provider != nil {
+		arguments = append(provider.ExternalDNSOverrides(),
+			bom.KeyValue{Key: "txtOwnerId", Value: ownerID},
+			bom.KeyValue{Key: "txtPrefix", Value: txtPrefix},
+		)
+	} else {
+		arguments = []bom.KeyValue{
+			{Key: "domainFilters[0]", Value: oci.DNSZoneName},
+			{Key: "zoneIDFilters[0]", Value: oci.DNSZoneOCID},
+			{Key: "ociDnsScope", Value: oci.DNSScope},
+			{Key: "txtOwnerId", Value: ownerID},
+			{Key: "txtPrefix", Value: txtPrefix},
+			{Key: "extraVolumes[0].name", Value: "config"},
+			{Key: "extraVolumes[0].secret.secretName", Value: oci.OCIConfigSecret},
+			{Key: "extraVolumeMounts[0].name", Value: "config"},
+			{Key: "extraVolumeMounts[0].mountPath", Value: "/etc/kubernetes/"},
+		}
 	}
 	for i, source := range getSources(effectiveCR) {
 		arguments = append(arguments, bom.KeyValue{
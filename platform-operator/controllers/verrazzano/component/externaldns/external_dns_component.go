@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package externaldns
@@ -114,7 +114,7 @@ func (c *externalDNSComponent) PreUninstall(ctx spi.ComponentContext) error {
 func (c *externalDNSComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
 	// Do not allow any changes except to enable the component post-install
 	if c.IsEnabled(old) && !c.IsEnabled(new) {
-		return fmt.Errorf("Disabling an existing OCI DNS configuration is not allowed")
+		return fmt.Errorf("Disabling an existing DNS provider configuration is not allowed")
 	}
 	return c.HelmComponent.ValidateUpdate(old, new)
 }
@@ -123,7 +123,7 @@ func (c *externalDNSComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.
 func (c *externalDNSComponent) ValidateUpdateV1Beta1(old *installv1beta1.Verrazzano, new *installv1beta1.Verrazzano) error {
 	// Do not allow any changes except to enable the component post-install
 	if c.IsEnabled(old) && !c.IsEnabled(new) {
-		return fmt.Errorf("Disabling an existing OCI DNS configuration is not allowed")
+		return fmt.Errorf("Disabling an existing DNS provider configuration is not allowed")
 	}
 	return c.HelmComponent.ValidateUpdateV1Beta1(old, new)
 }
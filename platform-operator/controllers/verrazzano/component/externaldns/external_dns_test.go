@@ -1,9 +1,10 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package externaldns
 
 import (
+	"context"
 	"fmt"
 	"github.com/verrazzano/verrazzano/pkg/helm"
 	"github.com/verrazzano/verrazzano/pkg/k8sutil"
@@ -17,6 +18,7 @@ import (
 	"helm.sh/helm/v3/pkg/time"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	corev1Cli "k8s.io/client-go/kubernetes/typed/core/v1"
 	"strings"
@@ -644,3 +646,68 @@ func TestGetOverrides(t *testing.T) {
 		})
 	}
 }
+
+// TestAppendExternalDNSOverridesRFC2136 tests the AppendOverrides fn
+// GIVEN a call to AppendOverrides
+// WHEN an RFC 2136 DNS provider is configured
+// THEN the rfc2136 provider overrides are created, with the TSIG secret passed in the environment
+func TestAppendExternalDNSOverridesRFC2136(t *testing.T) {
+	defer helm.SetDefaultActionConfigFunction()
+	helm.SetActionConfigFunction(testActionConfigWithInstallationNoValues)
+	localvz := vz.DeepCopy()
+	localvz.Spec.Components.DNS.RFC2136 = &vzapi.RFC2136{
+		DNSZoneName: zoneName,
+		Nameserver:  "10.0.0.53",
+		TSIGKeyName: "verrazzano",
+		TSIGSecret:  "tsig",
+	}
+
+	kvs, err := AppendOverrides(spi.NewFakeContext(nil, localvz, nil, false, profileDir), ComponentName, ComponentNamespace, "", []bom.KeyValue{})
+	assert.NoError(t, err)
+	assert.Contains(t, kvs, bom.KeyValue{Key: "provider", Value: "rfc2136"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "domainFilters[0]", Value: zoneName})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "rfc2136.host", Value: "10.0.0.53"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "rfc2136.port", Value: "53"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "rfc2136.tsigSecretAlg", Value: "hmac-sha256"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "extraEnv[0].valueFrom.secretKeyRef.name", Value: "tsig"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "txtOwnerId", Value: "v8o-811c9dc5"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "sources[0]", Value: "ingress"})
+	for _, kv := range kvs {
+		assert.NotEqual(t, "extraVolumes[0].name", kv.Key)
+	}
+}
+
+// TestAppendExternalDNSOverridesWebhook tests the AppendOverrides fn
+// GIVEN a call to AppendOverrides
+// WHEN a webhook DNS provider is configured
+// THEN an error is returned, external-dns does not support webhook providers
+func TestAppendExternalDNSOverridesWebhook(t *testing.T) {
+	localvz := vz.DeepCopy()
+	localvz.Spec.Components.DNS.Webhook = &vzapi.WebhookDNS{DNSZoneName: zoneName, GroupName: "acme.example.com", SolverName: "solver"}
+
+	_, err := AppendOverrides(spi.NewFakeContext(nil, localvz, nil, false, profileDir), ComponentName, ComponentNamespace, "", []bom.KeyValue{})
+	assert.Error(t, err)
+}
+
+// TestExternalDNSPreInstallPowerDNS tests the PreInstall fn
+// GIVEN a call to this fn
+// WHEN I call PreInstall with a PowerDNS provider
+// THEN the PowerDNS API key secret is copied to the component namespace
+func TestExternalDNSPreInstallPowerDNS(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pdns",
+				Namespace: constants.VerrazzanoInstallNamespace,
+			},
+			Data: map[string][]byte{"api-key": []byte("key")},
+		}).Build()
+	localvz := vz.DeepCopy()
+	localvz.Spec.Components.DNS.PowerDNS = &vzapi.PowerDNS{DNSZoneName: zoneName, APIURL: "http://pdns", APIKeySecret: "pdns"}
+	err := fakeComponent.PreInstall(spi.NewFakeContext(client, localvz, nil, false))
+	assert.NoError(t, err)
+
+	secret := &v1.Secret{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: "pdns"}, secret))
+	assert.Equal(t, []byte("key"), secret.Data["api-key"])
+}
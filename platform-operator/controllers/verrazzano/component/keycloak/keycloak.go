@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package keycloak
@@ -6,7 +6,6 @@ package keycloak
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -15,7 +14,7 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/bom"
 	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/pkg/k8s/ready"
-	"github.com/verrazzano/verrazzano/pkg/k8sutil"
+	"github.com/verrazzano/verrazzano/pkg/keycloakutil"
 	vzpassword "github.com/verrazzano/verrazzano/pkg/security/password"
 	"github.com/verrazzano/verrazzano/pkg/semver"
 	"github.com/verrazzano/verrazzano/pkg/vzcr"
@@ -32,8 +31,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
-	restclient "k8s.io/client-go/rest"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -61,7 +58,9 @@ const (
 	routerAddr              = "mysql"
 	dbHostKey               = "database.hostname"
 	headlessService         = "keycloak-headless"
-	kcAdminScript           = "/opt/keycloak/bin/kcadm.sh"
+	passwordPolicy          = "length(8) and notUsername"
+	loginTheme              = "oracle"
+	accessTokenLifespan     = 1200
 	keycloakSecretName      = "keycloak-http" //nolint:gosec //#gosec G101
 	keycloakIngressName     = "keycloak"
 )
@@ -631,69 +630,8 @@ const argocdClientUrisTemplate = `
 	]
 `
 
-// KeycloakClients represents an array of clients currently configured in Keycloak
-type KeycloakClients []struct {
-	ID       string `json:"id"`
-	ClientID string `json:"clientId"`
-}
-
-// KeycloakClientScopes represents an array of client-scopes currently configured in Keycloak
-type KeycloakClientScopes []struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
-// SubGroup represents the subgroups that Keycloak groups may contain
-type SubGroup struct {
-	ID        string        `json:"id"`
-	Name      string        `json:"name"`
-	Path      string        `json:"path"`
-	SubGroups []interface{} `json:"subGroups"`
-}
-
-// KeycloakGroups is an array of groups configured in Keycloak
-type KeycloakGroups []struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Path      string `json:"path"`
-	SubGroups []SubGroup
-}
-
-// KeycloakRoles is an array of roles configured in Keycloak
-type KeycloakRoles []struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	Composite   bool   `json:"composite"`
-	ClientRole  bool   `json:"clientRole"`
-	ContainerID string `json:"containerId"`
-}
-
 // KeycloakUser is an user configured in Keycloak
-type KeycloakUser struct {
-	ID                         string        `json:"id"`
-	CreatedTimestamp           int64         `json:"createdTimestamp"`
-	Username                   string        `json:"username"`
-	Enabled                    bool          `json:"enabled"`
-	Totp                       bool          `json:"totp"`
-	EmailVerified              bool          `json:"emailVerified"`
-	DisableableCredentialTypes []interface{} `json:"disableableCredentialTypes"`
-	RequiredActions            []interface{} `json:"requiredActions"`
-	NotBefore                  int           `json:"notBefore"`
-	Access                     struct {
-		ManageGroupMembership bool `json:"manageGroupMembership"`
-		View                  bool `json:"view"`
-		MapRoles              bool `json:"mapRoles"`
-		Impersonate           bool `json:"impersonate"`
-		Manage                bool `json:"manage"`
-	} `json:"access"`
-}
-
-// KeycloakClientSecret represents a client-secret of a client currently configured in Keycloak
-type KeycloakClientSecret struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
-}
+type KeycloakUser = keycloakutil.User
 
 type templateData struct {
 	DNSSubDomain string
@@ -706,9 +644,6 @@ type imageData struct {
 	Image string
 }
 
-// maskPw will mask passwords in strings with '******'
-var maskPw = vzpassword.MaskFunction("password ")
-
 // AppendKeycloakOverrides appends the Keycloak theme for the Key keycloak.extraInitContainers.
 // A go template is used to replace the image in the init container spec.
 func AppendKeycloakOverrides(compContext spi.ComponentContext, _ string, _ string, _ string, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
@@ -837,118 +772,59 @@ func updateKeycloakIngress(ctx spi.ComponentContext) error {
 	return err
 }
 
-// updateKeycloakUris invokes kcadm.sh in Keycloak pod to update the client with Keycloak rewrite and weborigin uris
-func updateKeycloakUris(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, kcPod *corev1.Pod, clientID string, uriTemplate string, dnsSubdomain *string) error {
-	data, err := populateClientTemplate(ctx, "{"+uriTemplate+"}", "", dnsSubdomain)
-	if err != nil {
-		return err
-	}
-
-	// Update client
-	updateClientCmd := kcAdminScript + " update clients/" + clientID + " -r " + vzconst.VerrazzanoOIDCSystemRealm + " -b '" +
-		strings.TrimSpace(data) +
-		"'"
-	ctx.Log().Debugf("updateKeycloakUris: Update client with Id = %s, Cmd = %s", clientID, updateClientCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(updateClientCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed updating client with Id = %s stdout = %s, stderr = %s", clientID, stdout, stderr)
-		return err
-	}
-
-	ctx.Log().Debug("Component Keycloak successfully updated Keycloak URIs")
-	return nil
+// newAdminClient creates a client of the Keycloak admin REST API, leveraged to replace method (unit testing)
+var newAdminClient = func(ctx spi.ComponentContext) (*keycloakutil.AdminClient, error) {
+	return keycloakutil.NewClusterAdminClient(ctx.Client(), ctx.Log())
 }
 
 // configureKeycloakRealms configures the Verrazzano system realm
 func configureKeycloakRealms(ctx spi.ComponentContext) error {
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return err
-	}
-
 	// Login to Keycloak
-	err = LoginKeycloak(ctx, cfg, cli)
+	kc, err := LoginKeycloak(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Create VerrazzanoSystem Realm
-	err = createVerrazzanoSystemRealm(ctx, cfg, cli)
+	err = createVerrazzanoSystemRealm(ctx, kc)
 	if err != nil {
 		return err
 	}
 
 	// Create Verrazzano Users Group
-	userGroupID, err := createVerrazzanoGroup(ctx, cfg, cli, vzUsersGroup, "")
-	if err != nil {
-		return err
-	}
-	if userGroupID == "" {
-		err := errors.New("Component Keycloak failed; user Group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-
-	// Create Verrazzano Admin Group
-	adminGroupID, err := createVerrazzanoGroup(ctx, cfg, cli, vzAdminGroup, userGroupID)
-	if err != nil {
-		return err
-	}
-	if adminGroupID == "" {
-		err := errors.New("Component Keycloak failed; admin group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-
-	// Create Verrazzano Project Monitors Group
-	monitorGroupID, err := createVerrazzanoGroup(ctx, cfg, cli, vzMonitorGroup, userGroupID)
-	if err != nil {
-		return err
-	}
-	if monitorGroupID == "" {
-		err = errors.New("Component Keycloak failed; monitor group ID from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return err
-	}
-
-	// Create Verrazzano System Group
-	_, err = createVerrazzanoGroup(ctx, cfg, cli, vzSystemGroup, userGroupID)
+	userGroupID, err := createVerrazzanoGroup(ctx, kc, vzUsersGroup, "")
 	if err != nil {
 		return err
 	}
 
-	// Create Verrazzano API Access Role
-	err = createVerrazzanoRole(ctx, cfg, cli, vzAPIAccessRole)
-	if err != nil {
-		return err
-	}
-
-	// Create Verrazzano Log Pusher Role
-	err = createVerrazzanoRole(ctx, cfg, cli, vzLogPusherRole)
-	if err != nil {
-		return err
+	// Create Verrazzano Admin, Project Monitors and System Groups
+	for _, group := range []string{vzAdminGroup, vzMonitorGroup, vzSystemGroup} {
+		if _, err = createVerrazzanoGroup(ctx, kc, group, userGroupID); err != nil {
+			return err
+		}
 	}
 
-	// Create Verrazzano OpenSearch Admin role
-	err = createVerrazzanoRole(ctx, cfg, cli, vzOpenSearchAdminRole)
-	if err != nil {
-		return err
+	// Create Verrazzano API Access, Log Pusher and OpenSearch Admin Roles
+	for _, role := range []string{vzAPIAccessRole, vzLogPusherRole, vzOpenSearchAdminRole} {
+		if err = createVerrazzanoRole(ctx, kc, role); err != nil {
+			return err
+		}
 	}
 
 	// Granting Roles to Groups
-	err = grantRolesToGroups(ctx, cfg, cli, userGroupID, adminGroupID, monitorGroupID)
+	err = grantRolesToGroups(ctx, kc, userGroupID)
 	if err != nil {
 		return err
 	}
 
 	// Creating Verrazzano User
-	err = createUser(ctx, cfg, cli, vzUserName, "verrazzano", constants.VerrazzanoSystemNamespace, vzAdminGroup, "Verrazzano", "Admin")
+	err = createUser(ctx, kc, vzUserName, "verrazzano", constants.VerrazzanoSystemNamespace, vzAdminGroup, "Verrazzano", "Admin")
 	if err != nil {
 		return err
 	}
 
 	// Creating Verrazzano Internal Prometheus User
-	err = createUser(ctx, cfg, cli, vzInternalPromUser, "verrazzano-prom-internal", constants.VerrazzanoSystemNamespace, vzSystemGroup, "", "")
+	err = createUser(ctx, kc, vzInternalPromUser, "verrazzano-prom-internal", constants.VerrazzanoSystemNamespace, vzSystemGroup, "", "")
 	if err != nil {
 		return err
 	}
@@ -960,57 +836,57 @@ func configureKeycloakRealms(ctx spi.ComponentContext) error {
 		return err
 	}
 	if err == nil {
-		err = createUser(ctx, cfg, cli, constants.ThanosInternalUserSecretName, constants.ThanosInternalUserSecretName, constants.VerrazzanoMonitoringNamespace, vzSystemGroup, "", "")
+		err = createUser(ctx, kc, constants.ThanosInternalUserSecretName, constants.ThanosInternalUserSecretName, constants.VerrazzanoMonitoringNamespace, vzSystemGroup, "", "")
 		if err != nil {
 			return err
 		}
 	}
 
 	// Creating Verrazzano Internal ES User
-	err = createUser(ctx, cfg, cli, vzInternalEsUser, "verrazzano-es-internal", constants.VerrazzanoSystemNamespace, vzSystemGroup, "", "")
+	err = createUser(ctx, kc, vzInternalEsUser, "verrazzano-es-internal", constants.VerrazzanoSystemNamespace, vzSystemGroup, "", "")
 	if err != nil {
 		return err
 	}
 
 	// Create verrazzano-pkce client
-	err = CreateOrUpdateClient(ctx, cfg, cli, "verrazzano-pkce", pkceTmpl, pkceClientUrisTemplate, false, nil)
+	err = CreateOrUpdateClient(ctx, kc, "verrazzano-pkce", pkceTmpl, pkceClientUrisTemplate, false, nil)
 	if err != nil {
 		return err
 	}
 
 	// Creating verrazzano-pg client
-	err = CreateOrUpdateClient(ctx, cfg, cli, "verrazzano-pg", pgClient, "", true, nil)
+	err = CreateOrUpdateClient(ctx, kc, "verrazzano-pg", pgClient, "", true, nil)
 	if err != nil {
 		return err
 	}
 
 	// Grant vz_opensearch_admin role to verrazzano user
-	err = addRealmRoleToUser(ctx, cfg, cli, vzUserName, vzconst.VerrazzanoOIDCSystemRealm, vzOpenSearchAdminRole)
+	err = addRealmRoleToUser(ctx, kc, vzUserName, vzconst.VerrazzanoOIDCSystemRealm, vzOpenSearchAdminRole)
 	if err != nil {
 		return err
 	}
 
 	// Grant vz_log_pusher role to verrazzano-es-internal user
-	err = addRealmRoleToUser(ctx, cfg, cli, vzInternalEsUser, vzconst.VerrazzanoOIDCSystemRealm, vzLogPusherRole)
+	err = addRealmRoleToUser(ctx, kc, vzInternalEsUser, vzconst.VerrazzanoOIDCSystemRealm, vzLogPusherRole)
 	if err != nil {
 		return err
 	}
 
 	if vzcr.IsRancherEnabled(ctx.EffectiveCR()) {
 		// Creating rancher client
-		err = CreateOrUpdateClient(ctx, cfg, cli, "rancher", rancherClientTmpl, rancherClientUrisTemplate, true, nil)
+		err = CreateOrUpdateClient(ctx, kc, "rancher", rancherClientTmpl, rancherClientUrisTemplate, true, nil)
 		if err != nil {
 			return err
 		}
 
 		// Update Keycloak AuthConfig for Rancher with client secret
-		err = updateRancherClientSecretForKeycloakAuthConfig(ctx)
+		err = updateRancherClientSecretForKeycloakAuthConfig(ctx, kc)
 		if err != nil {
 			return err
 		}
 
 		// Add view-users role to verrazzano user
-		err = addClientRoleToUser(ctx, cfg, cli, vzUserName, realmManagement, vzconst.VerrazzanoOIDCSystemRealm, viewUsersRole)
+		err = addClientRoleToUser(ctx, kc, vzUserName, realmManagement, vzconst.VerrazzanoOIDCSystemRealm, viewUsersRole)
 		if err != nil {
 			return err
 		}
@@ -1018,59 +894,43 @@ func configureKeycloakRealms(ctx spi.ComponentContext) error {
 
 	if vzcr.IsArgoCDEnabled(ctx.EffectiveCR()) {
 		// Creating groups client scope
-		err = createOrUpdateClientScope(ctx, cfg, cli, "groups")
+		err = createOrUpdateClientScope(ctx, kc, "groups")
 		if err != nil {
 			return err
 		}
 
 		// Creating Argo CD client
-		err = CreateOrUpdateClient(ctx, cfg, cli, "argocd", argocdClientTmpl, argocdClientUrisTemplate, true, nil)
+		err = CreateOrUpdateClient(ctx, kc, "argocd", argocdClientTmpl, argocdClientUrisTemplate, true, nil)
 		if err != nil {
 			return err
 		}
 
 		// Setting the Access Token Lifespan value to 20mins.
 		// Required to ensure Argo CD UI does not log out the user until the Access Token lifespan expires
-		// Setting password policy for Verrazzano realm
-		err = setAccessTokenLifespanForRealm(ctx, cfg, cli, "verrazzano-system")
+		err = setAccessTokenLifespanForRealm(ctx, kc, vzconst.VerrazzanoOIDCSystemRealm)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Setting password policy for master
-	err = setPasswordPolicyForRealm(ctx, cfg, cli, "master", "passwordPolicy=length(8) and notUsername")
-	if err != nil {
-		return err
-	}
-
-	// Setting password policy for Verrazzano realm
-	err = setPasswordPolicyForRealm(ctx, cfg, cli, "verrazzano-system", "passwordPolicy=length(8) and notUsername")
-	if err != nil {
-		return err
-	}
-
-	// Configuring login theme for master
-	err = configureLoginThemeForRealm(ctx, cfg, cli, "master", "oracle")
-	if err != nil {
-		return err
-	}
-
-	// Configuring login theme for verrazzano-system
-	err = configureLoginThemeForRealm(ctx, cfg, cli, "verrazzano-system", "oracle")
-	if err != nil {
-		return err
+	// Setting password policy and login theme for master and Verrazzano realm
+	for _, realmName := range []string{keycloakutil.MasterRealm, vzconst.VerrazzanoOIDCSystemRealm} {
+		if err = setPasswordPolicyForRealm(ctx, kc, realmName, passwordPolicy); err != nil {
+			return err
+		}
+		if err = configureLoginThemeForRealm(ctx, kc, realmName, loginTheme); err != nil {
+			return err
+		}
 	}
 
 	// Enabling vzconst.VerrazzanoOIDCSystemRealm realm
-	err = enableVerrazzanoSystemRealm(ctx, cfg, cli)
+	err = enableVerrazzanoSystemRealm(ctx, kc)
 	if err != nil {
 		return err
 	}
 
-	// Removing login config file
-	err = removeLoginConfigFile(ctx, cfg, cli)
-	if err != nil {
+	// Create or update the additional realm objects declared by the Keycloak component
+	if err = reconcileRealmObjects(ctx, kc); err != nil {
 		return err
 	}
 
@@ -1078,58 +938,35 @@ func configureKeycloakRealms(ctx spi.ComponentContext) error {
 	return nil
 }
 
-// loginKeycloak logs into Keycloak so kcadm API calls can be made
-func LoginKeycloak(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
+// LoginKeycloak waits for the Keycloak pod to be ready and returns a client of the Keycloak admin REST API
+// that is logged in as the Keycloak administrator
+func LoginKeycloak(ctx spi.ComponentContext) (*keycloakutil.AdminClient, error) {
 	// Make sure the Keycloak pod is ready
 	kcPod := keycloakPod()
 	err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: kcPod.Namespace, Name: kcPod.Name}, kcPod)
 	if err != nil {
 		ctx.Log().Progressf("Component Keycloak failed to get pod %s: %v", kcPod.Name, err)
-		return err
+		return nil, err
 	}
 	if !isPodReady(kcPod) {
 		ctx.Log().Progressf("Component Keycloak waiting for pod %s to be ready", kcPod.Name)
-		return fmt.Errorf("Waiting for pod %s to be ready", kcPod.Name)
+		return nil, fmt.Errorf("Waiting for pod %s to be ready", kcPod.Name)
 	}
 
-	// Get the Keycloak admin password
-	secret := &corev1.Secret{}
-	err = ctx.Client().Get(context.TODO(), client.ObjectKey{
-		Namespace: constants.KeycloakNamespace,
-		Name:      keycloakSecretName,
-	}, secret)
+	kc, err := newAdminClient(ctx)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Keycloak password: %s", err)
-		return err
-	}
-	pw := secret.Data["password"]
-	keycloakpw := string(pw)
-	if keycloakpw == "" {
-		err = errors.New("Component Keycloak failed; Keycloak password is an empty string")
-		ctx.Log().Error(err)
-		return err
+		ctx.Log().Errorf("Component Keycloak failed creating the Keycloak admin client: %v", err)
+		return nil, err
 	}
-	ctx.Log().Debug("LoginKeycloak: Successfully retrieved Keycloak password")
 
 	// Login to Keycloak
-	loginCmd := kcAdminScript + " config credentials --server http://localhost:8080/auth --realm master --user keycloakadmin --password " + keycloakpw
-	ctx.Log().Debugf("LoginKeycloak: Login Cmd = %s", maskPw(loginCmd))
-	stdOut, stdErr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(loginCmd))
-	if err != nil {
-		ctx.Log().Progressf("Component Keycloak failed logging into Keycloak: stdout = %s: stderr = %s, err = %v", stdOut, stdErr, maskPw(err.Error()))
-		return fmt.Errorf("error: %s", maskPw(err.Error()))
+	if err = kc.Login(); err != nil {
+		ctx.Log().Progressf("Component Keycloak failed logging into Keycloak: %v", err)
+		return nil, err
 	}
 	ctx.Log().Once("Component Keycloak successfully logged into Keycloak")
 
-	return nil
-}
-
-func bashCMD(command string) []string {
-	return []string{
-		"bash",
-		"-c",
-		command,
-	}
+	return kc, nil
 }
 
 func keycloakPod() *corev1.Pod {
@@ -1211,88 +1048,47 @@ func getDNSDomain(c client.Client, vz *vzapi.Verrazzano) (string, error) {
 	return dnsDomain, nil
 }
 
-func createVerrazzanoSystemRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	realm := "realm=" + vzconst.VerrazzanoOIDCSystemRealm
-	checkRealmExistsCmd := kcAdminScript + " get realms/" + vzconst.VerrazzanoOIDCSystemRealm
-	ctx.Log().Debugf("createVerrazzanoSystemRealm: Check Verrazzano System Realm Exists Cmd = %s", checkRealmExistsCmd)
-	_, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(checkRealmExistsCmd))
+func createVerrazzanoSystemRealm(ctx spi.ComponentContext, kc *keycloakutil.AdminClient) error {
+	enabled := false
+	created, err := kc.CreateRealmIfNotExists(keycloakutil.Realm{Realm: vzconst.VerrazzanoOIDCSystemRealm, Enabled: &enabled})
 	if err != nil {
-		ctx.Log().Debug("createVerrazzanoSystemRealm: Verrazzano System Realm doesn't exist: Creating it")
-		createRealmCmd := kcAdminScript + " create realms -s " + realm + " -s enabled=false"
-		ctx.Log().Debugf("createVerrazzanoSystemRealm: Create Verrazzano System Realm Cmd = %s", createRealmCmd)
-		stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createRealmCmd))
-		if err != nil {
-			ctx.Log().Errorf("Component Keycloak failed creating Verrazzano System Realm: stdout = %s, stderr = %s", stdout, stderr)
-			return err
-		}
+		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano System Realm: %v", err)
+		return err
+	}
+	if created {
 		ctx.Log().Once("Component Keycloak successfully created the Verrazzano system realm")
 	}
 	return nil
 }
 
-func createVerrazzanoGroup(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, group string, parentID string) (string, error) {
-	kcPod := keycloakPod()
-	keycloakGroups, err := getKeycloakGroups(ctx, cfg, cli, kcPod)
-	if err == nil && groupExists(keycloakGroups, group) {
-		// Group already exists
-		return getGroupID(keycloakGroups, group), nil
-	}
-	groupsResource := "groups"
-	groupName := "name=" + group
-	if parentID != "" {
-		groupsResource = fmt.Sprintf("groups/%s/children", parentID)
-	}
-
-	cmd := fmt.Sprintf("%s create %s -r %s -s %s", kcAdminScript, groupsResource, vzconst.VerrazzanoOIDCSystemRealm, groupName)
-	ctx.Log().Debugf("createVerrazzanoGroup: Create Verrazzano %s Group Cmd = %s", group, cmd)
-	out, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(cmd))
+func createVerrazzanoGroup(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, group string, parentID string) (string, error) {
+	groupID, err := kc.CreateGroupIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, group, parentID)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano %s Group: command output = %s", group, out)
+		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano %s Group: %v", group, err)
 		return "", err
 	}
-	ctx.Log().Debugf("createVerrazzanoGroup: Create Verrazzano %s Group Output = %s", group, out)
-	if len(out) == 0 {
-		err = fmt.Errorf("Component Keycloak failed; %s group ID from Keycloak is zero length", group)
-		ctx.Log().Error(err)
-		return "", err
-	}
-	arr := strings.Split(string(out), "'")
-	if len(arr) != 3 {
-		return "", fmt.Errorf("Component Keycloak failed parsing output returned from %s Group create stdout returned = %s", group, out)
-	}
-	ctx.Log().Debugf("createVerrazzanoGroup: %s Group ID = %s", group, arr[1])
+	ctx.Log().Debugf("createVerrazzanoGroup: %s Group ID = %s", group, groupID)
 	ctx.Log().Oncef("Component Keycloak successfully created the Verrazzano %s group", group)
-	return arr[1], nil
+	return groupID, nil
 }
 
-func createVerrazzanoRole(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, roleName string) error {
-	kcPod := keycloakPod()
-	keycloakRoles, err := getKeycloakRoles(ctx, cfg, cli, kcPod)
-	if err == nil && roleExists(keycloakRoles, roleName) {
-		return nil
-	}
-	role := "name=" + roleName
-	createRoleCmd := kcAdminScript + " create roles -r " + vzconst.VerrazzanoOIDCSystemRealm + " -s " + role
-	ctx.Log().Debugf("createVerrazzanoRole: Create %s role cmd = %s", roleName, createRoleCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createRoleCmd))
+func createVerrazzanoRole(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, roleName string) error {
+	created, err := kc.CreateRealmRoleIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, roleName)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating %s role: stdout = %s, stderr = %s", roleName, stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed creating %s role: %v", roleName, err)
 		return err
 	}
-	ctx.Log().Oncef("Component Keycloak successfully created the %s role", roleName)
+	if created {
+		ctx.Log().Oncef("Component Keycloak successfully created the %s role", roleName)
+	}
 	return nil
 }
 
-func grantRolesToGroups(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userGroupID string, adminGroupID string, monitorGroupID string) error {
-	// Keycloak API does not fail if Role already exists as of 15.0.3
-	kcPod := keycloakPod()
+func grantRolesToGroups(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, userGroupID string) error {
 	// Granting vz_api_access role to verrazzano users group
-	grantAPIAccessToVzUserGroupCmd := kcAdminScript + " add-roles -r " + vzconst.VerrazzanoOIDCSystemRealm + " --gid " + userGroupID + " --rolename " + vzAPIAccessRole
-	ctx.Log().Debugf("grantRolesToGroups: Grant API Access to VZ Users Cmd = %s", grantAPIAccessToVzUserGroupCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(grantAPIAccessToVzUserGroupCmd))
+	err := kc.AddRealmRolesToGroup(vzconst.VerrazzanoOIDCSystemRealm, userGroupID, vzAPIAccessRole)
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed granting api access role to Verrazzano users group: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed granting api access role to Verrazzano users group: %v", err)
 		return err
 	}
 	ctx.Log().Once("Component Keycloak successfully granted the access role to the Verrazzano user group")
@@ -1300,113 +1096,88 @@ func grantRolesToGroups(ctx spi.ComponentContext, cfg *restclient.Config, cli ku
 	return nil
 }
 
-func createUser(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userName, secretName, secretNamespace, groupName, firstName, lastName string) error {
-	kcPod := keycloakPod()
-	keycloakUsers, err := getKeycloakUsers(ctx, cfg, cli, kcPod)
-	if err == nil && userExists(keycloakUsers, userName) {
-		return nil
+func createUser(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, userName, secretName, secretNamespace, groupName, firstName, lastName string) error {
+	user, err := kc.GetUser(vzconst.VerrazzanoOIDCSystemRealm, userName)
+	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed retrieving user %s: %v", userName, err)
+		return err
 	}
-	vzUser := "username=" + userName
-	vzUserGroup := "groups[0]=/" + vzUsersGroup + "/" + groupName
-	createVzUserCmd := kcAdminScript + " create users -r " + vzconst.VerrazzanoOIDCSystemRealm + " -s " + vzUser + " -s " + vzUserGroup + " -s enabled=true"
-	if firstName != "" {
-		createVzUserCmd = createVzUserCmd + " -s firstName=" + firstName
+	if user != nil {
+		return nil
 	}
 
-	if lastName != "" {
-		createVzUserCmd = createVzUserCmd + " -s lastName=" + lastName
+	vzpw, err := getSecretPassword(ctx, secretNamespace, secretName)
+	if err != nil {
+		return err
 	}
 
-	ctx.Log().Debugf("createUser: Create Verrazzano User Cmd = %s", createVzUserCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(createVzUserCmd))
+	userID, err := kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.User{
+		Username:  userName,
+		Enabled:   true,
+		FirstName: firstName,
+		LastName:  lastName,
+		Groups:    []string{"/" + vzUsersGroup + "/" + groupName},
+	})
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano user: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed creating Verrazzano user %s: %v", userName, err)
 		return err
 	}
 	ctx.Log().Debugf("createUser: Successfully Created VZ User %s", userName)
 
-	vzpw, err := getSecretPassword(ctx, secretNamespace, secretName)
+	err = kc.SetPassword(vzconst.VerrazzanoOIDCSystemRealm, userID, vzpw)
 	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed setting Verrazzano user %s password: %v", userName, err)
 		return err
 	}
-	setVZUserPwCmd := kcAdminScript + " set-password -r " + vzconst.VerrazzanoOIDCSystemRealm + " --username " + userName + " --new-password " + vzpw
-	ctx.Log().Debugf("createUser: Set Verrazzano User PW Cmd = %s", maskPw(setVZUserPwCmd))
-	stdout, stderr, err = k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setVZUserPwCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed setting Verrazzano user password: stdout = %s, stderr = %s", stdout, stderr)
-		return fmt.Errorf("error: %s", maskPw(err.Error()))
-	}
 	ctx.Log().Debugf("createUser: Created VZ User %s PW", userName)
 	ctx.Log().Oncef("Component Keycloak successfully created user %s", userName)
 
 	return nil
 }
 
-func createOrUpdateClientScope(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, groupname string) error {
-	keycloakClientScopes, err := getKeycloakClientScopes(ctx)
+func createOrUpdateClientScope(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, groupname string) error {
+	created, err := kc.CreateClientScopeIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.ClientScope{Name: groupname, Protocol: "openid-connect"})
 	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed creating %s client scope: %v", groupname, err)
 		return err
 	}
-
-	kcPod := keycloakPod()
-	if clientScopeName := getClientScopeName(keycloakClientScopes, groupname); clientScopeName != "" {
-		return nil
+	if created {
+		ctx.Log().Oncef("Component Keycloak successfully created client-scope %s", groupname)
 	}
-
-	// Create client scope
-	clientCreateCmd := kcAdminScript + " create -x client-scopes -r " + vzconst.VerrazzanoOIDCSystemRealm + " -s name=groups -s protocol=openid-connect"
-	ctx.Log().Debugf("createOrUpdateClient: Create %s client Cmd = %s", groupname, clientCreateCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(clientCreateCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating %s client scope stdout = %s, stderr = %s", groupname, stdout, stderr)
-		return err
-	}
-	ctx.Log().Debugf("createOrUpdateClientScope: Created %s client-scope", groupname)
-	ctx.Log().Oncef("Component Keycloak successfully created client-scope %s", groupname)
 	return nil
 }
 
-func CreateOrUpdateClient(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, clientName string, clientTemplate string, uriTemplate string, generateSecret bool, dnsSubdomain *string) error {
-	keycloakClients, err := getKeycloakClients(ctx)
+// CreateOrUpdateClient creates the client from the client template if it doesn't exist, otherwise the
+// Keycloak redirect and web origin URIs of the client are updated from the URI template
+func CreateOrUpdateClient(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, clientName string, clientTemplate string, uriTemplate string, generateSecret bool, dnsSubdomain *string) error {
+	data, err := populateClientTemplate(ctx, clientTemplate, clientName, dnsSubdomain)
 	if err != nil {
 		return err
 	}
-
-	kcPod := keycloakPod()
-	if clientID := getClientID(keycloakClients, clientName); clientID != "" {
-		if uriTemplate != "" {
-			err := updateKeycloakUris(ctx, cfg, cli, kcPod, clientID, uriTemplate, dnsSubdomain)
-			if err != nil {
-				return err
-			}
+	var uris string
+	if uriTemplate != "" {
+		uris, err = populateClientTemplate(ctx, "{"+uriTemplate+"}", "", dnsSubdomain)
+		if err != nil {
+			return err
 		}
-
-		return nil
 	}
 
-	data, err := populateClientTemplate(ctx, clientTemplate, clientName, dnsSubdomain)
+	id, created, err := kc.CreateOrUpdateClient(vzconst.VerrazzanoOIDCSystemRealm, clientName, []byte(data), []byte(uris))
 	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed creating or updating %s client: %v", clientName, err)
 		return err
 	}
-
-	// Create client
-	clientCreateCmd := kcAdminScript + " create clients -r " + vzconst.VerrazzanoOIDCSystemRealm + " -f - <<\\END" +
-		data +
-		"END"
-
-	ctx.Log().Debugf("CreateOrUpdateClient: Create %s client Cmd = %s", clientName, clientCreateCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(clientCreateCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating %s client: stdout = %s, stderr = %s", clientName, stdout, stderr)
-		return err
+	if !created {
+		ctx.Log().Debugf("CreateOrUpdateClient: Updated %s client", clientName)
+		return nil
 	}
 
 	if generateSecret {
-		err = generateClientSecret(ctx, cfg, cli, clientName, stdout, kcPod)
-		if err != nil {
-			ctx.Log().Errorf("Component Keycloak failed creating %s client secret: err = %s", clientName, err.Error())
+		if _, err = kc.RegenerateClientSecret(vzconst.VerrazzanoOIDCSystemRealm, id); err != nil {
+			ctx.Log().Errorf("Component Keycloak failed creating %s client secret: %v", clientName, err)
 			return err
 		}
+		ctx.Log().Oncef("Component Keycloak generated client secret for client: %v", clientName)
 	}
 
 	ctx.Log().Debugf("CreateOrUpdateClient: Created %s client", clientName)
@@ -1414,261 +1185,48 @@ func CreateOrUpdateClient(ctx spi.ComponentContext, cfg *restclient.Config, cli
 	return nil
 }
 
-func setAccessTokenLifespanForRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, realmName string) error {
-	kcPod := keycloakPod()
-	setTokenCmd := kcAdminScript + " update realms/" + realmName + " -s accessTokenLifespan=1200"
-	ctx.Log().Debugf("setAccessTokenLifespanForRealm: Setting Access Token Lifespan for realm %s Cmd = %s", realmName, setTokenCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setTokenCmd))
+func setAccessTokenLifespanForRealm(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, realmName string) error {
+	lifespan := int32(accessTokenLifespan)
+	err := kc.UpdateRealm(keycloakutil.Realm{Realm: realmName, AccessTokenLifespan: &lifespan})
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed setting access token lifespan for verrazzano-system: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed setting access token lifespan for realm %s: %v", realmName, err)
 		return err
 	}
-	ctx.Log().Debugf("setAccessTokenLifespanForRealm: Set Access Token Lifespan for realm %s", realmName)
 	ctx.Log().Oncef("Component Keycloak successfully set the Access Token Lifespan for realm %s", realmName)
 	return nil
 }
 
-func setPasswordPolicyForRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, realmName string, policy string) error {
-	kcPod := keycloakPod()
-	setPolicyCmd := kcAdminScript + " update realms/" + realmName + " -s \"" + policy + "\""
-	ctx.Log().Debugf("setPasswordPolicyForRealm: Setting password policy for realm %s Cmd = %s", realmName, setPolicyCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setPolicyCmd))
+func setPasswordPolicyForRealm(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, realmName string, policy string) error {
+	err := kc.UpdateRealm(keycloakutil.Realm{Realm: realmName, PasswordPolicy: &policy})
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed setting password policy for master: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed setting password policy for realm %s: %v", realmName, err)
 		return err
 	}
-	ctx.Log().Debugf("setPasswordPolicyForRealm: Set password policy for realm %s", realmName)
 	ctx.Log().Oncef("Component Keycloak successfully set the password policy for realm %s", realmName)
 	return nil
 }
 
-func configureLoginThemeForRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, realmName string, loginTheme string) error {
-	kcPod := keycloakPod()
-	setLoginThemeCmd := kcAdminScript + " update realms/" + realmName + " -s loginTheme=" + loginTheme
-	ctx.Log().Debugf("configureLoginThemeForRealm: Configuring login theme Cmd = %s", setLoginThemeCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setLoginThemeCmd))
+func configureLoginThemeForRealm(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, realmName string, loginTheme string) error {
+	err := kc.UpdateRealm(keycloakutil.Realm{Realm: realmName, LoginTheme: &loginTheme})
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed configuring login theme for master: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed configuring login theme for realm %s: %v", realmName, err)
 		return err
 	}
-	ctx.Log().Debug("configureLoginThemeForRealm: Configured login theme for master Cmd")
 	ctx.Log().Oncef("Component Keycloak successfully set the login theme for realm %s", realmName)
 	return nil
 }
 
-func enableVerrazzanoSystemRealm(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	setVzEnableRealmCmd := kcAdminScript + " update realms/" + vzconst.VerrazzanoOIDCSystemRealm + " -s enabled=true"
-	ctx.Log().Debugf("enableVerrazzanoSystemRealm: Enabling vzconst.VerrazzanoOIDCSystemRealm realm Cmd = %s", setVzEnableRealmCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(setVzEnableRealmCmd))
+func enableVerrazzanoSystemRealm(ctx spi.ComponentContext, kc *keycloakutil.AdminClient) error {
+	enabled := true
+	err := kc.UpdateRealm(keycloakutil.Realm{Realm: vzconst.VerrazzanoOIDCSystemRealm, Enabled: &enabled})
 	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed enabling vzconst.VerrazzanoOIDCSystemRealm realm: stdout = %s, stderr = %s", stdout, stderr)
+		ctx.Log().Errorf("Component Keycloak failed enabling realm %s: %v", vzconst.VerrazzanoOIDCSystemRealm, err)
 		return err
 	}
-	ctx.Log().Debug("enableVerrazzanoSystemRealm: Enabled vzconst.VerrazzanoOIDCSystemRealm realm")
-	ctx.Log().Once("Component Keycloak successfully enabled the vzconst.VerrazzanoOIDCSystemRealm realm")
-
+	ctx.Log().Oncef("Component Keycloak successfully enabled the %s realm", vzconst.VerrazzanoOIDCSystemRealm)
 	return nil
 }
 
-func removeLoginConfigFile(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface) error {
-	kcPod := keycloakPod()
-	removeLoginConfigFileCmd := "rm ~/.keycloak/kcadm.config"
-	ctx.Log().Debugf("removeLoginConfigFile: Removing login config file Cmd = %s", removeLoginConfigFileCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(removeLoginConfigFileCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed removing login config file: stdout = %s, stderr = %s", stdout, stderr)
-		return err
-	}
-	ctx.Log().Debug("removeLoginConfigFile: Removed login config file")
-	return nil
-}
-
-// getKeycloakGroups returns a structure of Groups in Realm verrazzano-system
-func getKeycloakGroups(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, kcPod *corev1.Pod) (KeycloakGroups, error) {
-	var keycloakGroups KeycloakGroups
-	// Get the Client ID JSON array
-	cmd := fmt.Sprintf("%s get groups -r %s", kcAdminScript, vzconst.VerrazzanoOIDCSystemRealm)
-	out, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(cmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Groups: %s", err)
-		return nil, err
-	}
-	if len(out) == 0 {
-		err = errors.New("Component Keycloak failed; groups JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal([]byte(out), &keycloakGroups)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling groups json: %v", err)
-		return nil, err
-	}
-
-	return keycloakGroups, nil
-}
-
-func groupExists(keycloakGroups KeycloakGroups, groupName string) bool {
-	for _, keycloakGroup := range keycloakGroups {
-		if keycloakGroup.Name == groupName {
-			return true
-		}
-		for _, subGroup := range keycloakGroup.SubGroups {
-			if subGroup.Name == groupName {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func getGroupID(keycloakGroups KeycloakGroups, groupName string) string {
-	for _, keycloakGroup := range keycloakGroups {
-		if keycloakGroup.Name == groupName {
-			return keycloakGroup.ID
-		}
-		for _, subGroup := range keycloakGroup.SubGroups {
-			if subGroup.Name == groupName {
-				return subGroup.ID
-			}
-		}
-	}
-	return ""
-}
-
-// getKeycloakRoless returns a structure of Groups in Realm verrazzano-system
-func getKeycloakRoles(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, kcPod *corev1.Pod) (KeycloakRoles, error) {
-	var keycloakRoles KeycloakRoles
-	// Get the Client ID JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(kcAdminScript+" get-roles -r "+vzconst.VerrazzanoOIDCSystemRealm))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Roles: %s", err)
-		return nil, err
-	}
-	if len(out) == 0 {
-		err = errors.New("Component Keycloak failed; roles JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal([]byte(out), &keycloakRoles)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling groups json: %v", err)
-		return nil, err
-	}
-
-	return keycloakRoles, nil
-}
-
-func roleExists(keycloakRoles KeycloakRoles, roleName string) bool {
-	for _, keycloakRole := range keycloakRoles {
-		if keycloakRole.Name == roleName {
-			return true
-		}
-	}
-	return false
-}
-
-// getKeycloakUsers returns a structure of Users in Realm verrazzano-system
-func getKeycloakUsers(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, kcPod *corev1.Pod) ([]KeycloakUser, error) {
-	var keycloakUsers []KeycloakUser
-	// Get the Client ID JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(kcAdminScript+" get users -r "+vzconst.VerrazzanoOIDCSystemRealm))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving Users: %s", err)
-		return nil, err
-	}
-	if len(out) == 0 {
-		err := errors.New("Component Keycloak failed; users JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal([]byte(out), &keycloakUsers)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling users json: %v", err)
-		return nil, err
-	}
-	return keycloakUsers, nil
-}
-
-func userExists(keycloakUsers []KeycloakUser, userName string) bool {
-	for _, keycloakUser := range keycloakUsers {
-		if keycloakUser.Username == userName {
-			return true
-		}
-	}
-	return false
-}
-
-// getKeycloakClients returns a structure of Users in Realm verrazzano-system
-func getKeycloakClients(ctx spi.ComponentContext) (KeycloakClients, error) {
-	var keycloakClients KeycloakClients
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
-	// Get the Client ID JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, keycloakPod(), ComponentName, bashCMD(kcAdminScript+" get clients -r "+vzconst.VerrazzanoOIDCSystemRealm+" --fields id,clientId"))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving clients: %s", err)
-		return nil, err
-	}
-	if len(out) == 0 {
-		err := errors.New("Component Keycloak failed; clients JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal([]byte(out), &keycloakClients)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling client json: %v", err)
-		return nil, err
-	}
-	return keycloakClients, nil
-}
-
-// getKeycloakClientsScopes returns a structure of ClientScopes in Realm verrazzano-system
-func getKeycloakClientScopes(ctx spi.ComponentContext) (KeycloakClientScopes, error) {
-	var keycloakClientScopes KeycloakClientScopes
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
-	// Get the Client ID JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, keycloakPod(), ComponentName, bashCMD(kcAdminScript+" get client-scopes -r "+vzconst.VerrazzanoOIDCSystemRealm+" --fields id,name"))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed retrieving client-scopes: %s", err)
-		return nil, err
-	}
-	if len(out) == 0 {
-		err := errors.New("Component Keycloak failed; clients JSON from Keycloak is zero length")
-		ctx.Log().Error(err)
-		return nil, err
-	}
-	err = json.Unmarshal([]byte(out), &keycloakClientScopes)
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed ummarshalling client json: %v", err)
-		return nil, err
-	}
-	return keycloakClientScopes, nil
-}
-
-func getClientID(keycloakClients KeycloakClients, clientName string) string {
-	for _, keycloakClient := range keycloakClients {
-		if keycloakClient.ClientID == clientName {
-			return keycloakClient.ID
-		}
-	}
-	return ""
-}
-
-func getClientScopeName(keycloakClientScopes KeycloakClientScopes, groupname string) string {
-	for _, keycloakClient := range keycloakClientScopes {
-		if keycloakClient.Name == groupname {
-			return keycloakClient.Name
-		}
-	}
-	return ""
-}
-
 func (c KeycloakComponent) isKeycloakReady(ctx spi.ComponentContext) bool {
 	prefix := fmt.Sprintf("Component %s", ctx.GetComponent())
 	return ready.StatefulSetsAreReady(ctx.Log(), ctx.Client(), c.AvailabilityObjects.StatefulsetNames, 1, prefix)
@@ -1793,58 +1351,39 @@ func populateClientTemplate(ctx spi.ComponentContext, tmpl string, clientID stri
 
 // GetRancherClientSecretFromKeycloak returns the secret from rancher client in Keycloak
 func GetRancherClientSecretFromKeycloak(ctx spi.ComponentContext) (string, error) {
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return "", err
-	}
-
 	// Login to Keycloak
-	err = LoginKeycloak(ctx, cfg, cli)
+	kc, err := LoginKeycloak(ctx)
 	if err != nil {
 		return "", err
 	}
+	return getRancherClientSecret(ctx, kc)
+}
 
-	kcClients, err := getKeycloakClients(ctx)
+// getRancherClientSecret returns the secret of the rancher client, or an empty string if the client does not exist
+func getRancherClientSecret(ctx spi.ComponentContext, kc *keycloakutil.AdminClient) (string, error) {
+	kcClient, err := kc.GetClient(vzconst.VerrazzanoOIDCSystemRealm, "rancher")
 	if err != nil {
+		ctx.Log().Errorf("failed retrieving rancher client from keycloak: %v", err)
 		return "", err
 	}
-
-	id := ""
-	for _, kcClient := range kcClients {
-		if kcClient.ClientID == "rancher" {
-			id = kcClient.ID
-		}
-	}
-
-	if id == "" {
+	if kcClient == nil {
 		ctx.Log().Debugf("GetRancherClientSecretFromKeycloak: rancher client does not exist")
 		return "", nil
 	}
+	return getClientSecret(ctx, kc, kcClient)
+}
 
-	var clientSecret KeycloakClientSecret
-	// Get the Client secret JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, keycloakPod(), ComponentName, bashCMD(kcAdminScript+" get clients/"+id+"/client-secret -r "+vzconst.VerrazzanoOIDCSystemRealm))
-	if err != nil {
-		ctx.Log().Errorf("failed retrieving rancher client secret from keycloak: %s", err)
-		return "", err
-	}
-	if len(out) == 0 {
-		err = errors.New("client secret json from keycloak is zero length")
-		ctx.Log().Error(err)
-		return "", err
-	}
-
-	err = json.Unmarshal([]byte(out), &clientSecret)
+// getClientSecret returns the secret of the given client
+func getClientSecret(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, kcClient *keycloakutil.Client) (string, error) {
+	secret, err := kc.GetClientSecret(vzconst.VerrazzanoOIDCSystemRealm, kcClient.ID)
 	if err != nil {
-		ctx.Log().Errorf("failed ummarshalling client secret json: %v", err)
+		ctx.Log().Errorf("failed retrieving %s client secret from keycloak: %v", kcClient.ClientID, err)
 		return "", err
 	}
-
-	if clientSecret.Value == "" {
+	if secret == "" {
 		return "", ctx.Log().ErrorNewErr("client secret is empty")
 	}
-
-	return clientSecret.Value, nil
+	return secret, nil
 }
 
 type (
@@ -1858,127 +1397,47 @@ type (
 
 // GetClientSecret returns the secret from Argo CD client in Keycloak
 func (p DefaultArgoClientSecretProvider) GetClientSecret(ctx spi.ComponentContext) (string, error) {
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return "", err
-	}
-
 	// Login to Keycloak
-	err = LoginKeycloak(ctx, cfg, cli)
+	kc, err := LoginKeycloak(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	kcClients, err := getKeycloakClients(ctx)
+	kcClient, err := kc.GetClient(vzconst.VerrazzanoOIDCSystemRealm, "argocd")
 	if err != nil {
+		ctx.Log().Errorf("failed retrieving argocd client from keycloak: %v", err)
 		return "", err
 	}
-
-	id := ""
-	for _, kcClient := range kcClients {
-		if kcClient.ClientID == "argocd" {
-			id = kcClient.ID
-		}
-	}
-
-	if id == "" {
+	if kcClient == nil {
 		ctx.Log().Debugf("GetArgoCDClientSecretFromKeycloak: Argo CD client does not exist")
-		err = errors.New("Argo CD client does not exist")
-		return "", err
-	}
-
-	var clientSecret KeycloakClientSecret
-	// Get the Client secret JSON array
-	out, _, err := k8sutil.ExecPod(cli, cfg, keycloakPod(), ComponentName, bashCMD(kcAdminScript+" get clients/"+id+"/client-secret -r "+vzconst.VerrazzanoOIDCSystemRealm))
-	if err != nil {
-		ctx.Log().Errorf("failed retrieving argocd client secret from keycloak: %s", err)
-		return "", err
-	}
-	if len(out) == 0 {
-		err = errors.New("client secret json from keycloak is zero length")
-		ctx.Log().Error(err)
-		return "", err
+		return "", errors.New("Argo CD client does not exist")
 	}
-
-	err = json.Unmarshal([]byte(out), &clientSecret)
-	if err != nil {
-		ctx.Log().Errorf("failed ummarshalling client secret json: %v", err)
-		return "", err
-	}
-
-	if clientSecret.Value == "" {
-		return "", ctx.Log().ErrorNewErr("client secret is empty")
-	}
-
-	return clientSecret.Value, nil
-}
-
-func generateClientSecret(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, clientName string, createClientOutput string, kcPod *corev1.Pod) error {
-	if len(createClientOutput) == 0 {
-		err := fmt.Errorf("Component Keycloak failed; %s client ID from Keycloak is zero length", clientName)
-		ctx.Log().Error(err)
-		return err
-	}
-
-	arr := strings.Split(string(createClientOutput), "'")
-	if len(arr) != 3 {
-		return fmt.Errorf("Component Keycloak failed parsing output returned from %s Client create stdout returned = %s", clientName, createClientOutput)
-	}
-
-	clientID := arr[1]
-	ctx.Log().Debugf("generateClientSecret: %s Client ID = %s", clientName, clientID)
-
-	// Create client secret
-	clientCreateSecretCmd := kcAdminScript + " create clients/" + clientID + "/client-secret" + " -r " + vzconst.VerrazzanoOIDCSystemRealm
-	ctx.Log().Debugf("generateClientSecret: Create %s client secret Cmd = %s", clientName, clientCreateSecretCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(clientCreateSecretCmd))
-	if err != nil {
-		ctx.Log().Errorf("Component Keycloak failed creating %s client secret: stdout = %s, stderr = %s", clientName, stdout, stderr)
-		return err
-	}
-
-	ctx.Log().Oncef("Component Keycloak generated client secret for client: %v", clientName)
-	return nil
+	return getClientSecret(ctx, kc, kcClient)
 }
 
 // GetVerrazzanoUserFromKeycloak returns the user verrazzano in Keycloak
 func GetVerrazzanoUserFromKeycloak(ctx spi.ComponentContext) (*KeycloakUser, error) {
-	cfg, cli, err := k8sutil.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
-
 	// Login to Keycloak
-	err = LoginKeycloak(ctx, cfg, cli)
+	kc, err := LoginKeycloak(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	kcUsers, err := getKeycloakUsers(ctx, cfg, cli, keycloakPod())
+	vzUser, err := kc.GetUser(vzconst.VerrazzanoOIDCSystemRealm, vzUserName)
 	if err != nil {
+		ctx.Log().Errorf("Component Keycloak failed retrieving user %s: %v", vzUserName, err)
 		return nil, err
 	}
-
-	var vzUser KeycloakUser
-	found := false
-	for _, user := range kcUsers {
-		if user.Username == "verrazzano" {
-			vzUser = user
-			found = true
-			break
-		}
-	}
-
-	if !found {
+	if vzUser == nil {
 		return nil, ctx.Log().ErrorfThrottledNewErr("GetVerrazzanoUserIDFromKeycloak: verrazzano user does not exist")
 	}
 
-	return &vzUser, nil
+	return vzUser, nil
 }
 
-func updateRancherClientSecretForKeycloakAuthConfig(ctx spi.ComponentContext) error {
+func updateRancherClientSecretForKeycloakAuthConfig(ctx spi.ComponentContext, kc *keycloakutil.AdminClient) error {
 	log := ctx.Log()
-	clientSecret, err := GetRancherClientSecretFromKeycloak(ctx)
+	clientSecret, err := getRancherClientSecret(ctx, kc)
 	if err != nil {
 		return log.ErrorfThrottledNewErr("failed updating client secret in keycloak auth config, unable to fetch rancher client secret: %s", err.Error())
 	}
@@ -1989,13 +1448,13 @@ func updateRancherClientSecretForKeycloakAuthConfig(ctx spi.ComponentContext) er
 }
 
 // addRealmRoleToUser adds a realm role to the given user in the target realm
-func addRealmRoleToUser(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userName, targetRealm, roleName string) error {
-	kcPod := keycloakPod()
-	addRoleCmd := fmt.Sprintf("%s add-roles -r %s --uusername %s --rolename %s", kcAdminScript, targetRealm, userName, roleName)
-	ctx.Log().Debugf("Adding realm role %s to user %s, using command: %s", roleName, userName, addRoleCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(addRoleCmd))
+func addRealmRoleToUser(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, userName, targetRealm, roleName string) error {
+	userID, err := getUserID(kc, targetRealm, userName)
+	if err == nil {
+		err = kc.AddRealmRolesToUser(targetRealm, userID, roleName)
+	}
 	if err != nil {
-		ctx.Log().Errorf("Adding realm role %s to the user %s failed: stdout = &s, stderr = %s, error = %s", roleName, userName, stdout, stderr, err.Error())
+		ctx.Log().Errorf("Adding realm role %s to the user %s failed: %v", roleName, userName, err)
 		return err
 	}
 	ctx.Log().Oncef("Added realm role %s to the user %s", roleName, userName)
@@ -2003,19 +1462,31 @@ func addRealmRoleToUser(ctx spi.ComponentContext, cfg *restclient.Config, cli ku
 }
 
 // addClientRoleToUser adds client role to the given user in the target realm
-func addClientRoleToUser(ctx spi.ComponentContext, cfg *restclient.Config, cli kubernetes.Interface, userName, clientID, targetRealm, roleName string) error {
-	kcPod := keycloakPod()
-	addRoleCmd := kcAdminScript + " add-roles -r " + targetRealm + " --uusername " + userName + " --cclientid " + clientID + " --rolename " + roleName
-	ctx.Log().Debugf("Adding client role %s to the user %s, using command: %s", roleName, userName, addRoleCmd)
-	stdout, stderr, err := k8sutil.ExecPod(cli, cfg, kcPod, ComponentName, bashCMD(addRoleCmd))
+func addClientRoleToUser(ctx spi.ComponentContext, kc *keycloakutil.AdminClient, userName, clientID, targetRealm, roleName string) error {
+	userID, err := getUserID(kc, targetRealm, userName)
+	if err == nil {
+		err = kc.AddClientRolesToUser(targetRealm, userID, clientID, roleName)
+	}
 	if err != nil {
-		ctx.Log().Errorf("Adding client role %s to the user %s failed: stdout = %s, stderr = %s, error = %s", roleName, userName, stdout, stderr, err.Error())
+		ctx.Log().Errorf("Adding client role %s to the user %s failed: %v", roleName, userName, err)
 		return err
 	}
 	ctx.Log().Oncef("Added client role %s to the user %s", roleName, userName)
 	return nil
 }
 
+// getUserID returns the ID of the user in the given realm
+func getUserID(kc *keycloakutil.AdminClient, realm string, userName string) (string, error) {
+	user, err := kc.GetUser(realm, userName)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", fmt.Errorf("user %s does not exist in realm %s", userName, realm)
+	}
+	return user.ID, nil
+}
+
 // DoesDeprecatedIngressHostExist returns true if ingress host exists
 func DoesDeprecatedIngressHostExist(ctx spi.ComponentContext, namespace string) (bool, error) {
 	ingressList := &networkv1.IngressList{}
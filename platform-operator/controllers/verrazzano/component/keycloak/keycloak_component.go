@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package keycloak
@@ -24,6 +24,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/secret"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/config"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -97,6 +98,10 @@ func (c KeycloakComponent) Reconcile(ctx spi.ComponentContext) error {
 		return err
 	}
 
+	if err := checkRestoreInProgress(ctx); err != nil {
+		return err
+	}
+
 	// If the Keycloak component is ready, confirm the configuration is working.
 	// If ephemeral storage is being used, the Keycloak configuration will be rebuilt if needed.
 	if c.isKeycloakReady(ctx) {
@@ -169,6 +174,11 @@ func (c KeycloakComponent) PostInstall(ctx spi.ComponentContext) error {
 
 // PreUpgrade - component level processing for pre-upgrade
 func (c KeycloakComponent) PreUpgrade(ctx spi.ComponentContext) error {
+	// The upgrade would scale Keycloak back up while its database is restored
+	if err := checkRestoreInProgress(ctx); err != nil {
+		return err
+	}
+
 	isDeleteRequired, err := isDeleteStatefulSetRequired(ctx)
 	if err != nil {
 		return err
@@ -206,6 +216,24 @@ func (c KeycloakComponent) PostUpgrade(ctx spi.ComponentContext) error {
 	return configureKeycloakRealms(ctx)
 }
 
+// checkRestoreInProgress returns a retryable error while a VerrazzanoRestore has scaled Keycloak down to restore the
+// Keycloak database. The restore scales Keycloak back up once the database is restored.
+func checkRestoreInProgress(ctx spi.ComponentContext) error {
+	sts := appsv1.StatefulSet{}
+	err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: ComponentName}, &sts)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := sts.Annotations[constants.KeycloakRestoreReplicasAnnotation]; ok {
+		ctx.Log().Progressf("Component %s waiting for the restore of the Keycloak database to complete", ComponentName)
+		return ctrlerrors.RetryableError{Source: ComponentName}
+	}
+	return nil
+}
+
 // IsEnabled Keycloak-specific enabled check for installation
 func (c KeycloakComponent) IsEnabled(effectiveCR runtime.Object) bool {
 	return vzcr.IsKeycloakEnabled(effectiveCR)
@@ -219,6 +247,22 @@ func (c KeycloakComponent) IsReady(ctx spi.ComponentContext) bool {
 	return false
 }
 
+// ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
+func (c KeycloakComponent) ValidateInstall(vz *vzapi.Verrazzano) error {
+	if err := validateRealmObjectsSourcesV1Alpha1(vz); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstall(vz)
+}
+
+// ValidateInstallV1Beta1 checks if the specified Verrazzano CR is valid for this component to be installed
+func (c KeycloakComponent) ValidateInstallV1Beta1(vz *installv1beta1.Verrazzano) error {
+	if err := validateRealmObjectsSources(vz); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstallV1Beta1(vz)
+}
+
 // ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
 func (c KeycloakComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verrazzano) error {
 	// Do not allow any changes except to enable the component post-install
@@ -229,6 +273,9 @@ func (c KeycloakComponent) ValidateUpdate(old *vzapi.Verrazzano, new *vzapi.Verr
 	if err := common.CompareInstallArgs(c.getInstallArgs(old), c.getInstallArgs(new)); err != nil {
 		return fmt.Errorf("Updates to InstallArgs not allowed for %s", ComponentJSONName)
 	}
+	if err := validateRealmObjectsSourcesV1Alpha1(new); err != nil {
+		return err
+	}
 	return c.HelmComponent.ValidateUpdate(old, new)
 }
 
@@ -238,6 +285,9 @@ func (c KeycloakComponent) ValidateUpdateV1Beta1(old *installv1beta1.Verrazzano,
 	if c.IsEnabled(old) && !c.IsEnabled(new) {
 		return fmt.Errorf("Disabling component %s is not allowed", ComponentJSONName)
 	}
+	if err := validateRealmObjectsSources(new); err != nil {
+		return err
+	}
 	return c.HelmComponent.ValidateUpdateV1Beta1(old, new)
 }
 
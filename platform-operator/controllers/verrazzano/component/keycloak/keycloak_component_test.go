@@ -1,9 +1,12 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package keycloak
 
 import (
+	"context"
+
+	ctrlerrors "github.com/verrazzano/verrazzano/pkg/controller/errors"
 	"github.com/verrazzano/verrazzano/pkg/helm"
 	"github.com/verrazzano/verrazzano/pkg/k8sutil"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
@@ -18,6 +21,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -145,6 +149,29 @@ func TestPreinstall(t *testing.T) {
 	}
 }
 
+// TestPreUpgradeRestoreInProgress tests the Keycloak PreUpgrade call during a restore
+// GIVEN a Keycloak StatefulSet that a VerrazzanoRestore has scaled down
+//
+//	WHEN I call PreUpgrade
+//	THEN a retryable error is returned, so that the upgrade doesn't scale Keycloak back up
+func TestPreUpgradeRestoreInProgress(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ComponentName,
+			Namespace:   ComponentNamespace,
+			Annotations: map[string]string{constants.KeycloakRestoreReplicasAnnotation: "1"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(sts).Build()
+	ctx := spi.NewFakeContext(c, testVZ, nil, false)
+	err := NewComponent().PreUpgrade(ctx)
+	assert.True(t, ctrlerrors.IsRetryableError(err))
+
+	delete(sts.Annotations, constants.KeycloakRestoreReplicasAnnotation)
+	assert.NoError(t, c.Update(context.TODO(), sts))
+	assert.NoError(t, checkRestoreInProgress(ctx))
+}
+
 // TestKeycloakComponentValidateUpdate tests the Keycloak ValidateUpdate call
 // GIVEN a Keycloak component
 //
@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package keycloak
@@ -6,17 +6,16 @@ package keycloak
 import (
 	"context"
 	"fmt"
-	"github.com/verrazzano/verrazzano/pkg/test/keycloakutil"
-	"k8s.io/apimachinery/pkg/api/resource"
-	"net/url"
-	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	certmanager "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/bom"
-	"github.com/verrazzano/verrazzano/pkg/k8sutil"
-	k8sutilfake "github.com/verrazzano/verrazzano/pkg/k8sutil/fake"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/keycloakutil"
+	testkeycloak "github.com/verrazzano/verrazzano/pkg/test/keycloakutil"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
@@ -28,9 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -61,11 +58,6 @@ var crEnabled = vzapi.Verrazzano{
 	},
 }
 
-func fakeRESTConfig() (*rest.Config, kubernetes.Interface, error) {
-	cfg, cli := k8sutilfake.NewClientsetConfig()
-	return cfg, cli, nil
-}
-
 func createTestNginxService() *v1.Service {
 	return &v1.Service{
 		TypeMeta: metav1.TypeMeta{},
@@ -94,336 +86,72 @@ func createTestKeycloakAuthConfig() unstructured.Unstructured {
 	return authConfig
 }
 
-func fakeConfigureRealmCommands(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		command := commands[2]
-		if strings.Contains(command, "create groups") {
-			return "Created new group with id 'quick-brown-fox'", "", nil
-		}
-
-		if strings.Contains(command, "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"jump-window\"}]", "", nil
-		}
-
-		if strings.Contains(command, "get client-scopes") {
-			return "[{\"id\" : \"quick-fox\",\"name\" : \"blah\"}]", "", nil
-		}
-		if strings.Contains(command, "create -x client-scopes") {
-			return "Created client-scope 'blah'", "", nil
-		}
-
-		if strings.Contains(command, "create clients/") {
-			return "Created client secret blah", "", nil
-		}
-
-		if strings.Contains(command, "create clients") {
-			return "Created client 'blahblah'", "", nil
-		}
-
-	}
-
-	return "", "", nil
-}
-
-func fakeConfigureRealmCommandsUpdateKeycloakURIFailed(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "create groups") {
-			return "Created new group with id 'quick-brown-fox'", "", nil
-		}
-	}
-
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"},{\"id\" : \"quick-fox-1\",\"clientId\" : \"verrazzano-pg\"}]", "", nil
-		}
-	}
-
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "update clients") {
-			return "", "", fmt.Errorf("failed")
-		}
-	}
-	return "", "", nil
-}
-
-func fakeCreateUserGroupCommand(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "create groups") {
-			return "Created new group with id 'quick-brown-fox'", "", nil
-		}
-	}
-	return "", "", nil
-}
-
-func fakeCreateUserGroupCommandFail(url *url.URL) (string, string, error) {
-	return "", "", nil
-}
-
-func fakeCreateUserGroupParseCommandFail(url *url.URL) (string, string, error) {
-	return "invalidoutput", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloak(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "{\"type\":\"secret\",\"value\":\"abcdef\"}", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakGetClientsFails(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get clients") {
-			return "", "", fmt.Errorf("failed")
-		}
-
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakNoRancherClient(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"norancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakClientSecretFailed(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "", "", fmt.Errorf("failed")
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakClientSecretResultEmpty(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakClientSecretResultInvalid(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "invalid", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetRancherClientSecretFromKeycloakClientSecretEmpty(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "{\"type\":\"secret\",\"value\":\"\"}", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"rancher\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloak(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "{\"type\":\"secret\",\"value\":\"abcdef\"}", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"argocd\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakGetClientsFails(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get clients") {
-			return "", "", fmt.Errorf("failed")
-		}
-
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakNoArgoCDClient(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"noargocd\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakClientSecretFailed(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "", "", fmt.Errorf("failed")
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"argocd\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakClientSecretResultEmpty(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"argocd\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakClientSecretResultInvalid(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "invalid", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"argocd\"}]", "", nil
+// setupFakeAdminServer starts a fake Keycloak admin API that is used by the component instead of Keycloak,
+// the administrator password is read from the keycloak-http secret
+func setupFakeAdminServer(t *testing.T) *testkeycloak.FakeAdminServer {
+	server := testkeycloak.NewFakeAdminServer(keycloakutil.AdminUsername, "password")
+	savedNewAdminClient := newAdminClient
+	newAdminClient = func(ctx spi.ComponentContext) (*keycloakutil.AdminClient, error) {
+		password, err := keycloakutil.GetAdminPassword(ctx.Client())
+		if err != nil {
+			return nil, err
 		}
+		return keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, password), nil
 	}
-
-	return "", "", nil
-}
-
-func fakeGetArgoCDClientSecretFromKeycloakClientSecretEmpty(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "client-secret") {
-			return "{\"type\":\"secret\",\"value\":\"\"}", "", nil
-		}
-
-		if strings.Contains(commands[2], "get clients") {
-			return "[{\"id\" : \"quick-fox\",\"clientId\" : \"argocd\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
-}
-
-func fakeGetVerrazzanoUserFromKeycloak(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get users") {
-			return "[{\"id\" : \"quick-fox\",\"username\" : \"verrazzano\"}]", "", nil
-		}
-	}
-
-	return "", "", nil
+	t.Cleanup(func() {
+		newAdminClient = savedNewAdminClient
+		server.Close()
+	})
+	return server
 }
 
-func fakeGetVerrazzanoUserFromKeycloakFails(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get users") {
-			return "", "", fmt.Errorf("failed")
+// newTestRealmServer starts a fake Keycloak admin API with the Verrazzano system realm and a client
+func newTestRealmServer(t *testing.T, clientID string, withSecret bool) *testkeycloak.FakeAdminServer {
+	server := setupFakeAdminServer(t)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	_, err := kc.CreateRealmIfNotExists(keycloakutil.Realm{Realm: vzconst.VerrazzanoOIDCSystemRealm})
+	assert.NoError(t, err)
+	if clientID != "" {
+		id, _, err := kc.CreateOrUpdateClient(vzconst.VerrazzanoOIDCSystemRealm, clientID, []byte(`{"clientId": "`+clientID+`"}`), nil)
+		assert.NoError(t, err)
+		if withSecret {
+			_, err = kc.RegenerateClientSecret(vzconst.VerrazzanoOIDCSystemRealm, id)
+			assert.NoError(t, err)
 		}
-
 	}
-
-	return "", "", nil
+	return server
 }
 
-func fakeGetVerrazzanoUserFromKeycloakResultEmpty(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get users") {
-			return "", "", nil
-		}
+// newTestUserSecrets returns the secrets holding the passwords of the users created in the Verrazzano system realm
+func newTestUserSecrets(withThanos bool) []client.Object {
+	secrets := []client.Object{
+		newTestPasswordSecret(constants.VerrazzanoSystemNamespace, "verrazzano", "blah di blah"),
+		newTestPasswordSecret(constants.VerrazzanoSystemNamespace, "verrazzano-prom-internal", "blah di blah"),
+		newTestPasswordSecret(constants.VerrazzanoSystemNamespace, "verrazzano-es-internal", "blah di blah"),
 	}
-
-	return "", "", nil
-}
-
-func fakeGetVerrazzanoUserFromKeycloakResultInvalid(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get users") {
-			return "invalid", "", nil
-		}
+	if withThanos {
+		secrets = append(secrets, newTestPasswordSecret(constants.VerrazzanoMonitoringNamespace, "verrazzano-thanos-internal", "blah di blah"))
 	}
-
-	return "", "", nil
+	return secrets
 }
 
-func fakeGetVerrazzanoUserFromKeycloakNoVerrazzanoUser(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], "get users") {
-			return "[{\"id\" : \"quick-fox\",\"username\" : \"notverrazzano\"}]", "", nil
-		}
+func newTestPasswordSecret(namespace string, name string, password string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       map[string][]byte{"password": []byte(password)},
 	}
-
-	return "", "", nil
 }
 
-func TestUpdateKeycloakURIs(t *testing.T) {
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	loginSecret := keycloakutil.CreateTestKeycloakLoginSecret()
-	cfg, cli, _ := fakeRESTConfig()
+// TestCreateOrUpdateClient tests the creation and update of a Keycloak client
+// GIVEN a client, and a k8s environment
+// WHEN I call CreateOrUpdateClient
+// THEN the client is created from the client template, or its URIs are updated if it exists,
+// otherwise an error is returned if the environment or the template is invalid
+func TestCreateOrUpdateClient(t *testing.T) {
+	loginSecret := testkeycloak.CreateTestKeycloakLoginSecret()
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
 	clientID := "client"
+	clientTemplate := `{"clientId": "{{.ClientID}}", "redirectUris": ["https://client.{{.DNSSubDomain}}/create"], "publicClient": false}`
 	uriTemplate := "\"redirectUris\": [\"https://client.{{.DNSSubDomain}}/verify-auth\"]"
 	osIngress := &networkv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -439,45 +167,64 @@ func TestUpdateKeycloakURIs(t *testing.T) {
 	}
 	tests := []struct {
 		name        string
-		ctx         spi.ComponentContext
-		clientID    string
+		c           client.Client
 		uriTemplate string
+		exists      bool
 		wantErr     bool
+		wantURI     string
 	}{
+		{
+			name:        "testCreateClient",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, createTestNginxService(), osIngress).Build(),
+			uriTemplate: uriTemplate,
+			wantURI:     "https://client.default.192.132.111.122.nip.io/create",
+		},
 		{
 			name:        "testUpdateKeycloakURIs",
-			ctx:         spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, createTestNginxService(), osIngress).Build(), testVZ, nil, false),
-			clientID:    clientID,
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, createTestNginxService(), osIngress).Build(),
 			uriTemplate: uriTemplate,
-			wantErr:     false,
+			exists:      true,
+			wantURI:     "https://client.default.192.132.111.122.nip.io/verify-auth",
 		},
 		{
 			name:        "testFailForInvalidUriTemplate",
-			ctx:         spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, createTestNginxService()).Build(), testVZ, nil, false),
-			clientID:    clientID,
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, createTestNginxService()).Build(),
 			uriTemplate: "test.{{{.DNSSubDomain}}",
+			exists:      true,
 			wantErr:     true,
 		},
 		{
 			name:        "testFailForNoIngress",
-			ctx:         spi.NewFakeContext(fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret).Build(), testVZ, nil, false),
-			wantErr:     true,
-			clientID:    clientID,
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
 			uriTemplate: uriTemplate,
+			exists:      true,
+			wantErr:     true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			podExecResult := k8sutilfake.PodExecResult
-			if tt.wantErr && tt.name == "testFailForNoKeycloakClientsReturned" {
-				k8sutilfake.PodExecResult = func(url *url.URL) (string, string, error) {
-					return "", "[]", nil
-				}
+			existing := ""
+			if tt.exists {
+				existing = clientID
 			}
-			defer func() { k8sutilfake.PodExecResult = podExecResult }()
-			if err := updateKeycloakUris(tt.ctx, cfg, cli, keycloakPod(), tt.clientID, tt.uriTemplate, nil); (err != nil) != tt.wantErr {
-				t.Errorf("updateKeycloakUris() error = %v, wantErr %v", err, tt.wantErr)
+			server := newTestRealmServer(t, existing, false)
+			vz := testVZ.DeepCopy()
+			vz.Spec.EnvironmentName = "default"
+			ctx := spi.NewFakeContext(tt.c, vz, nil, false)
+			kc, err := LoginKeycloak(ctx)
+			assert.NoError(t, err)
+
+			err = CreateOrUpdateClient(ctx, kc, clientID, clientTemplate, tt.uriTemplate, true, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
 			}
+			assert.NoError(t, err)
+			realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+			rep := realm.FindClient(clientID)
+			assert.Equal(t, []interface{}{tt.wantURI}, rep["redirectUris"])
+			// The secret is only generated for new clients
+			assert.Equal(t, !tt.exists, realm.ClientSecrets[rep["id"].(string)] != "")
 		})
 	}
 }
@@ -487,13 +234,10 @@ func TestUpdateKeycloakURIs(t *testing.T) {
 // WHEN I call configureKeycloakRealms
 // THEN configure the Keycloak realms, otherwise returning an error if the environment is invalid
 func TestConfigureKeycloakRealms(t *testing.T) {
-	loginSecret := keycloakutil.CreateTestKeycloakLoginSecret()
+	loginSecret := testkeycloak.CreateTestKeycloakLoginSecret()
 	nginxService := createTestNginxService()
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	podExecFunc := k8sutilfake.PodExecResult
 	authConfig := createTestKeycloakAuthConfig()
-	keycloakPod := keycloakutil.CreateTestKeycloakPod()
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
 
 	osIngress := &networkv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -511,241 +255,102 @@ func TestConfigureKeycloakRealms(t *testing.T) {
 	var tests = []struct {
 		name        string
 		c           client.Client
-		stdout      string
 		isErr       bool
 		errContains string
-		execFunc    func(url *url.URL) (string, string, error)
+		wantUsers   []string
 	}{
 		{
 			"should fail when login fails",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
-			"blahblah",
 			true,
 			"secrets \"keycloak-http\" not found",
-			fakeCreateUserGroupCommand,
-		},
-		{
-			"should fail to retrieve user group ID from Keycloak when stdout is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			"",
-			true,
-			"Component Keycloak failed; verrazzano-users group ID from Keycloak is zero length",
-			fakeCreateUserGroupCommandFail,
-		},
-		{
-			"should fail to retrieve user group ID from Keycloak when stdout is incorrect",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			"",
-			true,
-			"failed parsing output returned from verrazzano-users Group",
-			fakeCreateUserGroupParseCommandFail,
+			nil,
 		},
 		{
 			"should fail when Verrazzano secret is not present",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			"blahblah'id",
 			true,
 			"secrets \"verrazzano\" not found",
-			fakeCreateUserGroupCommand,
+			nil,
 		},
 		{
 			"should fail when Verrazzano secret has no password",
 			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte(""),
-					},
-				}).Build(),
-			"blahblah'id",
+				newTestPasswordSecret(constants.VerrazzanoSystemNamespace, "verrazzano", "")).Build(),
 			true,
 			"password field empty in secret",
-			fakeCreateUserGroupCommand,
+			nil,
 		},
 		{
 			"should fail when nginx service is not present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, osIngress,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-thanos-internal",
-						Namespace: "verrazzano-monitoring",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod, osIngress).
+				WithObjects(newTestUserSecrets(true)...).Build(),
 			true,
 			"services \"ingress-controller-ingress-nginx-controller\" not found",
-			fakeConfigureRealmCommands,
+			nil,
 		},
 		{
-			"fails during updateKeycloakURIs",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, osIngress,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-thanos-internal",
-						Namespace: "verrazzano-monitoring",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
-			true,
-			"keycloak/keycloak-0: failed",
-			fakeConfigureRealmCommandsUpdateKeycloakURIFailed,
-		},
-		{
-			"should pass when able to successfully exec commands on the keycloak pod and all k8s objects are present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, &authConfig, osIngress,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-thanos-internal",
-						Namespace: "verrazzano-monitoring",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			"should pass when Keycloak accepts the requests and all k8s objects are present",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, &authConfig, osIngress).
+				WithObjects(newTestUserSecrets(true)...).Build(),
 			false,
 			"",
-			fakeConfigureRealmCommands,
+			[]string{vzUserName, vzInternalPromUser, constants.ThanosInternalUserSecretName, vzInternalEsUser},
 		},
 		{
-			"should pass when able to successfully exec commands on the keycloak pod and thanos secret does not exist (because thanos is not installed)",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, &authConfig, osIngress,
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-prom-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				},
-				&v1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "verrazzano-es-internal",
-						Namespace: "verrazzano-system",
-					},
-					Data: map[string][]byte{
-						"password": []byte("blah di blah"),
-					},
-				}).Build(),
-			"blahblah'id",
+			"should pass when Keycloak accepts the requests and thanos secret does not exist (because thanos is not installed)",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, nginxService, keycloakPod, &authConfig, osIngress).
+				WithObjects(newTestUserSecrets(false)...).Build(),
 			false,
 			"",
-			fakeConfigureRealmCommands,
+			[]string{vzUserName, vzInternalPromUser, vzInternalEsUser},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := setupFakeAdminServer(t)
 			ctx := spi.NewFakeContext(tt.c, testVZ, nil, false)
-			k8sutilfake.PodExecResult = tt.execFunc
-			defer func() { k8sutilfake.PodExecResult = podExecFunc }()
 			err := configureKeycloakRealms(ctx)
 			if tt.isErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errContains)
-			} else {
-				assert.NoError(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			// Configuring the realms again does not duplicate anything
+			assert.NoError(t, configureKeycloakRealms(ctx))
+
+			realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+			assert.Equal(t, true, realm.Representation["enabled"])
+			for _, r := range []*testkeycloak.FakeRealm{realm, server.Realms[keycloakutil.MasterRealm]} {
+				assert.Equal(t, passwordPolicy, r.Representation["passwordPolicy"])
+				assert.Equal(t, loginTheme, r.Representation["loginTheme"])
 			}
+
+			usersGroup := realm.FindGroup("/" + vzUsersGroup)
+			assert.Len(t, realm.Groups, 1)
+			assert.Len(t, usersGroup.SubGroups, 3)
+			assert.Equal(t, []string{vzAPIAccessRole}, realm.GroupRoles[usersGroup.ID])
+			assert.Len(t, realm.Roles, 3)
+
+			assert.Len(t, realm.Users, len(tt.wantUsers))
+			for _, name := range tt.wantUsers {
+				user := realm.FindUser(name)
+				assert.NotNil(t, user, name)
+				assert.Equal(t, "blah di blah", realm.Passwords[user["id"].(string)])
+			}
+			vzUser := realm.FindUser(vzUserName)
+			assert.Equal(t, []interface{}{"/" + vzUsersGroup + "/" + vzAdminGroup}, vzUser["groups"])
+			assert.Equal(t, "Verrazzano", vzUser["firstName"])
+			assert.Equal(t, []string{vzOpenSearchAdminRole, realmManagement + "/" + viewUsersRole}, realm.UserRoles[vzUser["id"].(string)])
+
+			for _, clientID := range []string{"verrazzano-pkce", "verrazzano-pg", "rancher"} {
+				assert.NotNil(t, realm.FindClient(clientID), clientID)
+			}
+			assert.Len(t, realm.Clients, 4)
+			assert.NotEmpty(t, realm.ClientSecrets[realm.FindClient("rancher")["id"].(string)])
 		})
 	}
 }
@@ -850,41 +455,54 @@ func TestGetEnvironmentName(t *testing.T) {
 // WHEN I call LoginKeycloak
 // THEN throw an error if the k8s environment is invalid (bad secret)
 func TestLoginKeycloak(t *testing.T) {
-	httpSecret := keycloakutil.CreateTestKeycloakLoginSecret()
-	httpSecretEmptyPassword := keycloakutil.CreateTestKeycloakLoginSecret()
+	httpSecret := testkeycloak.CreateTestKeycloakLoginSecret()
+	httpSecretEmptyPassword := testkeycloak.CreateTestKeycloakLoginSecret()
 	httpSecretEmptyPassword.Data["password"] = []byte("")
-	cfg, restclient, _ := fakeRESTConfig()
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
+	httpSecretWrongPassword := testkeycloak.CreateTestKeycloakLoginSecret()
+	httpSecretWrongPassword.Data["password"] = []byte("wrong")
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
+	setupFakeAdminServer(t)
 
 	var tests = []struct {
 		name  string
 		c     client.Client
 		isErr bool
 	}{
+		{
+			"should fail when the pod does not exist",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecret).Build(),
+			true,
+		},
 		{
 			"should fail when secret does not exist",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build(),
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
 			true,
 		},
 		{
 			"should fail to find the keycloak password if it is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecretEmptyPassword).Build(),
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecretEmptyPassword, keycloakPod).Build(),
+			true,
+		},
+		{
+			"should fail when Keycloak rejects the password",
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecretWrongPassword, keycloakPod).Build(),
 			true,
 		},
 		{
 			"should log into keycloak when the password is present",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecret, keycloakutil.CreateTestKeycloakPod()).Build(),
+			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(httpSecret, keycloakPod).Build(),
 			false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := LoginKeycloak(spi.NewFakeContext(tt.c, testVZ, nil, false), cfg, restclient)
+			kc, err := LoginKeycloak(spi.NewFakeContext(tt.c, testVZ, nil, false))
 			if tt.isErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.NotNil(t, kc)
 			}
 		})
 	}
@@ -994,422 +612,6 @@ func getBoolPtr(b bool) *bool {
 	return &b
 }
 
-// TestGetClientId tests that the function returns the Id whether client exists
-// GIVEN an array of keycloak Clients
-// WHEN I call getClientId
-// THEN return the Id of the client if the client exists in the array of clients
-func TestGetClientId(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakClients
-		out  string
-	}{
-		{"testEmptyClients",
-			KeycloakClients{},
-			"",
-		},
-		{"testClientNotFound",
-			KeycloakClients{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-			},
-			"",
-		},
-		{"testClientFound",
-			KeycloakClients{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-				{
-					"973974",
-					"someClient",
-				},
-			},
-			"973974",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, getClientID(tt.in, "someClient"))
-		})
-	}
-}
-
-// TestGetClientScopeName tests that the function returns the Name whether client-scope exists
-// GIVEN an array of keycloak Client-scopes
-// WHEN I call getClientScopeName
-// THEN return the Name of the client-scope if the client-scope exists in the array of clients
-func TestGetClientScopeName(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakClientScopes
-		out  string
-	}{
-		{"testEmptyClients",
-			KeycloakClientScopes{},
-			"",
-		},
-		{"testClientNotFound",
-			KeycloakClientScopes{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-			},
-			"",
-		},
-		{"testClientFound",
-			KeycloakClientScopes{
-				{
-					"973973",
-					"thisClient",
-				},
-				{
-					"973974",
-					"thatClient",
-				},
-				{
-					"973974",
-					"someClient",
-				},
-			},
-			"someClient",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, getClientScopeName(tt.in, "someClient"))
-		})
-	}
-}
-
-// TestUserExists tests that the function returns false/true whether user exists
-// GIVEN an array of keycloak Users
-// WHEN I call userExists
-// THEN return true/false whether the user exists in the array of users
-func TestUserExists(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   []KeycloakUser
-		out  bool
-	}{
-		{"testEmptyUsers",
-			[]KeycloakUser{},
-			false,
-		},
-		{"testUserNotFound",
-			[]KeycloakUser{
-				{
-					ID:       "955995",
-					Username: "thisUser",
-				},
-				{
-					ID:       "955996",
-					Username: "thatUser",
-				},
-			},
-			false,
-		},
-		{"testUserFound",
-			[]KeycloakUser{
-				{
-					ID:       "955995",
-					Username: "thisUser",
-				},
-				{
-					ID:       "955996",
-					Username: "thatUser",
-				},
-				{
-					ID:       "955997",
-					Username: "someUser",
-				},
-			},
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, userExists(tt.in, "someUser"))
-		})
-	}
-}
-
-// TestRoleExists tests that the function returns false/true whether role exists
-// GIVEN an array of keycloak Roles
-// WHEN I call roleExists
-// THEN return true/false whether the role exists in the array of roles
-func TestRoleExists(t *testing.T) {
-	var tests = []struct {
-		name string
-		in   KeycloakRoles
-		out  bool
-	}{
-		{"testEmptyRoles",
-			KeycloakRoles{},
-			false,
-		},
-		{"testRoleNotFound",
-			KeycloakRoles{
-				{
-					ID:   "955995",
-					Name: "thisRole",
-				},
-				{
-					ID:   "955996",
-					Name: "thatRole",
-				},
-			},
-			false,
-		},
-		{"testRoleFound",
-			KeycloakRoles{
-				{
-					ID:   "955995",
-					Name: "thisRole",
-				},
-				{
-					ID:   "955996",
-					Name: "thatRole",
-				},
-				{
-					ID:   "955997",
-					Name: "someRole",
-				},
-			},
-			true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, roleExists(tt.in, "someRole"))
-		})
-	}
-}
-
-// TestGroupExists tests that the function returns false/true whether group exists
-// GIVEN an array of keycloak Groups
-// WHEN I call groupExists
-// THEN return true/false whether the group exists in the Keycloak Group structure
-func TestGroupExists(t *testing.T) {
-
-	var (
-		tests = []struct {
-			name string
-			in   KeycloakGroups
-			out  bool
-		}{
-			{"testEmptyGroups",
-				KeycloakGroups{},
-				false,
-			},
-			{"testGroupNotFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "thisGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				false,
-			},
-			{"testGroupFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "foundGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				true,
-			},
-			{"testSubGroupFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "someGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-							{
-								ID:   "555555",
-								Name: "foundGroup",
-							},
-						},
-					},
-				},
-				true,
-			},
-		}
-	)
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, groupExists(tt.in, "foundGroup"))
-		})
-	}
-}
-
-// TestGetGroupID tests that the function returns the correct groupID for the group name
-// GIVEN an array of keycloak Groups
-// WHEN I call getGroupID
-// THEN return the groupID for the GroupName passed in, empty string for not found
-func TestGetGroupID(t *testing.T) {
-
-	var (
-		tests = []struct {
-			name string
-			in   KeycloakGroups
-			out  string
-		}{
-			{"testEmptyGroups",
-				KeycloakGroups{},
-				"",
-			},
-			{"testGroupIDNotFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "thisGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				"",
-			},
-			{"testGroupIDFound",
-				KeycloakGroups{
-					{
-						ID:        "999999",
-						Name:      "foundGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-						},
-					},
-				},
-				"999999",
-			},
-			{"testSubGroupIDFound",
-				KeycloakGroups{
-					{
-						ID:        "955995",
-						Name:      "someGroup",
-						SubGroups: nil,
-					},
-					{
-						ID:   "",
-						Name: "",
-						SubGroups: []SubGroup{
-							{
-								ID:   "333333",
-								Name: "subGroup1",
-							},
-							{
-								ID:   "444444",
-								Name: "subGroup2",
-							},
-							{
-								ID:   "999999",
-								Name: "foundGroup",
-							},
-						},
-					},
-				},
-				"999999",
-			},
-		}
-	)
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.out, getGroupID(tt.in, "foundGroup"))
-		})
-	}
-}
-
 func TestUpdateKeycloakIngress(t *testing.T) {
 	ingress := &networkv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{Name: "keycloak", Namespace: "keycloak"},
@@ -1552,81 +754,72 @@ func TestDeleteStatefulSet(t *testing.T) {
 // WHEN I call GetRancherClientSecretFromKeycloak
 // THEN returns an rancher client secret, otherwise returning an error if the environment is invalid
 func TestGetRancherClientSecretFromKeycloak(t *testing.T) {
-	loginSecret := keycloakutil.CreateTestKeycloakLoginSecret()
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	podExecFunc := k8sutilfake.PodExecResult
-	keycloakPod := keycloakutil.CreateTestKeycloakPod()
+	loginSecret := testkeycloak.CreateTestKeycloakLoginSecret()
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
 
 	var tests = []struct {
 		name        string
 		c           client.Client
+		clientID    string
+		withSecret  bool
+		unreachable bool
 		isErr       bool
 		errContains string
-		execFunc    func(url *url.URL) (string, string, error)
 	}{
 		{
-			"should fail when login fails",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
-			true,
-			"secrets \"keycloak-http\" not found",
-			fakeGetRancherClientSecretFromKeycloak,
+			name:        "should fail when login fails",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
+			clientID:    "rancher",
+			withSecret:  true,
+			isErr:       true,
+			errContains: "secrets \"keycloak-http\" not found",
 		},
 		{
-			"should fail when fails to get clients",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"keycloak/keycloak-0: failed",
-			fakeGetRancherClientSecretFromKeycloakGetClientsFails,
+			name:        "should fail when Keycloak is unreachable",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:    "rancher",
+			unreachable: true,
+			isErr:       true,
 		},
 		{
-			"should not fail when rancher client id does not exist",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			false,
-			"",
-			fakeGetRancherClientSecretFromKeycloakNoRancherClient,
+			name:     "should not fail when rancher client id does not exist",
+			c:        fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID: "norancher",
 		},
 		{
-			"should fail when fetching client secret fails",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetRancherClientSecretFromKeycloakClientSecretFailed,
+			name:        "should fail when client secret is empty",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:    "rancher",
+			isErr:       true,
+			errContains: "client secret is empty",
 		},
 		{
-			"should fail when client secret result is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetRancherClientSecretFromKeycloakClientSecretResultEmpty,
-		},
-		{
-			"should fail when client secret result is invalid",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetRancherClientSecretFromKeycloakClientSecretResultInvalid,
-		},
-		{
-			"should fail when client secret is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"client secret is empty",
-			fakeGetRancherClientSecretFromKeycloakClientSecretEmpty,
+			name:       "should return the client secret",
+			c:          fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:   "rancher",
+			withSecret: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := newTestRealmServer(t, tt.clientID, tt.withSecret)
+			if tt.unreachable {
+				server.Close()
+			}
 			ctx := spi.NewFakeContext(tt.c, testVZ, nil, false)
-			k8sutilfake.PodExecResult = tt.execFunc
-			defer func() { k8sutilfake.PodExecResult = podExecFunc }()
-			_, err := GetRancherClientSecretFromKeycloak(ctx)
+			secret, err := GetRancherClientSecretFromKeycloak(ctx)
 			if tt.isErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+			if tt.withSecret {
+				assert.Equal(t, realm.ClientSecrets[realm.FindClient("rancher")["id"].(string)], secret)
 			} else {
-				assert.NoError(t, err)
+				assert.Empty(t, secret)
 			}
 		})
 	}
@@ -1637,82 +830,71 @@ func TestGetRancherClientSecretFromKeycloak(t *testing.T) {
 // WHEN I call TestGetArgoCDClientSecretFromKeycloak
 // THEN returns an Argo CD client secret, otherwise returning an error if the environment is invalid
 func TestGetArgoCDClientSecretFromKeycloak(t *testing.T) {
-	loginSecret := keycloakutil.CreateTestKeycloakLoginSecret()
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	podExecFunc := k8sutilfake.PodExecResult
-	keycloakPod := keycloakutil.CreateTestKeycloakPod()
+	loginSecret := testkeycloak.CreateTestKeycloakLoginSecret()
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
 
 	var tests = []struct {
 		name        string
 		c           client.Client
+		clientID    string
+		withSecret  bool
+		unreachable bool
 		isErr       bool
 		errContains string
-		execFunc    func(url *url.URL) (string, string, error)
 	}{
 		{
-			"should fail when login fails",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
-			true,
-			"secrets \"keycloak-http\" not found",
-			fakeGetArgoCDClientSecretFromKeycloak,
+			name:        "should fail when login fails",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
+			clientID:    "argocd",
+			withSecret:  true,
+			isErr:       true,
+			errContains: "secrets \"keycloak-http\" not found",
 		},
 		{
-			"should fail when fails to get clients",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"keycloak/keycloak-0: failed",
-			fakeGetArgoCDClientSecretFromKeycloakGetClientsFails,
+			name:        "should fail when Keycloak is unreachable",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:    "argocd",
+			unreachable: true,
+			isErr:       true,
 		},
 		{
-			"should  fail when Argo CD client id does not exist",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetArgoCDClientSecretFromKeycloakNoArgoCDClient,
+			name:        "should fail when Argo CD client id does not exist",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:    "noargocd",
+			isErr:       true,
+			errContains: "Argo CD client does not exist",
 		},
 		{
-			"should fail when fetching Argo CD client secret ",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetArgoCDClientSecretFromKeycloakClientSecretFailed,
+			name:        "should fail when client secret is empty",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:    "argocd",
+			isErr:       true,
+			errContains: "client secret is empty",
 		},
 		{
-			"should fail when client secret result is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetArgoCDClientSecretFromKeycloakClientSecretResultEmpty,
-		},
-		{
-			"should fail when client secret result is invalid",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetArgoCDClientSecretFromKeycloakClientSecretResultInvalid,
-		},
-		{
-			"should fail when client secret is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"client secret is empty",
-			fakeGetArgoCDClientSecretFromKeycloakClientSecretEmpty,
+			name:       "should return the client secret",
+			c:          fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			clientID:   "argocd",
+			withSecret: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := newTestRealmServer(t, tt.clientID, tt.withSecret)
+			if tt.unreachable {
+				server.Close()
+			}
 			ctx := spi.NewFakeContext(tt.c, testVZ, nil, false)
-			k8sutilfake.PodExecResult = tt.execFunc
-			defer func() { k8sutilfake.PodExecResult = podExecFunc }()
-			_, err := DefaultArgoClientSecretProvider{}.GetClientSecret(ctx)
+			secret, err := DefaultArgoClientSecretProvider{}.GetClientSecret(ctx)
 			if tt.isErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errContains)
-			} else {
-				assert.NoError(t, err)
+				return
 			}
+			assert.NoError(t, err)
+			realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+			assert.Equal(t, realm.ClientSecrets[realm.FindClient("argocd")["id"].(string)], secret)
 		})
 	}
 }
@@ -1722,68 +904,65 @@ func TestGetArgoCDClientSecretFromKeycloak(t *testing.T) {
 // WHEN I call GetVerrazzanoUserFromKeycloak
 // THEN returns a verrazzano user struct, otherwise returning an error if the environment is invalid
 func TestGetVerrazzanoUserFromKeycloak(t *testing.T) {
-	loginSecret := keycloakutil.CreateTestKeycloakLoginSecret()
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	podExecFunc := k8sutilfake.PodExecResult
-	keycloakPod := keycloakutil.CreateTestKeycloakPod()
+	loginSecret := testkeycloak.CreateTestKeycloakLoginSecret()
+	keycloakPod := testkeycloak.CreateTestKeycloakPod()
 
 	var tests = []struct {
 		name        string
 		c           client.Client
+		username    string
+		unreachable bool
 		isErr       bool
 		errContains string
-		execFunc    func(url *url.URL) (string, string, error)
 	}{
 		{
-			"should fail when login fails",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
-			true,
-			"secrets \"keycloak-http\" not found",
-			fakeGetVerrazzanoUserFromKeycloak,
-		},
-		{
-			"should fail when fails to get users",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"keycloak/keycloak-0: failed",
-			fakeGetVerrazzanoUserFromKeycloakFails,
+			name:        "should fail when login fails",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(keycloakPod).Build(),
+			username:    vzUserName,
+			isErr:       true,
+			errContains: "secrets \"keycloak-http\" not found",
 		},
 		{
-			"should fail when get users result is empty",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetVerrazzanoUserFromKeycloakResultEmpty,
+			name:        "should fail when Keycloak is unreachable",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			username:    vzUserName,
+			unreachable: true,
+			isErr:       true,
 		},
 		{
-			"should fail when get users result is invalid",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"",
-			fakeGetVerrazzanoUserFromKeycloakResultInvalid,
+			name:        "should fail when verrazzano user is not found",
+			c:           fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			username:    "notverrazzano",
+			isErr:       true,
+			errContains: "verrazzano user does not exist",
 		},
 		{
-			"should fail when verrazzano user is not found",
-			fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
-			true,
-			"verrazzano user does not exist",
-			fakeGetVerrazzanoUserFromKeycloakNoVerrazzanoUser,
+			name:     "should return the verrazzano user",
+			c:        fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(loginSecret, keycloakPod).Build(),
+			username: vzUserName,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			server := newTestRealmServer(t, "", false)
+			kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+			userID, err := kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.User{Username: tt.username, Enabled: true})
+			assert.NoError(t, err)
+			if tt.unreachable {
+				server.Close()
+			}
+
 			ctx := spi.NewFakeContext(tt.c, testVZ, nil, false)
-			k8sutilfake.PodExecResult = tt.execFunc
-			defer func() { k8sutilfake.PodExecResult = podExecFunc }()
-			_, err := GetVerrazzanoUserFromKeycloak(ctx)
+			user, err := GetVerrazzanoUserFromKeycloak(ctx)
 			if tt.isErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errContains)
-			} else {
-				assert.NoError(t, err)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, userID, user.ID)
+			assert.Equal(t, vzUserName, user.Username)
 		})
 	}
 }
@@ -1791,53 +970,50 @@ func TestGetVerrazzanoUserFromKeycloak(t *testing.T) {
 // TestAddClientRoleToUser adds a client role to the verrazzano user
 // GIVEN a client, and a k8s environment
 // WHEN I call addClientRoleToUser
-// THEN confirm that the function doesn't return an error
+// THEN confirm that the role is granted, otherwise an error is returned if the user or role does not exist
 func TestAddClientRoleToUser(t *testing.T) {
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	cfg, cli, _ := fakeRESTConfig()
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	userID, err := kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.User{Username: "testuser", Enabled: true})
+	assert.NoError(t, err)
 
 	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 	ctx := spi.NewFakeContext(c, testVZ, nil, false)
 
-	err := addClientRoleToUser(ctx, cfg, cli, "testuser", "test-client", "test-realm", "test-role")
+	err = addClientRoleToUser(ctx, kc, "testuser", realmManagement, vzconst.VerrazzanoOIDCSystemRealm, viewUsersRole)
 	assert.NoError(t, err)
-}
+	assert.Equal(t, []string{realmManagement + "/" + viewUsersRole}, server.Realms[vzconst.VerrazzanoOIDCSystemRealm].UserRoles[userID])
 
-func fakeAddRealmRoleCommand(url *url.URL) (string, string, error) {
-	var commands []string
-	if commands = url.Query()["command"]; len(commands) == 3 {
-		if strings.Contains(commands[2], kcAdminScript) &&
-			strings.Contains(commands[2], "add-roles") &&
-			strings.Contains(commands[2], "test-realm") &&
-			strings.Contains(commands[2], "test-role") {
-			return "Added new realm role: test-role'", "", nil
-		}
-	}
-	return "", "", fmt.Errorf("Failed to add realm role")
+	err = addClientRoleToUser(ctx, kc, "testuser", realmManagement, vzconst.VerrazzanoOIDCSystemRealm, "invalid-role")
+	assert.Error(t, err)
+	err = addClientRoleToUser(ctx, kc, "testuser", "test-client", vzconst.VerrazzanoOIDCSystemRealm, viewUsersRole)
+	assert.Error(t, err)
+	err = addClientRoleToUser(ctx, kc, "nouser", realmManagement, vzconst.VerrazzanoOIDCSystemRealm, viewUsersRole)
+	assert.Error(t, err)
 }
 
 // TestAddRealmRoleToUser adds a realm role to a user
 // GIVEN a client, and a k8s environment
 // WHEN I call addRealmRoleToUser
-// THEN confirm that the function doesn't return an error
+// THEN confirm that the role is granted, otherwise an error is returned if the role does not exist
 func TestAddRealmRoleToUser(t *testing.T) {
-	k8sutil.ClientConfig = fakeRESTConfig
-	k8sutil.NewPodExecutor = k8sutilfake.NewPodExecutor
-	podExecFunc := k8sutilfake.PodExecResult
-	k8sutilfake.PodExecResult = fakeAddRealmRoleCommand
-	defer func() { k8sutilfake.PodExecResult = podExecFunc }()
-	cfg, cli, _ := fakeRESTConfig()
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	userID, err := kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.User{Username: "test-user", Enabled: true})
+	assert.NoError(t, err)
+	_, err = kc.CreateRealmRoleIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, "test-role")
+	assert.NoError(t, err)
 
 	c := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
 	ctx := spi.NewFakeContext(c, testVZ, nil, false)
 
-	err := addRealmRoleToUser(ctx, cfg, cli, "test-user", "test-realm", "test-role")
+	err = addRealmRoleToUser(ctx, kc, "test-user", vzconst.VerrazzanoOIDCSystemRealm, "test-role")
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"test-role"}, server.Realms[vzconst.VerrazzanoOIDCSystemRealm].UserRoles[userID])
 
-	err = addRealmRoleToUser(ctx, cfg, cli, "test-user", "test-realm", "invalid-role")
+	err = addRealmRoleToUser(ctx, kc, "test-user", vzconst.VerrazzanoOIDCSystemRealm, "invalid-role")
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Failed to add realm role")
+	assert.True(t, keycloakutil.IsNotFound(err))
 }
 
 // TestIsDeleteSTSRequired tests the call to isDeleteStatefulSetRequired
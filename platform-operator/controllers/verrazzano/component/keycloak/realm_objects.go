@@ -0,0 +1,519 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/keycloakutil"
+	vzstring "github.com/verrazzano/verrazzano/pkg/string"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common/override"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	realmRoleKind        = "RealmRole"
+	clientKind           = "Client"
+	clientRoleKind       = "ClientRole"
+	groupKind            = "Group"
+	identityProviderKind = "IdentityProvider"
+	userFederationKind   = "UserFederation"
+	userKind             = "User"
+	sourceKind           = "Source"
+
+	userStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+	ldapProviderID          = "ldap"
+)
+
+// reservedClientIDs are the clients created by Verrazzano, which can not be declared as realm objects
+var reservedClientIDs = []string{"verrazzano-pkce", "verrazzano-pg", "rancher", "argocd", realmManagement}
+
+// reservedUsernames are the users created by Verrazzano, which can not be declared as realm objects
+var reservedUsernames = []string{vzUserName, vzInternalPromUser, vzInternalEsUser, constants.ThanosInternalUserSecretName}
+
+// realmObjectsStatus holds the status of the realm objects from the last reconcile of the Keycloak component. The
+// health checker publishes it in the Verrazzano resource.
+var realmObjectsStatus = struct {
+	lock       sync.Mutex
+	statuses   []vzapi.KeycloakRealmObjectStatus
+	reconciled bool
+}{}
+
+// realmObjects are the Keycloak objects declared in a ConfigMap or Secret of the Keycloak component. The format
+// follows the Keycloak realm export, clients and identity providers are Keycloak representations.
+type realmObjects struct {
+	RealmRoles        []string                 `json:"realmRoles,omitempty"`
+	ClientRoles       map[string][]string      `json:"clientRoles,omitempty"`
+	Clients           []map[string]interface{} `json:"clients,omitempty"`
+	Groups            []realmGroup             `json:"groups,omitempty"`
+	IdentityProviders []map[string]interface{} `json:"identityProviders,omitempty"`
+	UserFederation    []userFederationProvider `json:"userFederation,omitempty"`
+	Users             []realmUser              `json:"users,omitempty"`
+}
+
+// realmGroup is a declared group with its role mappings and child groups
+type realmGroup struct {
+	Name        string              `json:"name"`
+	RealmRoles  []string            `json:"realmRoles,omitempty"`
+	ClientRoles map[string][]string `json:"clientRoles,omitempty"`
+	SubGroups   []realmGroup        `json:"subGroups,omitempty"`
+}
+
+// realmUser is a declared user with its group memberships and role mappings. The initial password is only set when
+// the user is created, and the user has to change it at the first login.
+type realmUser struct {
+	Username        string              `json:"username"`
+	Email           string              `json:"email,omitempty"`
+	EmailVerified   bool                `json:"emailVerified,omitempty"`
+	FirstName       string              `json:"firstName,omitempty"`
+	LastName        string              `json:"lastName,omitempty"`
+	Enabled         *bool               `json:"enabled,omitempty"`
+	Groups          []string            `json:"groups,omitempty"`
+	RealmRoles      []string            `json:"realmRoles,omitempty"`
+	ClientRoles     map[string][]string `json:"clientRoles,omitempty"`
+	InitialPassword string              `json:"initialPassword,omitempty"`
+}
+
+// userFederationProvider is a declared user storage provider, LDAP if the provider ID is not set
+type userFederationProvider struct {
+	Name       string              `json:"name"`
+	ProviderID string              `json:"providerId,omitempty"`
+	Config     map[string][]string `json:"config,omitempty"`
+}
+
+// realmObjectsReconciler creates or updates the objects of a source in the Verrazzano system realm and records
+// the status of each object. The objects created and the role mappings declared are recorded in the applied realm
+// objects, so that they can be removed when they are no longer declared.
+type realmObjectsReconciler struct {
+	ctx      spi.ComponentContext
+	kc       *keycloakutil.AdminClient
+	realmID  string
+	source   string
+	previous *appliedRealmObjects
+	applied  *appliedRealmObjects
+	statuses []vzapi.KeycloakRealmObjectStatus
+}
+
+// reconcileRealmObjects creates or updates the realm objects declared by the Keycloak component and records their
+// status. The objects created by a previous reconcile that are no longer declared are deleted, and the role mappings
+// that are no longer declared are revoked. An object that fails is only reported in its status, so that a bad
+// declaration does not block the Keycloak component. An error is returned if the realm objects can not be reconciled
+// at all.
+func reconcileRealmObjects(ctx spi.ComponentContext, kc *keycloakutil.AdminClient) error {
+	keycloak := ctx.EffectiveCR().Spec.Components.Keycloak
+	var sources []vzapi.KeycloakRealmObjectsSource
+	if keycloak != nil {
+		sources = keycloak.RealmObjects
+	}
+	previous, err := getAppliedRealmObjects(ctx)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 && previous.isEmpty() {
+		setRealmObjectsStatus(nil)
+		return nil
+	}
+
+	realm, err := kc.GetRealm(vzconst.VerrazzanoOIDCSystemRealm)
+	if err != nil {
+		return err
+	}
+	applied := newAppliedRealmObjects()
+	var statuses []vzapi.KeycloakRealmObjectStatus
+	complete := true
+	for _, source := range sources {
+		r := &realmObjectsReconciler{ctx: ctx, kc: kc, realmID: realm.ID, source: realmObjectsSourceName(source), previous: previous, applied: applied}
+		complete = r.reconcile(source) && complete
+		statuses = append(statuses, r.statuses...)
+	}
+	pruner := &realmObjectsReconciler{ctx: ctx, kc: kc, realmID: realm.ID, previous: previous, applied: applied}
+	if complete {
+		pruner.prune()
+	} else {
+		// The objects of a source that can not be read are not known, so nothing is removed until it can be read
+		applied.merge(previous)
+	}
+	statuses = append(statuses, pruner.statuses...)
+
+	setRealmObjectsStatus(statuses)
+	return saveAppliedRealmObjects(ctx, applied)
+}
+
+// reconcile reads the objects of the source and creates or updates them. Returns false if the source can not be read.
+func (r *realmObjectsReconciler) reconcile(source vzapi.KeycloakRealmObjectsSource) bool {
+	objects, err := readRealmObjects(r.ctx, source)
+	if err != nil {
+		r.setStatus(sourceKind, r.source, err)
+		return false
+	}
+	for _, role := range objects.RealmRoles {
+		created, err := r.kc.CreateRealmRoleIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, role)
+		r.own(&r.applied.RealmRoles, r.previous.RealmRoles, role, created)
+		r.setStatus(realmRoleKind, role, err)
+	}
+	for _, rep := range objects.Clients {
+		r.reconcileClient(rep)
+	}
+	for _, clientID := range sortedKeys(objects.ClientRoles) {
+		r.reconcileClientRoles(clientID, objects.ClientRoles[clientID])
+	}
+	for _, group := range objects.Groups {
+		r.reconcileGroup(group, "", "")
+	}
+	for _, rep := range objects.IdentityProviders {
+		r.reconcileIdentityProvider(rep)
+	}
+	for _, provider := range objects.UserFederation {
+		r.reconcileUserFederation(provider)
+	}
+	for _, user := range objects.Users {
+		r.reconcileUser(user)
+	}
+	return true
+}
+
+// reconcileClient creates the client, or applies the declared fields to the existing client
+func (r *realmObjectsReconciler) reconcileClient(rep map[string]interface{}) {
+	clientID, _ := rep["clientId"].(string)
+	if clientID == "" {
+		r.setStatus(clientKind, "", fmt.Errorf("the clientId of the client is not set"))
+		return
+	}
+	for _, reserved := range reservedClientIDs {
+		if clientID == reserved {
+			r.setStatus(clientKind, clientID, fmt.Errorf("the client %s is managed by Verrazzano", clientID))
+			return
+		}
+	}
+	data, err := json.Marshal(rep)
+	created := false
+	if err == nil {
+		_, created, err = r.kc.CreateOrUpdateClient(vzconst.VerrazzanoOIDCSystemRealm, clientID, data, data)
+	}
+	r.own(&r.applied.Clients, r.previous.Clients, clientID, created)
+	r.setStatus(clientKind, clientID, err)
+}
+
+// reconcileClientRoles creates the roles of the client
+func (r *realmObjectsReconciler) reconcileClientRoles(clientID string, roles []string) {
+	kcClient, err := r.kc.GetClient(vzconst.VerrazzanoOIDCSystemRealm, clientID)
+	if err == nil && kcClient == nil {
+		err = fmt.Errorf("the client %s does not exist", clientID)
+	}
+	for _, role := range roles {
+		roleErr := err
+		created := false
+		if roleErr == nil {
+			created, roleErr = r.kc.CreateClientRoleIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, kcClient.ID, role)
+		}
+		r.own(&r.applied.ClientRoles, r.previous.ClientRoles, clientID+"/"+role, created)
+		r.setStatus(clientRoleKind, clientID+"/"+role, roleErr)
+	}
+}
+
+// reconcileGroup creates the group and its child groups, and grants the declared roles of the group. The roles
+// that were declared before but are no longer declared are revoked.
+func (r *realmObjectsReconciler) reconcileGroup(group realmGroup, parentID string, parentPath string) {
+	path := parentPath + "/" + group.Name
+	if group.Name == "" {
+		r.setStatus(groupKind, path, fmt.Errorf("the name of the group is not set"))
+		return
+	}
+	groups, err := r.kc.GetGroups(vzconst.VerrazzanoOIDCSystemRealm)
+	var groupID string
+	created := false
+	if err == nil {
+		created = keycloakutil.FindGroupByPath(groups, path) == nil
+		groupID, err = r.kc.CreateGroupIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, group.Name, parentID)
+	}
+	r.own(&r.applied.Groups, r.previous.Groups, path, created && err == nil)
+	mappings := appliedMappings{RealmRoles: group.RealmRoles, ClientRoles: group.ClientRoles}
+	r.applied.GroupMappings[path] = mappings
+	if err == nil {
+		err = r.reconcileRoleMappings(groupID, false, mappings, r.previous.GroupMappings[path])
+	}
+	r.setStatus(groupKind, path, err)
+	if groupID == "" {
+		return
+	}
+	for _, subGroup := range group.SubGroups {
+		r.reconcileGroup(subGroup, groupID, path)
+	}
+}
+
+// reconcileIdentityProvider creates or replaces the identity provider
+func (r *realmObjectsReconciler) reconcileIdentityProvider(rep map[string]interface{}) {
+	alias, _ := rep["alias"].(string)
+	if alias == "" {
+		r.setStatus(identityProviderKind, "", fmt.Errorf("the alias of the identity provider is not set"))
+		return
+	}
+	data, err := json.Marshal(rep)
+	created := false
+	if err == nil {
+		created, err = r.kc.CreateOrUpdateIdentityProvider(vzconst.VerrazzanoOIDCSystemRealm, alias, data)
+	}
+	r.own(&r.applied.IdentityProviders, r.previous.IdentityProviders, alias, created)
+	r.setStatus(identityProviderKind, alias, err)
+}
+
+// reconcileUserFederation creates or replaces the user storage provider
+func (r *realmObjectsReconciler) reconcileUserFederation(provider userFederationProvider) {
+	if provider.Name == "" {
+		r.setStatus(userFederationKind, "", fmt.Errorf("the name of the user federation provider is not set"))
+		return
+	}
+	providerID := provider.ProviderID
+	if providerID == "" {
+		providerID = ldapProviderID
+	}
+	_, created, err := r.kc.CreateOrUpdateComponent(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.Component{
+		Name:         provider.Name,
+		ProviderID:   providerID,
+		ProviderType: userStorageProviderType,
+		ParentID:     r.realmID,
+		Config:       provider.Config,
+	})
+	r.own(&r.applied.UserFederation, r.previous.UserFederation, provider.Name, created)
+	r.setStatus(userFederationKind, provider.Name, err)
+}
+
+// reconcileUser creates the user, or updates the declared fields of the existing user. The user is added to the
+// declared groups and granted the declared roles, the groups and roles that were declared before but are no longer
+// declared are removed.
+func (r *realmObjectsReconciler) reconcileUser(user realmUser) {
+	if user.Username == "" {
+		r.setStatus(userKind, "", fmt.Errorf("the username of the user is not set"))
+		return
+	}
+	for _, reserved := range reservedUsernames {
+		if user.Username == reserved {
+			r.setStatus(userKind, user.Username, fmt.Errorf("the user %s is managed by Verrazzano", user.Username))
+			return
+		}
+	}
+	rep := keycloakutil.User{
+		Username:      user.Username,
+		Enabled:       user.Enabled == nil || *user.Enabled,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}
+	existing, err := r.kc.GetUser(vzconst.VerrazzanoOIDCSystemRealm, user.Username)
+	created := false
+	if err == nil && existing == nil {
+		rep.ID, err = r.kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, rep)
+		created = err == nil
+		if created && user.InitialPassword != "" {
+			err = r.kc.ResetPassword(vzconst.VerrazzanoOIDCSystemRealm, rep.ID, user.InitialPassword, true)
+		}
+	} else if err == nil {
+		rep.ID = existing.ID
+		err = r.kc.UpdateUser(vzconst.VerrazzanoOIDCSystemRealm, rep)
+	}
+	r.own(&r.applied.Users, r.previous.Users, user.Username, created)
+	mappings := appliedMappings{Groups: user.Groups, RealmRoles: user.RealmRoles, ClientRoles: user.ClientRoles}
+	r.applied.UserMappings[user.Username] = mappings
+	previous := r.previous.UserMappings[user.Username]
+	if err == nil {
+		err = r.reconcileGroupMemberships(rep.ID, user.Groups, difference(previous.Groups, user.Groups))
+	}
+	if err == nil {
+		err = r.reconcileRoleMappings(rep.ID, true, mappings, previous)
+	}
+	r.setStatus(userKind, user.Username, err)
+}
+
+// reconcileGroupMemberships adds the user to the groups and removes the user from the removed groups, the groups
+// are identified by their paths
+func (r *realmObjectsReconciler) reconcileGroupMemberships(userID string, paths []string, removed []string) error {
+	if len(paths) == 0 && len(removed) == 0 {
+		return nil
+	}
+	groups, err := r.kc.GetGroups(vzconst.VerrazzanoOIDCSystemRealm)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		group := keycloakutil.FindGroupByPath(groups, path)
+		if group == nil {
+			return fmt.Errorf("the group %s does not exist", path)
+		}
+		if err := r.kc.AddUserToGroup(vzconst.VerrazzanoOIDCSystemRealm, userID, group.ID); err != nil {
+			return err
+		}
+	}
+	for _, path := range removed {
+		if group := keycloakutil.FindGroupByPath(groups, path); group != nil {
+			if err := r.kc.RemoveUserFromGroup(vzconst.VerrazzanoOIDCSystemRealm, userID, group.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileRoleMappings grants the declared roles to the group or user with the given ID, and revokes the roles
+// that were declared before but are no longer declared
+func (r *realmObjectsReconciler) reconcileRoleMappings(id string, user bool, declared appliedMappings, previous appliedMappings) error {
+	if len(declared.RealmRoles) > 0 {
+		grant := r.kc.AddRealmRolesToGroup
+		if user {
+			grant = r.kc.AddRealmRolesToUser
+		}
+		if err := grant(vzconst.VerrazzanoOIDCSystemRealm, id, declared.RealmRoles...); err != nil {
+			return err
+		}
+	}
+	for _, clientID := range sortedKeys(declared.ClientRoles) {
+		if roles := declared.ClientRoles[clientID]; len(roles) > 0 {
+			grant := r.kc.AddClientRolesToGroup
+			if user {
+				grant = r.kc.AddClientRolesToUser
+			}
+			if err := grant(vzconst.VerrazzanoOIDCSystemRealm, id, clientID, roles...); err != nil {
+				return err
+			}
+		}
+	}
+	return r.revokeRoleMappings(id, user, previous.revoked(declared))
+}
+
+// revokeRoleMappings revokes the roles from the group or user with the given ID
+func (r *realmObjectsReconciler) revokeRoleMappings(id string, user bool, mappings appliedMappings) error {
+	if len(mappings.RealmRoles) > 0 {
+		revoke := r.kc.RemoveRealmRolesFromGroup
+		if user {
+			revoke = r.kc.RemoveRealmRolesFromUser
+		}
+		if err := revoke(vzconst.VerrazzanoOIDCSystemRealm, id, mappings.RealmRoles...); err != nil {
+			return err
+		}
+	}
+	for _, clientID := range sortedKeys(mappings.ClientRoles) {
+		if roles := mappings.ClientRoles[clientID]; len(roles) > 0 {
+			revoke := r.kc.RemoveClientRolesFromGroup
+			if user {
+				revoke = r.kc.RemoveClientRolesFromUser
+			}
+			if err := revoke(vzconst.VerrazzanoOIDCSystemRealm, id, clientID, roles...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// own records the object as created by the realm objects, if it was created now or by a previous reconcile
+func (r *realmObjectsReconciler) own(applied *[]string, previous []string, name string, created bool) {
+	if (created || vzstring.SliceContainsString(previous, name)) && !vzstring.SliceContainsString(*applied, name) {
+		*applied = append(*applied, name)
+	}
+}
+
+// setStatus records the status of an object, the object failed if the error is not nil
+func (r *realmObjectsReconciler) setStatus(kind string, name string, err error) {
+	status := vzapi.KeycloakRealmObjectStatus{
+		Kind:   kind,
+		Name:   name,
+		Source: r.source,
+		State:  vzapi.KeycloakRealmObjectReady,
+	}
+	if err != nil {
+		status.State = vzapi.KeycloakRealmObjectFailed
+		status.Message = err.Error()
+		r.ctx.Log().Errorf("Failed to reconcile Keycloak %s %s declared in %s: %v", kind, name, r.source, err)
+	}
+	r.statuses = append(r.statuses, status)
+}
+
+// sortedKeys returns the sorted client IDs of client role declarations, so that the status does not change between passes
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// readRealmObjects reads the realm objects from the ConfigMap or Secret key of the source
+func readRealmObjects(ctx spi.ComponentContext, source vzapi.KeycloakRealmObjectsSource) (*realmObjects, error) {
+	data, err := override.GetInstallOverridesYAML(ctx, []vzapi.Overrides{{ConfigMapRef: source.ConfigMapRef, SecretRef: source.SecretRef}})
+	if err != nil {
+		return nil, err
+	}
+	objects := &realmObjects{}
+	for _, d := range data {
+		if err := yaml.UnmarshalStrict([]byte(d), objects); err != nil {
+			return nil, fmt.Errorf("the realm objects are not valid: %v", err)
+		}
+	}
+	return objects, nil
+}
+
+// realmObjectsSourceName returns the name of the ConfigMap or Secret key of the source used in the status
+func realmObjectsSourceName(source vzapi.KeycloakRealmObjectsSource) string {
+	if source.SecretRef != nil {
+		return fmt.Sprintf("Secret %s/%s", source.SecretRef.Name, source.SecretRef.Key)
+	}
+	if source.ConfigMapRef != nil {
+		return fmt.Sprintf("ConfigMap %s/%s", source.ConfigMapRef.Name, source.ConfigMapRef.Key)
+	}
+	return ""
+}
+
+// setRealmObjectsStatus records the status of the realm objects
+func setRealmObjectsStatus(statuses []vzapi.KeycloakRealmObjectStatus) {
+	realmObjectsStatus.lock.Lock()
+	defer realmObjectsStatus.lock.Unlock()
+	realmObjectsStatus.statuses = statuses
+	realmObjectsStatus.reconciled = true
+}
+
+// GetRealmObjectsStatus returns the status of the realm objects from the last reconcile of the Keycloak component,
+// and false if the realm objects have not been reconciled since the operator started
+func GetRealmObjectsStatus() ([]vzapi.KeycloakRealmObjectStatus, bool) {
+	realmObjectsStatus.lock.Lock()
+	defer realmObjectsStatus.lock.Unlock()
+	return append([]vzapi.KeycloakRealmObjectStatus{}, realmObjectsStatus.statuses...), realmObjectsStatus.reconciled
+}
+
+// validateRealmObjectsSourcesV1Alpha1 checks the realm objects sources of a v1alpha1 Verrazzano resource
+func validateRealmObjectsSourcesV1Alpha1(vz *vzapi.Verrazzano) error {
+	converted := v1beta1.Verrazzano{}
+	if err := common.ConvertVerrazzanoCR(vz, &converted); err != nil {
+		return err
+	}
+	return validateRealmObjectsSources(&converted)
+}
+
+// validateRealmObjectsSources checks that each realm objects source refers to either a ConfigMap or a Secret
+func validateRealmObjectsSources(vz *v1beta1.Verrazzano) error {
+	if vz.Spec.Components.Keycloak == nil {
+		return nil
+	}
+	for i, source := range vz.Spec.Components.Keycloak.RealmObjects {
+		if (source.ConfigMapRef == nil) == (source.SecretRef == nil) {
+			return fmt.Errorf("Keycloak realmObjects[%d] must refer to either a ConfigMap or a Secret", i)
+		}
+		if source.ConfigMapRef != nil && (source.ConfigMapRef.Name == "" || source.ConfigMapRef.Key == "") {
+			return fmt.Errorf("Keycloak realmObjects[%d] must set the name and key of the ConfigMap", i)
+		}
+		if source.SecretRef != nil && (source.SecretRef.Name == "" || source.SecretRef.Key == "") {
+			return fmt.Errorf("Keycloak realmObjects[%d] must set the name and key of the Secret", i)
+		}
+	}
+	return nil
+}
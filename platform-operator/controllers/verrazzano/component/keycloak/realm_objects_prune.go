@@ -0,0 +1,297 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/keycloakutil"
+	vzstring "github.com/verrazzano/verrazzano/pkg/string"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// appliedRealmObjectsConfigMap records the realm objects applied by the last reconcile of the Keycloak component
+	appliedRealmObjectsConfigMap = "verrazzano-keycloak-realm-objects"
+	appliedRealmObjectsKey       = "applied.json"
+)
+
+// appliedRealmObjects are the objects created by the realm objects, which are deleted when they are no longer
+// declared, and the declared role mappings and group memberships, which are revoked when they are no longer declared.
+// Objects that already existed when they were declared, like the objects created by Verrazzano, are never deleted.
+type appliedRealmObjects struct {
+	RealmRoles []string `json:"realmRoles,omitempty"`
+	Clients    []string `json:"clients,omitempty"`
+	// ClientRoles are the roles of clients, in the format <client ID>/<role>
+	ClientRoles       []string `json:"clientRoles,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+	IdentityProviders []string `json:"identityProviders,omitempty"`
+	UserFederation    []string `json:"userFederation,omitempty"`
+	Users             []string `json:"users,omitempty"`
+	// GroupMappings and UserMappings are the declared mappings by group path and user name
+	GroupMappings map[string]appliedMappings `json:"groupMappings,omitempty"`
+	UserMappings  map[string]appliedMappings `json:"userMappings,omitempty"`
+}
+
+// appliedMappings are the roles granted to a group or user, and the groups of a user
+type appliedMappings struct {
+	Groups      []string            `json:"groups,omitempty"`
+	RealmRoles  []string            `json:"realmRoles,omitempty"`
+	ClientRoles map[string][]string `json:"clientRoles,omitempty"`
+}
+
+func newAppliedRealmObjects() *appliedRealmObjects {
+	return &appliedRealmObjects{GroupMappings: map[string]appliedMappings{}, UserMappings: map[string]appliedMappings{}}
+}
+
+// isEmpty returns true if no realm objects were applied
+func (a *appliedRealmObjects) isEmpty() bool {
+	return len(a.RealmRoles) == 0 && len(a.Clients) == 0 && len(a.ClientRoles) == 0 && len(a.Groups) == 0 &&
+		len(a.IdentityProviders) == 0 && len(a.UserFederation) == 0 && len(a.Users) == 0 &&
+		len(a.GroupMappings) == 0 && len(a.UserMappings) == 0
+}
+
+// merge adds the objects and mappings of the other applied realm objects
+func (a *appliedRealmObjects) merge(other *appliedRealmObjects) {
+	a.RealmRoles = union(a.RealmRoles, other.RealmRoles)
+	a.Clients = union(a.Clients, other.Clients)
+	a.ClientRoles = union(a.ClientRoles, other.ClientRoles)
+	a.Groups = union(a.Groups, other.Groups)
+	a.IdentityProviders = union(a.IdentityProviders, other.IdentityProviders)
+	a.UserFederation = union(a.UserFederation, other.UserFederation)
+	a.Users = union(a.Users, other.Users)
+	for path, mappings := range other.GroupMappings {
+		a.GroupMappings[path] = a.GroupMappings[path].merge(mappings)
+	}
+	for username, mappings := range other.UserMappings {
+		a.UserMappings[username] = a.UserMappings[username].merge(mappings)
+	}
+}
+
+// merge returns the union of the mappings
+func (m appliedMappings) merge(other appliedMappings) appliedMappings {
+	merged := appliedMappings{
+		Groups:      union(m.Groups, other.Groups),
+		RealmRoles:  union(m.RealmRoles, other.RealmRoles),
+		ClientRoles: map[string][]string{},
+	}
+	for clientID, roles := range m.ClientRoles {
+		merged.ClientRoles[clientID] = union(merged.ClientRoles[clientID], roles)
+	}
+	for clientID, roles := range other.ClientRoles {
+		merged.ClientRoles[clientID] = union(merged.ClientRoles[clientID], roles)
+	}
+	return merged
+}
+
+// revoked returns the roles of the mappings that are not in the declared mappings
+func (m appliedMappings) revoked(declared appliedMappings) appliedMappings {
+	revoked := appliedMappings{
+		RealmRoles:  difference(m.RealmRoles, declared.RealmRoles),
+		ClientRoles: map[string][]string{},
+	}
+	for clientID, roles := range m.ClientRoles {
+		if removed := difference(roles, declared.ClientRoles[clientID]); len(removed) > 0 {
+			revoked.ClientRoles[clientID] = removed
+		}
+	}
+	return revoked
+}
+
+// prune deletes the objects created by a previous reconcile that are no longer declared, and revokes the mappings of
+// the groups and users that are no longer declared. An object that can not be deleted is kept in the applied realm
+// objects, so that the deletion is retried.
+func (r *realmObjectsReconciler) prune() {
+	r.pruneUsers()
+	r.pruneGroups()
+	for _, alias := range difference(r.previous.IdentityProviders, r.applied.IdentityProviders) {
+		r.pruned(identityProviderKind, alias, &r.applied.IdentityProviders, r.kc.DeleteIdentityProvider(vzconst.VerrazzanoOIDCSystemRealm, alias))
+	}
+	for _, name := range difference(r.previous.UserFederation, r.applied.UserFederation) {
+		r.pruned(userFederationKind, name, &r.applied.UserFederation, r.kc.DeleteComponent(vzconst.VerrazzanoOIDCSystemRealm, name, userStorageProviderType))
+	}
+	for _, clientRole := range difference(r.previous.ClientRoles, r.applied.ClientRoles) {
+		r.pruned(clientRoleKind, clientRole, &r.applied.ClientRoles, r.deleteClientRole(clientRole))
+	}
+	for _, clientID := range difference(r.previous.Clients, r.applied.Clients) {
+		r.pruned(clientKind, clientID, &r.applied.Clients, r.kc.DeleteClient(vzconst.VerrazzanoOIDCSystemRealm, clientID))
+	}
+	for _, role := range difference(r.previous.RealmRoles, r.applied.RealmRoles) {
+		r.pruned(realmRoleKind, role, &r.applied.RealmRoles, r.kc.DeleteRealmRole(vzconst.VerrazzanoOIDCSystemRealm, role))
+	}
+}
+
+// pruneUsers deletes the users that are no longer declared, or revokes the groups and roles declared for them if
+// they were not created by the realm objects
+func (r *realmObjectsReconciler) pruneUsers() {
+	for _, username := range sortedMappingKeys(r.previous.UserMappings) {
+		if _, ok := r.applied.UserMappings[username]; ok {
+			continue
+		}
+		user, err := r.kc.GetUser(vzconst.VerrazzanoOIDCSystemRealm, username)
+		if err == nil && user != nil {
+			if vzstring.SliceContainsString(r.previous.Users, username) {
+				err = r.kc.DeleteUser(vzconst.VerrazzanoOIDCSystemRealm, user.ID)
+			} else {
+				mappings := r.previous.UserMappings[username]
+				err = r.reconcileGroupMemberships(user.ID, nil, mappings.Groups)
+				if err == nil {
+					err = r.revokeRoleMappings(user.ID, true, mappings)
+				}
+			}
+		}
+		if err != nil {
+			r.applied.UserMappings[username] = r.previous.UserMappings[username]
+		}
+		r.pruned(userKind, username, &r.applied.Users, err)
+	}
+}
+
+// pruneGroups deletes the groups that are no longer declared, or revokes the roles declared for them if they were
+// not created by the realm objects. Child groups are handled before their parents.
+func (r *realmObjectsReconciler) pruneGroups() {
+	paths := sortedMappingKeys(r.previous.GroupMappings)
+	sort.SliceStable(paths, func(i, j int) bool {
+		return strings.Count(paths[i], "/") > strings.Count(paths[j], "/")
+	})
+	for _, path := range paths {
+		if _, ok := r.applied.GroupMappings[path]; ok {
+			continue
+		}
+		groups, err := r.kc.GetGroups(vzconst.VerrazzanoOIDCSystemRealm)
+		if err == nil {
+			if group := keycloakutil.FindGroupByPath(groups, path); group != nil {
+				if vzstring.SliceContainsString(r.previous.Groups, path) {
+					err = r.kc.DeleteGroup(vzconst.VerrazzanoOIDCSystemRealm, group.ID)
+				} else {
+					err = r.revokeRoleMappings(group.ID, false, r.previous.GroupMappings[path])
+				}
+			}
+		}
+		if err != nil {
+			r.applied.GroupMappings[path] = r.previous.GroupMappings[path]
+		}
+		r.pruned(groupKind, path, &r.applied.Groups, err)
+	}
+}
+
+// deleteClientRole deletes a client role in the format <client ID>/<role>
+func (r *realmObjectsReconciler) deleteClientRole(clientRole string) error {
+	clientID, role, _ := strings.Cut(clientRole, "/")
+	kcClient, err := r.kc.GetClient(vzconst.VerrazzanoOIDCSystemRealm, clientID)
+	if err != nil || kcClient == nil {
+		return err
+	}
+	return r.kc.DeleteClientRole(vzconst.VerrazzanoOIDCSystemRealm, kcClient.ID, role)
+}
+
+// pruned reports an object that could not be removed, and keeps it in the applied objects if it was created by the
+// realm objects so that the removal is retried
+func (r *realmObjectsReconciler) pruned(kind string, name string, applied *[]string, err error) {
+	if err == nil {
+		return
+	}
+	r.own(applied, r.previous.listOf(kind), name, false)
+	r.setStatus(kind, name, fmt.Errorf("failed to remove the %s that is no longer declared: %v", kind, err))
+}
+
+// listOf returns the applied objects of the kind
+func (a *appliedRealmObjects) listOf(kind string) []string {
+	switch kind {
+	case realmRoleKind:
+		return a.RealmRoles
+	case clientKind:
+		return a.Clients
+	case clientRoleKind:
+		return a.ClientRoles
+	case groupKind:
+		return a.Groups
+	case identityProviderKind:
+		return a.IdentityProviders
+	case userFederationKind:
+		return a.UserFederation
+	case userKind:
+		return a.Users
+	}
+	return nil
+}
+
+// getAppliedRealmObjects returns the realm objects applied by the last reconcile
+func getAppliedRealmObjects(ctx spi.ComponentContext) (*appliedRealmObjects, error) {
+	applied := newAppliedRealmObjects()
+	cm := &corev1.ConfigMap{}
+	err := ctx.Client().Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: appliedRealmObjectsConfigMap}, cm)
+	if errors.IsNotFound(err) {
+		return applied, nil
+	}
+	if err != nil {
+		return nil, ctx.Log().ErrorfNewErr("Failed to get the ConfigMap %s/%s: %v", ComponentNamespace, appliedRealmObjectsConfigMap, err)
+	}
+	if data := cm.Data[appliedRealmObjectsKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), applied); err != nil {
+			return nil, ctx.Log().ErrorfNewErr("Failed to parse the applied Keycloak realm objects in ConfigMap %s/%s: %v", ComponentNamespace, appliedRealmObjectsConfigMap, err)
+		}
+	}
+	if applied.GroupMappings == nil {
+		applied.GroupMappings = map[string]appliedMappings{}
+	}
+	if applied.UserMappings == nil {
+		applied.UserMappings = map[string]appliedMappings{}
+	}
+	return applied, nil
+}
+
+// saveAppliedRealmObjects records the realm objects applied by this reconcile
+func saveAppliedRealmObjects(ctx spi.ComponentContext, applied *appliedRealmObjects) error {
+	data, err := json.Marshal(applied)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = ComponentNamespace
+	cm.Name = appliedRealmObjectsConfigMap
+	_, err = controllerutil.CreateOrUpdate(context.TODO(), ctx.Client(), cm, func() error {
+		cm.Data = map[string]string{appliedRealmObjectsKey: string(data)}
+		return nil
+	})
+	if err != nil {
+		return ctx.Log().ErrorfNewErr("Failed to update the ConfigMap %s/%s: %v", ComponentNamespace, appliedRealmObjectsConfigMap, err)
+	}
+	return nil
+}
+
+// sortedMappingKeys returns the sorted group paths or user names of the mappings
+func sortedMappingKeys(m map[string]appliedMappings) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// difference returns the values that are not in the other values
+func difference(values []string, other []string) []string {
+	var result []string
+	for _, v := range values {
+		if !vzstring.SliceContainsString(other, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// union returns the values, followed by the other values that are not in the values
+func union(values []string, other []string) []string {
+	return append(append([]string{}, values...), difference(other, values)...)
+}
@@ -0,0 +1,399 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package keycloak
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/keycloakutil"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testRealmObjectsNamespace = "default"
+
+	testRealmObjects = `
+realmRoles:
+- operators
+clients:
+- clientId: grafana-external
+  publicClient: true
+  redirectUris:
+  - https://grafana.example.com/*
+  defaultClientScopes:
+  - profile
+clientRoles:
+  grafana-external:
+  - viewer
+groups:
+- name: operations
+  realmRoles:
+  - operators
+  clientRoles:
+    grafana-external:
+    - viewer
+  subGroups:
+  - name: oncall
+identityProviders:
+- alias: corp-oidc
+  providerId: oidc
+  enabled: true
+  config:
+    clientId: verrazzano
+    authorizationUrl: https://idp.example.com/auth
+`
+
+	testUserFederation = `
+userFederation:
+- name: corp-ldap
+  config:
+    connectionUrl:
+    - ldaps://ldap.example.com
+    bindCredential:
+    - secret
+`
+)
+
+// newRealmObjectsContext returns a component context for a Verrazzano resource that declares the given realm objects
+func newRealmObjectsContext(sources []vzapi.KeycloakRealmObjectsSource, objs ...client.Object) (spi.ComponentContext, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Name: "verrazzano", Namespace: testRealmObjectsNamespace},
+		Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Keycloak: &vzapi.KeycloakComponent{RealmObjects: sources},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vz).WithObjects(objs...).Build()
+	return spi.NewFakeContext(c, vz, nil, false), c
+}
+
+// getRealmObjectStatuses returns the realm object statuses recorded by the last reconcile
+func getRealmObjectStatuses(t *testing.T) []vzapi.KeycloakRealmObjectStatus {
+	statuses, ok := GetRealmObjectsStatus()
+	assert.True(t, ok)
+	return statuses
+}
+
+// TestReconcileRealmObjects tests the reconciliation of the declared realm objects
+// GIVEN a ConfigMap declaring roles, clients, groups and an identity provider, and a Secret declaring LDAP federation
+// WHEN reconcileRealmObjects is called twice
+// THEN the objects are created once in the Verrazzano system realm and the status of each object is Ready
+func TestReconcileRealmObjects(t *testing.T) {
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-objects", Namespace: testRealmObjectsNamespace},
+		Data:       map[string]string{"objects.yaml": testRealmObjects},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-ldap", Namespace: testRealmObjectsNamespace},
+		Data:       map[string][]byte{"ldap.yaml": []byte(testUserFederation)},
+	}
+	ctx, c := newRealmObjectsContext([]vzapi.KeycloakRealmObjectsSource{
+		{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: cm.Name}, Key: "objects.yaml"}},
+		{SecretRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: secret.Name}, Key: "ldap.yaml"}},
+	}, cm, secret)
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	}
+
+	realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+	assert.NotNil(t, realm.Roles["operators"])
+	grafana := realm.FindClient("grafana-external")
+	assert.Equal(t, []interface{}{"https://grafana.example.com/*"}, grafana["redirectUris"])
+	assert.Equal(t, []interface{}{"profile"}, grafana["defaultClientScopes"])
+	assert.NotNil(t, realm.ClientRoles[grafana["id"].(string)]["viewer"])
+	operations := realm.FindGroup("/operations")
+	assert.Len(t, realm.Groups, 1)
+	assert.Len(t, operations.SubGroups, 1)
+	assert.Equal(t, []string{"operators", "grafana-external/viewer"}, realm.GroupRoles[operations.ID])
+	assert.Equal(t, "https://idp.example.com/auth", realm.IdentityProviders["corp-oidc"]["config"].(map[string]interface{})["authorizationUrl"])
+	assert.Len(t, realm.Components, 1)
+	assert.Equal(t, "ldap", realm.Components[0]["providerId"])
+	assert.Equal(t, "org.keycloak.storage.UserStorageProvider", realm.Components[0]["providerType"])
+	assert.Equal(t, vzconst.VerrazzanoOIDCSystemRealm, realm.Components[0]["parentId"])
+
+	statuses := getRealmObjectStatuses(t)
+	assert.Len(t, statuses, 7)
+	for _, status := range statuses {
+		assert.Equal(t, vzapi.KeycloakRealmObjectReady, status.State, "%s %s", status.Kind, status.Name)
+	}
+	assert.Equal(t, vzapi.KeycloakRealmObjectStatus{Kind: groupKind, Name: "/operations/oncall",
+		Source: "ConfigMap keycloak-objects/objects.yaml", State: vzapi.KeycloakRealmObjectReady}, statuses[4])
+	assert.Equal(t, "Secret keycloak-ldap/ldap.yaml", statuses[6].Source)
+
+	// Changes of the declared objects are applied to the existing objects
+	cm.Data["objects.yaml"] = `
+clients:
+- clientId: grafana-external
+  redirectUris:
+  - https://grafana.example.org/*
+`
+	assert.NoError(t, c.Update(context.TODO(), cm))
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	assert.Equal(t, []interface{}{"https://grafana.example.org/*"}, realm.FindClient("grafana-external")["redirectUris"])
+	assert.Equal(t, true, realm.FindClient("grafana-external")["publicClient"])
+	assert.Len(t, getRealmObjectStatuses(t), 2)
+}
+
+// TestReconcileRealmObjectsFailures tests the status of realm objects that can not be reconciled
+// GIVEN realm objects declared in a missing ConfigMap, a reserved client and a group with an unknown role
+// WHEN reconcileRealmObjects is called
+// THEN no error is returned, the failed objects have the Failed status and the other objects are created
+// WHEN the realm objects are removed from the Verrazzano resource
+// THEN the created objects are deleted and the recorded status of the realm objects is empty
+func TestReconcileRealmObjectsFailures(t *testing.T) {
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-objects", Namespace: testRealmObjectsNamespace},
+		Data: map[string]string{"objects.yaml": `
+clients:
+- clientId: rancher
+- clientId: app
+groups:
+- name: app-users
+  realmRoles:
+  - unknown
+`},
+	}
+	ctx, _ := newRealmObjectsContext([]vzapi.KeycloakRealmObjectsSource{
+		{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "objects.yaml"}},
+		{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: cm.Name}, Key: "objects.yaml"}},
+	}, cm)
+
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+
+	statuses := getRealmObjectStatuses(t)
+	assert.Len(t, statuses, 4)
+	assert.Equal(t, sourceKind, statuses[0].Kind)
+	assert.Equal(t, vzapi.KeycloakRealmObjectFailed, statuses[0].State)
+	assert.Equal(t, vzapi.KeycloakRealmObjectFailed, statuses[1].State)
+	assert.Contains(t, statuses[1].Message, "managed by Verrazzano")
+	assert.Equal(t, vzapi.KeycloakRealmObjectReady, statuses[2].State)
+	assert.Equal(t, vzapi.KeycloakRealmObjectFailed, statuses[3].State)
+
+	realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+	assert.NotNil(t, realm.FindClient("app"))
+	assert.Nil(t, realm.FindClient("rancher"))
+	assert.NotNil(t, realm.FindGroup("/app-users"))
+
+	ctx.EffectiveCR().Spec.Components.Keycloak.RealmObjects = nil
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	assert.Empty(t, getRealmObjectStatuses(t))
+	assert.Nil(t, realm.FindClient("app"))
+	assert.Nil(t, realm.FindGroup("/app-users"))
+}
+
+// TestPruneRealmObjects tests the removal of realm objects that are no longer declared
+// GIVEN a ConfigMap declaring a role, a client role, groups, a user, and role mappings of an existing group and user
+// WHEN the objects are removed from the declaration and reconcileRealmObjects is called
+// THEN the objects created from the declaration are deleted, and the declared mappings of the existing group and
+// user are revoked while the existing group and user are kept
+func TestPruneRealmObjects(t *testing.T) {
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	_, err := kc.CreateGroupIfNotExists(vzconst.VerrazzanoOIDCSystemRealm, "existing", "")
+	assert.NoError(t, err)
+	_, err = kc.CreateUser(vzconst.VerrazzanoOIDCSystemRealm, keycloakutil.User{Username: "bob", Enabled: true})
+	assert.NoError(t, err)
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-objects", Namespace: testRealmObjectsNamespace},
+		Data: map[string]string{"objects.yaml": `
+realmRoles:
+- operators
+- auditors
+clients:
+- clientId: app
+clientRoles:
+  app:
+  - viewer
+  - editor
+groups:
+- name: existing
+  realmRoles:
+  - operators
+- name: operations
+  realmRoles:
+  - operators
+  - auditors
+  clientRoles:
+    app:
+    - viewer
+    - editor
+  subGroups:
+  - name: oncall
+users:
+- username: alice
+  email: alice@example.com
+  groups:
+  - /operations/oncall
+  realmRoles:
+  - auditors
+  initialPassword: changeme
+- username: bob
+  groups:
+  - /existing
+  realmRoles:
+  - operators
+`},
+	}
+	ctx, c := newRealmObjectsContext([]vzapi.KeycloakRealmObjectsSource{
+		{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: cm.Name}, Key: "objects.yaml"}},
+	}, cm)
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	for _, status := range getRealmObjectStatuses(t) {
+		assert.Equal(t, vzapi.KeycloakRealmObjectReady, status.State, "%s %s: %s", status.Kind, status.Name, status.Message)
+	}
+
+	realm := server.Realms[vzconst.VerrazzanoOIDCSystemRealm]
+	alice := realm.FindUser("alice")
+	assert.Equal(t, "alice@example.com", alice["email"])
+	assert.Equal(t, []interface{}{"/operations/oncall"}, alice["groups"])
+	assert.True(t, realm.TemporaryPasswords[alice["id"].(string)])
+	bob := realm.FindUser("bob")
+	assert.Equal(t, []interface{}{"/existing"}, bob["groups"])
+	assert.Equal(t, []string{"operators"}, realm.UserRoles[bob["id"].(string)])
+	operations := realm.FindGroup("/operations")
+	assert.Equal(t, []string{"operators", "auditors", "app/viewer", "app/editor"}, realm.GroupRoles[operations.ID])
+
+	// Only the objects and mappings that are still declared are kept
+	cm.Data["objects.yaml"] = `
+realmRoles:
+- operators
+clients:
+- clientId: app
+clientRoles:
+  app:
+  - viewer
+groups:
+- name: operations
+  realmRoles:
+  - operators
+  clientRoles:
+    app:
+    - viewer
+`
+	assert.NoError(t, c.Update(context.TODO(), cm))
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	assert.Len(t, getRealmObjectStatuses(t), 4)
+
+	assert.NotNil(t, realm.Roles["operators"])
+	assert.Nil(t, realm.Roles["auditors"])
+	app := realm.FindClient("app")
+	assert.NotNil(t, realm.ClientRoles[app["id"].(string)]["viewer"])
+	assert.Nil(t, realm.ClientRoles[app["id"].(string)]["editor"])
+	assert.Equal(t, []string{"operators", "app/viewer"}, realm.GroupRoles[operations.ID])
+	assert.Nil(t, realm.FindGroup("/operations/oncall"))
+	existing := realm.FindGroup("/existing")
+	assert.NotNil(t, existing)
+	assert.Empty(t, realm.GroupRoles[existing.ID])
+	assert.Nil(t, realm.FindUser("alice"))
+	assert.NotNil(t, realm.FindUser("bob"))
+	assert.Empty(t, realm.FindUser("bob")["groups"])
+	assert.Empty(t, realm.UserRoles[bob["id"].(string)])
+
+	// Objects that existed before they were declared are never deleted
+	ctx.EffectiveCR().Spec.Components.Keycloak.RealmObjects = nil
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	assert.Nil(t, realm.FindClient("app"))
+	assert.Nil(t, realm.FindGroup("/operations"))
+	assert.Nil(t, realm.Roles["operators"])
+	assert.NotNil(t, realm.FindGroup("/existing"))
+	assert.NotNil(t, realm.FindUser("bob"))
+	applied, err := getAppliedRealmObjects(ctx)
+	assert.NoError(t, err)
+	assert.True(t, applied.isEmpty())
+}
+
+// TestReconcileRealmObjectsReservedUser tests that users created by Verrazzano can not be declared
+// GIVEN a ConfigMap declaring the verrazzano user
+// WHEN reconcileRealmObjects is called
+// THEN the user has the Failed status
+func TestReconcileRealmObjectsReservedUser(t *testing.T) {
+	server := newTestRealmServer(t, "", false)
+	kc := keycloakutil.NewAdminClient(server.URL, "", server.Client(), keycloakutil.AdminUsername, "password")
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "keycloak-objects", Namespace: testRealmObjectsNamespace},
+		Data:       map[string]string{"objects.yaml": "users:\n- username: verrazzano\n"},
+	}
+	ctx, _ := newRealmObjectsContext([]vzapi.KeycloakRealmObjectsSource{
+		{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: cm.Name}, Key: "objects.yaml"}},
+	}, cm)
+	assert.NoError(t, reconcileRealmObjects(ctx, kc))
+	statuses := getRealmObjectStatuses(t)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, vzapi.KeycloakRealmObjectFailed, statuses[0].State)
+	assert.Contains(t, statuses[0].Message, "managed by Verrazzano")
+	assert.Nil(t, server.Realms[vzconst.VerrazzanoOIDCSystemRealm].FindUser("verrazzano"))
+}
+
+// TestValidateRealmObjectsSources tests the validation of the realm objects sources
+// GIVEN Verrazzano resources with realm objects sources
+// WHEN validateRealmObjectsSources is called
+// THEN an error is returned unless each source refers to the key of either a ConfigMap or a Secret
+func TestValidateRealmObjectsSources(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []v1beta1.KeycloakRealmObjectsSource
+		wantErr bool
+	}{
+		{
+			name: "valid ConfigMap and Secret",
+			sources: []v1beta1.KeycloakRealmObjectsSource{
+				{ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm"}, Key: "objects.yaml"}},
+				{SecretRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s"}, Key: "objects.yaml"}},
+			},
+		},
+		{
+			name:    "neither ConfigMap nor Secret",
+			sources: []v1beta1.KeycloakRealmObjectsSource{{}},
+			wantErr: true,
+		},
+		{
+			name: "both ConfigMap and Secret",
+			sources: []v1beta1.KeycloakRealmObjectsSource{{
+				ConfigMapRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm"}, Key: "objects.yaml"},
+				SecretRef:    &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s"}, Key: "objects.yaml"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "missing key",
+			sources: []v1beta1.KeycloakRealmObjectsSource{
+				{SecretRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s"}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vz := &v1beta1.Verrazzano{Spec: v1beta1.VerrazzanoSpec{Components: v1beta1.ComponentSpec{
+				Keycloak: &v1beta1.KeycloakComponent{RealmObjects: tt.sources},
+			}}}
+			err := NewComponent().ValidateInstallV1Beta1(vz)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/pkg/objectstorage"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// BackupScheduleName is the name of the backup schedule and backup profile of the InnoDB cluster
+	BackupScheduleName = "verrazzano-scheduled-backup"
+
+	// backupCredentialsSecret holds the AWS credentials and config files used by the MySQL backup jobs
+	backupCredentialsSecret = "mysql-backup-credentials" //nolint:gosec //#gosec G101
+	backupCredentialsKey    = "credentials"
+	backupConfigKey         = "config"
+	backupProfile           = "default"
+	accessKeyKey            = "access_key"
+	secretKeyKey            = "secret_key" //nolint:gosec //#gosec G101
+	defaultBackupRetention  = 7
+	// defaultBackupRegion is used to sign the object storage requests when the region of the bucket is not set
+	defaultBackupRegion = "us-east-1"
+
+	mySQLBackupListKind  = "MySQLBackupList"
+	mySQLBackupCompleted = "Completed"
+	mySQLBackupError     = "Error"
+)
+
+// appendBackupOverrides renders the scheduled backups of the MySQL component into a backup profile and a backup
+// schedule of the InnoDB cluster. The object storage credentials are copied from the verrazzano-install namespace
+// into the secret read by the backup jobs.
+func appendBackupOverrides(compContext spi.ComponentContext, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+	backup := GetBackup(compContext.EffectiveCR())
+	if backup == nil {
+		if isInstallOrUpdate(compContext) {
+			return kvs, deleteBackupCredentials(compContext)
+		}
+		return kvs, nil
+	}
+	if err := createOrUpdateBackupCredentials(compContext, backup.Storage); err != nil {
+		return kvs, err
+	}
+
+	profile := "backupProfiles[0]"
+	storage := profile + ".dumpInstance.storage.s3"
+	kvs = append(kvs, bom.KeyValue{Key: profile + ".name", Value: BackupScheduleName})
+	kvs = append(kvs, bom.KeyValue{Key: storage + ".bucketName", Value: backup.Storage.Bucket, SetString: true})
+	if len(backup.Storage.Prefix) > 0 {
+		kvs = append(kvs, bom.KeyValue{Key: storage + ".prefix", Value: backup.Storage.Prefix, SetString: true})
+	}
+	kvs = append(kvs, bom.KeyValue{Key: storage + ".endpoint", Value: backup.Storage.Endpoint, SetString: true})
+	kvs = append(kvs, bom.KeyValue{Key: storage + ".config", Value: backupCredentialsSecret})
+	kvs = append(kvs, bom.KeyValue{Key: storage + ".profile", Value: backupProfile})
+
+	schedule := "backupSchedules[0]"
+	kvs = append(kvs, bom.KeyValue{Key: schedule + ".name", Value: BackupScheduleName})
+	// commas are allowed in cron expressions, they must be escaped for Helm
+	kvs = append(kvs, bom.KeyValue{Key: schedule + ".schedule", Value: strings.ReplaceAll(backup.Schedule, ",", "\\,"), SetString: true})
+	kvs = append(kvs, bom.KeyValue{Key: schedule + ".enabled", Value: "true"})
+	kvs = append(kvs, bom.KeyValue{Key: schedule + ".backupProfileName", Value: BackupScheduleName})
+	return kvs, nil
+}
+
+// GetBackup returns the scheduled backups of the MySQL component, or nil if they are not configured
+func GetBackup(cr *vzapi.Verrazzano) *vzapi.MySQLBackup {
+	if cr == nil || cr.Spec.Components.Keycloak == nil {
+		return nil
+	}
+	return cr.Spec.Components.Keycloak.MySQL.Backup
+}
+
+// getBackupRetention returns the number of completed backups that are kept
+func getBackupRetention(backup *vzapi.MySQLBackup) int {
+	if backup.Retention == nil {
+		return defaultBackupRetention
+	}
+	return int(*backup.Retention)
+}
+
+// createOrUpdateBackupCredentials writes the object storage credentials in the AWS credentials and config file formats
+func createOrUpdateBackupCredentials(compContext spi.ComponentContext, storage vzapi.MySQLBackupStorage) error {
+	accessKey, secretKey, err := getBackupCredentials(compContext.Client(), storage)
+	if err != nil {
+		return compContext.Log().ErrorfNewErr("%v", err)
+	}
+
+	config := fmt.Sprintf("[%s]\n", backupProfile)
+	if len(storage.Region) > 0 {
+		config += fmt.Sprintf("region=%s\n", storage.Region)
+	}
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: backupCredentialsSecret}}
+	_, err = controllerruntime.CreateOrUpdate(context.TODO(), compContext.Client(), secret, func() error {
+		secret.Data = map[string][]byte{
+			backupCredentialsKey: []byte(fmt.Sprintf("[%s]\naws_access_key_id=%s\naws_secret_access_key=%s\n", backupProfile, accessKey, secretKey)),
+			backupConfigKey:      []byte(config),
+		}
+		return nil
+	})
+	if err != nil {
+		return compContext.Log().ErrorfNewErr("Failed creating or updating the MySQL backup credentials secret %s/%s: %v", ComponentNamespace, backupCredentialsSecret, err)
+	}
+	return nil
+}
+
+// getBackupCredentials returns the access key and secret key of the object storage from the credentials secret
+func getBackupCredentials(client clipkg.Client, storage vzapi.MySQLBackupStorage) (string, string, error) {
+	source := &v1.Secret{}
+	if err := client.Get(context.TODO(), types.NamespacedName{Namespace: vzconst.VerrazzanoInstallNamespace, Name: storage.CredentialsSecret}, source); err != nil {
+		return "", "", fmt.Errorf("Failed getting the MySQL backup credentials secret %s/%s: %v", vzconst.VerrazzanoInstallNamespace, storage.CredentialsSecret, err)
+	}
+	accessKey, ok := source.Data[accessKeyKey]
+	if !ok {
+		return "", "", fmt.Errorf("The MySQL backup credentials secret %s/%s has no %s key", vzconst.VerrazzanoInstallNamespace, storage.CredentialsSecret, accessKeyKey)
+	}
+	secretKey, ok := source.Data[secretKeyKey]
+	if !ok {
+		return "", "", fmt.Errorf("The MySQL backup credentials secret %s/%s has no %s key", vzconst.VerrazzanoInstallNamespace, storage.CredentialsSecret, secretKeyKey)
+	}
+	return string(accessKey), string(secretKey), nil
+}
+
+// deleteBackupCredentials deletes the object storage credentials when the scheduled backups are not configured
+func deleteBackupCredentials(compContext spi.ComponentContext) error {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: backupCredentialsSecret}}
+	if err := compContext.Client().Delete(context.TODO(), secret); err != nil && !errors.IsNotFound(err) {
+		return compContext.Log().ErrorfNewErr("Failed deleting the MySQL backup credentials secret %s/%s: %v", ComponentNamespace, backupCredentialsSecret, err)
+	}
+	return nil
+}
+
+// listScheduledBackups returns the MySQLBackup resources created by the backup schedule, oldest first
+func listScheduledBackups(client clipkg.Client) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(innoDBClusterGVK.GroupVersion().String())
+	list.SetKind(mySQLBackupListKind)
+	if err := client.List(context.TODO(), list, clipkg.InNamespace(ComponentNamespace)); err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("%s-%s", helmReleaseName, BackupScheduleName)
+	var backups []unstructured.Unstructured
+	for _, item := range list.Items {
+		if strings.HasPrefix(item.GetName(), prefix) {
+			backups = append(backups, item)
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		ti, tj := backups[i].GetCreationTimestamp(), backups[j].GetCreationTimestamp()
+		if ti.Equal(&tj) {
+			// the names of the scheduled backups end with their creation time
+			return backups[i].GetName() < backups[j].GetName()
+		}
+		return ti.Before(&tj)
+	})
+	return backups, nil
+}
+
+// GetBackupStatus returns the status of the scheduled backups: the most recent completed backup, and the number of
+// backups that failed since then
+func GetBackupStatus(client clipkg.Client) (*vzapi.MySQLBackupStatus, error) {
+	backups, err := listScheduledBackups(client)
+	if err != nil {
+		return nil, err
+	}
+	status := &vzapi.MySQLBackupStatus{}
+	for _, backup := range backups {
+		switch getBackupState(backup) {
+		case mySQLBackupCompleted:
+			status.LastBackup = backup.GetName()
+			status.LastSuccessTime = getBackupCompletionTime(backup)
+			status.Failures = 0
+			status.Message = ""
+		case mySQLBackupError:
+			status.Failures++
+			status.Message, _, _ = unstructured.NestedString(backup.Object, "status", "message")
+		}
+	}
+	return status, nil
+}
+
+// PruneBackups deletes the completed backups beyond the retention count, oldest first, along with the failed backups
+// older than the oldest backup that is kept. The MySQL operator does not delete the dump of a deleted backup, so the
+// objects of the dump are deleted from the object storage before the backup.
+func PruneBackups(client clipkg.Client, backup *vzapi.MySQLBackup) error {
+	backups, err := listScheduledBackups(client)
+	if err != nil {
+		return err
+	}
+	var completed []unstructured.Unstructured
+	for _, b := range backups {
+		if getBackupState(b) == mySQLBackupCompleted {
+			completed = append(completed, b)
+		}
+	}
+	retention := getBackupRetention(backup)
+	if len(completed) <= retention {
+		return nil
+	}
+	s3, err := newBackupStorageClient(client, backup.Storage)
+	if err != nil {
+		return err
+	}
+	oldestKept := completed[len(completed)-retention].GetName()
+	for i := range backups {
+		b := &backups[i]
+		if b.GetName() == oldestKept {
+			break
+		}
+		state := getBackupState(*b)
+		if state != mySQLBackupCompleted && state != mySQLBackupError {
+			continue
+		}
+		if err := deleteBackupDump(s3, backup.Storage, *b); err != nil {
+			return err
+		}
+		if err := client.Delete(context.TODO(), b); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// newBackupStorageClient returns a client of the object storage of the scheduled backups
+func newBackupStorageClient(client clipkg.Client, storage vzapi.MySQLBackupStorage) (*objectstorage.S3Client, error) {
+	accessKey, secretKey, err := getBackupCredentials(client, storage)
+	if err != nil {
+		return nil, err
+	}
+	region := storage.Region
+	if len(region) == 0 {
+		region = defaultBackupRegion
+	}
+	return &objectstorage.S3Client{
+		Endpoint:  storage.Endpoint,
+		Region:    region,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// deleteBackupDump deletes the objects of the dump of a backup. The dump is stored in the folder named after the
+// output of the backup. Nothing is done if the backup has no output.
+func deleteBackupDump(s3 *objectstorage.S3Client, storage vzapi.MySQLBackupStorage, backup unstructured.Unstructured) error {
+	output, _, _ := unstructured.NestedString(backup.Object, "status", "output")
+	if len(output) == 0 {
+		return nil
+	}
+	prefix := output + "/"
+	if len(storage.Prefix) > 0 {
+		prefix = strings.TrimSuffix(storage.Prefix, "/") + "/" + prefix
+	}
+	if _, err := s3.DeleteObjects(context.TODO(), storage.Bucket, prefix); err != nil {
+		return fmt.Errorf("Failed deleting the dump of MySQL backup %s: %v", backup.GetName(), err)
+	}
+	return nil
+}
+
+func getBackupState(backup unstructured.Unstructured) string {
+	state, _, _ := unstructured.NestedString(backup.Object, "status", "status")
+	return state
+}
+
+// getBackupCompletionTime returns the time the backup ended, or the time it was created if the completion time is
+// not reported
+func getBackupCompletionTime(backup unstructured.Unstructured) *metav1.Time {
+	completionTime, _, _ := unstructured.NestedString(backup.Object, "status", "completionTime")
+	if t, err := time.Parse(time.RFC3339, completionTime); err == nil {
+		return &metav1.Time{Time: t}
+	}
+	created := backup.GetCreationTimestamp()
+	return &created
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testBackupCredentials = "object-storage"
+
+// TestAppendBackupOverrides tests the rendering of the MySQL scheduled backups
+// GIVEN a Verrazzano CR with a MySQL backup schedule
+// WHEN appendBackupOverrides is called
+// THEN the backup profile and backup schedule overrides are returned, and the credentials secret is created
+func TestAppendBackupOverrides(t *testing.T) {
+	credentials := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoInstallNamespace, Name: testBackupCredentials},
+		Data:       map[string][]byte{accessKeyKey: []byte("key"), secretKeyKey: []byte("secret")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(credentials).Build()
+	ctx := spi.NewFakeContext(fakeClient, newBackupVerrazzano(), nil, false, profilesDir).Init(ComponentName).Operation(vzconst.InstallOperation)
+
+	kvs, err := appendBackupOverrides(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bom.KeyValue{
+		{Key: "backupProfiles[0].name", Value: BackupScheduleName},
+		{Key: "backupProfiles[0].dumpInstance.storage.s3.bucketName", Value: "keycloak-backups", SetString: true},
+		{Key: "backupProfiles[0].dumpInstance.storage.s3.prefix", Value: "mysql", SetString: true},
+		{Key: "backupProfiles[0].dumpInstance.storage.s3.endpoint", Value: "https://objectstorage.example.com", SetString: true},
+		{Key: "backupProfiles[0].dumpInstance.storage.s3.config", Value: backupCredentialsSecret},
+		{Key: "backupProfiles[0].dumpInstance.storage.s3.profile", Value: backupProfile},
+		{Key: "backupSchedules[0].name", Value: BackupScheduleName},
+		{Key: "backupSchedules[0].schedule", Value: "0 2\\,14 * * *", SetString: true},
+		{Key: "backupSchedules[0].enabled", Value: "true"},
+		{Key: "backupSchedules[0].backupProfileName", Value: BackupScheduleName},
+	}, kvs)
+
+	secret := &v1.Secret{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: backupCredentialsSecret}, secret))
+	assert.Equal(t, "[default]\naws_access_key_id=key\naws_secret_access_key=secret\n", string(secret.Data[backupCredentialsKey]))
+	assert.Equal(t, "[default]\nregion=us-ashburn-1\n", string(secret.Data[backupConfigKey]))
+}
+
+// TestAppendBackupOverridesDisabled tests the MySQL overrides without scheduled backups
+// GIVEN a Verrazzano CR without a MySQL backup schedule and a leftover credentials secret
+// WHEN appendBackupOverrides is called
+// THEN no overrides are returned and the credentials secret is deleted
+func TestAppendBackupOverridesDisabled(t *testing.T) {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: backupCredentialsSecret}}
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(secret).Build()
+	ctx := spi.NewFakeContext(fakeClient, &vzapi.Verrazzano{}, nil, false, profilesDir).Init(ComponentName).Operation(vzconst.UpdateOperation)
+
+	kvs, err := appendBackupOverrides(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, kvs)
+	err = fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), secret)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+// TestAppendBackupOverridesMissingCredentials tests the MySQL scheduled backups without the credentials secret
+// GIVEN a Verrazzano CR with a MySQL backup schedule
+// WHEN appendBackupOverrides is called and the credentials secret does not exist
+// THEN an error is returned
+func TestAppendBackupOverridesMissingCredentials(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	ctx := spi.NewFakeContext(fakeClient, newBackupVerrazzano(), nil, false, profilesDir).Init(ComponentName).Operation(vzconst.InstallOperation)
+
+	_, err := appendBackupOverrides(ctx, nil)
+	assert.Error(t, err)
+}
+
+// TestBackupStatusAndPrune tests the status and the retention of the MySQL scheduled backups
+// GIVEN completed and failed scheduled backups
+// WHEN GetBackupStatus and PruneBackups are called
+// THEN the status reports the last completed backup and the failures since then, and the completed backups beyond
+// the retention count are deleted with the failed backups older than the oldest backup that is kept, along with
+// their dumps in the object storage
+func TestBackupStatusAndPrune(t *testing.T) {
+	var listed, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			prefix := r.URL.Query().Get("prefix")
+			listed = append(listed, prefix)
+			_, _ = fmt.Fprintf(w, "<ListBucketResult><Contents><Key>%s@.json</Key></Contents></ListBucketResult>", prefix)
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	credentials := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoInstallNamespace, Name: testBackupCredentials},
+		Data:       map[string][]byte{accessKeyKey: []byte("key"), secretKeyKey: []byte("secret")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(
+		credentials,
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup1", now.Add(-5*time.Hour), mySQLBackupCompleted, ""),
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup2", now.Add(-4*time.Hour), mySQLBackupError, "failed"),
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup3", now.Add(-3*time.Hour), mySQLBackupCompleted, ""),
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup4", now.Add(-2*time.Hour), mySQLBackupCompleted, ""),
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup5", now.Add(-1*time.Hour), mySQLBackupError, "bucket not found"),
+		newTestMySQLBackup("mysql-manual-backup", now.Add(-6*time.Hour), mySQLBackupCompleted, ""),
+	).Build()
+
+	status, err := GetBackupStatus(fakeClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "mysql-verrazzano-scheduled-backup4", status.LastBackup)
+	assert.Equal(t, now.Add(-2*time.Hour).Add(time.Minute), status.LastSuccessTime.Time.UTC())
+	assert.Equal(t, int32(1), status.Failures)
+	assert.Equal(t, "bucket not found", status.Message)
+
+	backup := newBackupVerrazzano().Spec.Components.Keycloak.MySQL.Backup
+	retention := int32(2)
+	backup.Retention = &retention
+	backup.Storage.Endpoint = server.URL
+	assert.NoError(t, PruneBackups(fakeClient, backup))
+	assert.Equal(t, []string{"mysql/mysql-verrazzano-scheduled-backup1-dump/", "mysql/mysql-verrazzano-scheduled-backup2-dump/"}, listed)
+	assert.Equal(t, []string{"/keycloak-backups/mysql/mysql-verrazzano-scheduled-backup1-dump/@.json", "/keycloak-backups/mysql/mysql-verrazzano-scheduled-backup2-dump/@.json"}, deleted)
+	backups, err := listScheduledBackups(fakeClient)
+	assert.NoError(t, err)
+	var names []string
+	for _, b := range backups {
+		names = append(names, b.GetName())
+	}
+	assert.Equal(t, []string{"mysql-verrazzano-scheduled-backup3", "mysql-verrazzano-scheduled-backup4", "mysql-verrazzano-scheduled-backup5"}, names)
+
+	// the backups that are not scheduled are not deleted
+	manual := &unstructured.Unstructured{}
+	manual.SetAPIVersion(innoDBClusterGVK.GroupVersion().String())
+	manual.SetKind("MySQLBackup")
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: "mysql-manual-backup"}, manual))
+}
+
+func newBackupVerrazzano() *vzapi.Verrazzano {
+	retention := int32(5)
+	return &vzapi.Verrazzano{
+		Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Keycloak: &vzapi.KeycloakComponent{
+					MySQL: vzapi.MySQLComponent{
+						Backup: &vzapi.MySQLBackup{
+							Schedule:  "0 2,14 * * *",
+							Retention: &retention,
+							Storage: vzapi.MySQLBackupStorage{
+								Bucket:            "keycloak-backups",
+								Prefix:            "mysql",
+								Endpoint:          "https://objectstorage.example.com",
+								Region:            "us-ashburn-1",
+								CredentialsSecret: testBackupCredentials,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestMySQLBackup(name string, created time.Time, state string, message string) *unstructured.Unstructured {
+	backup := &unstructured.Unstructured{}
+	backup.SetAPIVersion(innoDBClusterGVK.GroupVersion().String())
+	backup.SetKind("MySQLBackup")
+	backup.SetNamespace(ComponentNamespace)
+	backup.SetName(name)
+	backup.SetCreationTimestamp(metav1.NewTime(created))
+	backup.Object["status"] = map[string]interface{}{
+		"status":         state,
+		"message":        message,
+		"completionTime": created.Add(time.Minute).Format(time.RFC3339),
+		"output":         name + "-dump",
+	}
+	return backup
+}
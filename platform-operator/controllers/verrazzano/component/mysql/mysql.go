@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package mysql
@@ -339,6 +339,12 @@ func appendMySQLOverrides(compContext spi.ComponentContext, _ string, _ string,
 	// Convert MySQL install-args to helm overrides
 	kvs = append(kvs, convertOldInstallArgs(helm.GetInstallArgs(getInstallArgs(cr)))...)
 
+	// Render the scheduled backups
+	kvs, err = appendBackupOverrides(compContext, kvs)
+	if err != nil {
+		return []bom.KeyValue{}, ctrlerrors.RetryableError{Source: ComponentName, Cause: err}
+	}
+
 	return kvs, nil
 }
 
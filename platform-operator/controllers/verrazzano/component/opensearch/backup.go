@@ -0,0 +1,235 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type (
+	SnapshotList struct {
+		Snapshots []Snapshot `json:"snapshots"`
+	}
+
+	Snapshot struct {
+		Snapshot    string         `json:"snapshot"`
+		State       string         `json:"state"`
+		Indices     []string       `json:"indices,omitempty"`
+		DataStreams []string       `json:"data_streams,omitempty"`
+		Failures    []SnapshotFail `json:"failures,omitempty"`
+	}
+
+	SnapshotFail struct {
+		Index  string `json:"index"`
+		Reason string `json:"reason"`
+	}
+)
+
+// Snapshot states
+const (
+	SnapshotStateInProgress = "IN_PROGRESS"
+	SnapshotStateSuccess    = "SUCCESS"
+	SnapshotStatePartial    = "PARTIAL"
+	SnapshotStateFailed     = "FAILED"
+)
+
+// CreateSnapshot starts a snapshot of all the indices and data streams in the snapshot repository. The metadata
+// is stored with the snapshot. Nothing is done if the snapshot already exists.
+func (o *OSClient) CreateSnapshot(client clipkg.Client, repository, name string, metadata map[string]string) error {
+	snapshot, err := o.GetSnapshot(client, repository, name)
+	if err != nil || snapshot != nil {
+		return err
+	}
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"indices":              defaultSnapshotIndices,
+		"include_global_state": true,
+		"metadata":             metadata,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/_snapshot/%s/%s", opensearchEndpoint, repository, name)
+	req, err := http.NewRequest("PUT", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(contentTypeHeader, applicationJSON)
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("got status code %d when creating snapshot %s in repository %s: %s", resp.StatusCode, name, repository, errorReason(resp))
+	}
+	return nil
+}
+
+// GetSnapshot returns a snapshot, or nil if the snapshot does not exist
+func (o *OSClient) GetSnapshot(client clipkg.Client, repository, name string) (*Snapshot, error) {
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/_snapshot/%s/%s", opensearchEndpoint, repository, name)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status code %d when fetching snapshot %s in repository %s: %s", resp.StatusCode, name, repository, errorReason(resp))
+	}
+	snapshots := &SnapshotList{}
+	if err := json.NewDecoder(resp.Body).Decode(snapshots); err != nil {
+		return nil, err
+	}
+	if len(snapshots.Snapshots) == 0 {
+		return nil, nil
+	}
+	return &snapshots.Snapshots[0], nil
+}
+
+// DeleteSnapshot deletes a snapshot. Nothing is done if the snapshot does not exist.
+func (o *OSClient) DeleteSnapshot(client clipkg.Client, repository, name string) error {
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/_snapshot/%s/%s", opensearchEndpoint, repository, name)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("got status code %d when deleting snapshot %s in repository %s: %s", resp.StatusCode, name, repository, errorReason(resp))
+	}
+	return nil
+}
+
+// RestoreSnapshot restores data streams and indices from a snapshot. OpenSearch can't restore a data stream or an
+// open index that exists, so the data streams and the indices are deleted first. The caller must stop the ingestion
+// into the data streams, otherwise they may be recreated before they are restored.
+func (o *OSClient) RestoreSnapshot(client clipkg.Client, repository, name string, dataStreams, indices []string) error {
+	if len(dataStreams) == 0 && len(indices) == 0 {
+		return nil
+	}
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return err
+	}
+	if len(dataStreams) > 0 {
+		if err := o.deleteForRestore(fmt.Sprintf("%s/_data_stream/%s", opensearchEndpoint, strings.Join(dataStreams, ","))); err != nil {
+			return fmt.Errorf("failed to delete data streams %v: %v", dataStreams, err)
+		}
+	}
+	if len(indices) > 0 {
+		if err := o.deleteForRestore(fmt.Sprintf("%s/%s?ignore_unavailable=true", opensearchEndpoint, strings.Join(indices, ","))); err != nil {
+			return fmt.Errorf("failed to delete indices %v: %v", indices, err)
+		}
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"indices":              strings.Join(append(append([]string{}, dataStreams...), indices...), ","),
+		"include_global_state": false,
+		"include_aliases":      true,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/_snapshot/%s/%s/_restore", opensearchEndpoint, repository, name)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(contentTypeHeader, applicationJSON)
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("got status code %d when restoring snapshot %s from repository %s: %s", resp.StatusCode, name, repository, errorReason(resp))
+	}
+	return nil
+}
+
+// deleteForRestore deletes data streams or indices before they are restored, ignoring the ones that don't exist
+func (o *OSClient) deleteForRestore(url string) error {
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("got status code %d: %s", resp.StatusCode, errorReason(resp))
+	}
+	return nil
+}
+
+// IsSnapshotRecoveryInProgress returns true while shards of the data streams or indices are being recovered
+func (o *OSClient) IsSnapshotRecoveryInProgress(client clipkg.Client, names []string) (bool, error) {
+	if len(names) == 0 {
+		return false, nil
+	}
+	pattern := strings.Join(names, ",")
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return false, err
+	}
+	url := fmt.Sprintf("%s/%s/_recovery?active_only=true", opensearchEndpoint, pattern)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("got status code %d when fetching the recovery of indices %s", resp.StatusCode, pattern)
+	}
+	recoveries := map[string]struct {
+		Shards []interface{} `json:"shards"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&recoveries); err != nil {
+		return false, err
+	}
+	for _, recovery := range recoveries {
+		if len(recovery.Shards) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCreateSnapshot tests the creation of a snapshot
+// GIVEN a snapshot that doesn't exist
+// WHEN CreateSnapshot is called
+// THEN the snapshot of all the indices is started with the metadata
+// GIVEN a snapshot that exists
+// WHEN CreateSnapshot is called
+// THEN the snapshot is not started again
+func TestCreateSnapshot(t *testing.T) {
+	var exists bool
+	var puts int
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/_snapshot/repo/backup1", request.URL.Path)
+		switch request.Method {
+		case "GET":
+			if exists {
+				return newResponse(http.StatusOK, `{"snapshots":[{"snapshot":"backup1","state":"IN_PROGRESS"}]}`), nil
+			}
+			return newResponse(http.StatusNotFound, `{"error":{"type":"snapshot_missing_exception"},"status":404}`), nil
+		case "PUT":
+			puts++
+			body, err := io.ReadAll(request.Body)
+			assert.NoError(t, err)
+			payload := map[string]interface{}{}
+			assert.NoError(t, json.Unmarshal(body, &payload))
+			assert.Equal(t, "*", payload["indices"])
+			assert.Equal(t, map[string]interface{}{"verrazzano.io/backup": "backup1"}, payload["metadata"])
+			return newResponse(http.StatusOK, `{"accepted":true}`), nil
+		}
+		return newResponse(http.StatusBadRequest, ""), nil
+	}
+
+	c := newOpenSearchTestClient()
+	assert.NoError(t, o.CreateSnapshot(c, "repo", "backup1", map[string]string{"verrazzano.io/backup": "backup1"}))
+	assert.Equal(t, 1, puts)
+
+	exists = true
+	assert.NoError(t, o.CreateSnapshot(c, "repo", "backup1", nil))
+	assert.Equal(t, 1, puts)
+}
+
+// TestGetSnapshot tests fetching a snapshot
+// GIVEN a snapshot that has failed shards
+// WHEN GetSnapshot is called
+// THEN the state and the failures of the snapshot are returned
+// GIVEN a snapshot that doesn't exist
+// WHEN GetSnapshot is called
+// THEN nil is returned
+func TestGetSnapshot(t *testing.T) {
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		if request.URL.Path == "/_snapshot/repo/backup1" {
+			return newResponse(http.StatusOK, `{"snapshots":[{"snapshot":"backup1","state":"PARTIAL","failures":[{"index":"verrazzano-system","reason":"IOException"}]}]}`), nil
+		}
+		return newResponse(http.StatusNotFound, ""), nil
+	}
+
+	c := newOpenSearchTestClient()
+	snapshot, err := o.GetSnapshot(c, "repo", "backup1")
+	assert.NoError(t, err)
+	assert.Equal(t, SnapshotStatePartial, snapshot.State)
+	assert.Equal(t, []SnapshotFail{{Index: "verrazzano-system", Reason: "IOException"}}, snapshot.Failures)
+
+	snapshot, err = o.GetSnapshot(c, "repo", "backup2")
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+// TestRestoreSnapshot tests the restore of a snapshot
+// GIVEN a snapshot
+// WHEN RestoreSnapshot is called with data streams and indices
+// THEN the data streams and the indices are deleted, then they are restored by name
+// WHEN the shards are being recovered
+// THEN IsSnapshotRecoveryInProgress returns true
+func TestRestoreSnapshot(t *testing.T) {
+	var requests []string
+	recovering := `{"verrazzano-system":{"shards":[{"id":0}]}}`
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		requests = append(requests, request.Method+" "+request.URL.Path)
+		switch request.Method {
+		case "DELETE":
+			return newResponse(http.StatusNotFound, ""), nil
+		case "POST":
+			body, err := io.ReadAll(request.Body)
+			assert.NoError(t, err)
+			payload := map[string]interface{}{}
+			assert.NoError(t, json.Unmarshal(body, &payload))
+			assert.Equal(t, "verrazzano-system,verrazzano-application-ns1,verrazzano-audit", payload["indices"])
+			assert.Equal(t, false, payload["include_global_state"])
+			return newResponse(http.StatusAccepted, `{"accepted":true}`), nil
+		case "GET":
+			assert.Equal(t, "/verrazzano-system,verrazzano-audit/_recovery", request.URL.Path)
+			return newResponse(http.StatusOK, recovering), nil
+		}
+		return newResponse(http.StatusBadRequest, ""), nil
+	}
+
+	c := newOpenSearchTestClient()
+	assert.NoError(t, o.RestoreSnapshot(c, "repo", "backup1", []string{"verrazzano-system", "verrazzano-application-ns1"}, []string{"verrazzano-audit"}))
+	assert.Equal(t, []string{
+		"DELETE /_data_stream/verrazzano-system,verrazzano-application-ns1",
+		"DELETE /verrazzano-audit",
+		"POST /_snapshot/repo/backup1/_restore",
+	}, requests)
+
+	// Nothing is restored without data streams and indices
+	requests = nil
+	assert.NoError(t, o.RestoreSnapshot(c, "repo", "backup1", nil, nil))
+	assert.Empty(t, requests)
+
+	inProgress, err := o.IsSnapshotRecoveryInProgress(c, []string{"verrazzano-system", "verrazzano-audit"})
+	assert.NoError(t, err)
+	assert.True(t, inProgress)
+
+	recovering = `{"verrazzano-system":{"shards":[]}}`
+	inProgress, err = o.IsSnapshotRecoveryInProgress(c, []string{"verrazzano-system", "verrazzano-audit"})
+	assert.NoError(t, err)
+	assert.False(t, inProgress)
+}
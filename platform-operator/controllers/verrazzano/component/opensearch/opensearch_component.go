@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearch
@@ -114,6 +114,9 @@ func (o opensearchComponent) PreInstall(ctx spi.ComponentContext) error {
 // Install OpenSearch component install processing
 func (o opensearchComponent) Install(ctx spi.ComponentContext) error {
 	ctx.Log().Progressf("Component: %s, Creating/Updating OpensearchCluster CR", ComponentName)
+	if err := common.CopySnapshotCredentialsSecrets(ctx); err != nil {
+		return err
+	}
 	args, err := common.BuildArgsForOpenSearchCR(ctx)
 	if err != nil {
 		return err
@@ -158,6 +161,9 @@ func (o opensearchComponent) PreUpgrade(ctx spi.ComponentContext) error {
 // Upgrade OpenSearch component upgrade processing
 func (o opensearchComponent) Upgrade(ctx spi.ComponentContext) error {
 	ctx.Log().Progressf("Component: %s, Creating/Updating OpensearchCluster CR", ComponentName)
+	if err := common.CopySnapshotCredentialsSecrets(ctx); err != nil {
+		return err
+	}
 	args, err := common.BuildArgsForOpenSearchCR(ctx)
 	if err != nil {
 		return err
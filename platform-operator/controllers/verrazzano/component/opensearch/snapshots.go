@@ -0,0 +1,491 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/verrazzano/pkg/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+type (
+	SnapshotRepository struct {
+		Type     string            `json:"type"`
+		Settings map[string]string `json:"settings"`
+	}
+
+	SnapshotInfo struct {
+		ID       string `json:"id"`
+		Status   string `json:"status"`
+		EndEpoch string `json:"end_epoch"`
+	}
+
+	SnapshotPolicyList struct {
+		Policies      []SnapshotPolicy `json:"policies"`
+		TotalPolicies int              `json:"total_policies"`
+	}
+
+	SnapshotPolicy struct {
+		ID             *string  `json:"_id,omitempty"`
+		PrimaryTerm    *int     `json:"_primary_term,omitempty"`
+		SequenceNumber *int     `json:"_seq_no,omitempty"`
+		Status         *int     `json:"status,omitempty"`
+		Policy         SMPolicy `json:"sm_policy"`
+	}
+
+	SMPolicy struct {
+		Name           string           `json:"name,omitempty"`
+		Description    string           `json:"description"`
+		Creation       SMCreation       `json:"creation"`
+		Deletion       *SMDeletion      `json:"deletion,omitempty"`
+		SnapshotConfig SMSnapshotConfig `json:"snapshot_config"`
+	}
+
+	SMCreation struct {
+		Schedule SMSchedule `json:"schedule"`
+	}
+
+	SMDeletion struct {
+		Schedule  SMSchedule        `json:"schedule"`
+		Condition SMDeleteCondition `json:"condition"`
+	}
+
+	SMSchedule struct {
+		Cron SMCron `json:"cron"`
+	}
+
+	SMCron struct {
+		Expression string `json:"expression"`
+		Timezone   string `json:"timezone"`
+	}
+
+	SMDeleteCondition struct {
+		MaxAge   string `json:"max_age,omitempty"`
+		MaxCount *int32 `json:"max_count,omitempty"`
+		MinCount *int32 `json:"min_count,omitempty"`
+	}
+
+	SMSnapshotConfig struct {
+		Repository string `json:"repository"`
+		Indices    string `json:"indices"`
+	}
+)
+
+const (
+	s3RepositoryType        = "s3"
+	defaultSnapshotTimeZone = "UTC"
+	defaultSnapshotIndices  = "*"
+	defaultSnapshotMinCount = int32(1)
+)
+
+// ConfigureSnapshots registers the snapshot repositories and creates or updates the snapshot policies declared in the
+// Verrazzano CR, deletes the Verrazzano-managed snapshot policies that are no longer declared, and returns the health of
+// the declared snapshot repositories.
+func (o *OSClient) ConfigureSnapshots(log vzlog.VerrazzanoLogger, client clipkg.Client, vz *vzv1alpha1.Verrazzano) ([]vzv1alpha1.SnapshotRepositoryStatus, error) {
+	if !*vz.Spec.Components.Elasticsearch.Enabled {
+		return nil, nil
+	}
+	if !o.IsOpenSearchReady(client) {
+		return nil, nil
+	}
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return nil, err
+	}
+	opensearch := vz.Spec.Components.Elasticsearch
+
+	var statuses []vzv1alpha1.SnapshotRepositoryStatus
+	registered := map[string]bool{}
+	for _, repository := range opensearch.SnapshotRepositories {
+		if err := o.createOrUpdateSnapshotRepository(log, opensearchEndpoint, repository); err != nil {
+			// Report the failure in the repository status and carry on with the other repositories
+			log.ErrorfThrottled("Failed to register OpenSearch snapshot repository %s: %v", repository.Name, err)
+			statuses = append(statuses, vzv1alpha1.SnapshotRepositoryStatus{
+				Name:    repository.Name,
+				Message: err.Error(),
+			})
+			continue
+		}
+		registered[repository.Name] = true
+		statuses = append(statuses, o.getSnapshotRepositoryStatus(opensearchEndpoint, repository.Name))
+	}
+
+	for _, policy := range opensearch.SnapshotPolicies {
+		if !registered[policy.Repository] {
+			log.Progressf("Waiting for snapshot repository %s to be registered before creating snapshot policy %s", policy.Repository, policy.Name)
+			continue
+		}
+		if err := o.createOrUpdateSnapshotPolicy(log, opensearchEndpoint, policy); err != nil {
+			return statuses, err
+		}
+	}
+	if err := o.cleanupSnapshotPolicies(opensearchEndpoint, opensearch.SnapshotPolicies); err != nil {
+		return statuses, err
+	}
+	return statuses, nil
+}
+
+// createOrUpdateSnapshotRepository registers a snapshot repository if it does not exist, else the repository will be updated.
+// If the repository already exists and its settings match the VZ repository spec, no update will be issued
+func (o *OSClient) createOrUpdateSnapshotRepository(log vzlog.VerrazzanoLogger, opensearchEndpoint string, repository vzv1alpha1.OpenSearchSnapshotRepository) error {
+	repositoryURL := fmt.Sprintf("%s/_snapshot/%s", opensearchEndpoint, repository.Name)
+	existingRepository, err := o.getSnapshotRepository(repositoryURL, repository.Name)
+	if err != nil {
+		return err
+	}
+	snapshotRepository := toSnapshotRepository(repository)
+	if existingRepository != nil && !snapshotRepositoryNeedsUpdate(snapshotRepository, existingRepository) {
+		return nil
+	}
+	log.Debugf("Registering OpenSearch snapshot repository %s", repository.Name)
+	payload, err := json.Marshal(snapshotRepository)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("PUT", repositoryURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(contentTypeHeader, applicationJSON)
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status code %d when registering snapshot repository %s: %s", resp.StatusCode, repository.Name, errorReason(resp))
+	}
+	return nil
+}
+
+// getSnapshotRepository returns the snapshot repository, or nil if it does not exist
+func (o *OSClient) getSnapshotRepository(repositoryURL, name string) (*SnapshotRepository, error) {
+	req, err := http.NewRequest("GET", repositoryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status code %d when fetching snapshot repository %s", resp.StatusCode, name)
+	}
+	repositories := map[string]SnapshotRepository{}
+	if err := json.NewDecoder(resp.Body).Decode(&repositories); err != nil {
+		return nil, err
+	}
+	repository, ok := repositories[name]
+	if !ok {
+		return nil, nil
+	}
+	return &repository, nil
+}
+
+// getSnapshotRepositoryStatus verifies the snapshot repository and reports its most recent snapshot
+func (o *OSClient) getSnapshotRepositoryStatus(opensearchEndpoint, name string) vzv1alpha1.SnapshotRepositoryStatus {
+	status := vzv1alpha1.SnapshotRepositoryStatus{Name: name}
+	if err := o.verifySnapshotRepository(opensearchEndpoint, name); err != nil {
+		status.Message = err.Error()
+		return status
+	}
+	status.Verified = true
+	snapshot, err := o.getLastSnapshot(opensearchEndpoint, name)
+	if err != nil {
+		status.Message = err.Error()
+		return status
+	}
+	if snapshot == nil {
+		return status
+	}
+	status.LastSnapshot = snapshot.ID
+	status.LastSnapshotState = snapshot.Status
+	if endEpoch, err := strconv.ParseInt(snapshot.EndEpoch, 10, 64); err == nil && endEpoch > 0 {
+		endTime := metav1.NewTime(time.Unix(endEpoch, 0))
+		status.LastSnapshotTime = &endTime
+	}
+	return status
+}
+
+// verifySnapshotRepository returns an error if any OpenSearch node cannot access the snapshot repository
+func (o *OSClient) verifySnapshotRepository(opensearchEndpoint, name string) error {
+	url := fmt.Sprintf("%s/_snapshot/%s/_verify", opensearchEndpoint, name)
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status code %d when verifying snapshot repository %s: %s", resp.StatusCode, name, errorReason(resp))
+	}
+	return nil
+}
+
+// getLastSnapshot returns the most recently started snapshot in the repository, or nil if there are no snapshots
+func (o *OSClient) getLastSnapshot(opensearchEndpoint, name string) (*SnapshotInfo, error) {
+	url := fmt.Sprintf("%s/_cat/snapshots/%s?format=json", opensearchEndpoint, name)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got status code %d when listing snapshots in repository %s", resp.StatusCode, name)
+	}
+	var snapshots []SnapshotInfo
+	if err := json.NewDecoder(resp.Body).Decode(&snapshots); err != nil {
+		return nil, err
+	}
+	// Snapshots are listed in the order they were started
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	return &snapshots[len(snapshots)-1], nil
+}
+
+// createOrUpdateSnapshotPolicy creates a snapshot policy if it does not exist, else the policy will be updated.
+// If the policy already exists and its spec matches the VZ policy spec, no update will be issued
+func (o *OSClient) createOrUpdateSnapshotPolicy(log vzlog.VerrazzanoLogger, opensearchEndpoint string, policy vzv1alpha1.OpenSearchSnapshotPolicy) error {
+	policyURL := fmt.Sprintf("%s/_plugins/_sm/policies/%s", opensearchEndpoint, policy.Name)
+	existingPolicy, err := o.getSnapshotPolicyByName(policyURL)
+	if err != nil {
+		return err
+	}
+	snapshotPolicy := toSnapshotPolicy(policy)
+	if !snapshotPolicyNeedsUpdate(snapshotPolicy, existingPolicy) {
+		return nil
+	}
+	payload, err := json.Marshal(snapshotPolicy.Policy)
+	if err != nil {
+		return err
+	}
+
+	var method string
+	var url string
+	var statusCode int
+	switch *existingPolicy.Status {
+	case http.StatusOK: // The policy exists and must be updated in place if it has changed
+		method = "PUT"
+		url = fmt.Sprintf("%s?if_seq_no=%d&if_primary_term=%d", policyURL, *existingPolicy.SequenceNumber, *existingPolicy.PrimaryTerm)
+		statusCode = http.StatusOK
+	case http.StatusNotFound: // The policy doesn't exist and must be created
+		method = "POST"
+		url = policyURL
+		statusCode = http.StatusCreated
+	default:
+		return fmt.Errorf("invalid status when fetching snapshot policy %s: %d", policy.Name, *existingPolicy.Status)
+	}
+	log.Debugf("Creating or updating OpenSearch snapshot policy %s", policy.Name)
+	req, err := http.NewRequest(method, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(contentTypeHeader, applicationJSON)
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != statusCode {
+		return fmt.Errorf("got status code %d when updating snapshot policy %s, expected %d", resp.StatusCode, policy.Name, statusCode)
+	}
+	return nil
+}
+
+func (o *OSClient) getSnapshotPolicyByName(policyURL string) (*SnapshotPolicy, error) {
+	req, err := http.NewRequest("GET", policyURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	existingPolicy := &SnapshotPolicy{}
+	existingPolicy.Status = &resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		return existingPolicy, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(existingPolicy); err != nil {
+		return nil, err
+	}
+	return existingPolicy, nil
+}
+
+func (o *OSClient) cleanupSnapshotPolicies(opensearchEndpoint string, policies []vzv1alpha1.OpenSearchSnapshotPolicy) error {
+	url := fmt.Sprintf("%s/_plugins/_sm/policies", opensearchEndpoint)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// No snapshot policies have been created yet
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status code %d when querying snapshot policies for cleanup", resp.StatusCode)
+	}
+	policyList := &SnapshotPolicyList{}
+	if err := json.NewDecoder(resp.Body).Decode(policyList); err != nil {
+		return err
+	}
+
+	expectedPolicyMap := map[string]bool{}
+	for _, policy := range policies {
+		expectedPolicyMap[policy.Name] = true
+	}
+	// A policy is eligible for deletion if it is marked as operator managed, but the VZ no longer
+	// has a policy entry for it
+	for _, policy := range policyList.Policies {
+		if policy.Policy.Description == operatorManagedPolicy && !expectedPolicyMap[policy.Policy.Name] {
+			if err := o.deleteSnapshotPolicy(opensearchEndpoint, policy.Policy.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *OSClient) deleteSnapshotPolicy(opensearchEndpoint, policyName string) error {
+	url := fmt.Sprintf("%s/_plugins/_sm/policies/%s", opensearchEndpoint, policyName)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status code %d when deleting snapshot policy %s", resp.StatusCode, policyName)
+	}
+	return nil
+}
+
+// snapshotRepositoryNeedsUpdate returns true if the repository type or settings have changed
+func snapshotRepositoryNeedsUpdate(repository *SnapshotRepository, existingRepository *SnapshotRepository) bool {
+	return repository.Type != existingRepository.Type ||
+		diff.Diff(repository.Settings, existingRepository.Settings) != ""
+}
+
+// snapshotPolicyNeedsUpdate returns true if the policy does not exist or the policy document has changed
+func snapshotPolicyNeedsUpdate(policy *SnapshotPolicy, existingPolicy *SnapshotPolicy) bool {
+	if *existingPolicy.Status != http.StatusOK {
+		return true
+	}
+	newPolicyDocument := policy.Policy
+	oldPolicyDocument := existingPolicy.Policy
+	return newPolicyDocument.Description != oldPolicyDocument.Description ||
+		diff.Diff(newPolicyDocument.Creation, oldPolicyDocument.Creation) != "" ||
+		diff.Diff(newPolicyDocument.Deletion, oldPolicyDocument.Deletion) != "" ||
+		diff.Diff(newPolicyDocument.SnapshotConfig, oldPolicyDocument.SnapshotConfig) != ""
+}
+
+// toSnapshotRepository returns the repository settings. The endpoint, region and credentials are settings of the
+// OpenSearch S3 client named after the repository, which are added to the OpenSearchCluster CR.
+func toSnapshotRepository(repository vzv1alpha1.OpenSearchSnapshotRepository) *SnapshotRepository {
+	settings := map[string]string{
+		"client": repository.Name,
+	}
+	if repository.S3 != nil {
+		settings["bucket"] = repository.S3.Bucket
+		if repository.S3.BasePath != "" {
+			settings["base_path"] = repository.S3.BasePath
+		}
+	}
+	return &SnapshotRepository{
+		Type:     s3RepositoryType,
+		Settings: settings,
+	}
+}
+
+func toSnapshotPolicy(policy vzv1alpha1.OpenSearchSnapshotPolicy) *SnapshotPolicy {
+	timeZone := defaultSnapshotTimeZone
+	if policy.TimeZone != "" {
+		timeZone = policy.TimeZone
+	}
+	indices := defaultSnapshotIndices
+	if len(policy.Indices) > 0 {
+		indices = strings.Join(policy.Indices, ",")
+	}
+	schedule := SMSchedule{
+		Cron: SMCron{
+			Expression: policy.Schedule,
+			Timezone:   timeZone,
+		},
+	}
+	smPolicy := SMPolicy{
+		Description: operatorManagedPolicy,
+		Creation: SMCreation{
+			Schedule: schedule,
+		},
+		SnapshotConfig: SMSnapshotConfig{
+			Repository: policy.Repository,
+			Indices:    indices,
+		},
+	}
+	if policy.MaxAge != "" || policy.MaxCount != nil {
+		minCount := defaultSnapshotMinCount
+		if policy.MinCount != nil {
+			minCount = *policy.MinCount
+		}
+		smPolicy.Deletion = &SMDeletion{
+			Schedule: schedule,
+			Condition: SMDeleteCondition{
+				MaxAge:   policy.MaxAge,
+				MaxCount: policy.MaxCount,
+				MinCount: &minCount,
+			},
+		}
+	}
+	return &SnapshotPolicy{Policy: smPolicy}
+}
+
+// errorReason returns the reason in an OpenSearch error response, or the response body if there is no reason
+func errorReason(resp *http.Response) string {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err.Error()
+	}
+	errorResponse := struct {
+		Error struct {
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, &errorResponse); err == nil && errorResponse.Error.Reason != "" {
+		return errorResponse.Error.Reason
+	}
+	return string(body)
+}
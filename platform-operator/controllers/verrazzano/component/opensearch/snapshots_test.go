@@ -0,0 +1,199 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+const (
+	testSnapshotPolicyNotFound = `{"error":{"type":"status_exception","reason":"Snapshot management policy not found"},"status":404}`
+	testSnapshots              = `[
+  {"id":"daily-2022.08.04","status":"SUCCESS","end_epoch":"1659578590"},
+  {"id":"daily-2022.08.05","status":"PARTIAL","end_epoch":"1659664990"}
+]`
+)
+
+func createTestSnapshotRepository() vzv1alpha1.OpenSearchSnapshotRepository {
+	return vzv1alpha1.OpenSearchSnapshotRepository{
+		Name: "backups",
+		S3: &vzv1alpha1.OpenSearchS3Repository{
+			Bucket:            "opensearch-backups",
+			BasePath:          "verrazzano",
+			CredentialsSecret: "backup-credentials",
+		},
+	}
+}
+
+func createTestSnapshotPolicy() vzv1alpha1.OpenSearchSnapshotPolicy {
+	maxCount := int32(14)
+	return vzv1alpha1.OpenSearchSnapshotPolicy{
+		Name:       "daily",
+		Repository: "backups",
+		Schedule:   "0 2 * * *",
+		MaxAge:     "7d",
+		MaxCount:   &maxCount,
+	}
+}
+
+// existingSnapshotPolicy returns the response for a stored snapshot policy
+func existingSnapshotPolicy(t *testing.T, policy vzv1alpha1.OpenSearchSnapshotPolicy) string {
+	id := policy.Name + "-sm-policy"
+	seqNo, primaryTerm := 3, 1
+	snapshotPolicy := toSnapshotPolicy(policy)
+	snapshotPolicy.ID = &id
+	snapshotPolicy.SequenceNumber = &seqNo
+	snapshotPolicy.PrimaryTerm = &primaryTerm
+	snapshotPolicy.Policy.Name = policy.Name
+	data, err := json.Marshal(snapshotPolicy)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+// TestConfigureSnapshots tests registering snapshot repositories and creating snapshot policies
+// GIVEN a Verrazzano CR with a snapshot repository and policy that do not exist in OpenSearch
+// WHEN ConfigureSnapshots is called
+// THEN the repository is registered, the policy is created, a stale managed policy is deleted
+// and the health of the repository is returned
+func TestConfigureSnapshots(t *testing.T) {
+	var requests []string
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		requests = append(requests, request.Method+" "+request.URL.RequestURI())
+		switch request.Method + " " + request.URL.Path {
+		case "GET /_snapshot/backups":
+			return newResponse(http.StatusNotFound, `{"error":{"reason":"[backups] missing"},"status":404}`), nil
+		case "PUT /_snapshot/backups", "POST /_snapshot/backups/_verify", "DELETE /_plugins/_sm/policies/weekly":
+			return newResponse(http.StatusOK, `{"acknowledged":true}`), nil
+		case "GET /_cat/snapshots/backups":
+			return newResponse(http.StatusOK, testSnapshots), nil
+		case "GET /_plugins/_sm/policies/daily":
+			return newResponse(http.StatusNotFound, testSnapshotPolicyNotFound), nil
+		case "POST /_plugins/_sm/policies/daily":
+			return newResponse(http.StatusCreated, existingSnapshotPolicy(t, createTestSnapshotPolicy())), nil
+		case "GET /_plugins/_sm/policies":
+			weekly := createTestSnapshotPolicy()
+			weekly.Name = "weekly"
+			return newResponse(http.StatusOK, `{"policies":[`+existingSnapshotPolicy(t, weekly)+`],"total_policies":1}`), nil
+		}
+		return newResponse(http.StatusBadRequest, ""), nil
+	}
+	vz := createVZ(&vzv1alpha1.ElasticsearchComponent{
+		SnapshotRepositories: []vzv1alpha1.OpenSearchSnapshotRepository{createTestSnapshotRepository()},
+		SnapshotPolicies:     []vzv1alpha1.OpenSearchSnapshotPolicy{createTestSnapshotPolicy()},
+	})
+	statuses, err := o.ConfigureSnapshots(vzlog.DefaultLogger(), newOpenSearchTestClient(), vz)
+	assert.NoError(t, err)
+	assert.Contains(t, requests, "PUT /_snapshot/backups")
+	assert.Contains(t, requests, "POST /_plugins/_sm/policies/daily")
+	assert.Contains(t, requests, "DELETE /_plugins/_sm/policies/weekly")
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, "backups", statuses[0].Name)
+	assert.True(t, statuses[0].Verified)
+	assert.Equal(t, "daily-2022.08.05", statuses[0].LastSnapshot)
+	assert.Equal(t, "PARTIAL", statuses[0].LastSnapshotState)
+	assert.Equal(t, time.Unix(1659664990, 0).UTC(), statuses[0].LastSnapshotTime.UTC())
+	assert.Empty(t, statuses[0].Message)
+}
+
+// TestConfigureSnapshotsUnchanged tests reconciling snapshot repositories and policies that are up to date
+// GIVEN a snapshot repository and policy that match the Verrazzano CR
+// WHEN ConfigureSnapshots is called
+// THEN neither is updated
+func TestConfigureSnapshotsUnchanged(t *testing.T) {
+	repository := createTestSnapshotRepository()
+	existingRepository, err := json.Marshal(map[string]*SnapshotRepository{"backups": toSnapshotRepository(repository)})
+	assert.NoError(t, err)
+	policy := createTestSnapshotPolicy()
+
+	var updates []string
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		switch request.Method + " " + request.URL.Path {
+		case "GET /_snapshot/backups":
+			return newResponse(http.StatusOK, string(existingRepository)), nil
+		case "POST /_snapshot/backups/_verify":
+			return newResponse(http.StatusOK, `{"nodes":{}}`), nil
+		case "GET /_cat/snapshots/backups":
+			return newResponse(http.StatusOK, `[]`), nil
+		case "GET /_plugins/_sm/policies/daily":
+			return newResponse(http.StatusOK, existingSnapshotPolicy(t, policy)), nil
+		case "GET /_plugins/_sm/policies":
+			return newResponse(http.StatusOK, `{"policies":[`+existingSnapshotPolicy(t, policy)+`],"total_policies":1}`), nil
+		}
+		updates = append(updates, request.Method+" "+request.URL.Path)
+		return newResponse(http.StatusOK, `{}`), nil
+	}
+	vz := createVZ(&vzv1alpha1.ElasticsearchComponent{
+		SnapshotRepositories: []vzv1alpha1.OpenSearchSnapshotRepository{repository},
+		SnapshotPolicies:     []vzv1alpha1.OpenSearchSnapshotPolicy{policy},
+	})
+	statuses, err := o.ConfigureSnapshots(vzlog.DefaultLogger(), newOpenSearchTestClient(), vz)
+	assert.NoError(t, err)
+	assert.Empty(t, updates)
+	assert.Equal(t, []vzv1alpha1.SnapshotRepositoryStatus{{Name: "backups", Verified: true}}, statuses)
+}
+
+// TestConfigureSnapshotsUnhealthyRepository tests reporting a repository that cannot be registered
+// GIVEN OpenSearch that rejects a snapshot repository
+// WHEN ConfigureSnapshots is called
+// THEN the failure is reported in the repository status and policies using the repository are skipped
+func TestConfigureSnapshotsUnhealthyRepository(t *testing.T) {
+	var policyRequests int
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		switch request.Method + " " + request.URL.Path {
+		case "GET /_snapshot/backups":
+			return newResponse(http.StatusNotFound, `{}`), nil
+		case "PUT /_snapshot/backups":
+			return newResponse(http.StatusInternalServerError, `{"error":{"reason":"Unknown repository type [s3]"},"status":500}`), nil
+		case "GET /_plugins/_sm/policies":
+			return newResponse(http.StatusNotFound, testSnapshotPolicyNotFound), nil
+		}
+		policyRequests++
+		return newResponse(http.StatusOK, `{}`), nil
+	}
+	vz := createVZ(&vzv1alpha1.ElasticsearchComponent{
+		SnapshotRepositories: []vzv1alpha1.OpenSearchSnapshotRepository{createTestSnapshotRepository()},
+		SnapshotPolicies:     []vzv1alpha1.OpenSearchSnapshotPolicy{createTestSnapshotPolicy()},
+	})
+	statuses, err := o.ConfigureSnapshots(vzlog.DefaultLogger(), newOpenSearchTestClient(), vz)
+	assert.NoError(t, err)
+	assert.Zero(t, policyRequests)
+	assert.Len(t, statuses, 1)
+	assert.False(t, statuses[0].Verified)
+	assert.Contains(t, statuses[0].Message, "Unknown repository type [s3]")
+}
+
+// TestSnapshotPolicyNeedsUpdate tests detecting snapshot policy changes
+// GIVEN an existing snapshot policy
+// WHEN the declared policy is compared to it
+// THEN an update is needed only when the policy has changed
+func TestSnapshotPolicyNeedsUpdate(t *testing.T) {
+	status := http.StatusOK
+	policy := createTestSnapshotPolicy()
+	existing := toSnapshotPolicy(policy)
+	existing.Status = &status
+	existing.Policy.Name = policy.Name
+	assert.False(t, snapshotPolicyNeedsUpdate(toSnapshotPolicy(policy), existing))
+
+	policy.Schedule = "0 3 * * *"
+	assert.True(t, snapshotPolicyNeedsUpdate(toSnapshotPolicy(policy), existing))
+
+	policy = createTestSnapshotPolicy()
+	policy.MaxAge = ""
+	policy.MaxCount = nil
+	assert.True(t, snapshotPolicyNeedsUpdate(toSnapshotPolicy(policy), existing))
+
+	notFound := http.StatusNotFound
+	assert.True(t, snapshotPolicyNeedsUpdate(toSnapshotPolicy(policy), &SnapshotPolicy{Status: &notFound}))
+}
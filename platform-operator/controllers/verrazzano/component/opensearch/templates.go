@@ -0,0 +1,296 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/verrazzano/pkg/diff"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+type (
+	IndexTemplateList struct {
+		IndexTemplates []NamedIndexTemplate `json:"index_templates"`
+	}
+
+	NamedIndexTemplate struct {
+		Name          string        `json:"name"`
+		IndexTemplate IndexTemplate `json:"index_template"`
+	}
+
+	IndexTemplate struct {
+		IndexPatterns []string               `json:"index_patterns"`
+		ComposedOf    []string               `json:"composed_of,omitempty"`
+		Priority      *int32                 `json:"priority,omitempty"`
+		DataStream    map[string]interface{} `json:"data_stream,omitempty"`
+		Template      interface{}            `json:"template,omitempty"`
+		Meta          TemplateMeta           `json:"_meta"`
+	}
+
+	ComponentTemplateList struct {
+		ComponentTemplates []NamedComponentTemplate `json:"component_templates"`
+	}
+
+	NamedComponentTemplate struct {
+		Name              string            `json:"name"`
+		ComponentTemplate ComponentTemplate `json:"component_template"`
+	}
+
+	ComponentTemplate struct {
+		Template interface{}  `json:"template"`
+		Meta     TemplateMeta `json:"_meta"`
+	}
+
+	// TemplateMeta identifies templates managed by the integration controller. The hash of the
+	// declared template is recorded so that changes can be detected without comparing the
+	// settings and mappings that OpenSearch normalizes.
+	TemplateMeta struct {
+		ManagedBy string `json:"managed_by,omitempty"`
+		SpecHash  string `json:"spec_hash,omitempty"`
+	}
+)
+
+const (
+	indexTemplateKind     = "index template"
+	componentTemplateKind = "component template"
+)
+
+// ConfigureTemplates creates or updates the component templates and index templates declared in the Verrazzano CR,
+// and deletes the Verrazzano-managed templates that are no longer declared.
+func (o *OSClient) ConfigureTemplates(log vzlog.VerrazzanoLogger, client clipkg.Client, vz *vzv1alpha1.Verrazzano) error {
+	if !*vz.Spec.Components.Elasticsearch.Enabled {
+		return nil
+	}
+	if !o.IsOpenSearchReady(client) {
+		return nil
+	}
+	opensearchEndpoint, err := GetOpenSearchHTTPEndpoint(client)
+	if err != nil {
+		return err
+	}
+	opensearch := vz.Spec.Components.Elasticsearch
+	// Component templates must exist before the index templates composed of them
+	for _, template := range opensearch.ComponentTemplates {
+		if err := o.createOrUpdateComponentTemplate(log, opensearchEndpoint, template); err != nil {
+			return err
+		}
+	}
+	for _, template := range opensearch.IndexTemplates {
+		if err := o.createOrUpdateIndexTemplate(log, opensearchEndpoint, template); err != nil {
+			return err
+		}
+	}
+	// Index templates must be deleted before the component templates they are composed of
+	if err := o.cleanupIndexTemplates(opensearchEndpoint, opensearch.IndexTemplates); err != nil {
+		return err
+	}
+	return o.cleanupComponentTemplates(opensearchEndpoint, opensearch.ComponentTemplates)
+}
+
+// createOrUpdateIndexTemplate creates an index template if it does not exist, else the template will be updated.
+// If the template already exists and matches the VZ template spec, no update will be issued
+func (o *OSClient) createOrUpdateIndexTemplate(log vzlog.VerrazzanoLogger, opensearchEndpoint string, template vzv1alpha1.OpenSearchIndexTemplate) error {
+	templateURL := fmt.Sprintf("%s/_index_template/%s", opensearchEndpoint, template.Name)
+	existingTemplates := &IndexTemplateList{}
+	found, err := o.getTemplate(templateURL, existingTemplates)
+	if err != nil {
+		return err
+	}
+	indexTemplate := toIndexTemplate(template)
+	if found && len(existingTemplates.IndexTemplates) > 0 &&
+		!indexTemplateNeedsUpdate(indexTemplate, &existingTemplates.IndexTemplates[0].IndexTemplate) {
+		return nil
+	}
+	log.Debugf("Creating or updating OpenSearch index template %s", template.Name)
+	return o.putTemplate(templateURL, indexTemplateKind, template.Name, indexTemplate)
+}
+
+// createOrUpdateComponentTemplate creates a component template if it does not exist, else the template will be updated.
+// If the template already exists and matches the VZ template spec, no update will be issued
+func (o *OSClient) createOrUpdateComponentTemplate(log vzlog.VerrazzanoLogger, opensearchEndpoint string, template vzv1alpha1.OpenSearchComponentTemplate) error {
+	templateURL := fmt.Sprintf("%s/_component_template/%s", opensearchEndpoint, template.Name)
+	existingTemplates := &ComponentTemplateList{}
+	found, err := o.getTemplate(templateURL, existingTemplates)
+	if err != nil {
+		return err
+	}
+	componentTemplate := toComponentTemplate(template)
+	if found && len(existingTemplates.ComponentTemplates) > 0 &&
+		!componentTemplateNeedsUpdate(componentTemplate, &existingTemplates.ComponentTemplates[0].ComponentTemplate) {
+		return nil
+	}
+	log.Debugf("Creating or updating OpenSearch component template %s", template.Name)
+	return o.putTemplate(templateURL, componentTemplateKind, template.Name, componentTemplate)
+}
+
+// getTemplate decodes the template at the given URL into templates, returning false if it does not exist
+func (o *OSClient) getTemplate(templateURL string, templates interface{}) (bool, error) {
+	req, err := http.NewRequest("GET", templateURL, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("got status code %d when fetching template %s", resp.StatusCode, templateURL)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(templates); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (o *OSClient) putTemplate(templateURL, kind, name string, template interface{}) error {
+	payload, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("PUT", templateURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(contentTypeHeader, applicationJSON)
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("got status code %d when updating %s %s, expected %d", resp.StatusCode, kind, name, http.StatusOK)
+	}
+	return nil
+}
+
+func (o *OSClient) cleanupIndexTemplates(opensearchEndpoint string, templates []vzv1alpha1.OpenSearchIndexTemplate) error {
+	existingTemplates := &IndexTemplateList{}
+	if _, err := o.getTemplate(fmt.Sprintf("%s/_index_template", opensearchEndpoint), existingTemplates); err != nil {
+		return err
+	}
+	expectedTemplateMap := map[string]bool{}
+	for _, template := range templates {
+		expectedTemplateMap[template.Name] = true
+	}
+	for _, template := range existingTemplates.IndexTemplates {
+		if template.IndexTemplate.Meta.ManagedBy == operatorManagedPolicy && !expectedTemplateMap[template.Name] {
+			if err := o.deleteTemplate(fmt.Sprintf("%s/_index_template/%s", opensearchEndpoint, template.Name), indexTemplateKind, template.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *OSClient) cleanupComponentTemplates(opensearchEndpoint string, templates []vzv1alpha1.OpenSearchComponentTemplate) error {
+	existingTemplates := &ComponentTemplateList{}
+	if _, err := o.getTemplate(fmt.Sprintf("%s/_component_template", opensearchEndpoint), existingTemplates); err != nil {
+		return err
+	}
+	expectedTemplateMap := map[string]bool{}
+	for _, template := range templates {
+		expectedTemplateMap[template.Name] = true
+	}
+	for _, template := range existingTemplates.ComponentTemplates {
+		if template.ComponentTemplate.Meta.ManagedBy == operatorManagedPolicy && !expectedTemplateMap[template.Name] {
+			if err := o.deleteTemplate(fmt.Sprintf("%s/_component_template/%s", opensearchEndpoint, template.Name), componentTemplateKind, template.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *OSClient) deleteTemplate(templateURL, kind, name string) error {
+	req, err := http.NewRequest("DELETE", templateURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.DoHTTP(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("got status code %d when deleting %s %s", resp.StatusCode, kind, name)
+	}
+	return nil
+}
+
+// indexTemplateNeedsUpdate returns true if the index template document has changed
+func indexTemplateNeedsUpdate(template *IndexTemplate, existingTemplate *IndexTemplate) bool {
+	return diff.Diff(template.IndexPatterns, existingTemplate.IndexPatterns) != "" ||
+		len(template.ComposedOf) != len(existingTemplate.ComposedOf) ||
+		(len(template.ComposedOf) > 0 && diff.Diff(template.ComposedOf, existingTemplate.ComposedOf) != "") ||
+		diff.Diff(template.Priority, existingTemplate.Priority) != "" ||
+		template.Meta != existingTemplate.Meta
+}
+
+// componentTemplateNeedsUpdate returns true if the component template document has changed
+func componentTemplateNeedsUpdate(template *ComponentTemplate, existingTemplate *ComponentTemplate) bool {
+	return template.Meta != existingTemplate.Meta
+}
+
+func toIndexTemplate(template vzv1alpha1.OpenSearchIndexTemplate) *IndexTemplate {
+	indexTemplate := &IndexTemplate{
+		IndexPatterns: template.IndexPatterns,
+		ComposedOf:    template.ComposedOf,
+		Priority:      template.Priority,
+		Template:      rawTemplate(template.Template),
+		Meta: TemplateMeta{
+			ManagedBy: operatorManagedPolicy,
+			SpecHash:  hashSum(template),
+		},
+	}
+	if template.DataStream {
+		indexTemplate.DataStream = map[string]interface{}{}
+	}
+	return indexTemplate
+}
+
+func toComponentTemplate(template vzv1alpha1.OpenSearchComponentTemplate) *ComponentTemplate {
+	componentTemplate := &ComponentTemplate{
+		Template: rawTemplate(template.Template),
+		Meta: TemplateMeta{
+			ManagedBy: operatorManagedPolicy,
+			SpecHash:  hashSum(template),
+		},
+	}
+	if componentTemplate.Template == nil {
+		componentTemplate.Template = map[string]interface{}{}
+	}
+	return componentTemplate
+}
+
+// rawTemplate returns the settings, mappings and aliases of a template as a JSON document
+func rawTemplate(template *apiextensionsv1.JSON) interface{} {
+	if template == nil || len(template.Raw) == 0 {
+		return nil
+	}
+	return json.RawMessage(template.Raw)
+}
+
+// hashSum returns the hash sum of the template spec
+func hashSum(spec interface{}) string {
+	sha := sha256.New()
+	if data, err := yaml.Marshal(spec); err == nil {
+		sha.Write(data)
+		return fmt.Sprintf("%x", sha.Sum(nil))
+	}
+	return ""
+}
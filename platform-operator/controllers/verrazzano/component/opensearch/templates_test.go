@@ -0,0 +1,206 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
+	vzv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+)
+
+const testTemplateNotFound = `{"error":{"type":"resource_not_found_exception","reason":"index template matching [app-logs] not found"},"status":404}`
+
+// newOpenSearchTestClient returns a client with a ready OpenSearch statefulset and the OpenSearch ingress
+func newOpenSearchTestClient() client.Client {
+	sts := &appv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "opensearch",
+			Namespace: constants.VerrazzanoLoggingNamespace,
+		},
+	}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "verrazzano-system",
+			Name:      "opensearch",
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "test",
+				},
+			},
+		},
+	}
+	return fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(sts, ingress).Build()
+}
+
+func newResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func createTestIndexTemplate() vzv1alpha1.OpenSearchIndexTemplate {
+	priority := int32(100)
+	return vzv1alpha1.OpenSearchIndexTemplate{
+		Name:          "app-logs",
+		IndexPatterns: []string{"app-logs-*"},
+		ComposedOf:    []string{"app-settings"},
+		Priority:      &priority,
+		DataStream:    true,
+	}
+}
+
+func createTestComponentTemplate() vzv1alpha1.OpenSearchComponentTemplate {
+	return vzv1alpha1.OpenSearchComponentTemplate{
+		Name:     "app-settings",
+		Template: &apiextensionsv1.JSON{Raw: []byte(`{"settings":{"number_of_replicas":1}}`)},
+	}
+}
+
+// existingIndexTemplates returns the index templates response for the given templates
+func existingIndexTemplates(t *testing.T, templates map[string]*IndexTemplate) string {
+	list := IndexTemplateList{}
+	for name, template := range templates {
+		list.IndexTemplates = append(list.IndexTemplates, NamedIndexTemplate{Name: name, IndexTemplate: *template})
+	}
+	data, err := json.Marshal(list)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+// TestConfigureTemplates tests creating, updating and deleting index and component templates
+// GIVEN a Verrazzano CR with index and component templates
+// WHEN ConfigureTemplates is called
+// THEN missing or changed templates are put, unchanged templates are left alone and
+// Verrazzano-managed templates that are no longer declared are deleted
+func TestConfigureTemplates(t *testing.T) {
+	indexTemplate := createTestIndexTemplate()
+	componentTemplate := createTestComponentTemplate()
+	stale := &IndexTemplate{IndexPatterns: []string{"old-*"}, Meta: TemplateMeta{ManagedBy: operatorManagedPolicy}}
+	unmanaged := &IndexTemplate{IndexPatterns: []string{"other-*"}}
+
+	var tests = []struct {
+		name            string
+		existingIndex   map[string]*IndexTemplate
+		expectedPuts    []string
+		expectedDeletes []string
+	}{
+		{
+			"templates are created when they do not exist",
+			map[string]*IndexTemplate{},
+			[]string{"/_component_template/app-settings", "/_index_template/app-logs"},
+			nil,
+		},
+		{
+			"unchanged templates are not updated and stale managed templates are deleted",
+			map[string]*IndexTemplate{
+				"app-logs": toIndexTemplate(indexTemplate),
+				"old":      stale,
+				"other":    unmanaged,
+			},
+			[]string{"/_component_template/app-settings"},
+			[]string{"/_index_template/old"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var puts, deletes []string
+			o := NewOSClient("abc")
+			o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+				path := request.URL.Path
+				switch request.Method {
+				case "GET":
+					if path == "/_index_template" {
+						return newResponse(http.StatusOK, existingIndexTemplates(t, tt.existingIndex)), nil
+					}
+					if path == "/_component_template" {
+						return newResponse(http.StatusOK, `{"component_templates":[]}`), nil
+					}
+					name := path[strings.LastIndex(path, "/")+1:]
+					if strings.HasPrefix(path, "/_index_template/") && tt.existingIndex[name] != nil {
+						return newResponse(http.StatusOK, existingIndexTemplates(t, map[string]*IndexTemplate{name: tt.existingIndex[name]})), nil
+					}
+					return newResponse(http.StatusNotFound, testTemplateNotFound), nil
+				case "PUT":
+					puts = append(puts, path)
+					return newResponse(http.StatusOK, `{"acknowledged":true}`), nil
+				case "DELETE":
+					deletes = append(deletes, path)
+					return newResponse(http.StatusOK, `{"acknowledged":true}`), nil
+				}
+				return newResponse(http.StatusBadRequest, ""), nil
+			}
+			vz := createVZ(&vzv1alpha1.ElasticsearchComponent{
+				IndexTemplates:     []vzv1alpha1.OpenSearchIndexTemplate{indexTemplate},
+				ComponentTemplates: []vzv1alpha1.OpenSearchComponentTemplate{componentTemplate},
+			})
+			err := o.ConfigureTemplates(vzlog.DefaultLogger(), newOpenSearchTestClient(), vz)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPuts, puts)
+			assert.Equal(t, tt.expectedDeletes, deletes)
+		})
+	}
+}
+
+// TestConfigureTemplatesFailure tests a failed template update
+// GIVEN OpenSearch that rejects a template
+// WHEN ConfigureTemplates is called
+// THEN an error is returned
+func TestConfigureTemplatesFailure(t *testing.T) {
+	o := NewOSClient("abc")
+	o.DoHTTP = func(request *http.Request) (*http.Response, error) {
+		if request.Method == "PUT" {
+			return newResponse(http.StatusBadRequest, `{"error":{"reason":"bad mapping"},"status":400}`), nil
+		}
+		return newResponse(http.StatusNotFound, testTemplateNotFound), nil
+	}
+	vz := createVZ(&vzv1alpha1.ElasticsearchComponent{
+		IndexTemplates: []vzv1alpha1.OpenSearchIndexTemplate{createTestIndexTemplate()},
+	})
+	err := o.ConfigureTemplates(vzlog.DefaultLogger(), newOpenSearchTestClient(), vz)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("got status code %d when updating index template app-logs", http.StatusBadRequest))
+}
+
+// TestIndexTemplateNeedsUpdate tests detecting index template changes
+// GIVEN an existing index template
+// WHEN the declared template is compared to it
+// THEN an update is needed only when the template has changed
+func TestIndexTemplateNeedsUpdate(t *testing.T) {
+	template := createTestIndexTemplate()
+	existing := toIndexTemplate(template)
+	assert.False(t, indexTemplateNeedsUpdate(toIndexTemplate(template), existing))
+
+	template.IndexPatterns = []string{"other-*"}
+	assert.True(t, indexTemplateNeedsUpdate(toIndexTemplate(template), existing))
+
+	template = createTestIndexTemplate()
+	template.Template = &apiextensionsv1.JSON{Raw: []byte(`{"settings":{"number_of_shards":2}}`)}
+	assert.True(t, indexTemplateNeedsUpdate(toIndexTemplate(template), existing))
+
+	template = createTestIndexTemplate()
+	template.ComposedOf = nil
+	existing.ComposedOf = []string{}
+	existing.Meta = toIndexTemplate(template).Meta
+	assert.False(t, indexTemplateNeedsUpdate(toIndexTemplate(template), existing))
+}
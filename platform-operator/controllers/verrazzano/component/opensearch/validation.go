@@ -1,10 +1,12 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearch
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 )
 
@@ -36,8 +38,13 @@ func validateNoDuplicatedConfiguration(vz *v1beta1.Verrazzano) error {
 	}
 	opensearch := vz.Spec.Components.OpenSearch
 
-	return validateNoDuplicateNodeGroups(opensearch)
-
+	if err := validateNoDuplicateNodeGroups(opensearch); err != nil {
+		return err
+	}
+	if err := validateTemplates(opensearch); err != nil {
+		return err
+	}
+	return validateSnapshots(opensearch)
 }
 
 // validateNoDuplicateNodeGroups rejects Nodes with duplicated group names
@@ -50,3 +57,54 @@ func validateNoDuplicateNodeGroups(opensearch *v1beta1.OpenSearchComponent) erro
 	}
 	return nil
 }
+
+// validateTemplates rejects index and component templates with duplicated names or missing required fields
+func validateTemplates(opensearch *v1beta1.OpenSearchComponent) error {
+	tracker := newTracker()
+	for _, template := range opensearch.IndexTemplates {
+		if err := tracker.add(template.Name); err != nil || template.Name == "" {
+			return fmt.Errorf("OpenSearch index template name is duplicated or invalid: %v", err)
+		}
+		if len(template.IndexPatterns) == 0 {
+			return fmt.Errorf("OpenSearch index template %s must specify at least one index pattern", template.Name)
+		}
+	}
+	tracker = newTracker()
+	for _, template := range opensearch.ComponentTemplates {
+		if err := tracker.add(template.Name); err != nil || template.Name == "" {
+			return fmt.Errorf("OpenSearch component template name is duplicated or invalid: %v", err)
+		}
+		if template.Template == nil {
+			return fmt.Errorf("OpenSearch component template %s must specify a template", template.Name)
+		}
+	}
+	return nil
+}
+
+// validateSnapshots rejects snapshot repositories and policies with duplicated names or missing required fields,
+// and snapshot policies that do not refer to a declared snapshot repository
+func validateSnapshots(opensearch *v1beta1.OpenSearchComponent) error {
+	tracker := newTracker()
+	for _, repository := range opensearch.SnapshotRepositories {
+		if err := tracker.add(repository.Name); err != nil || repository.Name == "" {
+			return fmt.Errorf("OpenSearch snapshot repository name is duplicated or invalid: %v", err)
+		}
+		if repository.S3 == nil || repository.S3.Bucket == "" || repository.S3.CredentialsSecret == "" {
+			return fmt.Errorf("OpenSearch snapshot repository %s must specify an S3 bucket and credentials secret", repository.Name)
+		}
+	}
+	repositories := tracker
+	tracker = newTracker()
+	for _, policy := range opensearch.SnapshotPolicies {
+		if err := tracker.add(policy.Name); err != nil || policy.Name == "" {
+			return fmt.Errorf("OpenSearch snapshot policy name is duplicated or invalid: %v", err)
+		}
+		if !repositories.set[policy.Repository] {
+			return fmt.Errorf("OpenSearch snapshot policy %s refers to snapshot repository %s, which is not declared", policy.Name, policy.Repository)
+		}
+		if len(strings.Fields(policy.Schedule)) != 5 {
+			return fmt.Errorf("OpenSearch snapshot policy %s schedule %q must be a cron expression with five fields", policy.Name, policy.Schedule)
+		}
+	}
+	return nil
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearch
@@ -100,3 +100,84 @@ func TestValidateNoDuplicatedConfiguration(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateTemplatesAndSnapshots tests validation of index templates, component templates, snapshot repositories
+// and snapshot policies
+// GIVEN a Verrazzano CR
+// WHEN validateNoDuplicatedConfiguration is called
+// THEN an error is returned for duplicated names, missing required fields and undeclared repositories
+func TestValidateTemplatesAndSnapshots(t *testing.T) {
+	withSnapshots := func(modify func(opensearch *vzapi.ElasticsearchComponent)) *vzapi.Verrazzano {
+		opensearch := &vzapi.ElasticsearchComponent{
+			IndexTemplates:       []vzapi.OpenSearchIndexTemplate{createTestIndexTemplate()},
+			ComponentTemplates:   []vzapi.OpenSearchComponentTemplate{createTestComponentTemplate()},
+			SnapshotRepositories: []vzapi.OpenSearchSnapshotRepository{createTestSnapshotRepository()},
+			SnapshotPolicies:     []vzapi.OpenSearchSnapshotPolicy{createTestSnapshotPolicy()},
+		}
+		modify(opensearch)
+		return createVZ(opensearch)
+	}
+	var tests = []struct {
+		name     string
+		vz       *vzapi.Verrazzano
+		hasError bool
+	}{
+		{
+			"valid templates and snapshots",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {}),
+			false,
+		},
+		{
+			"duplicated index template",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.IndexTemplates = append(opensearch.IndexTemplates, createTestIndexTemplate())
+			}),
+			true,
+		},
+		{
+			"index template without index patterns",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.IndexTemplates[0].IndexPatterns = nil
+			}),
+			true,
+		},
+		{
+			"component template without template",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.ComponentTemplates[0].Template = nil
+			}),
+			true,
+		},
+		{
+			"snapshot repository without credentials",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.SnapshotRepositories[0].S3.CredentialsSecret = ""
+			}),
+			true,
+		},
+		{
+			"snapshot policy with undeclared repository",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.SnapshotPolicies[0].Repository = "missing"
+			}),
+			true,
+		},
+		{
+			"snapshot policy with invalid schedule",
+			withSnapshots(func(opensearch *vzapi.ElasticsearchComponent) {
+				opensearch.SnapshotPolicies[0].Schedule = "daily"
+			}),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v1beta1vz := &v1beta1.Verrazzano{}
+			assert.NoError(t, tt.vz.ConvertTo(v1beta1vz))
+			if err := validateNoDuplicatedConfiguration(v1beta1vz); (err != nil) != tt.hasError {
+				t.Errorf("validateNoDuplicatedConfiguration() error = %v, hasError: %v", err, tt.hasError)
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package opensearchoperator
@@ -15,6 +15,7 @@ import (
 	installv1beta1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	"github.com/verrazzano/verrazzano/platform-operator/internal/vzconfig"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -97,6 +98,10 @@ func appendOverrides(ctx spi.ComponentContext, _ string, _ string, _ string, kvs
 		Key:   "clusterResourceNamespace",
 		Value: clusterResourceNamespace,
 	})
+	kvs = append(kvs, bom.KeyValue{
+		Key:   "multitenancy.enabled",
+		Value: strconv.FormatBool(vzcr.IsOpenSearchDashboardsMultitenancyEnabled(ctx.EffectiveCR())),
+	})
 	return kvs, nil
 }
 
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operator
@@ -55,5 +55,6 @@ func (c prometheusComponent) GetWatchDescriptors() []controllerspi.WatchDescript
 	return watch.CombineWatchDescriptors(
 		watch.GetModuleInstalledWatches([]string{nginx.ComponentName, common.IstioComponentName, appoper.ComponentName, clusteroperator.ComponentName, cmconstants.ClusterIssuerComponentName, vmo.ComponentName}),
 		watch.GetModuleUpdatedWatches([]string{nginx.ComponentName, cmconstants.ClusterIssuerComponentName, vmo.ComponentName}),
+		common.GetThanosCredentialsSecretWatch(),
 	)
 }
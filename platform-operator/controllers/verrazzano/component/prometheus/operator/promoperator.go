@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operator
@@ -299,6 +299,11 @@ func AppendOverrides(ctx spi.ComponentContext, _ string, _ string, _ string, kvs
 		return kvs, err
 	}
 
+	kvs, err = appendThanosStorageOverrides(ctx, kvs)
+	if err != nil {
+		return kvs, err
+	}
+
 	// If the cert-manager component is enabled, use it for webhook certificates, otherwise Prometheus Operator
 	// will use the kube-webhook-certgen image
 	kvs = append(kvs, bom.KeyValue{
@@ -441,6 +446,28 @@ func appendDefaultImageOverrides(ctx spi.ComponentContext, kvs []bom.KeyValue, s
 	return kvs, nil
 }
 
+// appendThanosStorageOverrides configures the Prometheus Thanos sidecar to upload the metrics to the Thanos object
+// storage, if Thanos is enabled with an object storage. Otherwise, the object storage secret left by a previous
+// configuration is deleted, so that the copied credentials do not remain when Thanos is disabled.
+func appendThanosStorageOverrides(ctx spi.ComponentContext, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+	storage := common.GetThanosStorage(ctx.EffectiveCR())
+	if storage == nil {
+		if isInstallOrUpdate(ctx) {
+			return kvs, common.DeleteThanosObjstoreSecret(ctx)
+		}
+		return kvs, nil
+	}
+	// The Thanos component also writes the object storage secret, it is written here as well since the Prometheus
+	// Operator is installed before Thanos
+	if err := common.CreateOrUpdateThanosObjstoreSecret(ctx, storage); err != nil {
+		return kvs, err
+	}
+	return append(kvs, []bom.KeyValue{
+		{Key: "prometheus.prometheusSpec.thanos.objectStorageConfig.name", Value: common.ThanosObjstoreSecretName},
+		{Key: "prometheus.prometheusSpec.thanos.objectStorageConfig.key", Value: common.ThanosObjstoreKey},
+	}...), nil
+}
+
 // appendThanosImageOverrides appends overrides for the Thanos image in the Prometheus prometheusSpec and
 // the default base image in prometheusOperator
 func appendThanosImageOverrides(ctx spi.ComponentContext, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
@@ -854,3 +881,9 @@ func reconcileAlertmanagerIngress(ctx spi.ComponentContext) error {
 
 	return nil
 }
+
+// isInstallOrUpdate returns true if the component is being installed or updated
+func isInstallOrUpdate(ctx spi.ComponentContext) bool {
+	operationType := ctx.Init(ComponentName).GetOperation()
+	return operationType == constants.InstallOperation || operationType == constants.UpdateOperation
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operator
@@ -254,7 +254,10 @@ func checkExistingCNEPrometheus(vz runtime.Object) error {
 
 // PostUninstall is the Prometheus Operator PostInstall SPI function
 func (c prometheusComponent) PostUninstall(ctx spi.ComponentContext) error {
-	return deletePrometheusStackIngresses(ctx)
+	if err := deletePrometheusStackIngresses(ctx); err != nil {
+		return err
+	}
+	return common.DeleteThanosObjstoreSecret(ctx)
 }
 
 // deletePrometheusStackIngresses deletes Prometheus and Alertmanager ingresses
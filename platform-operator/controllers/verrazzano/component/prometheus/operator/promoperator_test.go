@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operator
@@ -1551,3 +1551,79 @@ func TestAppendPrometheusRuleOverrides(t *testing.T) {
 		})
 	}
 }
+
+// TestAppendThanosStorageOverrides tests the appendThanosStorageOverrides function.
+func TestAppendThanosStorageOverrides(t *testing.T) {
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoInstallNamespace, Name: "object-storage"},
+		Data:       map[string][]byte{"access_key": []byte("key"), "secret_key": []byte("secret")},
+	}
+	storage := &vzapi.ThanosStorage{S3: &vzapi.ThanosS3Storage{
+		Bucket:            "thanos-metrics",
+		Endpoint:          "https://objectstorage.example.com",
+		CredentialsSecret: "object-storage",
+	}}
+	tests := []struct {
+		name            string
+		thanos          *vzapi.ThanosComponent
+		expectOverrides []bom.KeyValue
+	}{
+		{
+			// GIVEN a VZ CR with Thanos enabled without object storage
+			// WHEN the appendThanosStorageOverrides function is called
+			// THEN no key/value overrides are returned
+			name:   "Thanos without object storage",
+			thanos: &vzapi.ThanosComponent{Enabled: &trueValue},
+		},
+		{
+			// GIVEN a VZ CR with Thanos disabled and an object storage
+			// WHEN the appendThanosStorageOverrides function is called
+			// THEN no key/value overrides are returned
+			name:   "Thanos disabled",
+			thanos: &vzapi.ThanosComponent{Enabled: &falseValue, Storage: storage},
+		},
+		{
+			// GIVEN a VZ CR with Thanos enabled with an object storage
+			// WHEN the appendThanosStorageOverrides function is called
+			// THEN the Thanos sidecar is configured to upload the metrics to the object storage
+			name:   "Thanos with object storage",
+			thanos: &vzapi.ThanosComponent{Enabled: &trueValue, Storage: storage},
+			expectOverrides: []bom.KeyValue{
+				{Key: "prometheus.prometheusSpec.thanos.objectStorageConfig.name", Value: common.ThanosObjstoreSecretName},
+				{Key: "prometheus.prometheusSpec.thanos.objectStorageConfig.key", Value: common.ThanosObjstoreKey},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(credentials.DeepCopy()).Build()
+			vz := &vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{Thanos: tt.thanos}}}
+			ctx := spi.NewFakeContext(client, vz, nil, false)
+
+			kvs, err := appendThanosStorageOverrides(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectOverrides, kvs)
+
+			secret := &corev1.Secret{}
+			err = client.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}, secret)
+			assert.Equal(t, tt.expectOverrides == nil, errors.IsNotFound(err))
+		})
+	}
+}
+
+// TestAppendThanosStorageOverridesDisabled tests the appendThanosStorageOverrides function when Thanos is disabled
+// GIVEN a VZ CR with Thanos disabled and the object storage secret left by a previous configuration
+// WHEN the appendThanosStorageOverrides function is called during an update
+// THEN no key/value overrides are returned and the object storage secret is deleted
+func TestAppendThanosStorageOverridesDisabled(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}}
+	client := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(secret).Build()
+	vz := &vzapi.Verrazzano{Spec: vzapi.VerrazzanoSpec{Components: vzapi.ComponentSpec{Thanos: &vzapi.ThanosComponent{Enabled: &falseValue}}}}
+	ctx := spi.NewFakeContext(client, vz, nil, false).Init(ComponentName).Operation(constants.UpdateOperation)
+
+	kvs, err := appendThanosStorageOverrides(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, kvs)
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}, secret)
+	assert.True(t, errors.IsNotFound(err))
+}
@@ -1,21 +1,47 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package thanos
 
 import (
 	"github.com/verrazzano/verrazzano-modules/pkg/controller/spi/controllerspi"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common/watch"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/fluentoperator"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/nginx"
 	promoperator "github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/prometheus/operator"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
+// valuesConfig Structure for the translated effective Verrazzano CR values to Module CR Helm values
+type valuesConfig struct {
+	Storage                *vzapi.ThanosStorage        `json:"storage,omitempty"`
+	Retention              *vzapi.ThanosRetention      `json:"retention,omitempty"`
+	StoreGatewayPartitions []vzapi.ThanosTimePartition `json:"storeGatewayPartitions,omitempty"`
+}
+
+// GetModuleConfigAsHelmValues returns an unstructured JSON valuesConfig representing the portion of the Verrazzano CR that corresponds to the module
+func (c ThanosComponent) GetModuleConfigAsHelmValues(effectiveCR *vzapi.Verrazzano) (*apiextensionsv1.JSON, error) {
+	if effectiveCR == nil || effectiveCR.Spec.Components.Thanos == nil {
+		return nil, nil
+	}
+
+	thanos := effectiveCR.Spec.Components.Thanos
+	configSnippet := valuesConfig{
+		Storage:                thanos.Storage,
+		Retention:              thanos.Retention,
+		StoreGatewayPartitions: thanos.StoreGatewayPartitions,
+	}
+	return spi.NewModuleConfigHelmValuesWrapper(configSnippet)
+}
+
 // GetWatchDescriptors returns the list of WatchDescriptors for objects being watched by the component
 func (c ThanosComponent) GetWatchDescriptors() []controllerspi.WatchDescriptor {
 	return watch.CombineWatchDescriptors(
 		watch.GetModuleInstalledWatches([]string{common.IstioComponentName, nginx.ComponentName, promoperator.ComponentName, fluentoperator.ComponentName}),
 		watch.GetModuleUpdatedWatches([]string{nginx.ComponentName, common.IstioComponentName, promoperator.ComponentName}),
+		common.GetThanosCredentialsSecretWatch(),
 	)
 }
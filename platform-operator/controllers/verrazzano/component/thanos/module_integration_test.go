@@ -0,0 +1,38 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package thanos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+)
+
+// TestGetModuleSpec tests the GetModuleConfigAsHelmValues function impl for this component
+// GIVEN a call to GetModuleConfigAsHelmValues
+//
+//	WHEN for various Verrazzano CR configurations
+//	THEN the generated helm values JSON snippet holds the Thanos object storage settings
+func TestGetModuleSpec(t *testing.T) {
+	c := NewComponent()
+
+	got, err := c.GetModuleConfigAsHelmValues(&vzapi.Verrazzano{})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = c.GetModuleConfigAsHelmValues(newStorageVerrazzano())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+	  "verrazzano": {
+		"module": {
+		  "spec": {
+			"storage": {"s3": {"bucket": "thanos-metrics", "endpoint": "https://objectstorage.example.com", "credentialsSecret": "object-storage"}},
+			"retention": {"raw": "30d", "oneHour": "0d"},
+			"storeGatewayPartitions": [{"maxTime": "-2w"}, {"minTime": "-2w"}]
+		  }
+		}
+	  }
+	}`, string(got.Raw))
+}
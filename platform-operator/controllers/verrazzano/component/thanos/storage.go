@@ -0,0 +1,145 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package thanos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/pkg/vzcr"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	vzconst "github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// appendStorageOverrides renders the object storage of the Thanos metrics into the Thanos Compactor and Store Gateway
+// configuration. The object storage configuration is written to a secret that is also read by the Prometheus Thanos
+// sidecar.
+func appendStorageOverrides(ctx spi.ComponentContext, kvs []bom.KeyValue) ([]bom.KeyValue, error) {
+	storage := common.GetThanosStorage(ctx.EffectiveCR())
+	if storage == nil {
+		if isInstallOrUpdate(ctx) {
+			return kvs, common.DeleteThanosObjstoreSecret(ctx)
+		}
+		return kvs, nil
+	}
+	if err := common.CreateOrUpdateThanosObjstoreSecret(ctx, storage); err != nil {
+		return kvs, err
+	}
+	kvs = append(kvs, []bom.KeyValue{
+		{Key: "existingObjstoreSecret", Value: common.ThanosObjstoreSecretName},
+		{Key: "compactor.enabled", Value: "true"},
+		{Key: "storegateway.enabled", Value: "true"},
+	}...)
+
+	thanos := ctx.EffectiveCR().Spec.Components.Thanos
+	if retention := thanos.Retention; retention != nil {
+		resolutions := []struct {
+			key   string
+			value string
+		}{
+			{key: "compactor.retentionResolutionRaw", value: retention.Raw},
+			{key: "compactor.retentionResolution5m", value: retention.FiveMinutes},
+			{key: "compactor.retentionResolution1h", value: retention.OneHour},
+		}
+		for _, resolution := range resolutions {
+			if len(resolution.value) > 0 {
+				kvs = append(kvs, bom.KeyValue{Key: resolution.key, Value: resolution.value, SetString: true})
+			}
+		}
+	}
+
+	if len(thanos.StoreGatewayPartitions) > 0 {
+		kvs = append(kvs, bom.KeyValue{Key: "storegateway.sharded.enabled", Value: "true"})
+		for i, partition := range thanos.StoreGatewayPartitions {
+			prefix := fmt.Sprintf("storegateway.sharded.timePartitioning[%d]", i)
+			kvs = append(kvs, []bom.KeyValue{
+				{Key: prefix + ".min", Value: partition.MinTime, SetString: true},
+				{Key: prefix + ".max", Value: partition.MaxTime, SetString: true},
+			}...)
+		}
+	}
+	return kvs, nil
+}
+
+// getStoreGatewayShards returns the names of the Store Gateway statefulsets deployed for the time partitions
+func getStoreGatewayShards(ctx spi.ComponentContext) []types.NamespacedName {
+	var shards []types.NamespacedName
+	if common.GetThanosStorage(ctx.EffectiveCR()) == nil {
+		return shards
+	}
+	for i := range ctx.EffectiveCR().Spec.Components.Thanos.StoreGatewayPartitions {
+		shards = append(shards, types.NamespacedName{
+			Name:      fmt.Sprintf("%s-%d", storeGatewayStatefulset, i),
+			Namespace: ComponentNamespace,
+		})
+	}
+	return shards
+}
+
+// validateStorage validates the object storage, retention and Store Gateway partitions of the Thanos component
+func validateStorage(vz *v1beta1.Verrazzano) error {
+	thanos := vz.Spec.Components.Thanos
+	if thanos == nil || !vzcr.IsThanosEnabled(vz) {
+		return nil
+	}
+	if thanos.Storage == nil {
+		if thanos.Retention != nil || len(thanos.StoreGatewayPartitions) > 0 {
+			return fmt.Errorf("Thanos retention and Store Gateway partitions require the Thanos object storage")
+		}
+		return nil
+	}
+
+	s3 := thanos.Storage.S3
+	if s3 == nil {
+		return fmt.Errorf("Thanos object storage must specify an S3 object storage")
+	}
+	if len(s3.Bucket) == 0 || len(s3.Endpoint) == 0 || len(s3.CredentialsSecret) == 0 {
+		return fmt.Errorf("Thanos S3 object storage must specify a bucket, an endpoint and a credentials secret")
+	}
+	if _, _, err := common.ParseThanosS3Endpoint(s3.Endpoint); err != nil {
+		return fmt.Errorf("Thanos S3 object storage endpoint %s is invalid: %v", s3.Endpoint, err)
+	}
+
+	if retention := thanos.Retention; retention != nil {
+		for _, value := range []string{retention.Raw, retention.FiveMinutes, retention.OneHour} {
+			if _, err := model.ParseDuration(value); len(value) > 0 && err != nil {
+				return fmt.Errorf("Thanos retention %s is not a valid duration: %v", value, err)
+			}
+		}
+	}
+
+	for i, partition := range thanos.StoreGatewayPartitions {
+		if len(partition.MinTime) == 0 && len(partition.MaxTime) == 0 && len(thanos.StoreGatewayPartitions) > 1 {
+			return fmt.Errorf("Thanos Store Gateway partition %d must specify a minimum or a maximum time", i)
+		}
+		for _, value := range []string{partition.MinTime, partition.MaxTime} {
+			if len(value) > 0 && !isTimeOrDuration(value) {
+				return fmt.Errorf("Thanos Store Gateway partition %d time %s is neither an RFC 3339 time nor a relative duration", i, value)
+			}
+		}
+	}
+	return nil
+}
+
+// isTimeOrDuration returns true if the value is an RFC 3339 time, or a duration relative to the current time such as
+// -2w, as accepted by the Thanos Store Gateway time flags
+func isTimeOrDuration(value string) bool {
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return true
+	}
+	_, err := model.ParseDuration(strings.TrimPrefix(value, "-"))
+	return err == nil
+}
+
+// isInstallOrUpdate returns true if the component is being installed or updated
+func isInstallOrUpdate(ctx spi.ComponentContext) bool {
+	operationType := ctx.Init(ComponentName).GetOperation()
+	return operationType == vzconst.InstallOperation || operationType == vzconst.UpdateOperation
+}
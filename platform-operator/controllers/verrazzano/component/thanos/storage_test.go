@@ -0,0 +1,200 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package thanos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/platform-operator/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testStorageCredentials = "object-storage"
+
+// TestAppendStorageOverrides tests the rendering of the Thanos object storage
+// GIVEN a Verrazzano CR with a Thanos object storage, retention and Store Gateway partitions
+// WHEN appendStorageOverrides is called
+// THEN the Compactor and Store Gateway overrides are returned, and the object storage secret is created
+func TestAppendStorageOverrides(t *testing.T) {
+	credentials := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: constants.VerrazzanoInstallNamespace, Name: testStorageCredentials},
+		Data:       map[string][]byte{"access_key": []byte("key"), "secret_key": []byte("secret")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(credentials).Build()
+	ctx := spi.NewFakeContext(fakeClient, newStorageVerrazzano(), nil, false).Init(ComponentName).Operation(constants.InstallOperation)
+
+	kvs, err := appendStorageOverrides(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []bom.KeyValue{
+		{Key: "existingObjstoreSecret", Value: common.ThanosObjstoreSecretName},
+		{Key: "compactor.enabled", Value: "true"},
+		{Key: "storegateway.enabled", Value: "true"},
+		{Key: "compactor.retentionResolutionRaw", Value: "30d", SetString: true},
+		{Key: "compactor.retentionResolution1h", Value: "0d", SetString: true},
+		{Key: "storegateway.sharded.enabled", Value: "true"},
+		{Key: "storegateway.sharded.timePartitioning[0].min", Value: "", SetString: true},
+		{Key: "storegateway.sharded.timePartitioning[0].max", Value: "-2w", SetString: true},
+		{Key: "storegateway.sharded.timePartitioning[1].min", Value: "-2w", SetString: true},
+		{Key: "storegateway.sharded.timePartitioning[1].max", Value: "", SetString: true},
+	}, kvs)
+
+	secret := &v1.Secret{}
+	assert.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}, secret))
+	assert.Contains(t, string(secret.Data[common.ThanosObjstoreKey]), "bucket: thanos-metrics")
+
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: ComponentNamespace, Name: "thanos-storegateway-0"},
+		{Namespace: ComponentNamespace, Name: "thanos-storegateway-1"},
+	}, getStoreGatewayShards(ctx))
+}
+
+// TestAppendStorageOverridesDisabled tests the Thanos overrides without object storage
+// GIVEN a Verrazzano CR without a Thanos object storage and a leftover object storage secret
+// WHEN appendStorageOverrides is called
+// THEN no overrides are returned and the object storage secret is deleted
+func TestAppendStorageOverridesDisabled(t *testing.T) {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}}
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(secret).Build()
+	ctx := spi.NewFakeContext(fakeClient, &v1alpha1.Verrazzano{}, nil, false).Init(ComponentName).Operation(constants.UpdateOperation)
+
+	kvs, err := appendStorageOverrides(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, kvs)
+	assert.Empty(t, getStoreGatewayShards(ctx))
+	err = fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), secret)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+// TestAppendStorageOverridesMissingCredentials tests the Thanos object storage without the credentials secret
+// GIVEN a Verrazzano CR with a Thanos object storage
+// WHEN appendStorageOverrides is called and the credentials secret does not exist
+// THEN an error is returned
+func TestAppendStorageOverridesMissingCredentials(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).Build()
+	ctx := spi.NewFakeContext(fakeClient, newStorageVerrazzano(), nil, false).Init(ComponentName).Operation(constants.InstallOperation)
+
+	_, err := appendStorageOverrides(ctx, nil)
+	assert.Error(t, err)
+}
+
+// TestValidateStorage tests the validation of the Thanos object storage
+// GIVEN Verrazzano CRs with valid and invalid Thanos object storage settings
+// WHEN the Thanos component validates them
+// THEN only the invalid settings are rejected
+func TestValidateStorage(t *testing.T) {
+	trueVal := true
+	newVZ := func(thanos v1beta1.ThanosComponent) *v1beta1.Verrazzano {
+		thanos.Enabled = &trueVal
+		return &v1beta1.Verrazzano{Spec: v1beta1.VerrazzanoSpec{Components: v1beta1.ComponentSpec{Thanos: &thanos}}}
+	}
+	s3 := &v1beta1.ThanosS3Storage{Bucket: "thanos-metrics", Endpoint: "https://objectstorage.example.com", CredentialsSecret: testStorageCredentials}
+
+	tests := []struct {
+		name    string
+		vz      *v1beta1.Verrazzano
+		wantErr string
+	}{
+		{
+			name: "no storage",
+			vz:   newVZ(v1beta1.ThanosComponent{}),
+		},
+		{
+			name: "valid storage",
+			vz: newVZ(v1beta1.ThanosComponent{
+				Storage:                &v1beta1.ThanosStorage{S3: s3},
+				Retention:              &v1beta1.ThanosRetention{Raw: "30d", FiveMinutes: "6w", OneHour: "0d"},
+				StoreGatewayPartitions: []v1beta1.ThanosTimePartition{{MaxTime: "-2w"}, {MinTime: "-2w", MaxTime: "2026-01-01T00:00:00Z"}},
+			}),
+		},
+		{
+			name:    "retention without storage",
+			vz:      newVZ(v1beta1.ThanosComponent{Retention: &v1beta1.ThanosRetention{Raw: "30d"}}),
+			wantErr: "Thanos retention and Store Gateway partitions require the Thanos object storage",
+		},
+		{
+			name:    "no S3 storage",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{}}),
+			wantErr: "Thanos object storage must specify an S3 object storage",
+		},
+		{
+			name:    "no bucket",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{S3: &v1beta1.ThanosS3Storage{Endpoint: s3.Endpoint, CredentialsSecret: testStorageCredentials}}}),
+			wantErr: "Thanos S3 object storage must specify a bucket, an endpoint and a credentials secret",
+		},
+		{
+			name:    "invalid endpoint",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{S3: &v1beta1.ThanosS3Storage{Bucket: s3.Bucket, Endpoint: "ftp://objectstorage.example.com", CredentialsSecret: testStorageCredentials}}}),
+			wantErr: "Thanos S3 object storage endpoint ftp://objectstorage.example.com is invalid: unsupported scheme ftp",
+		},
+		{
+			name:    "invalid retention",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{S3: s3}, Retention: &v1beta1.ThanosRetention{FiveMinutes: "30 days"}}),
+			wantErr: "Thanos retention 30 days is not a valid duration",
+		},
+		{
+			name:    "unbounded partition",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{S3: s3}, StoreGatewayPartitions: []v1beta1.ThanosTimePartition{{MaxTime: "-2w"}, {}}}),
+			wantErr: "Thanos Store Gateway partition 1 must specify a minimum or a maximum time",
+		},
+		{
+			name:    "invalid partition time",
+			vz:      newVZ(v1beta1.ThanosComponent{Storage: &v1beta1.ThanosStorage{S3: s3}, StoreGatewayPartitions: []v1beta1.ThanosTimePartition{{MaxTime: "two weeks ago"}}}),
+			wantErr: "Thanos Store Gateway partition 0 time two weeks ago is neither an RFC 3339 time nor a relative duration",
+		},
+	}
+	c := NewComponent()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := []error{
+				c.ValidateInstallV1Beta1(tt.vz),
+				c.ValidateUpdateV1Beta1(&v1beta1.Verrazzano{}, tt.vz),
+			}
+			vz := &v1alpha1.Verrazzano{}
+			assert.NoError(t, vz.ConvertFrom(tt.vz))
+			errs = append(errs, c.ValidateInstall(vz), c.ValidateUpdate(&v1alpha1.Verrazzano{}, vz))
+			for _, err := range errs {
+				if len(tt.wantErr) == 0 {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func newStorageVerrazzano() *v1alpha1.Verrazzano {
+	trueVal := true
+	return &v1alpha1.Verrazzano{
+		Spec: v1alpha1.VerrazzanoSpec{
+			Components: v1alpha1.ComponentSpec{
+				Thanos: &v1alpha1.ThanosComponent{
+					Enabled: &trueVal,
+					Storage: &v1alpha1.ThanosStorage{
+						S3: &v1alpha1.ThanosS3Storage{
+							Bucket:            "thanos-metrics",
+							Endpoint:          "https://objectstorage.example.com",
+							CredentialsSecret: testStorageCredentials,
+						},
+					},
+					Retention:              &v1alpha1.ThanosRetention{Raw: "30d", OneHour: "0d"},
+					StoreGatewayPartitions: []v1alpha1.ThanosTimePartition{{MaxTime: "-2w"}, {MinTime: "-2w"}},
+				},
+			},
+		},
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package thanos
@@ -68,6 +68,11 @@ func AppendOverrides(ctx spi.ComponentContext, _ string, _ string, _ string, kvs
 
 	kvs = appendVerrazzanoOverrides(ctx, kvs)
 
+	kvs, err = appendStorageOverrides(ctx, kvs)
+	if err != nil {
+		return kvs, err
+	}
+
 	kvs, err = appendReloaderSidecarOverrides(ctx, kvs, bomFile)
 	if err != nil {
 		return kvs, ctx.Log().ErrorfNewErr("Failed to build Thanos sidecar overrides from the Verrazzano BOM: %v", err)
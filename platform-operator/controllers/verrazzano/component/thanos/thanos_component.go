@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package thanos
@@ -10,6 +10,7 @@ import (
 	"github.com/verrazzano/verrazzano/pkg/k8s/ready"
 	"github.com/verrazzano/verrazzano/pkg/vzcr"
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/authproxy"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
@@ -123,7 +124,9 @@ func (c ThanosComponent) getEnabledDeployments(ctx spi.ComponentContext) []types
 
 func (c ThanosComponent) getEnabledStatefulsets(ctx spi.ComponentContext) []types.NamespacedName {
 	enabledStatefulsets := []types.NamespacedName{}
-	for _, stsName := range c.AvailabilityObjects.StatefulsetNames {
+	// The Store Gateway is deployed as one statefulset per time partition when it is partitioned
+	statefulsets := append(append([]types.NamespacedName{}, c.AvailabilityObjects.StatefulsetNames...), getStoreGatewayShards(ctx)...)
+	for _, stsName := range statefulsets {
 		if exists, err := ready.DoesStatefulsetExist(ctx.Client(), stsName); err == nil && exists {
 			enabledStatefulsets = append(enabledStatefulsets, stsName)
 		}
@@ -136,6 +139,46 @@ func (c ThanosComponent) IsEnabled(effectiveCR runtime.Object) bool {
 	return vzcr.IsThanosEnabled(effectiveCR)
 }
 
+// ValidateInstall checks if the specified Verrazzano CR is valid for this component to be installed
+func (c ThanosComponent) ValidateInstall(vz *v1alpha1.Verrazzano) error {
+	convertedVZ := v1beta1.Verrazzano{}
+	if err := common.ConvertVerrazzanoCR(vz, &convertedVZ); err != nil {
+		return err
+	}
+	if err := validateStorage(&convertedVZ); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstall(vz)
+}
+
+// ValidateUpdate checks if the specified new Verrazzano CR is valid for this component to be updated
+func (c ThanosComponent) ValidateUpdate(old *v1alpha1.Verrazzano, new *v1alpha1.Verrazzano) error {
+	convertedVZ := v1beta1.Verrazzano{}
+	if err := common.ConvertVerrazzanoCR(new, &convertedVZ); err != nil {
+		return err
+	}
+	if err := validateStorage(&convertedVZ); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateUpdate(old, new)
+}
+
+// ValidateInstallV1Beta1 checks if the specified Verrazzano CR is valid for this component to be installed
+func (c ThanosComponent) ValidateInstallV1Beta1(vz *v1beta1.Verrazzano) error {
+	if err := validateStorage(vz); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateInstallV1Beta1(vz)
+}
+
+// ValidateUpdateV1Beta1 checks if the specified new Verrazzano CR is valid for this component to be updated
+func (c ThanosComponent) ValidateUpdateV1Beta1(old *v1beta1.Verrazzano, new *v1beta1.Verrazzano) error {
+	if err := validateStorage(new); err != nil {
+		return err
+	}
+	return c.HelmComponent.ValidateUpdateV1Beta1(old, new)
+}
+
 // PreInstall handles the pre-install operations for the Thanos component
 func (c ThanosComponent) PreInstall(ctx spi.ComponentContext) error {
 	if err := preInstallUpgrade(ctx); err != nil {
@@ -154,6 +197,15 @@ func (c ThanosComponent) PreUpgrade(ctx spi.ComponentContext) error {
 	return c.HelmComponent.PreUpgrade(ctx)
 }
 
+// PostUninstall deletes the Thanos object storage configuration, with the credentials copied from the verrazzano-install
+// namespace
+func (c ThanosComponent) PostUninstall(ctx spi.ComponentContext) error {
+	if err := common.DeleteThanosObjstoreSecret(ctx); err != nil {
+		return err
+	}
+	return c.HelmComponent.PostUninstall(ctx)
+}
+
 // GetIngressNames returns the Thanos ingress names
 func (c ThanosComponent) GetIngressNames(ctx spi.ComponentContext) []types.NamespacedName {
 	var ingressNames []types.NamespacedName
@@ -1,9 +1,10 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package thanos
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,9 +14,11 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"github.com/verrazzano/verrazzano/platform-operator/constants"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/authproxy"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/common"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/spi"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	k8scheme "k8s.io/client-go/kubernetes/scheme"
@@ -571,3 +574,17 @@ func makePod(baseName string, labels map[string]string) cliruntime.Object {
 		},
 	}
 }
+
+// TestPostUninstall tests the PostUninstall function for the Thanos component
+// GIVEN the Thanos object storage secret with the copied credentials
+// WHEN PostUninstall is called
+// THEN the object storage secret is deleted
+func TestPostUninstall(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: v1.ObjectMeta{Namespace: ComponentNamespace, Name: common.ThanosObjstoreSecretName}}
+	client := fake.NewClientBuilder().WithScheme(k8scheme.Scheme).WithObjects(secret).Build()
+	ctx := spi.NewFakeContext(client, &v1alpha1.Verrazzano{}, nil, false)
+
+	assert.NoError(t, NewComponent().PostUninstall(ctx))
+	err := client.Get(context.TODO(), cliruntime.ObjectKeyFromObject(secret), secret)
+	assert.True(t, errors.IsNotFound(err))
+}
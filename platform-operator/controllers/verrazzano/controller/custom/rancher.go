@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package custom
@@ -132,10 +132,8 @@ func getDNSSuffix(effectiveCR *vzv1alpha1.Verrazzano) string {
 
 	if effectiveCR.Spec.Components.DNS == nil || effectiveCR.Spec.Components.DNS.Wildcard != nil {
 		dnsSuffix = vzconfig.GetWildcardDomain(effectiveCR.Spec.Components.DNS)
-	} else if effectiveCR.Spec.Components.DNS.OCI != nil {
-		dnsSuffix = effectiveCR.Spec.Components.DNS.OCI.DNSZoneName
-	} else if effectiveCR.Spec.Components.DNS.External != nil {
-		dnsSuffix = effectiveCR.Spec.Components.DNS.External.Suffix
+	} else {
+		dnsSuffix = vzconfig.GetDNSZoneName(effectiveCR.Spec.Components.DNS)
 	}
 
 	return dnsSuffix
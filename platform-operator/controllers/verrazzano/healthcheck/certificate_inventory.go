@@ -0,0 +1,246 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// certificateNameAnnotation is set by cert-manager on the secrets of the Certificates it issues
+	certificateNameAnnotation = "cert-manager.io/certificate-name"
+	// managedClusterCAKey is the key of the CA certificate in the CA secret of a VerrazzanoManagedCluster
+	managedClusterCAKey = "cacrt"
+	// webhookPrefix is the name prefix of the webhook configurations created by Verrazzano
+	webhookPrefix = "verrazzano"
+)
+
+// inventoryCertificates returns the expiry of the certificates and CA bundles managed by Verrazzano:
+//   - the cert-manager Certificates issued by the Verrazzano cluster issuer, or in the Verrazzano namespaces
+//   - the TLS secrets and CA bundle secrets in the Verrazzano namespaces that are not issued by cert-manager
+//   - the CA bundles of the Verrazzano webhook configurations
+//   - the CA secrets of the VerrazzanoManagedClusters
+func inventoryCertificates(client clipkg.Client) ([]vzapi.CertificateStatus, error) {
+	namespaces, err := listVerrazzanoNamespaces(client)
+	if err != nil {
+		return nil, err
+	}
+	var certificates []vzapi.CertificateStatus
+	for _, inventory := range []func(clipkg.Client, map[string]bool) ([]vzapi.CertificateStatus, error){
+		inventoryCertManagerCertificates,
+		inventorySecrets,
+		inventoryWebhooks,
+		inventoryManagedClusterCAs,
+	} {
+		found, err := inventory(client, namespaces)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, found...)
+	}
+	return certificates, nil
+}
+
+// listVerrazzanoNamespaces returns the names of the namespaces labeled as Verrazzano namespaces
+func listVerrazzanoNamespaces(client clipkg.Client) (map[string]bool, error) {
+	nsList := &corev1.NamespaceList{}
+	if err := client.List(context.TODO(), nsList, clipkg.HasLabels{vzconst.LabelVerrazzanoNamespace}); err != nil {
+		return nil, err
+	}
+	namespaces := map[string]bool{}
+	for _, ns := range nsList.Items {
+		namespaces[ns.Name] = true
+	}
+	return namespaces, nil
+}
+
+// inventoryCertManagerCertificates returns the expiry of the issued cert-manager Certificates
+func inventoryCertManagerCertificates(client clipkg.Client, namespaces map[string]bool) ([]vzapi.CertificateStatus, error) {
+	certList := &certv1.CertificateList{}
+	if err := client.List(context.TODO(), certList); err != nil {
+		if isNotInstalled(err) {
+			// cert-manager is not installed
+			return nil, nil
+		}
+		return nil, err
+	}
+	var certificates []vzapi.CertificateStatus
+	for _, cert := range certList.Items {
+		if cert.Status.NotAfter == nil {
+			// not issued yet
+			continue
+		}
+		if cert.Spec.IssuerRef.Name != vzconst.VerrazzanoClusterIssuerName && !namespaces[cert.Namespace] {
+			continue
+		}
+		certificates = append(certificates, vzapi.CertificateStatus{
+			Kind:      vzapi.CertificateKindCertificate,
+			Namespace: cert.Namespace,
+			Name:      cert.Name,
+			NotAfter:  *cert.Status.NotAfter,
+		})
+	}
+	return certificates, nil
+}
+
+// inventorySecrets returns the expiry of the TLS secrets that are not issued by cert-manager, and of the CA bundle
+// secrets, in the Verrazzano namespaces
+func inventorySecrets(client clipkg.Client, namespaces map[string]bool) ([]vzapi.CertificateStatus, error) {
+	var certificates []vzapi.CertificateStatus
+	for namespace := range namespaces {
+		secretList := &corev1.SecretList{}
+		if err := client.List(context.TODO(), secretList, clipkg.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		for _, secret := range secretList.Items {
+			if bundle, ok := secret.Data[vzconst.CABundleKey]; ok {
+				if notAfter := getFirstExpiry(bundle); notAfter != nil {
+					certificates = append(certificates, vzapi.CertificateStatus{
+						Kind:      vzapi.CertificateKindCABundle,
+						Namespace: secret.Namespace,
+						Name:      secret.Name,
+						NotAfter:  *notAfter,
+					})
+				}
+				continue
+			}
+			if secret.Type != corev1.SecretTypeTLS {
+				continue
+			}
+			if _, ok := secret.Annotations[certificateNameAnnotation]; ok {
+				// the expiry is reported by the cert-manager Certificate
+				continue
+			}
+			if notAfter := getFirstExpiry(secret.Data[corev1.TLSCertKey]); notAfter != nil {
+				certificates = append(certificates, vzapi.CertificateStatus{
+					Kind:      vzapi.CertificateKindSecret,
+					Namespace: secret.Namespace,
+					Name:      secret.Name,
+					NotAfter:  *notAfter,
+				})
+			}
+		}
+	}
+	return certificates, nil
+}
+
+// inventoryWebhooks returns the expiry of the CA bundles of the Verrazzano webhook configurations
+func inventoryWebhooks(client clipkg.Client, _ map[string]bool) ([]vzapi.CertificateStatus, error) {
+	var bundles = map[string][][]byte{}
+	validatingList := &adminv1.ValidatingWebhookConfigurationList{}
+	if err := client.List(context.TODO(), validatingList); err != nil {
+		return nil, err
+	}
+	for _, config := range validatingList.Items {
+		for _, webhook := range config.Webhooks {
+			bundles[config.Name] = append(bundles[config.Name], webhook.ClientConfig.CABundle)
+		}
+	}
+	mutatingList := &adminv1.MutatingWebhookConfigurationList{}
+	if err := client.List(context.TODO(), mutatingList); err != nil {
+		return nil, err
+	}
+	for _, config := range mutatingList.Items {
+		for _, webhook := range config.Webhooks {
+			bundles[config.Name] = append(bundles[config.Name], webhook.ClientConfig.CABundle)
+		}
+	}
+
+	var certificates []vzapi.CertificateStatus
+	for name, caBundles := range bundles {
+		if !strings.HasPrefix(name, webhookPrefix) {
+			continue
+		}
+		var first *metav1.Time
+		for _, bundle := range caBundles {
+			if notAfter := getFirstExpiry(bundle); notAfter != nil && (first == nil || notAfter.Before(first)) {
+				first = notAfter
+			}
+		}
+		if first != nil {
+			certificates = append(certificates, vzapi.CertificateStatus{
+				Kind:     vzapi.CertificateKindWebhook,
+				Name:     name,
+				NotAfter: *first,
+			})
+		}
+	}
+	return certificates, nil
+}
+
+// inventoryManagedClusterCAs returns the expiry of the CA secrets of the VerrazzanoManagedClusters
+func inventoryManagedClusterCAs(client clipkg.Client, _ map[string]bool) ([]vzapi.CertificateStatus, error) {
+	vmcList := &clustersv1alpha1.VerrazzanoManagedClusterList{}
+	if err := client.List(context.TODO(), vmcList, clipkg.InNamespace(vzconst.VerrazzanoMultiClusterNamespace)); err != nil {
+		if isNotInstalled(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var certificates []vzapi.CertificateStatus
+	for _, vmc := range vmcList.Items {
+		if len(vmc.Spec.CASecret) == 0 {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := client.Get(context.TODO(), types.NamespacedName{Namespace: vmc.Namespace, Name: vmc.Spec.CASecret}, secret); err != nil {
+			if clipkg.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, err
+		}
+		if notAfter := getFirstExpiry(secret.Data[managedClusterCAKey]); notAfter != nil {
+			certificates = append(certificates, vzapi.CertificateStatus{
+				Kind:      vzapi.CertificateKindManagedClusterCA,
+				Namespace: secret.Namespace,
+				Name:      secret.Name,
+				NotAfter:  *notAfter,
+			})
+		}
+	}
+	return certificates, nil
+}
+
+// getFirstExpiry returns the expiry time of the first certificate to expire in the PEM data, or nil if the data has
+// no certificate
+func getFirstExpiry(data []byte) *metav1.Time {
+	var first *time.Time
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if first == nil || cert.NotAfter.Before(*first) {
+			first = &cert.NotAfter
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	return &metav1.Time{Time: *first}
+}
+
+// isNotInstalled returns true if the error is caused by a resource type that is not installed in the cluster
+func isNotInstalled(err error) bool {
+	return meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"time"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/metricsexporter"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// certificateCheckInterval is the minimum time between two certificate inventories
+	certificateCheckInterval = 5 * time.Minute
+
+	certificateExpiringReason = "CertificateExpiring"
+	certificateExpiredReason  = "CertificateExpired"
+	eventSourceComponent      = "verrazzano-platform-operator"
+)
+
+// defaultWarningHorizons are the remaining validity periods at which a warning event is emitted when none are
+// configured
+var defaultWarningHorizons = []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}
+
+// updateCertificates inventories the certificates managed by Verrazzano, publishes their expiry in metrics, emits
+// warning events for the certificates that crossed a warning horizon, and publishes a summary in the Verrazzano status
+func (p *HealthChecker) updateCertificates() error {
+	now := time.Now()
+	if now.Sub(p.lastCertificateCheck) < certificateCheckInterval {
+		return nil
+	}
+	vz, err := getVerrazzanoResource(p.client)
+	if err != nil {
+		return fmt.Errorf("Failed to get Verrazzano resource: %v", err)
+	}
+	if vz == nil {
+		return nil
+	}
+	p.lastCertificateCheck = now
+	certificates, err := inventoryCertificates(p.client)
+	if err != nil {
+		return fmt.Errorf("Failed to inventory the certificates: %v", err)
+	}
+	if err := metricsexporter.SetCertificateExpiryMetrics(certificates); err != nil {
+		return err
+	}
+	horizons := getWarningHorizons(vz)
+	if err := p.emitCertificateWarnings(vz, certificates, horizons, now); err != nil {
+		return err
+	}
+
+	// if cluster Verrazzano has identical status, don't send an update
+	status := summarizeCertificates(certificates, horizons, now)
+	if reflect.DeepEqual(vz.Status.Certificates, status) {
+		return nil
+	}
+	p.updater.Update(&UpdateEvent{
+		Certificates: status,
+	})
+	return nil
+}
+
+// getWarningHorizons returns the configured warning horizons, largest first
+func getWarningHorizons(vz *vzapi.Verrazzano) []time.Duration {
+	var horizons []time.Duration
+	if monitoring := vz.Spec.Security.CertificateMonitoring; monitoring != nil {
+		for _, horizon := range monitoring.WarningHorizons {
+			if horizon.Duration > 0 {
+				horizons = append(horizons, horizon.Duration)
+			}
+		}
+	}
+	if len(horizons) == 0 {
+		horizons = append(horizons, defaultWarningHorizons...)
+	}
+	sort.Slice(horizons, func(i, j int) bool { return horizons[i] > horizons[j] })
+	return horizons
+}
+
+// getCrossedHorizon returns the smallest warning horizon crossed by the certificate, 0 if the certificate is
+// expired, or -1 if no horizon is crossed
+func getCrossedHorizon(certificate vzapi.CertificateStatus, horizons []time.Duration, now time.Time) time.Duration {
+	remaining := certificate.NotAfter.Sub(now)
+	if remaining <= 0 {
+		return 0
+	}
+	crossed := time.Duration(-1)
+	for _, horizon := range horizons {
+		if remaining <= horizon {
+			crossed = horizon
+		}
+	}
+	return crossed
+}
+
+// summarizeCertificates counts the expiring and expired certificates, and lists them, the first to expire first
+func summarizeCertificates(certificates []vzapi.CertificateStatus, horizons []time.Duration, now time.Time) *vzapi.CertificatesStatus {
+	status := &vzapi.CertificatesStatus{Total: len(certificates)}
+	for _, certificate := range certificates {
+		switch crossed := getCrossedHorizon(certificate, horizons, now); {
+		case crossed == 0:
+			status.Expired++
+		case crossed > 0:
+			status.Expiring++
+		default:
+			continue
+		}
+		status.Certificates = append(status.Certificates, certificate)
+	}
+	sort.Slice(status.Certificates, func(i, j int) bool {
+		ci, cj := status.Certificates[i], status.Certificates[j]
+		if !ci.NotAfter.Equal(&cj.NotAfter) {
+			return ci.NotAfter.Before(&cj.NotAfter)
+		}
+		return getCertificateKey(ci) < getCertificateKey(cj)
+	})
+	return status
+}
+
+// emitCertificateWarnings emits a warning event on the Verrazzano resource each time a certificate crosses a warning
+// horizon, and when it expires
+func (p *HealthChecker) emitCertificateWarnings(vz *vzapi.Verrazzano, certificates []vzapi.CertificateStatus, horizons []time.Duration, now time.Time) error {
+	current := map[string]time.Duration{}
+	for _, certificate := range certificates {
+		crossed := getCrossedHorizon(certificate, horizons, now)
+		if crossed < 0 {
+			continue
+		}
+		// the key includes the expiry time, so that a renewed certificate is warned about again
+		key := fmt.Sprintf("%s/%d", getCertificateKey(certificate), certificate.NotAfter.Unix())
+		current[key] = crossed
+		if warned, ok := p.certificateWarnings[key]; ok && warned <= crossed {
+			continue
+		}
+		reason := certificateExpiringReason
+		message := fmt.Sprintf("%s %s expires at %s, in less than %s", certificate.Kind, getCertificateName(certificate), certificate.NotAfter.UTC().Format(time.RFC3339), crossed)
+		if crossed == 0 {
+			reason = certificateExpiredReason
+			message = fmt.Sprintf("%s %s expired at %s", certificate.Kind, getCertificateName(certificate), certificate.NotAfter.UTC().Format(time.RFC3339))
+		}
+		if err := p.createWarningEvent(vz, key+"/"+crossed.String(), reason, message); err != nil {
+			return err
+		}
+	}
+	p.certificateWarnings = current
+	return nil
+}
+
+// createWarningEvent creates a warning event on the Verrazzano resource. The event name is derived from the given
+// key, so that the same warning is not emitted twice while the event exists.
+func (p *HealthChecker) createWarningEvent(vz *vzapi.Verrazzano, key string, reason string, message string) error {
+	hash := fnv.New64a()
+	if _, err := hash.Write([]byte(key)); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", vz.Name, hash.Sum64()),
+			Namespace: vz.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: vzapi.SchemeGroupVersion.String(),
+			Kind:       "Verrazzano",
+			Name:       vz.Name,
+			Namespace:  vz.Namespace,
+			UID:        vz.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: eventSourceComponent},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := p.client.Create(context.TODO(), event); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("Failed to create the %s event: %v", reason, err)
+	}
+	p.logger.Warn(message)
+	return nil
+}
+
+func getCertificateKey(certificate vzapi.CertificateStatus) string {
+	return fmt.Sprintf("%s/%s", certificate.Kind, getCertificateName(certificate))
+}
+
+func getCertificateName(certificate vzapi.CertificateStatus) string {
+	if len(certificate.Namespace) == 0 {
+		return certificate.Name
+	}
+	return fmt.Sprintf("%s/%s", certificate.Namespace, certificate.Name)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	certv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/assert"
+	clustersv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconst "github.com/verrazzano/verrazzano/pkg/constants"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/metricsexporter"
+	adminv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8scheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestUpdateCertificates tests the certificate inventory
+// GIVEN a Verrazzano CR, and certificates from each source managed by Verrazzano
+// WHEN updateCertificates is called
+// THEN the Verrazzano status lists the expired and expiring certificates, and a warning event is emitted for each of
+// them, once
+func TestUpdateCertificates(t *testing.T) {
+	metricsexporter.RequiredInitialization()
+	now := time.Now()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"}}
+	p := newTestCertificateHealthCheck(vz,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: vzconst.VerrazzanoSystemNamespace, Labels: map[string]string{vzconst.LabelVerrazzanoNamespace: vzconst.VerrazzanoSystemNamespace}}},
+		// expiring TLS secret
+		newTestSecret(vzconst.VerrazzanoSystemNamespace, "tls", corev1.SecretTypeTLS, corev1.TLSCertKey, now.Add(2*time.Hour), nil),
+		// TLS secret issued by cert-manager, reported by its Certificate
+		newTestSecret(vzconst.VerrazzanoSystemNamespace, "issued", corev1.SecretTypeTLS, corev1.TLSCertKey, now.Add(time.Hour), map[string]string{certificateNameAnnotation: "issued"}),
+		// CA bundle valid for a year
+		newTestSecret(vzconst.VerrazzanoSystemNamespace, vzconst.PrivateCABundle, corev1.SecretTypeOpaque, vzconst.CABundleKey, now.Add(365*24*time.Hour), nil),
+		// TLS secret outside of the Verrazzano namespaces
+		newTestSecret("default", "other", corev1.SecretTypeTLS, corev1.TLSCertKey, now.Add(time.Hour), nil),
+		// expired Certificate issued by the Verrazzano cluster issuer
+		newTestCertificate("istio-system", "app-cert", vzconst.VerrazzanoClusterIssuerName, now.Add(-time.Hour)),
+		// Certificate outside of the Verrazzano namespaces, issued by another issuer
+		newTestCertificate("default", "other", "other-issuer", now.Add(-time.Hour)),
+		&adminv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "verrazzano-platform-operator-webhook"},
+			Webhooks: []adminv1.ValidatingWebhook{
+				{Name: "install.verrazzano.io", ClientConfig: adminv1.WebhookClientConfig{CABundle: newTestCertificatePEM(now.Add(100 * 24 * time.Hour))}},
+			},
+		},
+		&clustersv1alpha1.VerrazzanoManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: vzconst.VerrazzanoMultiClusterNamespace, Name: "managed1"},
+			Spec:       clustersv1alpha1.VerrazzanoManagedClusterSpec{CASecret: "ca-secret-managed1"},
+		},
+		// expiring managed cluster CA
+		newTestSecret(vzconst.VerrazzanoMultiClusterNamespace, "ca-secret-managed1", corev1.SecretTypeOpaque, managedClusterCAKey, now.Add(10*24*time.Hour), nil),
+	)
+
+	assert.NoError(t, p.updateCertificates())
+	var status *vzapi.CertificatesStatus
+	assert.Eventually(t, func() bool {
+		updated := &vzapi.Verrazzano{}
+		if err := p.client.Get(context.TODO(), client.ObjectKeyFromObject(vz), updated); err != nil {
+			return false
+		}
+		status = updated.Status.Certificates
+		return status != nil
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(t, 5, status.Total)
+	assert.Equal(t, 2, status.Expiring)
+	assert.Equal(t, 1, status.Expired)
+	assert.Len(t, status.Certificates, 3)
+	assert.Equal(t, vzapi.CertificateKindCertificate, status.Certificates[0].Kind)
+	assert.Equal(t, "app-cert", status.Certificates[0].Name)
+	assert.Equal(t, vzapi.CertificateKindSecret, status.Certificates[1].Kind)
+	assert.Equal(t, "tls", status.Certificates[1].Name)
+	assert.Equal(t, vzapi.CertificateKindManagedClusterCA, status.Certificates[2].Kind)
+	assert.Equal(t, "ca-secret-managed1", status.Certificates[2].Name)
+
+	events := &corev1.EventList{}
+	assert.NoError(t, p.client.List(context.TODO(), events, client.InNamespace(vz.Namespace)))
+	assert.Len(t, events.Items, 3)
+	reasons := map[string]int{}
+	for _, event := range events.Items {
+		assert.Equal(t, corev1.EventTypeWarning, event.Type)
+		assert.Equal(t, vz.Name, event.InvolvedObject.Name)
+		reasons[event.Reason]++
+	}
+	assert.Equal(t, map[string]int{certificateExpiredReason: 1, certificateExpiringReason: 2}, reasons)
+
+	// the next inventory does not emit the same warnings again
+	p.lastCertificateCheck = time.Time{}
+	assert.NoError(t, p.client.DeleteAllOf(context.TODO(), &corev1.Event{}, client.InNamespace(vz.Namespace)))
+	assert.NoError(t, p.updateCertificates())
+	assert.NoError(t, p.client.List(context.TODO(), events, client.InNamespace(vz.Namespace)))
+	assert.Empty(t, events.Items)
+}
+
+// TestCertificateWarningHorizons tests the warning horizons
+// GIVEN certificates expiring at various times
+// WHEN the crossed warning horizons are computed
+// THEN the smallest crossed horizon is returned, 0 for expired certificates and -1 if no horizon is crossed
+func TestCertificateWarningHorizons(t *testing.T) {
+	vz := &vzapi.Verrazzano{}
+	assert.Equal(t, defaultWarningHorizons, getWarningHorizons(vz))
+	vz.Spec.Security.CertificateMonitoring = &vzapi.CertificateMonitoring{
+		WarningHorizons: []metav1.Duration{{Duration: time.Hour}, {Duration: 48 * time.Hour}, {Duration: 0}},
+	}
+	horizons := getWarningHorizons(vz)
+	assert.Equal(t, []time.Duration{48 * time.Hour, time.Hour}, horizons)
+
+	now := time.Now()
+	expiringIn := func(d time.Duration) vzapi.CertificateStatus {
+		return vzapi.CertificateStatus{NotAfter: metav1.NewTime(now.Add(d))}
+	}
+	assert.Equal(t, time.Duration(-1), getCrossedHorizon(expiringIn(72*time.Hour), horizons, now))
+	assert.Equal(t, 48*time.Hour, getCrossedHorizon(expiringIn(24*time.Hour), horizons, now))
+	assert.Equal(t, time.Hour, getCrossedHorizon(expiringIn(time.Minute), horizons, now))
+	assert.Equal(t, time.Duration(0), getCrossedHorizon(expiringIn(-time.Minute), horizons, now))
+}
+
+func newTestCertificateHealthCheck(objs ...client.Object) *HealthChecker {
+	scheme := runtime.NewScheme()
+	_ = k8scheme.AddToScheme(scheme)
+	_ = vzapi.AddToScheme(scheme)
+	_ = certv1.AddToScheme(scheme)
+	_ = clustersv1alpha1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().WithObjects(objs...).WithScheme(scheme).Build()
+	return NewHealthChecker(NewStatusUpdater(c), c, 1*time.Second)
+}
+
+func newTestSecret(namespace string, name string, secretType corev1.SecretType, key string, notAfter time.Time, annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Annotations: annotations},
+		Type:       secretType,
+		Data:       map[string][]byte{key: newTestCertificatePEM(notAfter)},
+	}
+}
+
+func newTestCertificate(namespace string, name string, issuer string, notAfter time.Time) *certv1.Certificate {
+	return &certv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: certv1.CertificateSpec{
+			SecretName: name,
+			IssuerRef:  cmmeta.ObjectReference{Name: issuer, Kind: "ClusterIssuer"},
+		},
+		Status: certv1.CertificateStatus{NotAfter: &metav1.Time{Time: notAfter}},
+	}
+}
+
+// newTestCertificatePEM returns a PEM encoded self-signed certificate expiring at the given time
+func newTestCertificatePEM(notAfter time.Time) []byte {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package healthcheck
@@ -26,6 +26,9 @@ type HealthChecker struct {
 	logger   *zap.SugaredLogger
 	status   *AvailabilityStatus // Last known AvailabilityStatus
 	shutdown chan int            // The channel on which shutdown signals are sent/received
+
+	lastCertificateCheck time.Time                // Time of the last certificate inventory
+	certificateWarnings  map[string]time.Duration // Smallest warning horizon crossed by each expiring certificate
 }
 
 type AvailabilityStatus struct {
@@ -64,6 +67,18 @@ func (p *HealthChecker) Start() {
 				if err != nil {
 					p.logger.Errorf("%v", err)
 				}
+				// timer event also causes the MySQL scheduled backups to be checked
+				if err := p.updateMySQLBackup(); err != nil {
+					p.logger.Errorf("%v", err)
+				}
+				// timer event also causes the certificates to be inventoried, at most every certificateCheckInterval
+				if err := p.updateCertificates(); err != nil {
+					p.logger.Errorf("%v", err)
+				}
+				// timer event also causes the status of the Keycloak realm objects to be published
+				if err := p.updateKeycloakRealmObjects(); err != nil {
+					p.logger.Errorf("%v", err)
+				}
 			case <-p.shutdown:
 				// shutdown event causes termination
 				ticker.Stop()
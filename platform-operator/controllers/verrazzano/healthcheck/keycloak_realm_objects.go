@@ -0,0 +1,42 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"fmt"
+	"reflect"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/keycloak"
+)
+
+// Needed for unit testing
+var getRealmObjectsStatusFunc = keycloak.GetRealmObjectsStatus
+
+// updateKeycloakRealmObjects publishes the status of the Keycloak realm objects recorded by the last reconcile of the
+// Keycloak component
+func (p *HealthChecker) updateKeycloakRealmObjects() error {
+	statuses, reconciled := getRealmObjectsStatusFunc()
+	if !reconciled {
+		return nil
+	}
+	vz, err := getVerrazzanoResource(p.client)
+	if err != nil {
+		return fmt.Errorf("Failed to get Verrazzano resource: %v", err)
+	}
+	if vz == nil {
+		return nil
+	}
+	// if cluster Verrazzano has identical status, don't send an update
+	if (len(statuses) == 0 && len(vz.Status.KeycloakRealmObjects) == 0) || reflect.DeepEqual(vz.Status.KeycloakRealmObjects, statuses) {
+		return nil
+	}
+	if statuses == nil {
+		statuses = []vzapi.KeycloakRealmObjectStatus{}
+	}
+	p.updater.Update(&UpdateEvent{
+		KeycloakRealmObjects: statuses,
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/keycloak"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TestUpdateKeycloakRealmObjects tests the publication of the status of the Keycloak realm objects
+// GIVEN the status of the realm objects recorded by the Keycloak component
+// WHEN updateKeycloakRealmObjects is called
+// THEN the status is published in the Verrazzano resource
+// WHEN the realm objects are removed
+// THEN the status is cleared
+func TestUpdateKeycloakRealmObjects(t *testing.T) {
+	defer func() { getRealmObjectsStatusFunc = keycloak.GetRealmObjectsStatus }()
+	vz := &vzapi.Verrazzano{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"}}
+	p := newTestHealthCheck(vz)
+	getVZ := func() *vzapi.Verrazzano {
+		updated := &vzapi.Verrazzano{}
+		assert.NoError(t, p.client.Get(context.TODO(), client.ObjectKeyFromObject(vz), updated))
+		return updated
+	}
+
+	// nothing is published before the realm objects are reconciled
+	getRealmObjectsStatusFunc = func() ([]vzapi.KeycloakRealmObjectStatus, bool) { return nil, false }
+	assert.NoError(t, p.updateKeycloakRealmObjects())
+
+	statuses := []vzapi.KeycloakRealmObjectStatus{{Kind: "Client", Name: "app", State: vzapi.KeycloakRealmObjectReady}}
+	getRealmObjectsStatusFunc = func() ([]vzapi.KeycloakRealmObjectStatus, bool) { return statuses, true }
+	assert.NoError(t, p.updateKeycloakRealmObjects())
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(statuses, getVZ().Status.KeycloakRealmObjects)
+	}, 5*time.Second, 100*time.Millisecond)
+
+	getRealmObjectsStatusFunc = func() ([]vzapi.KeycloakRealmObjectStatus, bool) { return nil, true }
+	assert.NoError(t, p.updateKeycloakRealmObjects())
+	assert.Eventually(t, func() bool {
+		return len(getVZ().Status.KeycloakRealmObjects) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+}
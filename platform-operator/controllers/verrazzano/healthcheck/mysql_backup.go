@@ -0,0 +1,66 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"fmt"
+	"reflect"
+
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/mysql"
+	"github.com/verrazzano/verrazzano/platform-operator/metricsexporter"
+)
+
+// updateMySQLBackup prunes the MySQL scheduled backups beyond the retention count, then publishes the time of the
+// last successful backup and the number of failures since then in the mysql component status and in metrics
+func (p *HealthChecker) updateMySQLBackup() error {
+	vz, err := getVerrazzanoResource(p.client)
+	if err != nil {
+		return fmt.Errorf("Failed to get Verrazzano resource: %v", err)
+	}
+	if vz == nil {
+		return nil
+	}
+	backup := mysql.GetBackup(vz)
+	if backup == nil {
+		return nil
+	}
+	if err := mysql.PruneBackups(p.client, backup); err != nil {
+		return fmt.Errorf("Failed to delete the expired MySQL backups: %v", err)
+	}
+	status, err := mysql.GetBackupStatus(p.client)
+	if err != nil {
+		return fmt.Errorf("Failed to get the status of the MySQL backups: %v", err)
+	}
+	if err := setMySQLBackupMetrics(status); err != nil {
+		return err
+	}
+
+	// if cluster Verrazzano has identical status, don't send an update
+	comp, ok := vz.Status.Components[mysql.ComponentName]
+	if !ok || reflect.DeepEqual(comp.MySQLBackup, status) {
+		return nil
+	}
+	p.updater.Update(&UpdateEvent{
+		MySQLBackup: status,
+	})
+	return nil
+}
+
+// setMySQLBackupMetrics publishes the MySQL backup metrics
+func setMySQLBackupMetrics(status *vzapi.MySQLBackupStatus) error {
+	lastSuccess, err := metricsexporter.GetSimpleGaugeMetric(metricsexporter.MySQLBackupSuccess)
+	if err != nil {
+		return err
+	}
+	if status.LastSuccessTime != nil {
+		lastSuccess.Set(float64(status.LastSuccessTime.Unix()))
+	}
+	failures, err := metricsexporter.GetSimpleGaugeMetric(metricsexporter.MySQLBackupFailures)
+	if err != nil {
+		return err
+	}
+	failures.Set(float64(status.Failures))
+	return nil
+}
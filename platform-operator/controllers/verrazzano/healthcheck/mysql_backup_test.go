@@ -0,0 +1,81 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package healthcheck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/mysql"
+	"github.com/verrazzano/verrazzano/platform-operator/metricsexporter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TestUpdateMySQLBackup tests the status and metrics of the MySQL scheduled backups
+// GIVEN a Verrazzano CR with a MySQL backup schedule, a completed backup and a failed backup
+// WHEN updateMySQLBackup is called
+// THEN the mysql component status and the metrics report the completed backup and the failure
+func TestUpdateMySQLBackup(t *testing.T) {
+	metricsexporter.RequiredInitialization()
+	completed := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+	vz := &vzapi.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "verrazzano"},
+		Spec: vzapi.VerrazzanoSpec{
+			Components: vzapi.ComponentSpec{
+				Keycloak: &vzapi.KeycloakComponent{
+					MySQL: vzapi.MySQLComponent{
+						Backup: &vzapi.MySQLBackup{Schedule: "0 2 * * *"},
+					},
+				},
+			},
+		},
+		Status: vzapi.VerrazzanoStatus{
+			Components: map[string]*vzapi.ComponentStatusDetails{
+				mysql.ComponentName: {Name: mysql.ComponentName, State: vzapi.CompStateReady},
+			},
+		},
+	}
+	p := newTestHealthCheck(vz,
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup1", completed, "Completed", ""),
+		newTestMySQLBackup("mysql-verrazzano-scheduled-backup2", completed.Add(time.Hour), "Error", "bucket not found"))
+
+	assert.NoError(t, p.updateMySQLBackup())
+	assert.Eventually(t, func() bool {
+		updated := &vzapi.Verrazzano{}
+		if err := p.client.Get(context.TODO(), client.ObjectKeyFromObject(vz), updated); err != nil {
+			return false
+		}
+		status := updated.Status.Components[mysql.ComponentName].MySQLBackup
+		return status != nil && status.LastBackup == "mysql-verrazzano-scheduled-backup1" && status.Failures == 1 &&
+			status.Message == "bucket not found"
+	}, 5*time.Second, 100*time.Millisecond)
+
+	lastSuccess, err := metricsexporter.GetSimpleGaugeMetric(metricsexporter.MySQLBackupSuccess)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(completed.Unix()), testutil.ToFloat64(lastSuccess.Get()))
+	failures, err := metricsexporter.GetSimpleGaugeMetric(metricsexporter.MySQLBackupFailures)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(failures.Get()))
+}
+
+func newTestMySQLBackup(name string, completed time.Time, state string, message string) client.Object {
+	backup := &unstructured.Unstructured{}
+	backup.SetAPIVersion("mysql.oracle.com/v2")
+	backup.SetKind("MySQLBackup")
+	backup.SetNamespace(mysql.ComponentNamespace)
+	backup.SetName(name)
+	backup.SetCreationTimestamp(metav1.NewTime(completed))
+	backup.Object["status"] = map[string]interface{}{
+		"status":         state,
+		"message":        message,
+		"completionTime": completed.Format(time.RFC3339),
+	}
+	return backup
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package healthcheck
@@ -7,6 +7,8 @@ import (
 	"context"
 	"github.com/verrazzano/verrazzano/pkg/log"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/mysql"
+	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/opensearch"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +32,13 @@ type UpdateEvent struct {
 	Availability *AvailabilityStatus
 	InstanceInfo *vzapi.InstanceInfo
 	Components   map[string]*vzapi.ComponentStatusDetails
+	MySQLBackup  *vzapi.MySQLBackupStatus
+	Certificates *vzapi.CertificatesStatus
+	CARotation   *vzapi.CARotationStatus
+	// The status of the Keycloak realm objects, an empty slice clears the status
+	KeycloakRealmObjects []vzapi.KeycloakRealmObjectStatus
+	// The health of the OpenSearch snapshot repositories, an empty slice clears the status
+	SnapshotRepositories []vzapi.SnapshotRepositoryStatus
 }
 
 // VerrazzanoStatusUpdater implement Updater for asynchronous status updates, using updateChannel to receive UpdateEvent objects
@@ -67,26 +76,26 @@ func (v *VerrazzanoStatusUpdater) Update(event *UpdateEvent) {
 }
 
 // Start initiates a goroutine that listens of the status update channel for events
+// The channel is created before returning, so that events can be sent as soon as Start returns
 func (v *VerrazzanoStatusUpdater) Start() {
+	v.channelLock.Lock()
+	defer v.channelLock.Unlock()
+	if v.updateChannel != nil {
+		return
+	}
+	updateChannel := make(chan *UpdateEvent, channelBufferSize)
+	v.updateChannel = updateChannel
 	go func() {
-		v.channelLock.Lock()
-		defer v.channelLock.Unlock()
-		if v.updateChannel != nil {
-			return
-		}
-		v.updateChannel = make(chan *UpdateEvent, channelBufferSize)
-		go func() {
-			for {
-				event := <-v.updateChannel
-				if event == nil {
-					v.shutdown()
-					return
-				}
-				if err := v.doUpdate(event); err != nil {
-					v.logger.Errorf("Error updating component status: %v", err)
-				}
+		for {
+			event := <-updateChannel
+			if event == nil {
+				v.shutdown()
+				return
 			}
-		}()
+			if err := v.doUpdate(event); err != nil {
+				v.logger.Errorf("Error updating component status: %v", err)
+			}
+		}
 	}()
 }
 
@@ -154,4 +163,28 @@ func (u *UpdateEvent) merge(vz *vzapi.Verrazzano) {
 	if u.Availability != nil {
 		u.Availability.merge(vz)
 	}
+	// Add the status of the MySQL scheduled backups
+	if u.MySQLBackup != nil {
+		if comp, ok := vz.Status.Components[mysql.ComponentName]; ok {
+			comp.MySQLBackup = u.MySQLBackup
+		}
+	}
+	// Add the summary of the certificates
+	if u.Certificates != nil {
+		vz.Status.Certificates = u.Certificates
+	}
+	// Add the state of the CA rotation
+	if u.CARotation != nil {
+		vz.Status.CARotation = u.CARotation
+	}
+	// Add the status of the Keycloak realm objects
+	if u.KeycloakRealmObjects != nil {
+		vz.Status.KeycloakRealmObjects = u.KeycloakRealmObjects
+	}
+	// Add the health of the OpenSearch snapshot repositories
+	if u.SnapshotRepositories != nil {
+		if comp, ok := vz.Status.Components[opensearch.ComponentName]; ok {
+			comp.SnapshotRepositories = u.SnapshotRepositories
+		}
+	}
 }
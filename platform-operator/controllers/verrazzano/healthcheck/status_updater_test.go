@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package healthcheck
@@ -133,6 +133,42 @@ func TestStatusUpdater(t *testing.T) {
 	})
 }
 
+// TestStatusUpdaterImmediateUpdate tests an update sent as soon as the status updater is created
+// GIVEN a new status updater
+// WHEN an update is sent without waiting for the updater to start
+// THEN the update is applied to the Verrazzano resource
+func TestStatusUpdaterImmediateUpdate(t *testing.T) {
+	vz := testvz.DeepCopy()
+	c := fake.NewClientBuilder().WithObjects(vz).WithScheme(testScheme).Build()
+	updater := NewStatusUpdater(c)
+	assert.NotNil(t, updater.updateChannel)
+	u := &UpdateEvent{
+		Verrazzano: vz,
+		State:      vzapi.VzStateReady,
+	}
+	updater.Update(u)
+	retryFunction(t, func() bool {
+		return checkUpdate(t, c, u)
+	})
+	updater.Update(nil)
+}
+
+// TestMergeSnapshotRepositories tests merging the health of the OpenSearch snapshot repositories
+// GIVEN a Verrazzano resource with an OpenSearch component status
+// WHEN an update with the snapshot repositories is merged, then an update with no snapshot repositories
+// THEN the snapshot repositories are set in the component status, then cleared
+func TestMergeSnapshotRepositories(t *testing.T) {
+	vz := testvz.DeepCopy()
+	vz.Status.Components = map[string]*vzapi.ComponentStatusDetails{opensearch.ComponentName: {Name: opensearch.ComponentName}}
+	repositories := []vzapi.SnapshotRepositoryStatus{{Name: "backups", Verified: true}}
+	(&UpdateEvent{SnapshotRepositories: repositories}).merge(vz)
+	assert.Equal(t, repositories, vz.Status.Components[opensearch.ComponentName].SnapshotRepositories)
+	(&UpdateEvent{}).merge(vz)
+	assert.Equal(t, repositories, vz.Status.Components[opensearch.ComponentName].SnapshotRepositories)
+	(&UpdateEvent{SnapshotRepositories: []vzapi.SnapshotRepositoryStatus{}}).merge(vz)
+	assert.Empty(t, vz.Status.Components[opensearch.ComponentName].SnapshotRepositories)
+}
+
 func checkUpdate(t *testing.T, c client.Client, u *UpdateEvent) bool {
 	vz := &vzapi.Verrazzano{}
 	if err := c.Get(context.TODO(), types.NamespacedName{
@@ -1,4 +1,4 @@
-// Copyright (c) 2023, Oracle and/or its affiliates.
+// Copyright (c) 2023, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package operatorinit
@@ -24,8 +24,10 @@ func TestGenerateConfigMapFromHelmChartFiles(t *testing.T) {
 	vpoHelmChartConfigMap := generateVPOConfigMap(t)
 	assert.Equal(t, vpoHelmChartConfigMapName, vpoHelmChartConfigMap.Name)
 	assert.Equal(t, constants.VerrazzanoInstallNamespace, vpoHelmChartConfigMap.Namespace)
-	assert.Equal(t, 14, len(vpoHelmChartConfigMap.Data))
+	assert.Equal(t, 16, len(vpoHelmChartConfigMap.Data))
 	assert.Contains(t, vpoHelmChartConfigMap.Data, "crds...install.verrazzano.io_verrazzanos.yaml")
+	assert.Contains(t, vpoHelmChartConfigMap.Data, "crds...install.verrazzano.io_verrazzanobackups.yaml")
+	assert.Contains(t, vpoHelmChartConfigMap.Data, "crds...install.verrazzano.io_verrazzanorestores.yaml")
 	assert.Contains(t, vpoHelmChartConfigMap.Data, "templates...clusterrole.yaml")
 	assert.Contains(t, vpoHelmChartConfigMap.Data, "templates...clusterrolebinding.yaml")
 	assert.Contains(t, vpoHelmChartConfigMap.Data, "templates...deployment.yaml")
@@ -0,0 +1,238 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vzconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	acmev1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const (
+	// RFC2136TSIGSecretKey is the key of the TSIG key in the RFC 2136 DNS secret
+	RFC2136TSIGSecretKey = "tsig-secret" //nolint:gosec //#gosec G101
+	// PowerDNSAPIKeySecretKey is the key of the API key in the PowerDNS secret
+	PowerDNSAPIKeySecretKey = "api-key" //nolint:gosec //#gosec G101
+
+	defaultRFC2136Port          = 53
+	defaultRFC2136TSIGAlgorithm = "hmac-sha256"
+	defaultPowerDNSAPIPort      = 8081
+	powerDNSSolverName          = "pdns"
+)
+
+// tsigAlgorithms maps the TSIG algorithms used by external-dns to the ones used by cert-manager
+var tsigAlgorithms = map[string]string{
+	"hmac-md5":    "HMACMD5",
+	"hmac-sha1":   "HMACSHA1",
+	"hmac-sha256": "HMACSHA256",
+	"hmac-sha512": "HMACSHA512",
+}
+
+// DNSProvider is a DNS provider, other than Oracle Cloud Infrastructure DNS, configured in the DNS component. The
+// provider feeds the external-dns Helm overrides and the ACME DNS01 challenge solver of the Verrazzano cluster issuer.
+type DNSProvider interface {
+	// ZoneName returns the name of the DNS zone
+	ZoneName() string
+	// CredentialsSecret returns the name of the secret, in the verrazzano-install namespace, holding the credentials
+	// of the provider, or an empty string if the provider has no credentials
+	CredentialsSecret() string
+	// ExternalDNSOverrides returns the external-dns Helm overrides, or nil if the DNS records are not managed by
+	// external-dns
+	ExternalDNSOverrides() []bom.KeyValue
+	// DNS01Solver returns the ACME DNS01 challenge solver. The secrets it references are copies of the credentials
+	// secret in the cluster resource namespace of the cluster issuer.
+	DNS01Solver() (*acmev1.ACMEChallengeSolverDNS01, error)
+}
+
+// GetDNSProvider returns the DNS provider configured in the DNS component, or nil if none is configured
+func GetDNSProvider(dns *vzapi.DNSComponent) DNSProvider {
+	if dns == nil {
+		return nil
+	}
+	if dns.RFC2136 != nil {
+		return rfc2136Provider{dns.RFC2136}
+	}
+	if dns.PowerDNS != nil {
+		return powerDNSProvider{dns.PowerDNS}
+	}
+	if dns.Webhook != nil {
+		return webhookProvider{dns.Webhook}
+	}
+	return nil
+}
+
+// GetDNSZoneName returns the DNS zone name of the OCI, external or provider DNS configuration, or an empty string if
+// none is configured
+func GetDNSZoneName(dns *vzapi.DNSComponent) string {
+	if dns == nil {
+		return ""
+	}
+	if dns.OCI != nil {
+		return dns.OCI.DNSZoneName
+	}
+	if dns.External != nil {
+		return dns.External.Suffix
+	}
+	if provider := GetDNSProvider(dns); provider != nil {
+		return provider.ZoneName()
+	}
+	return ""
+}
+
+// GetTSIGAlgorithm returns the TSIG algorithm of the RFC 2136 DNS configuration, in the external-dns format
+func GetTSIGAlgorithm(algorithm string) string {
+	if len(algorithm) == 0 {
+		return defaultRFC2136TSIGAlgorithm
+	}
+	return strings.ToLower(algorithm)
+}
+
+// IsValidTSIGAlgorithm returns true if the TSIG algorithm is supported by both external-dns and cert-manager
+func IsValidTSIGAlgorithm(algorithm string) bool {
+	_, ok := tsigAlgorithms[GetTSIGAlgorithm(algorithm)]
+	return ok
+}
+
+// rfc2136Provider updates the DNS records with TSIG authenticated dynamic updates
+type rfc2136Provider struct {
+	config *vzapi.RFC2136
+}
+
+func (p rfc2136Provider) ZoneName() string {
+	return p.config.DNSZoneName
+}
+
+func (p rfc2136Provider) CredentialsSecret() string {
+	return p.config.TSIGSecret
+}
+
+func (p rfc2136Provider) ExternalDNSOverrides() []bom.KeyValue {
+	return []bom.KeyValue{
+		{Key: "provider", Value: "rfc2136"},
+		{Key: "domainFilters[0]", Value: p.config.DNSZoneName},
+		{Key: "rfc2136.host", Value: p.config.Nameserver},
+		{Key: "rfc2136.port", Value: fmt.Sprintf("%d", p.port())},
+		{Key: "rfc2136.zone", Value: p.config.DNSZoneName},
+		{Key: "rfc2136.tsigKeyname", Value: p.config.TSIGKeyName},
+		{Key: "rfc2136.tsigSecretAlg", Value: GetTSIGAlgorithm(p.config.TSIGAlgorithm)},
+		{Key: "extraEnv[0].name", Value: "EXTERNAL_DNS_RFC2136_TSIG_SECRET"},
+		{Key: "extraEnv[0].valueFrom.secretKeyRef.name", Value: p.config.TSIGSecret},
+		{Key: "extraEnv[0].valueFrom.secretKeyRef.key", Value: RFC2136TSIGSecretKey},
+	}
+}
+
+func (p rfc2136Provider) DNS01Solver() (*acmev1.ACMEChallengeSolverDNS01, error) {
+	algorithm, ok := tsigAlgorithms[GetTSIGAlgorithm(p.config.TSIGAlgorithm)]
+	if !ok {
+		return nil, fmt.Errorf("Invalid RFC 2136 TSIG algorithm %s", p.config.TSIGAlgorithm)
+	}
+	return &acmev1.ACMEChallengeSolverDNS01{
+		RFC2136: &acmev1.ACMEIssuerDNS01ProviderRFC2136{
+			Nameserver: fmt.Sprintf("%s:%d", p.config.Nameserver, p.port()),
+			TSIGSecret: cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: p.config.TSIGSecret},
+				Key:                  RFC2136TSIGSecretKey,
+			},
+			TSIGKeyName:   p.config.TSIGKeyName,
+			TSIGAlgorithm: algorithm,
+		},
+	}, nil
+}
+
+func (p rfc2136Provider) port() int32 {
+	if p.config.Port == nil {
+		return defaultRFC2136Port
+	}
+	return *p.config.Port
+}
+
+// powerDNSProvider updates the DNS records with the PowerDNS API, and solves the ACME DNS01 challenges with the
+// PowerDNS cert-manager webhook
+type powerDNSProvider struct {
+	config *vzapi.PowerDNS
+}
+
+func (p powerDNSProvider) ZoneName() string {
+	return p.config.DNSZoneName
+}
+
+func (p powerDNSProvider) CredentialsSecret() string {
+	return p.config.APIKeySecret
+}
+
+func (p powerDNSProvider) ExternalDNSOverrides() []bom.KeyValue {
+	return []bom.KeyValue{
+		{Key: "provider", Value: "pdns"},
+		{Key: "domainFilters[0]", Value: p.config.DNSZoneName},
+		{Key: "pdns.apiUrl", Value: p.config.APIURL},
+		{Key: "pdns.apiPort", Value: fmt.Sprintf("%d", p.apiPort()), SetString: true},
+		{Key: "extraEnv[0].name", Value: "PDNS_API_KEY"},
+		{Key: "extraEnv[0].valueFrom.secretKeyRef.name", Value: p.config.APIKeySecret},
+		{Key: "extraEnv[0].valueFrom.secretKeyRef.key", Value: PowerDNSAPIKeySecretKey},
+	}
+}
+
+func (p powerDNSProvider) DNS01Solver() (*acmev1.ACMEChallengeSolverDNS01, error) {
+	if len(p.config.WebhookGroupName) == 0 {
+		return nil, fmt.Errorf("The PowerDNS webhook group name is required to solve the ACME DNS01 challenges")
+	}
+	config, err := json.Marshal(map[string]interface{}{
+		"host": fmt.Sprintf("%s:%d", p.config.APIURL, p.apiPort()),
+		"apiKeySecretRef": map[string]string{
+			"name": p.config.APIKeySecret,
+			"key":  PowerDNSAPIKeySecretKey,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &acmev1.ACMEChallengeSolverDNS01{
+		Webhook: &acmev1.ACMEIssuerDNS01ProviderWebhook{
+			GroupName:  p.config.WebhookGroupName,
+			SolverName: powerDNSSolverName,
+			Config:     &apiextensionsv1.JSON{Raw: config},
+		},
+	}, nil
+}
+
+func (p powerDNSProvider) apiPort() int32 {
+	if p.config.APIPort == nil {
+		return defaultPowerDNSAPIPort
+	}
+	return *p.config.APIPort
+}
+
+// webhookProvider solves the ACME DNS01 challenges with a cert-manager webhook. The DNS records are managed outside
+// of Verrazzano, since external-dns does not support webhook providers.
+type webhookProvider struct {
+	config *vzapi.WebhookDNS
+}
+
+func (p webhookProvider) ZoneName() string {
+	return p.config.DNSZoneName
+}
+
+func (p webhookProvider) CredentialsSecret() string {
+	return ""
+}
+
+func (p webhookProvider) ExternalDNSOverrides() []bom.KeyValue {
+	return nil
+}
+
+func (p webhookProvider) DNS01Solver() (*acmev1.ACMEChallengeSolverDNS01, error) {
+	return &acmev1.ACMEChallengeSolverDNS01{
+		Webhook: &acmev1.ACMEIssuerDNS01ProviderWebhook{
+			GroupName:  p.config.GroupName,
+			SolverName: p.config.SolverName,
+			Config:     p.config.Config,
+		},
+	}, nil
+}
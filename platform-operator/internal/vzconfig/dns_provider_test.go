@@ -0,0 +1,108 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package vzconfig
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/pkg/bom"
+	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// TestGetDNSProvider tests the GetDNSProvider function
+// GIVEN a DNS component
+// WHEN GetDNSProvider is called
+// THEN a provider is returned for RFC 2136, PowerDNS and webhook DNS, and nil otherwise
+func TestGetDNSProvider(t *testing.T) {
+	assert.Nil(t, GetDNSProvider(nil))
+	assert.Nil(t, GetDNSProvider(&vzapi.DNSComponent{OCI: &vzapi.OCI{}}))
+	assert.Nil(t, GetDNSProvider(&vzapi.DNSComponent{Wildcard: &vzapi.Wildcard{}}))
+	assert.Equal(t, "rfc2136.io", GetDNSProvider(&vzapi.DNSComponent{RFC2136: &vzapi.RFC2136{DNSZoneName: "rfc2136.io"}}).ZoneName())
+	assert.Equal(t, "pdns.io", GetDNSProvider(&vzapi.DNSComponent{PowerDNS: &vzapi.PowerDNS{DNSZoneName: "pdns.io"}}).ZoneName())
+	assert.Equal(t, "webhook.io", GetDNSProvider(&vzapi.DNSComponent{Webhook: &vzapi.WebhookDNS{DNSZoneName: "webhook.io"}}).ZoneName())
+}
+
+// TestGetDNSZoneName tests the GetDNSZoneName function
+// GIVEN a DNS component
+// WHEN GetDNSZoneName is called
+// THEN the zone name of the OCI, external or provider DNS configuration is returned
+func TestGetDNSZoneName(t *testing.T) {
+	assert.Empty(t, GetDNSZoneName(nil))
+	assert.Empty(t, GetDNSZoneName(&vzapi.DNSComponent{Wildcard: &vzapi.Wildcard{}}))
+	assert.Equal(t, "oci.io", GetDNSZoneName(&vzapi.DNSComponent{OCI: &vzapi.OCI{DNSZoneName: "oci.io"}}))
+	assert.Equal(t, "external.io", GetDNSZoneName(&vzapi.DNSComponent{External: &vzapi.External{Suffix: "external.io"}}))
+	assert.Equal(t, "rfc2136.io", GetDNSZoneName(&vzapi.DNSComponent{RFC2136: &vzapi.RFC2136{DNSZoneName: "rfc2136.io"}}))
+	assert.Equal(t, "pdns.io", GetDNSZoneName(&vzapi.DNSComponent{PowerDNS: &vzapi.PowerDNS{DNSZoneName: "pdns.io"}}))
+	assert.Equal(t, "webhook.io", GetDNSZoneName(&vzapi.DNSComponent{Webhook: &vzapi.WebhookDNS{DNSZoneName: "webhook.io"}}))
+}
+
+// TestRFC2136Provider tests the RFC 2136 DNS provider
+// GIVEN an RFC 2136 DNS configuration
+// WHEN the external-dns overrides and the DNS01 solver are built
+// THEN the defaults are applied, and the TSIG algorithm is mapped to the cert-manager format
+func TestRFC2136Provider(t *testing.T) {
+	port := int32(5353)
+	provider := GetDNSProvider(&vzapi.DNSComponent{RFC2136: &vzapi.RFC2136{
+		DNSZoneName: "example.com",
+		Nameserver:  "ns.example.com",
+		Port:        &port,
+		TSIGKeyName: "verrazzano",
+		TSIGSecret:  "tsig",
+	}})
+	assert.Equal(t, "tsig", provider.CredentialsSecret())
+	kvs := provider.ExternalDNSOverrides()
+	assert.Contains(t, kvs, bom.KeyValue{Key: "provider", Value: "rfc2136"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "rfc2136.port", Value: "5353"})
+	assert.Contains(t, kvs, bom.KeyValue{Key: "rfc2136.tsigSecretAlg", Value: "hmac-sha256"})
+
+	solver, err := provider.DNS01Solver()
+	assert.NoError(t, err)
+	assert.Equal(t, "ns.example.com:5353", solver.RFC2136.Nameserver)
+	assert.Equal(t, "HMACSHA256", solver.RFC2136.TSIGAlgorithm)
+	assert.Equal(t, RFC2136TSIGSecretKey, solver.RFC2136.TSIGSecret.Key)
+
+	_, err = GetDNSProvider(&vzapi.DNSComponent{RFC2136: &vzapi.RFC2136{TSIGAlgorithm: "hmac-sha384"}}).DNS01Solver()
+	assert.Error(t, err)
+	assert.True(t, IsValidTSIGAlgorithm("HMAC-SHA512"))
+	assert.False(t, IsValidTSIGAlgorithm("hmac-sha384"))
+}
+
+// TestPowerDNSProvider tests the PowerDNS provider
+// GIVEN a PowerDNS configuration
+// WHEN the DNS01 solver is built
+// THEN an error is returned without webhook group name, otherwise the solver points to the PowerDNS API
+func TestPowerDNSProvider(t *testing.T) {
+	config := &vzapi.PowerDNS{DNSZoneName: "example.com", APIURL: "http://pdns", APIKeySecret: "pdns"}
+	provider := GetDNSProvider(&vzapi.DNSComponent{PowerDNS: config})
+	assert.Contains(t, provider.ExternalDNSOverrides(), bom.KeyValue{Key: "pdns.apiPort", Value: "8081", SetString: true})
+	_, err := provider.DNS01Solver()
+	assert.Error(t, err)
+
+	config.WebhookGroupName = "acme.example.com"
+	solver, err := provider.DNS01Solver()
+	assert.NoError(t, err)
+	assert.Equal(t, "acme.example.com", solver.Webhook.GroupName)
+	assert.Equal(t, "pdns", solver.Webhook.SolverName)
+	solverConfig := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(solver.Webhook.Config.Raw, &solverConfig))
+	assert.Equal(t, "http://pdns:8081", solverConfig["host"])
+}
+
+// TestWebhookProvider tests the webhook DNS provider
+// GIVEN a webhook DNS configuration
+// WHEN the external-dns overrides and the DNS01 solver are built
+// THEN no overrides are returned, and the solver is built from the configuration
+func TestWebhookProvider(t *testing.T) {
+	config := &apiextensionsv1.JSON{Raw: []byte(`{"key":"value"}`)}
+	provider := GetDNSProvider(&vzapi.DNSComponent{Webhook: &vzapi.WebhookDNS{DNSZoneName: "example.com", GroupName: "acme.example.com", SolverName: "solver", Config: config}})
+	assert.Nil(t, provider.ExternalDNSOverrides())
+	assert.Empty(t, provider.CredentialsSecret())
+	solver, err := provider.DNS01Solver()
+	assert.NoError(t, err)
+	assert.Equal(t, "solver", solver.Webhook.SolverName)
+	assert.Equal(t, config, solver.Webhook.Config)
+}
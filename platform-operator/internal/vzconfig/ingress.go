@@ -1,4 +1,4 @@
-// Copyright (c) 2021, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2021, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 package vzconfig
 
@@ -63,13 +63,11 @@ func GetDNSSuffix(client client.Client, vz *vzapi.Verrazzano) (string, error) {
 			return "", err
 		}
 		dnsSuffix = fmt.Sprintf("%s.%s", ingressIP, GetWildcardDomain(dnsConfig))
-	} else if dnsConfig.OCI != nil {
-		dnsSuffix = dnsConfig.OCI.DNSZoneName
-	} else if dnsConfig.External != nil {
-		dnsSuffix = dnsConfig.External.Suffix
+	} else {
+		dnsSuffix = GetDNSZoneName(dnsConfig)
 	}
 	if len(dnsSuffix) == 0 {
-		return "", fmt.Errorf("Invalid OCI DNS configuration, no zone name specified")
+		return "", fmt.Errorf("Invalid DNS configuration, no zone name specified")
 	}
 	return dnsSuffix, nil
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricsexporter
@@ -28,6 +28,7 @@ type data struct {
 	componentHealth          *ComponentHealth
 	componentInstallDuration *ComponentInstallDuration
 	componentUpgradeDuration *ComponentUpgradeDuration
+	certificateExpiry        *CertificateExpiry
 }
 type SimpleCounterMetric struct {
 	metric prometheus.Counter
@@ -99,6 +100,10 @@ type ComponentUpgradeDuration struct {
 	upgradeDuration *prometheus.GaugeVec
 }
 
+type CertificateExpiry struct {
+	notAfter *prometheus.GaugeVec
+}
+
 // This member function returns the simpleGaugeMetric that holds the upgrade time for a component
 func (c *ComponentHealth) SetComponentHealth(JSONname string, availability bool, isEnabled bool) (prometheus.Gauge, error) {
 	//isEnabled : true => 0, isEnabled : false => -1
@@ -1,16 +1,18 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricsexporter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	asserts "github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/pkg/log/vzlog"
 	installv1alpha1 "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/grafana"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Constants that hold the times that are used to test various cases of component timestamps being passed
@@ -273,3 +275,31 @@ func TestAnalyzeVerrazzanoResourceMetrics(t *testing.T) {
 		})
 	}
 }
+
+// TestSetCertificateExpiryMetrics tests the SetCertificateExpiryMetrics fn
+// GIVEN a call to SetCertificateExpiryMetrics
+// THEN the expiry of each certificate is published, and the metrics of the certificates that are not given anymore
+// are removed
+func TestSetCertificateExpiryMetrics(t *testing.T) {
+	assert := asserts.New(t)
+	notAfter := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	certificate := installv1alpha1.CertificateStatus{
+		Kind:      installv1alpha1.CertificateKindSecret,
+		Namespace: "verrazzano-system",
+		Name:      "tls",
+		NotAfter:  notAfter,
+	}
+	webhook := installv1alpha1.CertificateStatus{
+		Kind:     installv1alpha1.CertificateKindWebhook,
+		Name:     "verrazzano-platform-operator-webhook",
+		NotAfter: notAfter,
+	}
+	notAfterVec := MetricsExp.internalData.certificateExpiry.notAfter
+
+	assert.NoError(SetCertificateExpiryMetrics([]installv1alpha1.CertificateStatus{certificate, webhook}))
+	assert.Equal(2, testutil.CollectAndCount(notAfterVec))
+	assert.Equal(float64(notAfter.Unix()), testutil.ToFloat64(notAfterVec.WithLabelValues("Secret", "verrazzano-system", "tls")))
+
+	assert.NoError(SetCertificateExpiryMetrics([]installv1alpha1.CertificateStatus{webhook}))
+	assert.Equal(1, testutil.CollectAndCount(notAfterVec))
+}
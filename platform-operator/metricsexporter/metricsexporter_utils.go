@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package metricsexporter
@@ -33,6 +33,8 @@ const (
 	ReconcileDuration   metricName = "reconcile duration"
 	AvailableComponents metricName = "available components"
 	EnabledComponents   metricName = "enabled components"
+	MySQLBackupSuccess  metricName = "mysql backup success"
+	MySQLBackupFailures metricName = "mysql backup failures"
 )
 
 // Init cannot be called until the NGINX namespace is determined at startup
@@ -52,6 +54,7 @@ func RequiredInitialization() {
 			componentHealth:          initComponentHealthMetrics(),
 			componentInstallDuration: initComponentInstallDurationMetrics(),
 			componentUpgradeDuration: initComponentUpgradeDurationMetrics(),
+			certificateExpiry:        initCertificateExpiryMetrics(),
 		},
 	}
 	// initialize component availability metric to false
@@ -118,6 +121,15 @@ func initComponentUpgradeDurationMetrics() *ComponentUpgradeDuration {
 	}
 }
 
+func initCertificateExpiryMetrics() *CertificateExpiry {
+	return &CertificateExpiry{
+		notAfter: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vz_platform_operator_certificate_expiration_timestamp_seconds",
+			Help: "The expiry time of each certificate managed by Verrazzano",
+		}, []string{"kind", "namespace", "name"}),
+	}
+}
+
 // This function initializes the simpleGaugeMetricMap for the metricsExporter object
 func initSimpleGaugeMetricMap() map[metricName]*SimpleGaugeMetric {
 	return map[metricName]*SimpleGaugeMetric{
@@ -133,6 +145,18 @@ func initSimpleGaugeMetricMap() map[metricName]*SimpleGaugeMetric {
 				Help: "The number of currently enabled Verrazzano components",
 			}),
 		},
+		MySQLBackupSuccess: {
+			metric: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "vz_platform_operator_mysql_backup_last_success_timestamp_seconds",
+				Help: "The time of the last successful scheduled backup of the Keycloak MySQL database",
+			}),
+		},
+		MySQLBackupFailures: {
+			metric: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "vz_platform_operator_mysql_backup_failures",
+				Help: "The number of scheduled backups of the Keycloak MySQL database that failed since the last successful backup",
+			}),
+		},
 	}
 }
 
@@ -195,6 +219,20 @@ func SetComponentInstallDurationMetric(JSONName string, totalDuration int64) err
 	return nil
 }
 
+// SetCertificateExpiryMetrics replaces the certificate expiry metrics with the expiry of the given certificates
+func SetCertificateExpiryMetrics(certificates []vzapi.CertificateStatus) error {
+	notAfter := MetricsExp.internalData.certificateExpiry.notAfter
+	notAfter.Reset()
+	for _, certificate := range certificates {
+		metric, err := notAfter.GetMetricWithLabelValues(string(certificate.Kind), certificate.Namespace, certificate.Name)
+		if err != nil {
+			return err
+		}
+		metric.Set(float64(certificate.NotAfter.Unix()))
+	}
+	return nil
+}
+
 func SetComponentUpgradeDurationMetric(JSONName string, totalDuration int64) error {
 	metric, err := MetricsExp.internalData.componentUpgradeDuration.upgradeDuration.GetMetricWithLabelValues(JSONName)
 	if err != nil {
@@ -235,6 +273,7 @@ func registerMetricsHandlers(log *zap.SugaredLogger) {
 	MetricsExp.internalConfig.registry.MustRegister(MetricsExp.internalData.componentHealth.available)
 	MetricsExp.internalConfig.registry.MustRegister(MetricsExp.internalData.componentInstallDuration.installDuration)
 	MetricsExp.internalConfig.registry.MustRegister(MetricsExp.internalData.componentUpgradeDuration.upgradeDuration)
+	MetricsExp.internalConfig.registry.MustRegister(MetricsExp.internalData.certificateExpiry.notAfter)
 }
 
 // This function initializes the failedMetrics array
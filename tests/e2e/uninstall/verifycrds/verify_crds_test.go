@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package verifycrds
@@ -31,6 +31,7 @@ var verrazzanoiocrds = map[string]bool{
 	"verrazzanoweblogicworkloads.oam.verrazzano.io":                false,
 	"ocneociquickcreates.clusters.verrazzano.io":                   false,
 	"okequickcreates.clusters.verrazzano.io":                       false,
+	"dockerquickcreates.clusters.verrazzano.io":                    false,
 }
 
 // These CRDs are not deleted when using vz uninstall but are deleted when deleting the platform-operator.yaml.
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package certac
@@ -15,7 +15,6 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	aocnst "github.com/verrazzano/verrazzano/application-operator/constants"
-	"github.com/verrazzano/verrazzano/cluster-operator/controllers/vmc"
 	"github.com/verrazzano/verrazzano/pkg/constants"
 	"github.com/verrazzano/verrazzano/pkg/mcconstants"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -243,7 +242,7 @@ func reapplyManagedClusterRegManifest(newCACert string) {
 
 func waitForManifestSecretUpdated(managedClusterName string, newCACert string) {
 	start := time.Now()
-	manifestSecretName := vmc.GetManifestSecretName(managedClusterName)
+	manifestSecretName := mcconstants.GetManifestSecretName(managedClusterName)
 	gomega.Eventually(func() error {
 		manifestBytes, err := adminCluster.GetManifest(managedClusterName)
 		if err != nil {
@@ -0,0 +1,29 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package cluster
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/cluster/preview"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/cluster/register"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+)
+
+const (
+	CommandName = "cluster"
+	helpShort   = "Manage clusters"
+	helpLong    = `Manage the clusters created by the Verrazzano cluster operator, and register managed clusters with the admin cluster`
+)
+
+func NewCmdCluster(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+
+	// Add commands
+	cmd.AddCommand(preview.NewCmdClusterPreview(vzHelper))
+	cmd.AddCommand(register.NewCmdClusterRegister(vzHelper))
+	cmd.AddCommand(register.NewCmdClusterDeregister(vzHelper))
+
+	return cmd
+}
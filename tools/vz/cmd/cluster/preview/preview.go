@@ -0,0 +1,166 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package preview
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	ocipreview "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/preview"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	flagErrorStr = "error fetching flag: %s"
+	CommandName  = "preview"
+	helpShort    = "Preview the capacity and cost of a quick create cluster"
+	helpLong     = `Check that the OCI compartment of an OKEQuickCreate has the shapes and service limits to create the cluster, and estimate the monthly cost of the cluster`
+	helpExample  = `
+# Preview the capacity and cost of an OKE cluster
+vz cluster preview -f okequickcreate.yaml
+
+# Preview the cost of an OKE cluster using your own price table
+vz cluster preview -f okequickcreate.yaml --price-table prices.yaml
+`
+	priceTableFlag     = "price-table"
+	priceTableFlagHelp = "Path to a YAML price table used to estimate the cost of the cluster. The default is the built-in list prices."
+	filenameFlagHelp   = "Path to a file containing an OKEQuickCreate resource"
+)
+
+// The OCI credentials loader and client, overridden for unit testing
+var (
+	credentialsLoader oci.CredentialsLoader = oci.CredentialsLoaderImpl{}
+	newOCIClient                            = oci.NewClient
+)
+
+func NewCmdClusterPreview(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return RunCmdClusterPreview(cmd, vzHelper)
+	}
+
+	cmd.Example = helpExample
+
+	cmd.PersistentFlags().StringP(constants.FilenameFlag, constants.FilenameFlagShorthand, "", filenameFlagHelp)
+	cmd.PersistentFlags().String(priceTableFlag, "", priceTableFlagHelp)
+
+	// Verifies that the CLI args are not set at the creation of a command
+	vzHelper.VerifyCLIArgsNil(cmd)
+
+	return cmd
+}
+
+func RunCmdClusterPreview(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	filename, err := cmd.PersistentFlags().GetString(constants.FilenameFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+	if len(filename) == 0 {
+		return fmt.Errorf("A value for --%s is required", constants.FilenameFlag)
+	}
+	priceTableFile, err := cmd.PersistentFlags().GetString(priceTableFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+
+	q, err := readQuickCreate(filename)
+	if err != nil {
+		return err
+	}
+	prices, err := loadPriceTable(priceTableFile)
+	if err != nil {
+		return err
+	}
+
+	// Use the OCI credentials of the quick create identity
+	c, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	nsn := q.Spec.IdentityRef.AsNamespacedName()
+	creds, err := credentialsLoader.GetCredentialsIfAllowed(ctx, c, nsn, q.Namespace)
+	if err != nil {
+		return fmt.Errorf("Failed to access OCI credentials %s/%s: %v", nsn.Namespace, nsn.Name, err)
+	}
+	ociClient, err := newOCIClient(creds)
+	if err != nil {
+		return fmt.Errorf("Failed to create OCI client: %v", err)
+	}
+
+	report, err := ocipreview.Preview(ctx, ociClient, q.PreviewRequest(), prices)
+	if err != nil {
+		return fmt.Errorf("Failed to preview cluster %s/%s: %v", q.Namespace, q.Name, err)
+	}
+	printReport(vzHelper.GetOutputStream(), report)
+	if errs := report.Errors(); len(errs) > 0 {
+		return fmt.Errorf("The compartment does not have the capacity to create cluster %s/%s:\n%s", q.Namespace, q.Name, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func readQuickCreate(filename string) (*vmcv1alpha1.OKEQuickCreate, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read %s: %v", filename, err)
+	}
+	q := &vmcv1alpha1.OKEQuickCreate{}
+	if err := yaml.Unmarshal(data, q); err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %v", filename, err)
+	}
+	if q.Namespace == "" {
+		q.Namespace = constants.NamespaceFlagDefault
+	}
+	return q, nil
+}
+
+func loadPriceTable(filename string) (*ocipreview.PriceTable, error) {
+	if len(filename) == 0 {
+		return ocipreview.DefaultPriceTable()
+	}
+	prices, err := ocipreview.LoadPriceTableFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load price table %s: %v", filename, err)
+	}
+	return prices, nil
+}
+
+// printReport prints the shape availability, service limits and cost estimate of a cluster
+func printReport(out io.Writer, report *ocipreview.Report) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Shape availability:")
+	fmt.Fprintln(w, "NODE POOL\tSHAPE\tAVAILABILITY DOMAIN\tFAULT DOMAINS WITH CAPACITY\tAVAILABLE")
+	for _, s := range report.Shapes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", s.NodePool, s.Shape, s.AvailabilityDomain, strings.Join(s.FaultDomains, ","), s.Available)
+	}
+	fmt.Fprintln(w, "\nService limits:")
+	fmt.Fprintln(w, "LIMIT\tAVAILABILITY DOMAIN\tREQUIRED\tAVAILABLE")
+	for _, l := range report.Limits {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", l.Limit, l.AvailabilityDomain, l.Required, l.Available)
+	}
+	e := report.Estimate
+	fmt.Fprintf(w, "\nEstimated monthly cost (%s):\n", e.Currency)
+	fmt.Fprintln(w, "ITEM\tQUANTITY\tUNIT\tMONTHLY COST")
+	for _, item := range e.Items {
+		fmt.Fprintf(w, "%s\t%.1f\t%s\t%.2f\n", item.Description, item.Quantity, item.Unit, item.MonthlyCost)
+	}
+	fmt.Fprintf(w, "Total\t\t\t%.2f\n", e.MonthlyTotal())
+	if len(e.Unpriced) > 0 {
+		fmt.Fprintf(w, "\nShapes without a price are not included in the estimate: %s\n", strings.Join(e.Unpriced, ", "))
+	}
+	if len(e.Unavailable) > 0 {
+		fmt.Fprintf(w, "\nNode pools whose shape is not available are not included in the estimate: %s\n", strings.Join(e.Unavailable, ", "))
+	}
+	w.Flush()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package preview
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci"
+	ocifake "github.com/verrazzano/verrazzano/cluster-operator/controllers/quickcreate/controller/oci/fake"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	testhelpers "github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testQuickCreate = "testdata/okequickcreate.yaml"
+
+func testOCIClient(coreLimit int64) func(*oci.Credentials) (oci.Client, error) {
+	return func(_ *oci.Credentials) (oci.Client, error) {
+		return &ocifake.ClientImpl{
+			AvailabilityDomains: []oci.AvailabilityDomain{
+				{
+					Name:         "AD-1",
+					FaultDomains: []oci.FaultDomain{{Name: "FAULT-DOMAIN-1"}},
+				},
+			},
+			Shapes: map[string][]oci.Shape{
+				"AD-1": {{Name: "VM.Standard.E4.Flex", OCPUs: 1, MemoryGbs: 16}},
+			},
+			ComputeLimits: map[string]int64{
+				"standard-e4-core-count": coreLimit,
+			},
+		}, nil
+	}
+}
+
+// TestClusterPreview tests the cluster preview command
+// GIVEN an OKEQuickCreate and a compartment
+//
+//	WHEN I run the command vz cluster preview
+//	THEN expect the capacity and cost of the cluster to be reported
+func TestClusterPreview(t *testing.T) {
+	defer func() {
+		credentialsLoader = oci.CredentialsLoaderImpl{}
+		newOCIClient = oci.NewClient
+	}()
+	credentialsLoader = &ocifake.CredentialsLoaderImpl{
+		Credentials: &oci.Credentials{},
+	}
+
+	var tests = []struct {
+		name       string
+		coreLimit  int64
+		priceTable string
+		hasError   bool
+		contains   []string
+	}{
+		{
+			"compartment has capacity",
+			6,
+			"",
+			false,
+			[]string{"standard-e4-core-count", "FAULT-DOMAIN-1", "Estimated monthly cost (USD)", "Node pool test VM.Standard.E4.Flex OCPUs"},
+		},
+		{
+			"custom price table",
+			6,
+			"testdata/prices.yaml",
+			false,
+			[]string{"Estimated monthly cost (EUR)", "89.28"},
+		},
+		{
+			"compartment does not have capacity",
+			5,
+			"",
+			true,
+			[]string{"standard-e4-core-count"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newOCIClient = testOCIClient(tt.coreLimit)
+			rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+			defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+			rc.SetClient(fake.NewClientBuilder().WithScheme(helpers.NewScheme()).Build())
+			cmd := NewCmdClusterPreview(rc)
+			assert.NotNil(t, cmd)
+			assert.NoError(t, cmd.PersistentFlags().Set(constants.FilenameFlag, testQuickCreate))
+			if tt.priceTable != "" {
+				assert.NoError(t, cmd.PersistentFlags().Set(priceTableFlag, tt.priceTable))
+			}
+			err := cmd.Execute()
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			out, err := os.ReadFile(rc.Out.Name())
+			assert.NoError(t, err)
+			for _, s := range tt.contains {
+				assert.True(t, strings.Contains(string(out), s), "output should contain %s", s)
+			}
+		})
+	}
+}
+
+// TestClusterPreviewNoFile tests the cluster preview command without a file
+// GIVEN no OKEQuickCreate file
+//
+//	WHEN I run the command vz cluster preview
+//	THEN expect an error
+func TestClusterPreviewNoFile(t *testing.T) {
+	rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+	defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+	cmd := NewCmdClusterPreview(rc)
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), constants.FilenameFlag)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package register
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DeregisterCommandName = "deregister"
+	deregisterHelpShort   = "Deregister a managed cluster"
+	deregisterHelpLong    = `Deregister a managed cluster that was registered with the admin cluster.
+
+The command deletes the VerrazzanoManagedCluster resource from the admin cluster, waits for the cluster operator to
+clean up the cluster, and then removes the agent and registration secrets from the managed cluster. The admin cluster
+is selected with the --kubeconfig and --context flags.`
+	deregisterHelpExample = `
+# Deregister the cluster of the managed1 kubeconfig context from the admin cluster of the current context
+vz cluster deregister --name managed1 --managed-context managed1
+`
+)
+
+func NewCmdClusterDeregister(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, DeregisterCommandName, deregisterHelpShort, deregisterHelpLong)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return RunCmdClusterDeregister(cmd, vzHelper)
+	}
+
+	cmd.Example = deregisterHelpExample
+
+	cmd.PersistentFlags().String(constants.ClusterNameFlag, "", constants.ClusterNameFlagHelp)
+	cmd.PersistentFlags().String(constants.ManagedContextFlag, "", constants.ManagedContextFlagHelp)
+	cmd.PersistentFlags().String(constants.ManagedKubeConfigFlag, "", constants.ManagedKubeConfigFlagHelp)
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*5, constants.TimeoutFlagHelp)
+
+	// Verifies that the CLI args are not set at the creation of a command
+	vzHelper.VerifyCLIArgsNil(cmd)
+
+	return cmd
+}
+
+func RunCmdClusterDeregister(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	name, err := cmd.PersistentFlags().GetString(constants.ClusterNameFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("A value for --%s is required", constants.ClusterNameFlag)
+	}
+	timeout, err := cmd.PersistentFlags().GetDuration(constants.TimeoutFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+
+	adminClient, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+	managedClient, err := GetManagedClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	checks, err := deregisterCluster(adminClient, managedClient, name, time.Now().Add(timeout))
+	printChecks(vzHelper.GetOutputStream(), name, checks)
+	if err != nil {
+		return fmt.Errorf("Failed to deregister managed cluster %s: %v", name, err)
+	}
+	return nil
+}
+
+// deregisterCluster deregisters the managed cluster from the admin cluster and returns the result of each step
+func deregisterCluster(adminClient, managedClient client.Client, name string, deadline time.Time) ([]check, error) {
+	var checks []check
+	ctx := context.TODO()
+
+	managedCluster := &vmcv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vpoconstants.VerrazzanoMultiClusterNamespace,
+		},
+	}
+	err := adminClient.Get(ctx, client.ObjectKeyFromObject(managedCluster), managedCluster)
+	if apierrors.IsNotFound(err) {
+		checks = append(checks, check{"VerrazzanoManagedCluster", statusSkipped, "Not found in the admin cluster"})
+	} else if err != nil {
+		return append(checks, check{"VerrazzanoManagedCluster", statusFailed, err.Error()}), err
+	} else {
+		// The cluster operator cleans up the admin cluster before removing the finalizer of the VMC
+		if err := waitForDelete(ctx, adminClient, managedCluster, deadline); err != nil {
+			return append(checks, check{"VerrazzanoManagedCluster", statusFailed, err.Error()}), err
+		}
+		checks = append(checks, check{"VerrazzanoManagedCluster", statusOK, fmt.Sprintf("Deleted %s/%s", managedCluster.Namespace, managedCluster.Name)})
+	}
+
+	// Only delete the CA secret created by the register command
+	if caSecretName := caSecretPrefix + name; managedCluster.Spec.CASecret == caSecretName {
+		if err := deleteSecret(ctx, adminClient, vpoconstants.VerrazzanoMultiClusterNamespace, caSecretName); err != nil {
+			return append(checks, check{"Managed cluster CA", statusFailed, err.Error()}), err
+		}
+		checks = append(checks, check{"Managed cluster CA", statusOK, fmt.Sprintf("Deleted secret %s/%s", vpoconstants.VerrazzanoMultiClusterNamespace, caSecretName)})
+	}
+
+	// Without these secrets, the managed cluster agent no longer connects to the admin cluster
+	for _, secretName := range []string{vpoconstants.MCAgentSecret, vpoconstants.MCRegistrationSecret} {
+		if err := deleteSecret(ctx, managedClient, vpoconstants.VerrazzanoSystemNamespace, secretName); err != nil {
+			return append(checks, check{"Managed cluster secrets", statusFailed, err.Error()}), err
+		}
+	}
+	checks = append(checks, check{"Managed cluster secrets", statusOK, fmt.Sprintf("Deleted secrets %s and %s", vpoconstants.MCAgentSecret, vpoconstants.MCRegistrationSecret)})
+	return checks, nil
+}
+
+// waitForDelete deletes the VMC and waits for it to be removed
+func waitForDelete(ctx context.Context, adminClient client.Client, managedCluster *vmcv1alpha1.VerrazzanoManagedCluster, deadline time.Time) error {
+	if err := adminClient.Delete(ctx, managedCluster); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	for {
+		err := adminClient.Get(ctx, client.ObjectKeyFromObject(managedCluster), &vmcv1alpha1.VerrazzanoManagedCluster{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Timeout exceeded waiting for the cluster operator to delete %s/%s", managedCluster.Namespace, managedCluster.Name)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func deleteSecret(ctx context.Context, c client.Client, namespace, name string) error {
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return client.IgnoreNotFound(c.Delete(ctx, secret))
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package register
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/k8sutil"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	flagErrorStr = "error fetching flag: %s"
+	CommandName  = "register"
+	helpShort    = "Register a managed cluster"
+	helpLong     = `Register a managed cluster with the admin cluster, without Rancher or Cluster API.
+
+The command creates the VerrazzanoManagedCluster resource in the admin cluster, waits for the registration manifest,
+applies the manifest to the managed cluster, and then validates that the managed cluster agent, Prometheus and
+Thanos endpoints are reachable. The admin cluster is selected with the --kubeconfig and --context flags.`
+	helpExample = `
+# Register the cluster of the managed1 kubeconfig context with the admin cluster of the current context
+vz cluster register --name managed1 --managed-context managed1
+
+# Register a managed cluster using separate kubeconfig files, and wait up to 20 minutes for the agent to connect
+vz cluster register --name managed1 --kubeconfig admin.kubeconfig --managed-kubeconfig managed1.kubeconfig --timeout 20m
+`
+	descriptionFlag     = "description"
+	descriptionFlagHelp = "The description of the managed cluster"
+
+	caSecretPrefix = "ca-secret-"
+	caSecretKey    = "cacrt"
+
+	statusOK      = "OK"
+	statusFailed  = "FAILED"
+	statusSkipped = "SKIPPED"
+)
+
+// The managed cluster client, endpoint check and polling interval, overridden for unit testing
+var (
+	getManagedClient = cmdhelpers.GetClientForContext
+	checkEndpoint    = checkTLSEndpoint
+	pollInterval     = 5 * time.Second
+)
+
+// check is the result of a registration step, reported in the status summary
+type check struct {
+	name   string
+	status string
+	detail string
+}
+
+func NewCmdClusterRegister(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return RunCmdClusterRegister(cmd, vzHelper)
+	}
+
+	cmd.Example = helpExample
+
+	cmd.PersistentFlags().String(constants.ClusterNameFlag, "", constants.ClusterNameFlagHelp)
+	cmd.PersistentFlags().String(descriptionFlag, "", descriptionFlagHelp)
+	cmd.PersistentFlags().String(constants.ManagedContextFlag, "", constants.ManagedContextFlagHelp)
+	cmd.PersistentFlags().String(constants.ManagedKubeConfigFlag, "", constants.ManagedKubeConfigFlagHelp)
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*10, constants.TimeoutFlagHelp)
+
+	// Verifies that the CLI args are not set at the creation of a command
+	vzHelper.VerifyCLIArgsNil(cmd)
+
+	return cmd
+}
+
+func RunCmdClusterRegister(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	name, err := cmd.PersistentFlags().GetString(constants.ClusterNameFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("A value for --%s is required", constants.ClusterNameFlag)
+	}
+	description, err := cmd.PersistentFlags().GetString(descriptionFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+	timeout, err := cmd.PersistentFlags().GetDuration(constants.TimeoutFlag)
+	if err != nil {
+		return fmt.Errorf(flagErrorStr, err.Error())
+	}
+
+	adminClient, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+	managedClient, err := GetManagedClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	checks, err := registerCluster(adminClient, managedClient, name, description, time.Now().Add(timeout))
+	printChecks(vzHelper.GetOutputStream(), name, checks)
+	if err != nil {
+		return fmt.Errorf("Failed to register managed cluster %s: %v", name, err)
+	}
+	return nil
+}
+
+// GetManagedClient returns a client for the managed cluster selected by the --managed-context and --managed-kubeconfig
+// flags. The kubeconfig file of the admin cluster is used if --managed-kubeconfig is not specified.
+func GetManagedClient(cmd *cobra.Command) (client.Client, error) {
+	managedContext, err := cmd.PersistentFlags().GetString(constants.ManagedContextFlag)
+	if err != nil {
+		return nil, fmt.Errorf(flagErrorStr, err.Error())
+	}
+	managedKubeConfig, err := cmd.PersistentFlags().GetString(constants.ManagedKubeConfigFlag)
+	if err != nil {
+		return nil, fmt.Errorf(flagErrorStr, err.Error())
+	}
+	if len(managedContext) == 0 && len(managedKubeConfig) == 0 {
+		return nil, fmt.Errorf("A value for --%s or --%s is required", constants.ManagedContextFlag, constants.ManagedKubeConfigFlag)
+	}
+	if len(managedKubeConfig) == 0 {
+		managedKubeConfig, err = cmd.Flags().GetString(constants.GlobalFlagKubeConfig)
+		if err != nil {
+			return nil, fmt.Errorf(flagErrorStr, err.Error())
+		}
+	}
+	return getManagedClient(managedKubeConfig, managedContext)
+}
+
+// registerCluster registers the managed cluster with the admin cluster and returns the result of each registration step
+func registerCluster(adminClient, managedClient client.Client, name, description string, deadline time.Time) ([]check, error) {
+	var checks []check
+	ctx := context.TODO()
+
+	// Trust the managed cluster CA in the admin cluster if the managed cluster uses a private CA
+	caSecretName, caData, err := syncCASecret(ctx, adminClient, managedClient, name)
+	if err != nil {
+		return append(checks, check{"Managed cluster CA", statusFailed, err.Error()}), err
+	}
+	if len(caSecretName) > 0 {
+		checks = append(checks, check{"Managed cluster CA", statusOK, fmt.Sprintf("Created secret %s/%s", vpoconstants.VerrazzanoMultiClusterNamespace, caSecretName)})
+	} else {
+		checks = append(checks, check{"Managed cluster CA", statusSkipped, "The managed cluster uses trusted certificates"})
+	}
+
+	managedCluster := &vmcv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vpoconstants.VerrazzanoMultiClusterNamespace,
+		},
+	}
+	registrationStart := metav1.Now().Rfc3339Copy()
+	if _, err := controllerutil.CreateOrUpdate(ctx, adminClient, managedCluster, func() error {
+		if len(description) > 0 {
+			managedCluster.Spec.Description = description
+		}
+		if len(caSecretName) > 0 {
+			managedCluster.Spec.CASecret = caSecretName
+		}
+		return nil
+	}); err != nil {
+		return append(checks, check{"VerrazzanoManagedCluster", statusFailed, err.Error()}), err
+	}
+	checks = append(checks, check{"VerrazzanoManagedCluster", statusOK, fmt.Sprintf("Created %s/%s", managedCluster.Namespace, managedCluster.Name)})
+
+	// Wait for the cluster operator to generate the registration manifest
+	manifest, err := waitForManifest(ctx, adminClient, managedCluster, deadline)
+	if err != nil {
+		return append(checks, check{"Registration manifest", statusFailed, err.Error()}), err
+	}
+	checks = append(checks, check{"Registration manifest", statusOK, "Generated by the cluster operator"})
+
+	applier := k8sutil.NewYAMLApplier(managedClient, "")
+	if err := applier.ApplyS(string(manifest)); err != nil {
+		return append(checks, check{"Manifest applied", statusFailed, err.Error()}), err
+	}
+	checks = append(checks, check{"Manifest applied", statusOK, fmt.Sprintf("Applied %d objects to the managed cluster", len(applier.Objects()))})
+
+	// The agent heartbeat updates the last agent connect time of the VMC
+	managedCluster, err = waitForAgent(ctx, adminClient, managedCluster, registrationStart.Time, deadline)
+	if err != nil {
+		return append(checks, check{"Agent heartbeat", statusFailed, err.Error()}), err
+	}
+	checks = append(checks, check{"Agent heartbeat", statusOK, fmt.Sprintf("Last connected at %s", managedCluster.Status.LastAgentConnectTime.Format(time.RFC3339))})
+
+	var endpointErr error
+	for _, endpoint := range []struct {
+		name string
+		host string
+	}{
+		{"Prometheus endpoint", managedCluster.Status.PrometheusHost},
+		{"Thanos endpoint", managedCluster.Status.ThanosQueryStore},
+	} {
+		if len(endpoint.host) == 0 {
+			checks = append(checks, check{endpoint.name, statusSkipped, "Not reported by the managed cluster"})
+			continue
+		}
+		if err := checkEndpoint(endpoint.host, caData); err != nil {
+			checks = append(checks, check{endpoint.name, statusFailed, fmt.Sprintf("%s is not reachable: %v", endpoint.host, err)})
+			endpointErr = fmt.Errorf("%s %s is not reachable: %v", endpoint.name, endpoint.host, err)
+			continue
+		}
+		checks = append(checks, check{endpoint.name, statusOK, endpoint.host})
+	}
+	return checks, endpointErr
+}
+
+// syncCASecret creates the CA secret of the managed cluster in the admin cluster if the managed cluster uses a private
+// CA, and returns the name of the secret and the CA certificate. The name is empty if the managed cluster uses trusted
+// certificates.
+func syncCASecret(ctx context.Context, adminClient, managedClient client.Client, name string) (string, []byte, error) {
+	// If the verrazzano-tls-ca secret exists, the managed cluster uses a private CA
+	err := managedClient.Get(ctx, types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: vzconstants.PrivateCABundle}, &corev1.Secret{})
+	if apierrors.IsNotFound(err) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	tlsSecret := &corev1.Secret{}
+	if err := managedClient.Get(ctx, types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: vzconstants.VerrazzanoIngressTLSSecret}, tlsSecret); err != nil {
+		return "", nil, err
+	}
+	caData := tlsSecret.Data[mcconstants.CaCrtKey]
+	if len(caData) == 0 {
+		return "", nil, fmt.Errorf("The CA certificate of the managed cluster was not found in secret %s/%s", tlsSecret.Namespace, tlsSecret.Name)
+	}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      caSecretPrefix + name,
+			Namespace: vpoconstants.VerrazzanoMultiClusterNamespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, adminClient, caSecret, func() error {
+		caSecret.Data = map[string][]byte{caSecretKey: caData}
+		return nil
+	}); err != nil {
+		return "", nil, err
+	}
+	return caSecret.Name, caData, nil
+}
+
+// waitForManifest waits for the registration manifest of the managed cluster to be generated and returns it
+func waitForManifest(ctx context.Context, adminClient client.Client, managedCluster *vmcv1alpha1.VerrazzanoManagedCluster, deadline time.Time) ([]byte, error) {
+	secretName := mcconstants.GetManifestSecretName(managedCluster.Name)
+	for {
+		secret := &corev1.Secret{}
+		err := adminClient.Get(ctx, types.NamespacedName{Namespace: managedCluster.Namespace, Name: secretName}, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if manifest := secret.Data[mcconstants.YamlKey]; len(manifest) > 0 {
+			return manifest, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timeout exceeded waiting for the manifest secret %s/%s", managedCluster.Namespace, secretName)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// waitForAgent waits for the agent of the managed cluster to connect to the admin cluster after the registration
+// started, and returns the updated VMC
+func waitForAgent(ctx context.Context, adminClient client.Client, managedCluster *vmcv1alpha1.VerrazzanoManagedCluster, since time.Time, deadline time.Time) (*vmcv1alpha1.VerrazzanoManagedCluster, error) {
+	for {
+		updated := &vmcv1alpha1.VerrazzanoManagedCluster{}
+		if err := adminClient.Get(ctx, client.ObjectKeyFromObject(managedCluster), updated); err != nil {
+			return nil, err
+		}
+		if connectTime := updated.Status.LastAgentConnectTime; connectTime != nil && !connectTime.Time.Before(since) {
+			return updated, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timeout exceeded waiting for the managed cluster agent to connect")
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// checkTLSEndpoint checks that a TLS connection can be established with an endpoint, trusting the CA of the managed
+// cluster if there is one. Endpoints without a port use the HTTPS port.
+func checkTLSEndpoint(endpoint string, caData []byte) error {
+	address := endpoint
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		address = net.JoinHostPort(endpoint, "443")
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(caData) > 0 {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(caData)
+		config.RootCAs = pool
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 10 * time.Second}, "tcp", address, config)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func printChecks(out io.Writer, name string, checks []check) {
+	_, _ = fmt.Fprintf(out, "Managed cluster %s:\n", name)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, c := range checks {
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", c.name, c.status, c.detail)
+	}
+	_ = w.Flush()
+}
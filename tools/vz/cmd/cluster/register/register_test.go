@@ -0,0 +1,242 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package register
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	vmcv1alpha1 "github.com/verrazzano/verrazzano/cluster-operator/apis/clusters/v1alpha1"
+	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/pkg/mcconstants"
+	vpoconstants "github.com/verrazzano/verrazzano/platform-operator/constants"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	testhelpers "github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testClusterName = "managed1"
+	testManifest    = `apiVersion: v1
+kind: Secret
+metadata:
+  name: verrazzano-cluster-registration
+  namespace: verrazzano-system
+data:
+  managed-cluster-name: bWFuYWdlZDE=
+`
+	testCA = "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n"
+)
+
+func testOverrides(managedClient client.Client, endpointErr error) func() {
+	getManagedClient = func(_ string, _ string) (client.Client, error) {
+		return managedClient, nil
+	}
+	checkEndpoint = func(_ string, _ []byte) error {
+		return endpointErr
+	}
+	pollInterval = time.Millisecond
+	return func() {
+		getManagedClient = cmdhelpers.GetClientForContext
+		checkEndpoint = checkTLSEndpoint
+		pollInterval = 5 * time.Second
+	}
+}
+
+func testVMC(lastAgentConnectTime time.Time) *vmcv1alpha1.VerrazzanoManagedCluster {
+	connectTime := metav1.NewTime(lastAgentConnectTime)
+	return &vmcv1alpha1.VerrazzanoManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testClusterName,
+			Namespace: vpoconstants.VerrazzanoMultiClusterNamespace,
+		},
+		Spec: vmcv1alpha1.VerrazzanoManagedClusterSpec{
+			CASecret: caSecretPrefix + testClusterName,
+		},
+		Status: vmcv1alpha1.VerrazzanoManagedClusterStatus{
+			LastAgentConnectTime: &connectTime,
+			PrometheusHost:       "prometheus.vmi.system.managed1.example.com",
+		},
+	}
+}
+
+func testSecret(namespace, name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Data: data,
+	}
+}
+
+func testRunCommand(newCmd func(helpers.VZHelper) *cobra.Command, adminClient client.Client, args ...string) (string, error) {
+	buf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	rc := testhelpers.NewFakeRootCmdContext(genericclioptions.IOStreams{In: os.Stdin, Out: buf, ErrOut: errBuf})
+	rc.SetClient(adminClient)
+	cmd := newCmd(rc)
+	// The global kubeconfig flag is defined by the root command
+	cmd.Flags().String(constants.GlobalFlagKubeConfig, "", constants.GlobalFlagKubeConfigHelp)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+// TestClusterRegister tests the cluster register command
+// GIVEN an admin cluster and a managed cluster that uses a private CA
+//
+//	WHEN I run the command vz cluster register
+//	THEN expect the manifest to be applied to the managed cluster and a status summary to be printed
+func TestClusterRegister(t *testing.T) {
+	var tests = []struct {
+		name         string
+		adminObjects []client.Object
+		timeout      string
+		endpointErr  error
+		hasError     bool
+		contains     []string
+	}{
+		{
+			"register a managed cluster",
+			[]client.Object{
+				testVMC(time.Now().Add(time.Hour)),
+				testSecret(vpoconstants.VerrazzanoMultiClusterNamespace, "verrazzano-cluster-managed1-manifest", map[string][]byte{mcconstants.YamlKey: []byte(testManifest)}),
+			},
+			"1m",
+			nil,
+			false,
+			[]string{"Manifest applied", "Applied 1 objects", "Agent heartbeat", "Prometheus endpoint", "Thanos endpoint", "SKIPPED"},
+		},
+		{
+			"timeout waiting for the manifest",
+			nil,
+			"0s",
+			nil,
+			true,
+			[]string{"Registration manifest", "FAILED"},
+		},
+		{
+			"timeout waiting for the agent",
+			[]client.Object{
+				testVMC(time.Now().Add(-time.Hour)),
+				testSecret(vpoconstants.VerrazzanoMultiClusterNamespace, "verrazzano-cluster-managed1-manifest", map[string][]byte{mcconstants.YamlKey: []byte(testManifest)}),
+			},
+			"0s",
+			nil,
+			true,
+			[]string{"Manifest applied", "Agent heartbeat", "FAILED"},
+		},
+		{
+			"unreachable Prometheus endpoint",
+			[]client.Object{
+				testVMC(time.Now().Add(time.Hour)),
+				testSecret(vpoconstants.VerrazzanoMultiClusterNamespace, "verrazzano-cluster-managed1-manifest", map[string][]byte{mcconstants.YamlKey: []byte(testManifest)}),
+			},
+			"1m",
+			fmt.Errorf("connection refused"),
+			true,
+			[]string{"Prometheus endpoint", "connection refused"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			managedClient := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(
+				testSecret(vpoconstants.VerrazzanoSystemNamespace, vzconstants.PrivateCABundle, nil),
+				testSecret(vpoconstants.VerrazzanoSystemNamespace, vzconstants.VerrazzanoIngressTLSSecret, map[string][]byte{mcconstants.CaCrtKey: []byte(testCA)}),
+			).Build()
+			adminClient := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(tt.adminObjects...).Build()
+			defer testOverrides(managedClient, tt.endpointErr)()
+
+			out, err := testRunCommand(NewCmdClusterRegister, adminClient,
+				"--"+constants.ClusterNameFlag, testClusterName,
+				"--"+constants.ManagedKubeConfigFlag, "managed.kubeconfig",
+				"--"+constants.TimeoutFlag, tt.timeout)
+			if tt.hasError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				// The manifest is applied to the managed cluster
+				assert.NoError(t, managedClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: vpoconstants.MCRegistrationSecret}, &corev1.Secret{}))
+			}
+			for _, s := range tt.contains {
+				assert.Contains(t, out, s)
+			}
+
+			// The CA of the managed cluster is trusted by the admin cluster
+			caSecret := &corev1.Secret{}
+			assert.NoError(t, adminClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoMultiClusterNamespace, Name: caSecretPrefix + testClusterName}, caSecret))
+			assert.Equal(t, testCA, string(caSecret.Data[caSecretKey]))
+			vmc := &vmcv1alpha1.VerrazzanoManagedCluster{}
+			assert.NoError(t, adminClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoMultiClusterNamespace, Name: testClusterName}, vmc))
+			assert.Equal(t, caSecretPrefix+testClusterName, vmc.Spec.CASecret)
+		})
+	}
+}
+
+// TestClusterRegisterMissingFlags tests the cluster register command without the required flags
+// GIVEN no cluster name or managed cluster context
+//
+//	WHEN I run the command vz cluster register
+//	THEN expect an error
+func TestClusterRegisterMissingFlags(t *testing.T) {
+	adminClient := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).Build()
+	_, err := testRunCommand(NewCmdClusterRegister, adminClient)
+	assert.ErrorContains(t, err, constants.ClusterNameFlag)
+	_, err = testRunCommand(NewCmdClusterRegister, adminClient,
+		"--"+constants.ClusterNameFlag, testClusterName)
+	assert.ErrorContains(t, err, constants.ManagedContextFlag)
+}
+
+// TestClusterDeregister tests the cluster deregister command
+// GIVEN a registered managed cluster
+//
+//	WHEN I run the command vz cluster deregister
+//	THEN expect the VMC and CA secret to be deleted from the admin cluster, and the agent secrets from the managed cluster
+func TestClusterDeregister(t *testing.T) {
+	managedClient := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(
+		testSecret(vpoconstants.VerrazzanoSystemNamespace, vpoconstants.MCAgentSecret, nil),
+		testSecret(vpoconstants.VerrazzanoSystemNamespace, vpoconstants.MCRegistrationSecret, nil),
+	).Build()
+	adminClient := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(
+		testVMC(time.Now()),
+		testSecret(vpoconstants.VerrazzanoMultiClusterNamespace, caSecretPrefix+testClusterName, nil),
+	).Build()
+	defer testOverrides(managedClient, nil)()
+
+	out, err := testRunCommand(NewCmdClusterDeregister, adminClient,
+		"--"+constants.ClusterNameFlag, testClusterName,
+		"--"+constants.ManagedContextFlag, "managed1")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Deleted secrets")
+
+	err = adminClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoMultiClusterNamespace, Name: testClusterName}, &vmcv1alpha1.VerrazzanoManagedCluster{})
+	assert.True(t, apierrors.IsNotFound(err))
+	err = adminClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoMultiClusterNamespace, Name: caSecretPrefix + testClusterName}, &corev1.Secret{})
+	assert.True(t, apierrors.IsNotFound(err))
+	for _, name := range []string{vpoconstants.MCAgentSecret, vpoconstants.MCRegistrationSecret} {
+		err = managedClient.Get(context.TODO(), types.NamespacedName{Namespace: vpoconstants.VerrazzanoSystemNamespace, Name: name}, &corev1.Secret{})
+		assert.True(t, apierrors.IsNotFound(err))
+	}
+
+	// Deregistering again is not an error
+	_, err = testRunCommand(NewCmdClusterDeregister, adminClient,
+		"--"+constants.ClusterNameFlag, testClusterName,
+		"--"+constants.ManagedContextFlag, "managed1")
+	assert.NoError(t, err)
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2023, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package helpers
@@ -89,6 +89,16 @@ func getKubeConfigGivenCommand(cmd *cobra.Command) (*rest.Config, error) {
 	return config, err
 }
 
+// GetClientForContext - return a Kubernetes controller runtime client for a kubeconfig context other than the
+// one selected by the --context flag, for commands that operate on more than one cluster
+func GetClientForContext(kubeConfigLoc string, context string) (client.Client, error) {
+	config, err := k8sutil.GetKubeConfigGivenPathAndContext(kubeConfigLoc, context)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: helpers.NewScheme()})
+}
+
 // GetHTTPClient - return an HTTP client
 func (rc *RootCmdContext) GetHTTPClient() *http.Client {
 	return &http.Client{}
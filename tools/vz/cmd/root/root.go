@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package root
@@ -7,9 +7,11 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/analyze"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/bugreport"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/cluster"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/export"
 	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/install"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/rotateca"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/sanitize"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/status"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/uninstall"
@@ -46,6 +48,8 @@ func NewRootCmd(vzHelper helpers.VZHelper) *cobra.Command {
 	cmd.AddCommand(bugreport.NewCmdBugReport(vzHelper))
 	cmd.AddCommand(export.NewCmdExport(vzHelper))
 	cmd.AddCommand(sanitize.NewCmdSanitize(vzHelper))
+	cmd.AddCommand(cluster.NewCmdCluster(vzHelper))
+	cmd.AddCommand(rotateca.NewCmdRotateCA(vzHelper))
 
 	return cmd
 }
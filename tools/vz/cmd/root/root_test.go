@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package root
@@ -12,8 +12,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/analyze"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/bugreport"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/cluster"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/export"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/install"
+	"github.com/verrazzano/verrazzano/tools/vz/cmd/rotateca"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/status"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/uninstall"
 	"github.com/verrazzano/verrazzano/tools/vz/cmd/upgrade"
@@ -30,7 +32,7 @@ func TestNewRootCmd(t *testing.T) {
 	assert.NotNil(t, rootCmd)
 
 	// Verify the expected commands are defined
-	assert.Len(t, rootCmd.Commands(), 9)
+	assert.Len(t, rootCmd.Commands(), 11)
 	foundCount := 0
 	for _, cmd := range rootCmd.Commands() {
 		switch cmd.Name() {
@@ -50,9 +52,13 @@ func TestNewRootCmd(t *testing.T) {
 			foundCount++
 		case export.CommandName:
 			foundCount++
+		case cluster.CommandName:
+			foundCount++
+		case rotateca.CommandName:
+			foundCount++
 		}
 	}
-	assert.Equal(t, 8, foundCount)
+	assert.Equal(t, 10, foundCount)
 
 	// Verify the expected global flags are defined
 	assert.NotNil(t, rootCmd.PersistentFlags().Lookup(constants.GlobalFlagKubeConfig))
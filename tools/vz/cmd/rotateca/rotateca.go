@@ -0,0 +1,124 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package rotateca
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	cmdhelpers "github.com/verrazzano/verrazzano/tools/vz/cmd/helpers"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	"k8s.io/apimachinery/pkg/types"
+	clipkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	CommandName = "rotate-ca"
+	helpShort   = "Rotate the Verrazzano self-signed CA"
+	helpLong    = `The command 'rotate-ca' rotates the Verrazzano self-signed CA.
+
+A new CA is issued, and both the previous and the new CA are trusted until the certificates issued by the previous CA
+are reissued and the managed clusters have synchronized the new CA bundle. The previous CA is then retired.
+
+Only the CA issued by Verrazzano can be rotated. Custom CAs and ACME issuers are managed outside of Verrazzano.`
+	helpExample = `
+# Rotate the Verrazzano self-signed CA and wait for the rotation to complete
+vz rotate-ca
+
+# Rotate the Verrazzano self-signed CA without waiting for the rotation to complete
+vz rotate-ca --wait=false`
+)
+
+// pollInterval is the interval at which the status of the rotation is checked
+var pollInterval = constants.RefreshRate
+
+func NewCmdRotateCA(vzHelper helpers.VZHelper) *cobra.Command {
+	cmd := cmdhelpers.NewCommand(vzHelper, CommandName, helpShort, helpLong)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return runCmdRotateCA(cmd, vzHelper)
+	}
+	cmd.Example = helpExample
+	cmd.PersistentFlags().Bool(constants.WaitFlag, constants.WaitFlagDefault, "Wait for the rotation to complete. The wait period is controlled by --timeout.")
+	cmd.PersistentFlags().Duration(constants.TimeoutFlag, time.Minute*30, constants.TimeoutFlagHelp)
+
+	// Verifies that the CLI args are not set at the creation of a command
+	vzHelper.VerifyCLIArgsNil(cmd)
+
+	return cmd
+}
+
+// runCmdRotateCA - run the "vz rotate-ca" command
+func runCmdRotateCA(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
+	timeout, err := cmdhelpers.GetWaitTimeout(cmd, constants.TimeoutFlag)
+	if err != nil {
+		return err
+	}
+	client, err := vzHelper.GetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	vz, err := helpers.FindVerrazzanoResource(client)
+	if err != nil {
+		return err
+	}
+	if rotation := vz.Status.CARotation; rotation != nil && !isFinished(rotation) {
+		return fmt.Errorf("The CA rotation %s is already in progress, phase %s", rotation.ID, rotation.Phase)
+	}
+
+	// The rotation is identified by the value of the annotation, a new value requests a new rotation
+	id := strconv.FormatInt(time.Now().Unix(), 10)
+	if vz.Annotations == nil {
+		vz.Annotations = map[string]string{}
+	}
+	vz.Annotations[vzconstants.RotateCAAnnotation] = id
+	if err := client.Update(context.TODO(), vz); err != nil {
+		return fmt.Errorf("Failed to request the CA rotation: %s", err.Error())
+	}
+	fmt.Fprintf(vzHelper.GetOutputStream(), "Requested the rotation %s of the Verrazzano CA\n", id)
+
+	if timeout == 0 {
+		return nil
+	}
+	return waitForRotation(client, vzHelper, types.NamespacedName{Namespace: vz.Namespace, Name: vz.Name}, id, timeout)
+}
+
+// waitForRotation waits for the rotation with the given id to complete, reporting the phases of the rotation
+func waitForRotation(client clipkg.Client, vzHelper helpers.VZHelper, namespacedName types.NamespacedName, id string, timeout time.Duration) error {
+	startTime := time.Now()
+	var phase v1beta1.CARotationPhase
+	for {
+		vz, err := helpers.GetVerrazzanoResource(client, namespacedName)
+		if err != nil {
+			return err
+		}
+		if rotation := vz.Status.CARotation; rotation != nil && rotation.ID == id {
+			if rotation.Phase != phase {
+				phase = rotation.Phase
+				fmt.Fprintf(vzHelper.GetOutputStream(), "CA rotation phase: %s\n", phase)
+			}
+			switch phase {
+			case v1beta1.CARotationPhaseCompleted:
+				fmt.Fprintf(vzHelper.GetOutputStream(), "The Verrazzano CA %s is rotated\n", rotation.CommonName)
+				return nil
+			case v1beta1.CARotationPhaseFailed:
+				return fmt.Errorf("Failed to rotate the Verrazzano CA: %s", rotation.Message)
+			}
+		}
+		if time.Since(startTime) >= timeout {
+			return fmt.Errorf("Timeout %v exceeded waiting for the CA rotation %s to complete", timeout.String(), id)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func isFinished(rotation *v1beta1.CARotationStatus) bool {
+	return rotation.Phase == v1beta1.CARotationPhaseCompleted || rotation.Phase == v1beta1.CARotationPhaseFailed
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package rotateca
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vzconstants "github.com/verrazzano/verrazzano/pkg/constants"
+	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/constants"
+	"github.com/verrazzano/verrazzano/tools/vz/pkg/helpers"
+	testhelpers "github.com/verrazzano/verrazzano/tools/vz/test/helpers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var namespacedName = types.NamespacedName{Namespace: "default", Name: "verrazzano"}
+
+// TestRotateCACmd tests the rotate-ca command
+// GIVEN an environment with a single VZ resource
+//
+//	WHEN I run the command vz rotate-ca --wait=false
+//	THEN expect the rotate-ca annotation to be set on the VZ resource
+func TestRotateCACmd(t *testing.T) {
+	vz := newTestVerrazzano(nil)
+	c := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(vz).Build()
+
+	rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+	defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+	rc.SetClient(c)
+	cmd := NewCmdRotateCA(rc)
+	assert.NotNil(t, cmd)
+	assert.NoError(t, cmd.PersistentFlags().Set(constants.WaitFlag, "false"))
+
+	assert.NoError(t, cmd.Execute())
+	updated := &v1beta1.Verrazzano{}
+	assert.NoError(t, c.Get(context.TODO(), namespacedName, updated))
+	id := updated.Annotations[vzconstants.RotateCAAnnotation]
+	assert.NotEmpty(t, id)
+	outBytes, err := os.ReadFile(rc.Out.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, "Requested the rotation "+id+" of the Verrazzano CA\n", string(outBytes))
+}
+
+// TestRotateCACmdInProgress tests the rotate-ca command when a rotation is in progress
+// GIVEN an environment with a VZ resource being rotated
+//
+//	WHEN I run the command vz rotate-ca
+//	THEN expect an error and the rotation not to be requested again
+func TestRotateCACmdInProgress(t *testing.T) {
+	vz := newTestVerrazzano(&v1beta1.CARotationStatus{ID: "1", Phase: v1beta1.CARotationPhaseReissuingCertificates})
+	c := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(vz).Build()
+
+	rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+	defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+	rc.SetClient(c)
+	cmd := NewCmdRotateCA(rc)
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, "The CA rotation 1 is already in progress, phase ReissuingCertificates")
+	updated := &v1beta1.Verrazzano{}
+	assert.NoError(t, c.Get(context.TODO(), namespacedName, updated))
+	assert.Empty(t, updated.Annotations[vzconstants.RotateCAAnnotation])
+}
+
+// TestWaitForRotation tests waiting for a rotation
+// GIVEN a VZ resource with a completed or failed rotation
+//
+//	WHEN waiting for the rotation
+//	THEN expect success if the rotation completed, an error if it failed, and a timeout if it is another rotation
+func TestWaitForRotation(t *testing.T) {
+	defer func() { pollInterval = constants.RefreshRate }()
+	pollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		rotation *v1beta1.CARotationStatus
+		err      string
+	}{
+		{
+			name:     "completed",
+			rotation: &v1beta1.CARotationStatus{ID: "1", Phase: v1beta1.CARotationPhaseCompleted, CommonName: "verrazzano-root-ca-abcdefgh"},
+		},
+		{
+			name:     "failed",
+			rotation: &v1beta1.CARotationStatus{ID: "1", Phase: v1beta1.CARotationPhaseFailed, Message: "Only the Verrazzano self-signed CA can be rotated"},
+			err:      "Failed to rotate the Verrazzano CA: Only the Verrazzano self-signed CA can be rotated",
+		},
+		{
+			name:     "other rotation",
+			rotation: &v1beta1.CARotationStatus{ID: "0", Phase: v1beta1.CARotationPhaseCompleted},
+			err:      "Timeout 10ms exceeded waiting for the CA rotation 1 to complete",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(newTestVerrazzano(tt.rotation)).Build()
+			rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+			defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+
+			err := waitForRotation(c, rc, namespacedName, "1", 10*time.Millisecond)
+			if len(tt.err) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func newTestVerrazzano(rotation *v1beta1.CARotationStatus) *v1beta1.Verrazzano {
+	return &v1beta1.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespacedName.Namespace, Name: namespacedName.Name},
+		Status: v1beta1.VerrazzanoStatus{
+			State:      v1beta1.VzStateReady,
+			CARotation: rotation,
+		},
+	}
+}
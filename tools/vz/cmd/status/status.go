@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package status
@@ -9,6 +9,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/controllers/verrazzano/component/registry"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -24,7 +25,11 @@ const (
 	helpExample = `
 vz status
 vz status --context minikube
-vz status --kubeconfig ~/.kube/config --context minikube`
+vz status --kubeconfig ~/.kube/config --context minikube
+vz status --certs`
+
+	certsFlag     = "certs"
+	certsFlagHelp = "Include the summary of the certificates managed by Verrazzano, with the expired and expiring certificates"
 )
 
 // The component output is disabled pending the resolution some issues with
@@ -42,6 +47,18 @@ type TemplateInput struct {
 	State               string
 	Profile             string
 	AvailableComponents string
+
+	CertificatesEnabled bool
+	Certificates        *CertificatesInput
+}
+
+// CertificatesInput - the summary of the certificates managed by Verrazzano
+type CertificatesInput struct {
+	Total    int
+	Expiring int
+	Expired  int
+	// the expired and expiring certificates, the first to expire first
+	Expiries []string
 }
 
 // statusOutputTemplate - template for output of status command
@@ -61,6 +78,19 @@ Verrazzano Status
     {{ $key }}: {{ $value }}
 {{- end }}
 {{- end }}
+{{- if .CertificatesEnabled }}
+{{- with .Certificates }}
+  Certificates:
+    Total: {{.Total}}
+    Expiring: {{.Expiring}}
+    Expired: {{.Expired}}
+{{- range .Expiries }}
+    {{ . }}
+{{- end }}
+{{- else }}
+  Certificates: not inventoried yet
+{{- end }}
+{{- end }}
 {{- if .ComponentsEnabled }}
   Components:
 {{- range $key, $value := .Components }}
@@ -75,6 +105,7 @@ func NewCmdStatus(vzHelper helpers.VZHelper) *cobra.Command {
 		return runCmdStatus(cmd, vzHelper)
 	}
 	cmd.Example = helpExample
+	cmd.PersistentFlags().Bool(certsFlag, false, certsFlagHelp)
 
 	// Verifies that the CLI args are not set at the creation of a command
 	vzHelper.VerifyCLIArgsNil(cmd)
@@ -104,6 +135,8 @@ func runCmdStatus(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
 		AvailableComponents: getAvailableComponents(vz.Status.Available),
 		Profile:             getProfile(vz.Spec.Profile),
 	}
+	templateValues.CertificatesEnabled, _ = cmd.PersistentFlags().GetBool(certsFlag)
+	templateValues.Certificates = getCertificates(vz.Status.Certificates)
 	result, err := templates.ApplyTemplate(statusOutputTemplate, templateValues)
 	if err != nil {
 		return fmt.Errorf("Failed to generate %s command output: %s", CommandName, err.Error())
@@ -113,6 +146,25 @@ func runCmdStatus(cmd *cobra.Command, vzHelper helpers.VZHelper) error {
 	return nil
 }
 
+func getCertificates(certificates *v1beta1.CertificatesStatus) *CertificatesInput {
+	if certificates == nil {
+		return nil
+	}
+	values := &CertificatesInput{
+		Total:    certificates.Total,
+		Expiring: certificates.Expiring,
+		Expired:  certificates.Expired,
+	}
+	for _, certificate := range certificates.Certificates {
+		name := certificate.Name
+		if len(certificate.Namespace) > 0 {
+			name = certificate.Namespace + "/" + name
+		}
+		values.Expiries = append(values.Expiries, fmt.Sprintf("%s %s: %s", certificate.Kind, name, certificate.NotAfter.UTC().Format(time.RFC3339)))
+	}
+	return values
+}
+
 func getAvailableComponents(available *string) string {
 	if available == nil {
 		return ""
@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package status
@@ -7,6 +7,7 @@ import (
 	"github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1beta1"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	vzapi "github.com/verrazzano/verrazzano/platform-operator/apis/verrazzano/v1alpha1"
@@ -158,6 +159,54 @@ func TestStatusCmdDefaultProfile(t *testing.T) {
 	assert.Equal(t, expectedResult, result)
 }
 
+// TestStatusCmdCertificates tests the status command with the certificates summary
+// GIVEN an environment with a single VZ resource listing an expired certificate
+//
+//	WHEN I run the command vz status --certs
+//	THEN expect the certificates summary in the status report
+func TestStatusCmdCertificates(t *testing.T) {
+	vz := v1beta1.Verrazzano{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Status: v1beta1.VerrazzanoStatus{
+			Version: version,
+			State:   v1beta1.VzStateReady,
+			Certificates: &v1beta1.CertificatesStatus{
+				Total:    12,
+				Expiring: 0,
+				Expired:  1,
+				Certificates: []v1beta1.CertificateStatus{
+					{
+						Kind:      v1beta1.CertificateKindCertificate,
+						Namespace: "verrazzano-system",
+						Name:      "verrazzano-ca-certificate",
+						NotAfter:  metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+					},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(helpers.NewScheme()).WithObjects(&vz).Build()
+
+	rc := testhelpers.NewFakeRootCmdContextWithFiles(t)
+	defer testhelpers.CleanUpNewFakeRootCmdContextWithFiles(rc)
+	rc.SetClient(c)
+	statusCmd := NewCmdStatus(rc)
+	assert.NotNil(t, statusCmd)
+	assert.NoError(t, statusCmd.PersistentFlags().Set(certsFlag, "true"))
+
+	// Run the status command, check for the certificates summary to be displayed
+	err := statusCmd.Execute()
+	assert.NoError(t, err)
+	outBytes, err := os.ReadFile(rc.Out.Name())
+	assert.NoError(t, err)
+	result := string(outBytes)
+	assert.Contains(t, result, "  Certificates:\n    Total: 12\n    Expiring: 0\n    Expired: 1\n")
+	assert.Contains(t, result, "    Certificate verrazzano-system/verrazzano-ca-certificate: 2026-01-02T03:04:05Z\n")
+}
+
 // TestVZNotFound tests the status command
 // GIVEN an environment with a no VZ resources exist
 //
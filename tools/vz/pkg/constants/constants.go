@@ -1,4 +1,4 @@
-// Copyright (c) 2022, 2024, Oracle and/or its affiliates.
+// Copyright (c) 2022, 2026, Oracle and/or its affiliates.
 // Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
 
 package constants
@@ -60,6 +60,12 @@ const (
 	AutoBugReportFlagDefault     = true
 	AutoBugReportFlagHelp        = "Automatically call vz bug-report if command fails"
 	VzAnalysisReportTmpFile      = "details-*.out"
+	ClusterNameFlag              = "name"
+	ClusterNameFlagHelp          = "The name of the managed cluster"
+	ManagedContextFlag           = "managed-context"
+	ManagedContextFlagHelp       = "The name of the kubeconfig context of the managed cluster"
+	ManagedKubeConfigFlag        = "managed-kubeconfig"
+	ManagedKubeConfigFlagHelp    = "Path to the kubeconfig file of the managed cluster. The default is the kubeconfig file of the admin cluster."
 	// DatetimeFormat - suffix to vz bug report file in yyyymmddhhmmss format
 	DatetimeFormat = "20060102150405"
 )